@@ -0,0 +1,253 @@
+package min_transfers
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/ivasnev/FinFlow/ff-common/optimizers"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/utils"
+)
+
+// DefaultExactLimit - максимальное число участников с ненулевым балансом,
+// для которого выполняется точный перебор подмножеств.
+const DefaultExactLimit = 15
+
+// maxExactLimit ограничивает точный перебор: память и время растут как 2^n.
+const maxExactLimit = 22
+
+// Optimizer минимизирует число переводов без учёта исходных долговых связей.
+// Для небольших групп задача решается точно: участники разбиваются на максимальное
+// число подмножеств с нулевой суммой балансов, каждое закрывается (k-1) переводом.
+// Для больших групп используется эвристика: сначала погашаются пары с равными
+// суммами, затем крупнейший должник платит крупнейшему кредитору.
+type Optimizer struct {
+	exactLimit int
+}
+
+// Option определяет опцию конфигурации оптимизатора.
+type Option func(*Optimizer)
+
+// WithExactLimit задаёт максимальное число участников для точного перебора.
+// Значения больше 22 ограничиваются 22, отрицательные - нулём (только эвристика).
+func WithExactLimit(limit int) Option {
+	return func(o *Optimizer) {
+		switch {
+		case limit < 0:
+			o.exactLimit = 0
+		case limit > maxExactLimit:
+			o.exactLimit = maxExactLimit
+		default:
+			o.exactLimit = limit
+		}
+	}
+}
+
+// New создаёт новый экземпляр оптимизатора минимального числа переводов.
+func New(opts ...Option) *Optimizer {
+	o := &Optimizer{exactLimit: DefaultExactLimit}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// participant - участник с ненулевым балансом.
+type participant struct {
+	name    string
+	balance int
+}
+
+func (o *Optimizer) Optimize(debts []optimizers.Transfer) ([]optimizers.Transfer, error) {
+	if len(debts) == 0 {
+		return nil, nil
+	}
+
+	balances, err := utils.Balances(debts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Участники с ненулевым балансом в детерминированном порядке
+	var participants []participant
+	for _, user := range utils.Users(debts) {
+		if balances[user] != 0 {
+			participants = append(participants, participant{name: user, balance: balances[user]})
+		}
+	}
+	if len(participants) == 0 {
+		return nil, nil
+	}
+
+	if len(participants) <= o.exactLimit {
+		var transfers []optimizers.Transfer
+		for _, group := range zeroSumPartition(participants) {
+			transfers = append(transfers, settle(group)...)
+		}
+		return transfers, nil
+	}
+
+	return heuristic(participants), nil
+}
+
+// zeroSumPartition разбивает участников на максимальное число подмножеств с нулевой суммой.
+// dp[mask] - наибольшее число нулевых подмножеств, на которые можно разбить mask;
+// разбиение восстанавливается обратным проходом по удаляемым элементам.
+func zeroSumPartition(participants []participant) [][]participant {
+	n := len(participants)
+	full := 1<<n - 1
+
+	sum := make([]int, full+1)
+	for mask := 1; mask <= full; mask++ {
+		low := mask & -mask
+		i := bitIndex(low)
+		sum[mask] = sum[mask^low] + participants[i].balance
+	}
+
+	dp := make([]uint8, full+1)
+	for mask := 1; mask <= full; mask++ {
+		var best uint8
+		for rest := mask; rest > 0; rest &= rest - 1 {
+			bit := rest & -rest
+			if dp[mask^bit] > best {
+				best = dp[mask^bit]
+			}
+		}
+		if sum[mask] == 0 {
+			best++
+		}
+		dp[mask] = best
+	}
+
+	var groups [][]participant
+	var current []participant
+	for mask := full; mask > 0; {
+		if sum[mask] == 0 && len(current) > 0 {
+			groups = append(groups, current)
+			current = nil
+		}
+		bonus := uint8(0)
+		if sum[mask] == 0 {
+			bonus = 1
+		}
+		for rest := mask; rest > 0; rest &= rest - 1 {
+			bit := rest & -rest
+			if dp[mask^bit]+bonus == dp[mask] {
+				current = append(current, participants[bitIndex(bit)])
+				mask ^= bit
+				break
+			}
+		}
+	}
+	if len(current) > 0 {
+		groups = append(groups, current)
+	}
+	return groups
+}
+
+// settle закрывает группу с нулевой суммой не более чем (k-1) переводом:
+// каждый перевод обнуляет баланс хотя бы одного участника.
+func settle(group []participant) []optimizers.Transfer {
+	var debtors, creditors []participant
+	for _, p := range group {
+		if p.balance < 0 {
+			debtors = append(debtors, participant{name: p.name, balance: -p.balance})
+		} else {
+			creditors = append(creditors, p)
+		}
+	}
+	sortByBalance(debtors)
+	sortByBalance(creditors)
+
+	var transfers []optimizers.Transfer
+	i, j := 0, 0
+	for i < len(debtors) && j < len(creditors) {
+		amount := min(debtors[i].balance, creditors[j].balance)
+		transfers = append(transfers, optimizers.Transfer{From: debtors[i].name, To: creditors[j].name, Amount: amount})
+		debtors[i].balance -= amount
+		creditors[j].balance -= amount
+		if debtors[i].balance == 0 {
+			i++
+		}
+		if creditors[j].balance == 0 {
+			j++
+		}
+	}
+	return transfers
+}
+
+// heuristic сначала погашает пары должник-кредитор с равными суммами,
+// затем сводит оставшихся участников: крупнейший должник платит крупнейшему кредитору.
+func heuristic(participants []participant) []optimizers.Transfer {
+	var transfers []optimizers.Transfer
+
+	// Кредиторы, сгруппированные по сумме, для поиска точных пар
+	creditorsByAmount := make(map[int][]string)
+	for _, p := range participants {
+		if p.balance > 0 {
+			creditorsByAmount[p.balance] = append(creditorsByAmount[p.balance], p.name)
+		}
+	}
+
+	paired := make(map[string]bool)
+	var debtors []participant
+	for _, p := range participants {
+		if p.balance >= 0 {
+			continue
+		}
+		amount := -p.balance
+		if queue := creditorsByAmount[amount]; len(queue) > 0 {
+			transfers = append(transfers, optimizers.Transfer{From: p.name, To: queue[0], Amount: amount})
+			paired[queue[0]] = true
+			creditorsByAmount[amount] = queue[1:]
+			continue
+		}
+		debtors = append(debtors, participant{name: p.name, balance: amount})
+	}
+
+	var creditors []participant
+	for _, p := range participants {
+		if p.balance > 0 && !paired[p.name] {
+			creditors = append(creditors, p)
+		}
+	}
+
+	sortByBalance(debtors)
+	sortByBalance(creditors)
+	for len(debtors) > 0 && len(creditors) > 0 {
+		debtor, creditor := debtors[0], creditors[0]
+		amount := min(debtor.balance, creditor.balance)
+		transfers = append(transfers, optimizers.Transfer{From: debtor.name, To: creditor.name, Amount: amount})
+
+		debtors = reinsert(debtors[1:], participant{name: debtor.name, balance: debtor.balance - amount})
+		creditors = reinsert(creditors[1:], participant{name: creditor.name, balance: creditor.balance - amount})
+	}
+	return transfers
+}
+
+// reinsert возвращает участника с остатком в отсортированный по убыванию список.
+func reinsert(list []participant, p participant) []participant {
+	if p.balance == 0 {
+		return list
+	}
+	idx := sort.Search(len(list), func(i int) bool { return less(p, list[i]) })
+	list = append(list, participant{})
+	copy(list[idx+1:], list[idx:])
+	list[idx] = p
+	return list
+}
+
+// sortByBalance сортирует участников по убыванию суммы, при равенстве - по имени.
+func sortByBalance(list []participant) {
+	sort.Slice(list, func(i, j int) bool { return less(list[i], list[j]) })
+}
+
+func less(a, b participant) bool {
+	if a.balance != b.balance {
+		return a.balance > b.balance
+	}
+	return a.name < b.name
+}
+
+func bitIndex(bit int) int {
+	return bits.TrailingZeros(uint(bit))
+}
@@ -0,0 +1,128 @@
+package min_transfers
+
+import (
+	"testing"
+
+	"github.com/ivasnev/FinFlow/ff-common/optimizers"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/tests/testutil"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/utils/validator"
+)
+
+var _ optimizers.Optimizer = (*Optimizer)(nil)
+
+// debtsGreedyTrap: балансы a:+6, b:+4, c:-3, d:-3, e:-4.
+// Крупнейший должник крупнейшему кредитору даёт 4 перевода,
+// разбиение {a,c,d}, {b,e} - 3 перевода.
+func debtsGreedyTrap() []optimizers.Transfer {
+	return []optimizers.Transfer{
+		{From: "c", To: "b", Amount: 3},
+		{From: "d", To: "a", Amount: 3},
+		{From: "e", To: "a", Amount: 3},
+		{From: "e", To: "b", Amount: 1},
+	}
+}
+
+func assertValid(t *testing.T, debts, result []optimizers.Transfer) {
+	t.Helper()
+	v := validator.NewValidator(validator.WithBalancesCheck())
+	report := v.Validate(debts, result)
+	if !report.Valid {
+		t.Fatalf("expected valid result: %+v", report.Violations)
+	}
+}
+
+func TestMinTransfersFixtures(t *testing.T) {
+	opt := New()
+	cases := map[string][]optimizers.Transfer{
+		"direct_simple":      testutil.DebtsDirectSimple(),
+		"needs_intermediate": testutil.DebtsNeedsIntermediate(),
+		"complex_graph":      testutil.DebtsComplexGraph(),
+	}
+	for name, debts := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := opt.Optimize(debts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertValid(t, debts, result)
+		})
+	}
+}
+
+func TestMinTransfersCollapsesChain(t *testing.T) {
+	debts := testutil.DebtsNeedsIntermediate()
+	result, err := New().Optimize(debts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertValid(t, debts, result)
+	if len(result) != 1 || result[0].From != "A" || result[0].To != "C" {
+		t.Fatalf("expected single transfer A->C, got %+v", result)
+	}
+}
+
+func TestMinTransfersCycleCancels(t *testing.T) {
+	result, err := New().Optimize(testutil.DebtsTriangle())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 0 {
+		t.Fatalf("expected no transfers, got %+v", result)
+	}
+}
+
+func TestMinTransfersExactBeatsGreedy(t *testing.T) {
+	debts := debtsGreedyTrap()
+
+	exact, err := New().Optimize(debts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertValid(t, debts, exact)
+	if len(exact) != 3 {
+		t.Fatalf("expected 3 transfers, got %d: %+v", len(exact), exact)
+	}
+
+	// Эвристика на тех же данных остаётся корректной, но не оптимальной
+	heuristicResult, err := New(WithExactLimit(0)).Optimize(debts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertValid(t, debts, heuristicResult)
+	if len(heuristicResult) < len(exact) {
+		t.Fatalf("heuristic cannot beat exact search: %d < %d", len(heuristicResult), len(exact))
+	}
+}
+
+func TestMinTransfersHeuristicPairsEqualAmounts(t *testing.T) {
+	debts := []optimizers.Transfer{
+		{From: "a", To: "x", Amount: 7},
+		{From: "x", To: "b", Amount: 7},
+		{From: "c", To: "d", Amount: 5},
+	}
+	result, err := New(WithExactLimit(0)).Optimize(debts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertValid(t, debts, result)
+	if len(result) != 2 {
+		t.Fatalf("expected 2 transfers, got %+v", result)
+	}
+}
+
+func TestMinTransfersEmpty(t *testing.T) {
+	result, err := New().Optimize(testutil.DebtsEmpty())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != nil {
+		t.Fatalf("expected nil result, got %+v", result)
+	}
+}
+
+func TestMinTransfersInvalidAmount(t *testing.T) {
+	_, err := New().Optimize([]optimizers.Transfer{{From: "A", To: "B", Amount: -1}})
+	if err == nil {
+		t.Fatal("expected error for negative amount")
+	}
+}
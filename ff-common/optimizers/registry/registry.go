@@ -0,0 +1,148 @@
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ivasnev/FinFlow/ff-common/optimizers"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/dinic"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/edmonds_karp"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/greedy"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/interactive_maxflow"
+	mfd "github.com/ivasnev/FinFlow/ff-common/optimizers/maxflow/dinic"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/min_transfers"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/pushrelabel"
+)
+
+// Имена встроенных алгоритмов оптимизации.
+const (
+	Greedy             = "greedy"
+	Dinic              = "dinic"
+	EdmondsKarp        = "edmonds_karp"
+	PushRelabel        = "pushrelabel"
+	InteractiveMaxflow = "interactive_maxflow"
+	MinTransfers       = "min_transfers"
+
+	// DefaultAlgorithm - алгоритм, используемый, если другой не выбран.
+	DefaultAlgorithm = Dinic
+)
+
+// Factory создаёт новый экземпляр оптимизатора.
+type Factory func() optimizers.Optimizer
+
+// UnknownAlgorithmError указывает, что алгоритм с таким именем не зарегистрирован.
+type UnknownAlgorithmError struct {
+	Name string
+}
+
+func (e UnknownAlgorithmError) Error() string {
+	return fmt.Sprintf("unknown optimization algorithm: %q", e.Name)
+}
+
+// Registry хранит фабрики оптимизаторов по именам алгоритмов.
+// Безопасен для конкурентного использования.
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+// New создаёт пустой реестр.
+func New() *Registry {
+	return &Registry{factories: make(map[string]Factory)}
+}
+
+// NewDefault создаёт реестр со всеми встроенными алгоритмами.
+func NewDefault() *Registry {
+	r := New()
+	r.MustRegister(Greedy, func() optimizers.Optimizer { return greedy.New() })
+	r.MustRegister(Dinic, func() optimizers.Optimizer { return dinic.New() })
+	r.MustRegister(EdmondsKarp, func() optimizers.Optimizer { return edmonds_karp.New() })
+	r.MustRegister(PushRelabel, func() optimizers.Optimizer { return pushrelabel.New() })
+	r.MustRegister(InteractiveMaxflow, func() optimizers.Optimizer { return interactive_maxflow.New(mfd.Solver{}) })
+	r.MustRegister(MinTransfers, func() optimizers.Optimizer { return min_transfers.New() })
+	return r
+}
+
+// Register добавляет алгоритм в реестр. Имя должно быть непустым и уникальным.
+func (r *Registry) Register(name string, factory Factory) error {
+	if name == "" {
+		return fmt.Errorf("optimization algorithm name is empty")
+	}
+	if factory == nil {
+		return fmt.Errorf("optimization algorithm %q has nil factory", name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.factories[name]; ok {
+		return fmt.Errorf("optimization algorithm %q is already registered", name)
+	}
+	r.factories[name] = factory
+	return nil
+}
+
+// MustRegister регистрирует алгоритм и паникует при ошибке.
+func (r *Registry) MustRegister(name string, factory Factory) {
+	if err := r.Register(name, factory); err != nil {
+		panic(err)
+	}
+}
+
+// Get возвращает новый оптимизатор по имени алгоритма.
+// Пустое имя означает алгоритм по умолчанию.
+func (r *Registry) Get(name string) (optimizers.Optimizer, error) {
+	if name == "" {
+		name = DefaultAlgorithm
+	}
+
+	r.mu.RLock()
+	factory, ok := r.factories[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, UnknownAlgorithmError{Name: name}
+	}
+	return factory(), nil
+}
+
+// Has сообщает, зарегистрирован ли алгоритм.
+func (r *Registry) Has(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.factories[name]
+	return ok
+}
+
+// Names возвращает отсортированный список зарегистрированных алгоритмов.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var defaultRegistry = NewDefault()
+
+// Register добавляет алгоритм в реестр по умолчанию.
+func Register(name string, factory Factory) error {
+	return defaultRegistry.Register(name, factory)
+}
+
+// Get возвращает оптимизатор из реестра по умолчанию.
+func Get(name string) (optimizers.Optimizer, error) {
+	return defaultRegistry.Get(name)
+}
+
+// Has сообщает, зарегистрирован ли алгоритм в реестре по умолчанию.
+func Has(name string) bool {
+	return defaultRegistry.Has(name)
+}
+
+// Names возвращает алгоритмы реестра по умолчанию.
+func Names() []string {
+	return defaultRegistry.Names()
+}
@@ -0,0 +1,78 @@
+package registry
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ivasnev/FinFlow/ff-common/optimizers"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/greedy"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/tests/testutil"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/utils/validator"
+)
+
+func TestDefaultRegistryContainsBuiltins(t *testing.T) {
+	want := []string{Dinic, EdmondsKarp, Greedy, InteractiveMaxflow, MinTransfers, PushRelabel}
+	if got := Names(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected algorithms: %v, want %v", got, want)
+	}
+}
+
+func TestBuiltinsProduceValidResult(t *testing.T) {
+	debts := testutil.DebtsComplexGraph()
+	v := validator.NewValidator(validator.WithBalancesCheck())
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			opt, err := Get(name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			result, err := opt.Optimize(debts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if report := v.Validate(debts, result); !report.Valid {
+				t.Fatalf("invalid result: %+v", report.Violations)
+			}
+		})
+	}
+}
+
+func TestGetEmptyNameReturnsDefault(t *testing.T) {
+	opt, err := Get("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	def, _ := Get(DefaultAlgorithm)
+	if reflect.TypeOf(opt) != reflect.TypeOf(def) {
+		t.Fatalf("expected %T, got %T", def, opt)
+	}
+}
+
+func TestGetUnknownAlgorithm(t *testing.T) {
+	_, err := Get("simplex")
+	var unknown UnknownAlgorithmError
+	if !errors.As(err, &unknown) || unknown.Name != "simplex" {
+		t.Fatalf("expected UnknownAlgorithmError, got %v", err)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	r := New()
+	factory := func() optimizers.Optimizer { return greedy.New() }
+	if err := r.Register("custom", factory); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !r.Has("custom") {
+		t.Fatal("expected custom algorithm to be registered")
+	}
+	if err := r.Register("custom", factory); err == nil {
+		t.Fatal("expected error on duplicate registration")
+	}
+	if err := r.Register("", factory); err == nil {
+		t.Fatal("expected error on empty name")
+	}
+	if err := r.Register("nil", nil); err == nil {
+		t.Fatal("expected error on nil factory")
+	}
+}
@@ -15,6 +15,7 @@ ALGO_CONFIG = {
     "interactive_dinic": ("bench_interactive_dinic.json", "Random_Sweep_InteractiveMaxflow_Dinic"),
     "interactive_karp": ("bench_interactive_karp.json", "Random_Sweep_InteractiveMaxflow_EdmondsKarp"),
     "interactive_pushrelabel": ("bench_interactive_pushrelabel.json", "Random_Sweep_InteractiveMaxflow_PushRelabel"),
+    "min_transfers": ("bench_min_transfers.json", "Random_Sweep_MinTransfers"),
 }
 
 # Порядок в выводе Go: метрики по алфавиту; между полями могут быть лишние пробелы
@@ -107,7 +108,7 @@ def main():
     parser = argparse.ArgumentParser(description="Convert Go benchmark JSON to CSV datasets")
     parser.add_argument(
         "--algo",
-        choices=["greedy", "dinic", "karp", "pushrelabel", "interactive_dinic", "interactive_karp", "interactive_pushrelabel"],
+        choices=["greedy", "dinic", "karp", "pushrelabel", "interactive_dinic", "interactive_karp", "interactive_pushrelabel", "min_transfers"],
         help="Process only this algorithm",
     )
     parser.add_argument(
@@ -130,6 +131,7 @@ def main():
         "interactive_dinic": "Interactive Dinic",
         "interactive_karp": "Interactive EdmondsKarp",
         "interactive_pushrelabel": "Interactive PushRelabel",
+        "min_transfers": "MinTransfers",
     }
 
     for tag in algos_to_run:
@@ -11,6 +11,7 @@ RUN_PUSHRELABEL=0
 RUN_INTERACTIVE_DINIC=0
 RUN_INTERACTIVE_KARP=0
 RUN_INTERACTIVE_PUSHRELABEL=0
+RUN_MIN_TRANSFERS=0
 GENERATE_CSV=0
 GEN_GREEDY=0
 GEN_DINIC=0
@@ -19,6 +20,7 @@ GEN_PUSHRELABEL=0
 GEN_INTERACTIVE_DINIC=0
 GEN_INTERACTIVE_KARP=0
 GEN_INTERACTIVE_PUSHRELABEL=0
+GEN_MIN_TRANSFERS=0
 
 for arg in "$@"; do
     case "$arg" in
@@ -29,6 +31,7 @@ for arg in "$@"; do
         --bench-interactive-dinic)   RUN_INTERACTIVE_DINIC=1 ;;
         --bench-interactive-karp)    RUN_INTERACTIVE_KARP=1 ;;
         --bench-interactive-pushrelabel) RUN_INTERACTIVE_PUSHRELABEL=1 ;;
+        --bench-min-transfers)       RUN_MIN_TRANSFERS=1 ;;
         --bench-all)
             RUN_GREEDY=1
             RUN_DINIC=1
@@ -37,8 +40,9 @@ for arg in "$@"; do
             RUN_INTERACTIVE_DINIC=1
             RUN_INTERACTIVE_KARP=1
             RUN_INTERACTIVE_PUSHRELABEL=1
+            RUN_MIN_TRANSFERS=1
             ;;
-        --generate-csv)              GENERATE_CSV=1; GEN_GREEDY=1; GEN_DINIC=1; GEN_KARP=1; GEN_PUSHRELABEL=1; GEN_INTERACTIVE_DINIC=1; GEN_INTERACTIVE_KARP=1; GEN_INTERACTIVE_PUSHRELABEL=1 ;;
+        --generate-csv)              GENERATE_CSV=1; GEN_GREEDY=1; GEN_DINIC=1; GEN_KARP=1; GEN_PUSHRELABEL=1; GEN_INTERACTIVE_DINIC=1; GEN_INTERACTIVE_KARP=1; GEN_INTERACTIVE_PUSHRELABEL=1; GEN_MIN_TRANSFERS=1 ;;
         --generate-csv-greedy)       GENERATE_CSV=1; GEN_GREEDY=1 ;;
         --generate-csv-dinic)        GENERATE_CSV=1; GEN_DINIC=1 ;;
         --generate-csv-karp)         GENERATE_CSV=1; GEN_KARP=1 ;;
@@ -46,6 +50,7 @@ for arg in "$@"; do
         --generate-csv-interactive-dinic)    GENERATE_CSV=1; GEN_INTERACTIVE_DINIC=1 ;;
         --generate-csv-interactive-karp)    GENERATE_CSV=1; GEN_INTERACTIVE_KARP=1 ;;
         --generate-csv-interactive-pushrelabel) GENERATE_CSV=1; GEN_INTERACTIVE_PUSHRELABEL=1 ;;
+        --generate-csv-min-transfers) GENERATE_CSV=1; GEN_MIN_TRANSFERS=1 ;;
         -h|--help)
             echo "Usage: $0 [OPTIONS]"
             echo "Benchmarks:"
@@ -56,6 +61,7 @@ for arg in "$@"; do
             echo "  --bench-interactive-dinic    Run InteractiveMaxflow+Dinic benchmarks only"
             echo "  --bench-interactive-karp    Run InteractiveMaxflow+EdmondsKarp benchmarks only"
             echo "  --bench-interactive-pushrelabel Run InteractiveMaxflow+PushRelabel benchmarks only"
+            echo "  --bench-min-transfers        Run MinTransfers benchmarks only"
             echo "  --bench-all                  Run all benchmarks (default)"
             echo "Generate CSV from existing bench_*.json:"
             echo "  --generate-csv                       Generate all dataset_*.csv"
@@ -66,6 +72,7 @@ for arg in "$@"; do
             echo "  --generate-csv-interactive-dinic     Generate dataset_interactive_dinic.csv"
             echo "  --generate-csv-interactive-karp      Generate dataset_interactive_karp.csv"
             echo "  --generate-csv-interactive-pushrelabel Generate dataset_interactive_pushrelabel.csv"
+            echo "  --generate-csv-min-transfers         Generate dataset_min_transfers.csv"
             exit 0
             ;;
     esac
@@ -80,11 +87,12 @@ if [[ $GENERATE_CSV -eq 1 ]]; then
     [[ $GEN_INTERACTIVE_DINIC -eq 1 ]]    && python3 "$ANALITIC/bench_to_df.py" --algo interactive_dinic
     [[ $GEN_INTERACTIVE_KARP -eq 1 ]]     && python3 "$ANALITIC/bench_to_df.py" --algo interactive_karp
     [[ $GEN_INTERACTIVE_PUSHRELABEL -eq 1 ]] && python3 "$ANALITIC/bench_to_df.py" --algo interactive_pushrelabel
+    [[ $GEN_MIN_TRANSFERS -eq 1 ]]        && python3 "$ANALITIC/bench_to_df.py" --algo min_transfers
     exit 0
 fi
 
 # Если ничего не указано — запускаем всё
-if [[ $RUN_GREEDY -eq 0 && $RUN_DINIC -eq 0 && $RUN_KARP -eq 0 && $RUN_PUSHRELABEL -eq 0 && $RUN_INTERACTIVE_DINIC -eq 0 && $RUN_INTERACTIVE_KARP -eq 0 && $RUN_INTERACTIVE_PUSHRELABEL -eq 0 ]]; then
+if [[ $RUN_GREEDY -eq 0 && $RUN_DINIC -eq 0 && $RUN_KARP -eq 0 && $RUN_PUSHRELABEL -eq 0 && $RUN_INTERACTIVE_DINIC -eq 0 && $RUN_INTERACTIVE_KARP -eq 0 && $RUN_INTERACTIVE_PUSHRELABEL -eq 0 && $RUN_MIN_TRANSFERS -eq 0 ]]; then
     RUN_GREEDY=1
     RUN_DINIC=1
     RUN_KARP=1
@@ -92,6 +100,7 @@ if [[ $RUN_GREEDY -eq 0 && $RUN_DINIC -eq 0 && $RUN_KARP -eq 0 && $RUN_PUSHRELAB
     RUN_INTERACTIVE_DINIC=1
     RUN_INTERACTIVE_KARP=1
     RUN_INTERACTIVE_PUSHRELABEL=1
+    RUN_MIN_TRANSFERS=1
 fi
 
 # Список алгоритмов для последующей конвертации в CSV
@@ -103,6 +112,7 @@ ALGOS=""
 [[ $RUN_INTERACTIVE_DINIC -eq 1 ]]    && ALGOS="$ALGOS interactive_dinic"
 [[ $RUN_INTERACTIVE_KARP -eq 1 ]]     && ALGOS="$ALGOS interactive_karp"
 [[ $RUN_INTERACTIVE_PUSHRELABEL -eq 1 ]] && ALGOS="$ALGOS interactive_pushrelabel"
+[[ $RUN_MIN_TRANSFERS -eq 1 ]]        && ALGOS="$ALGOS min_transfers"
 
 # Запуск бенчмарков параллельно (каждый пишет в свой JSON)
 echo "Running benchmarks in parallel..."
@@ -113,6 +123,7 @@ echo "Running benchmarks in parallel..."
 [[ $RUN_INTERACTIVE_DINIC -eq 1 ]]    && go test -bench '^BenchmarkRandom_Sweep_InteractiveMaxflow_Dinic$' -benchmem -run=^$ -json ./tests > "$ANALITIC/bench_interactive_dinic.json" 2>&1 &
 [[ $RUN_INTERACTIVE_KARP -eq 1 ]]     && go test -bench '^BenchmarkRandom_Sweep_InteractiveMaxflow_EdmondsKarp$' -benchmem -run=^$ -json ./tests > "$ANALITIC/bench_interactive_karp.json" 2>&1 &
 [[ $RUN_INTERACTIVE_PUSHRELABEL -eq 1 ]] && go test -bench '^BenchmarkRandom_Sweep_InteractiveMaxflow_PushRelabel$' -benchmem -run=^$ -json ./tests > "$ANALITIC/bench_interactive_pushrelabel.json" 2>&1 &
+[[ $RUN_MIN_TRANSFERS -eq 1 ]]        && go test -bench '^BenchmarkRandom_Sweep_MinTransfers$' -benchmem -run=^$ -json ./tests > "$ANALITIC/bench_min_transfers.json" 2>&1 &
 
 wait
 echo "All benchmarks finished."
@@ -11,6 +11,7 @@ import (
 	"github.com/ivasnev/FinFlow/ff-common/optimizers/interactive_maxflow"
 	mfd "github.com/ivasnev/FinFlow/ff-common/optimizers/maxflow/dinic"
 	mfpr "github.com/ivasnev/FinFlow/ff-common/optimizers/maxflow/pushrelabel"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/min_transfers"
 	optpr "github.com/ivasnev/FinFlow/ff-common/optimizers/pushrelabel"
 	"github.com/ivasnev/FinFlow/ff-common/optimizers/tests/testutil"
 	"github.com/ivasnev/FinFlow/ff-common/optimizers/utils/validator"
@@ -112,3 +113,18 @@ func TestPushRelabelOptimizer(t *testing.T) {
 		assertOptimizerValid(t, testutil.DebtsComplexGraph(), opt, v)
 	})
 }
+
+func TestMinTransfersOptimizer(t *testing.T) {
+	opt := min_transfers.New()
+	// Оптимизатор создаёт новые связи, поэтому проверяются только балансы
+	v := validator.NewValidator(validator.WithBalancesCheck())
+	t.Run("direct_simple", func(t *testing.T) {
+		assertOptimizerValid(t, testutil.DebtsDirectSimple(), opt, v)
+	})
+	t.Run("needs_intermediate", func(t *testing.T) {
+		assertOptimizerValid(t, testutil.DebtsNeedsIntermediate(), opt, v)
+	})
+	t.Run("complex_graph", func(t *testing.T) {
+		assertOptimizerValid(t, testutil.DebtsComplexGraph(), opt, v)
+	})
+}
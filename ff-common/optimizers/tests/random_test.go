@@ -14,6 +14,7 @@ import (
 	mfd "github.com/ivasnev/FinFlow/ff-common/optimizers/maxflow/dinic"
 	mfek "github.com/ivasnev/FinFlow/ff-common/optimizers/maxflow/edmondskarp"
 	mfpr "github.com/ivasnev/FinFlow/ff-common/optimizers/maxflow/pushrelabel"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/min_transfers"
 	optpr "github.com/ivasnev/FinFlow/ff-common/optimizers/pushrelabel"
 	"github.com/ivasnev/FinFlow/ff-common/optimizers/utils"
 	"github.com/ivasnev/FinFlow/ff-common/optimizers/utils/validator"
@@ -198,6 +199,15 @@ func BenchmarkRandom_InteractiveMaxflow_PushRelabel(b *testing.B) {
 	reportBenchmarkMetrics(b, m)
 }
 
+func BenchmarkRandom_MinTransfers(b *testing.B) {
+	opt := min_transfers.New()
+	v := validator.NewValidator(validator.WithBalancesCheck())
+	const nodes, trans = 20, 4000
+	seed := int64(12345)
+	m := runOptimizerBenchmark(b, opt, v, nodes, trans, seed)
+	reportBenchmarkMetrics(b, m)
+}
+
 // nodesForSweep — набор узлов для sweep-бенчмарка.
 var nodesForSweep = []int{5, 10, 25, 50, 100, 200}
 
@@ -270,6 +280,12 @@ func BenchmarkRandom_Sweep_InteractiveMaxflow_PushRelabel(b *testing.B) {
 	runSweepBenchmark(b, opt, v, 12345)
 }
 
+func BenchmarkRandom_Sweep_MinTransfers(b *testing.B) {
+	opt := min_transfers.New()
+	v := validator.NewValidator(validator.WithBalancesCheck())
+	runSweepBenchmark(b, opt, v, 12345)
+}
+
 func TestRandomTransfers(t *testing.T) {
 	out := RandomTransfers(5, 20, 1, 100)
 	if len(out) != 20 {
@@ -303,3 +319,29 @@ func TestRandomTransfers_CollapseThenOptimize(t *testing.T) {
 		t.Logf("transfers: input %d -> output %d", len(input), len(result))
 	}
 }
+
+func TestRandomTransfers_MinTransfersNotWorseThanDinic(t *testing.T) {
+	v := validator.NewValidator(validator.WithBalancesCheck())
+	for seed := int64(1); seed <= 20; seed++ {
+		input, err := utils.CollapseTransfers(RandomTransfers(10, 40, seed, 200))
+		if err != nil {
+			t.Fatalf("collapse: %v", err)
+		}
+
+		exact, err := min_transfers.New().Optimize(input)
+		if err != nil {
+			t.Fatalf("optimize: %v", err)
+		}
+		if report := v.Validate(input, exact); !report.Valid {
+			t.Fatalf("seed %d: validation failed: %+v", seed, report.Violations)
+		}
+
+		flow, err := dinic.New().Optimize(input)
+		if err != nil {
+			t.Fatalf("optimize: %v", err)
+		}
+		if len(exact) > len(flow) {
+			t.Fatalf("seed %d: min_transfers produced %d transfers, dinic %d", seed, len(exact), len(flow))
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -27,11 +28,18 @@ func main() {
 	// Регистрация маршрутов
 	c.RegisterRoutes()
 
+	// Запуск фоновой обработки регулярных транзакций и очистки корзины
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	go c.RecurringWorker.Run(workerCtx)
+	go c.TrashWorker.Run(workerCtx)
+
 	// Создание и запуск приложения
 	application := app.New(router, cfg)
 
 	addr := fmt.Sprintf(":%d", cfg.Server.Port)
-	if err := application.Run(addr); err != nil {
+	err = application.Run(addr)
+	stopWorker()
+	if err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
@@ -0,0 +1,33 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrFileNotFound возвращается, если файла нет в ff-files сервисе
+var ErrFileNotFound = errors.New("файл не найден")
+
+// FilesAdapter определяет интерфейс для работы с ff-files сервисом
+type FilesAdapter interface {
+	GetFileMetadata(ctx context.Context, fileID string) (*FileMetadataDTO, error)
+	GetTemporaryURL(ctx context.Context, fileID string, expiresIn time.Duration) (*TemporaryURLDTO, error)
+	DeleteFile(ctx context.Context, fileID string) error
+}
+
+// FileMetadataDTO - метаданные файла из ff-files сервиса
+type FileMetadataDTO struct {
+	ID          string
+	Filename    string
+	ContentType string
+	Size        int64
+	OwnerID     string
+	UploadedAt  time.Time
+}
+
+// TemporaryURLDTO - временная ссылка на файл из ff-files сервиса
+type TemporaryURLDTO struct {
+	URL       string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,123 @@
+package fffiles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ivasnev/FinFlow/ff-files/pkg/api"
+	"github.com/ivasnev/FinFlow/ff-split/internal/adapters"
+)
+
+// Adapter - адаптер для работы с ff-files сервисом через сгенерированный клиент
+type Adapter struct {
+	client *api.ClientWithResponses
+}
+
+// NewAdapter создает новый адаптер для ff-files клиента
+func NewAdapter(baseURL string, httpClient *http.Client) (*Adapter, error) {
+	client, err := api.NewClientWithResponses(
+		baseURL,
+		api.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Adapter{client: client}, nil
+}
+
+// GetFileMetadata получает метаданные файла по его ID
+func (a *Adapter) GetFileMetadata(ctx context.Context, fileID string) (*adapters.FileMetadataDTO, error) {
+	id, err := uuid.Parse(fileID)
+	if err != nil {
+		return nil, adapters.ErrFileNotFound
+	}
+
+	resp, err := a.client.GetFileMetadataWithResponse(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка выполнения запроса: %w", err)
+	}
+
+	// Обработка различных статусов
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, adapters.ErrFileNotFound
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		if resp.JSON500 != nil {
+			return nil, fmt.Errorf("ошибка сервера: %s", resp.JSON500.Error)
+		}
+		return nil, fmt.Errorf("неожиданный статус: %d", resp.StatusCode())
+	}
+
+	data := resp.JSON200.Data
+	return &adapters.FileMetadataDTO{
+		ID:          data.FileId.String(),
+		Filename:    data.Filename,
+		ContentType: data.ContentType,
+		Size:        data.Size,
+		OwnerID:     data.OwnerId,
+		UploadedAt:  data.UploadDate,
+	}, nil
+}
+
+// GetTemporaryURL получает временную ссылку на файл со сроком действия expiresIn
+func (a *Adapter) GetTemporaryURL(ctx context.Context, fileID string, expiresIn time.Duration) (*adapters.TemporaryURLDTO, error) {
+	id, err := uuid.Parse(fileID)
+	if err != nil {
+		return nil, adapters.ErrFileNotFound
+	}
+
+	params := api.GenerateTemporaryUrlParams{}
+	if seconds := int(expiresIn.Seconds()); seconds > 0 {
+		params.ExpiresIn = &seconds
+	}
+
+	resp, err := a.client.GenerateTemporaryUrlWithResponse(ctx, id, &params)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка выполнения запроса: %w", err)
+	}
+
+	// Обработка различных статусов
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, adapters.ErrFileNotFound
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		if resp.JSON500 != nil {
+			return nil, fmt.Errorf("ошибка сервера: %s", resp.JSON500.Error)
+		}
+		return nil, fmt.Errorf("неожиданный статус: %d", resp.StatusCode())
+	}
+
+	return &adapters.TemporaryURLDTO{
+		URL:       resp.JSON200.Data.Url,
+		ExpiresAt: resp.JSON200.Data.ExpiresAt,
+	}, nil
+}
+
+// DeleteFile удаляет файл из ff-files сервиса
+func (a *Adapter) DeleteFile(ctx context.Context, fileID string) error {
+	id, err := uuid.Parse(fileID)
+	if err != nil {
+		return adapters.ErrFileNotFound
+	}
+
+	resp, err := a.client.DeleteFileWithResponse(ctx, id)
+	if err != nil {
+		return fmt.Errorf("ошибка выполнения запроса: %w", err)
+	}
+
+	// Обработка различных статусов
+	if resp.StatusCode() == http.StatusNotFound {
+		return adapters.ErrFileNotFound
+	}
+	if resp.StatusCode() != http.StatusOK {
+		if resp.JSON500 != nil {
+			return fmt.Errorf("ошибка сервера: %s", resp.JSON500.Error)
+		}
+		return fmt.Errorf("неожиданный статус: %d", resp.StatusCode())
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/adapters/fffiles.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	adapters "github.com/ivasnev/FinFlow/ff-split/internal/adapters"
+)
+
+// MockFilesAdapter is a mock of FilesAdapter interface.
+type MockFilesAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockFilesAdapterMockRecorder
+}
+
+// MockFilesAdapterMockRecorder is the mock recorder for MockFilesAdapter.
+type MockFilesAdapterMockRecorder struct {
+	mock *MockFilesAdapter
+}
+
+// NewMockFilesAdapter creates a new mock instance.
+func NewMockFilesAdapter(ctrl *gomock.Controller) *MockFilesAdapter {
+	mock := &MockFilesAdapter{ctrl: ctrl}
+	mock.recorder = &MockFilesAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFilesAdapter) EXPECT() *MockFilesAdapterMockRecorder {
+	return m.recorder
+}
+
+// DeleteFile mocks base method.
+func (m *MockFilesAdapter) DeleteFile(ctx context.Context, fileID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", ctx, fileID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockFilesAdapterMockRecorder) DeleteFile(ctx, fileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockFilesAdapter)(nil).DeleteFile), ctx, fileID)
+}
+
+// GetFileMetadata mocks base method.
+func (m *MockFilesAdapter) GetFileMetadata(ctx context.Context, fileID string) (*adapters.FileMetadataDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileMetadata", ctx, fileID)
+	ret0, _ := ret[0].(*adapters.FileMetadataDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileMetadata indicates an expected call of GetFileMetadata.
+func (mr *MockFilesAdapterMockRecorder) GetFileMetadata(ctx, fileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileMetadata", reflect.TypeOf((*MockFilesAdapter)(nil).GetFileMetadata), ctx, fileID)
+}
+
+// GetTemporaryURL mocks base method.
+func (m *MockFilesAdapter) GetTemporaryURL(ctx context.Context, fileID string, expiresIn time.Duration) (*adapters.TemporaryURLDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemporaryURL", ctx, fileID, expiresIn)
+	ret0, _ := ret[0].(*adapters.TemporaryURLDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemporaryURL indicates an expected call of GetTemporaryURL.
+func (mr *MockFilesAdapterMockRecorder) GetTemporaryURL(ctx, fileID, expiresIn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemporaryURL", reflect.TypeOf((*MockFilesAdapter)(nil).GetTemporaryURL), ctx, fileID, expiresIn)
+}
@@ -1,12 +1,14 @@
 package handler
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
 	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
 )
 
@@ -18,48 +20,49 @@ func (s *ServerHandler) GetActivitiesByEventID(c *gin.Context, idEvent int64) {
 		return
 	}
 
-	apiActivities := make([]api.ActivityResponse, 0, len(activities))
-	for _, a := range activities {
-		activityID := a.ID
-		iconID := a.IconID
-		apiActivities = append(apiActivities, api.ActivityResponse{
-			ActivityId:  &activityID,
-			Description: &a.Description,
-			IconId:      &iconID,
-			Datetime:    &a.CreatedAt,
-		})
-	}
-
+	apiActivities := convertActivitiesToAPI(activities)
 	c.JSON(http.StatusOK, api.ActivityListResponse{Activities: &apiActivities})
 }
 
-// GetActivityByID возвращает активность по ID
-func (s *ServerHandler) GetActivityByID(c *gin.Context, idEvent int64, idActivity int) {
-	activity, err := s.activityService.GetActivityByID(c.Request.Context(), idActivity)
+// GetActivityFeed возвращает страницу ленты активности мероприятия
+func (s *ServerHandler) GetActivityFeed(c *gin.Context, idEvent int64, params api.GetActivityFeedParams) {
+	dtoRequest := &service.ActivityFeedRequest{
+		UserID: params.UserId,
+		From:   params.From,
+		To:     params.To,
+	}
+	if params.Type != nil {
+		for _, activityType := range *params.Type {
+			dtoRequest.Types = append(dtoRequest.Types, string(activityType))
+		}
+	}
+	if params.Cursor != nil {
+		dtoRequest.Cursor = *params.Cursor
+	}
+	if params.Limit != nil {
+		dtoRequest.Limit = *params.Limit
+	}
+
+	feed, err := s.activityService.GetActivityFeed(c.Request.Context(), idEvent, dtoRequest)
 	if err != nil {
-		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении активности: %w", err))
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении ленты активности: %w", err))
 		return
 	}
 
-	if activity == nil {
-		c.JSON(http.StatusNotFound, api.ErrorResponse{
-		Id: c.GetHeader("X-Request-ID"),
-		Error: api.ErrorResponseDetail{
-			Code:    "not_found",
-			Message: "активность не найдена",
-		},
+	c.JSON(http.StatusOK, api.ActivityFeedResponse{
+		Activities: convertActivitiesToAPI(feed.Activities),
+		NextCursor: feed.NextCursor,
 	})
+}
+
+// GetActivityByID возвращает активность по ID
+func (s *ServerHandler) GetActivityByID(c *gin.Context, idEvent int64, idActivity int) {
+	activity, ok := s.getActivityInEvent(c, idEvent, idActivity)
+	if !ok {
 		return
 	}
 
-	activityID := activity.ID
-	iconID := activity.IconID
-	c.JSON(http.StatusOK, api.ActivityResponse{
-		ActivityId:  &activityID,
-		Description: &activity.Description,
-		IconId:      &iconID,
-		Datetime:    &activity.CreatedAt,
-	})
+	c.JSON(http.StatusOK, convertActivityToAPI(activity))
 }
 
 // CreateActivity создает новую активность
@@ -67,12 +70,12 @@ func (s *ServerHandler) CreateActivity(c *gin.Context, idEvent int64) {
 	var apiRequest api.ActivityRequest
 	if err := c.ShouldBindJSON(&apiRequest); err != nil {
 		c.JSON(http.StatusBadRequest, api.ErrorResponse{
-		Id: c.GetHeader("X-Request-ID"),
-		Error: api.ErrorResponseDetail{
-			Code:    "validation",
-			Message: "некорректные данные запроса",
-		},
-	})
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
 		return
 	}
 
@@ -94,27 +97,24 @@ func (s *ServerHandler) CreateActivity(c *gin.Context, idEvent int64) {
 		return
 	}
 
-	activityID := activity.ID
-	resultIconID := activity.IconID
-	c.JSON(http.StatusCreated, api.ActivityResponse{
-		ActivityId:  &activityID,
-		Description: &activity.Description,
-		IconId:      &resultIconID,
-		Datetime:    &activity.CreatedAt,
-	})
+	c.JSON(http.StatusCreated, convertActivityToAPI(activity))
 }
 
 // UpdateActivity обновляет активность
 func (s *ServerHandler) UpdateActivity(c *gin.Context, idEvent int64, idActivity int) {
+	if !s.ensureActivityInEvent(c, idEvent, idActivity) {
+		return
+	}
+
 	var apiRequest api.ActivityRequest
 	if err := c.ShouldBindJSON(&apiRequest); err != nil {
 		c.JSON(http.StatusBadRequest, api.ErrorResponse{
-		Id: c.GetHeader("X-Request-ID"),
-		Error: api.ErrorResponseDetail{
-			Code:    "validation",
-			Message: "некорректные данные запроса",
-		},
-	})
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
 		return
 	}
 
@@ -136,18 +136,15 @@ func (s *ServerHandler) UpdateActivity(c *gin.Context, idEvent int64, idActivity
 		return
 	}
 
-	activityID := activity.ID
-	resultIconID := activity.IconID
-	c.JSON(http.StatusOK, api.ActivityResponse{
-		ActivityId:  &activityID,
-		Description: &activity.Description,
-		IconId:      &resultIconID,
-		Datetime:    &activity.CreatedAt,
-	})
+	c.JSON(http.StatusOK, convertActivityToAPI(activity))
 }
 
 // DeleteActivity удаляет активность
 func (s *ServerHandler) DeleteActivity(c *gin.Context, idEvent int64, idActivity int) {
+	if !s.ensureActivityInEvent(c, idEvent, idActivity) {
+		return
+	}
+
 	err := s.activityService.DeleteActivity(c.Request.Context(), idActivity)
 	if err != nil {
 		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при удалении активности: %w", err))
@@ -156,3 +153,36 @@ func (s *ServerHandler) DeleteActivity(c *gin.Context, idEvent int64, idActivity
 
 	c.JSON(http.StatusOK, api.SuccessResponse{Success: true})
 }
+
+// Helper functions
+
+// convertActivityToAPI преобразует активность в API ответ
+func convertActivityToAPI(activity *models.Activity) api.ActivityResponse {
+	activityID := activity.ID
+	iconID := activity.IconID
+	activityType := api.ActivityType(activity.Type)
+	response := api.ActivityResponse{
+		ActivityId:  &activityID,
+		Type:        &activityType,
+		UserId:      activity.UserID,
+		Description: &activity.Description,
+		IconId:      &iconID,
+		Datetime:    &activity.CreatedAt,
+	}
+	if len(activity.Payload) > 0 {
+		var payload map[string]interface{}
+		if err := json.Unmarshal(activity.Payload, &payload); err == nil {
+			response.Payload = &payload
+		}
+	}
+	return response
+}
+
+// convertActivitiesToAPI преобразует список активностей в API ответ
+func convertActivitiesToAPI(activities []models.Activity) []api.ActivityResponse {
+	result := make([]api.ActivityResponse, 0, len(activities))
+	for i := range activities {
+		result = append(result, convertActivityToAPI(&activities[i]))
+	}
+	return result
+}
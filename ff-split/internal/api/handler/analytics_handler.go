@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+)
+
+// GetEventAnalytics возвращает аналитику расходов мероприятия
+func (s *ServerHandler) GetEventAnalytics(c *gin.Context, idEvent int64, params api.GetEventAnalyticsParams) {
+	dtoRequest := &service.AnalyticsRequest{
+		From: params.From,
+		To:   params.To,
+	}
+	if params.Interval != nil {
+		dtoRequest.Interval = string(*params.Interval)
+	}
+	if params.Top != nil {
+		dtoRequest.Top = *params.Top
+	}
+
+	analytics, err := s.analyticsService.GetEventAnalytics(c.Request.Context(), idEvent, dtoRequest)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении аналитики мероприятия: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertAnalyticsToAPI(analytics))
+}
+
+// Helper functions
+
+// convertAnalyticsToAPI преобразует аналитику мероприятия в API ответ
+func convertAnalyticsToAPI(analytics *service.EventAnalyticsDTO) api.EventAnalyticsResponse {
+	byCategory := make([]api.CategorySpendingDTO, 0, len(analytics.ByCategory))
+	for _, category := range analytics.ByCategory {
+		byCategory = append(byCategory, api.CategorySpendingDTO{
+			CategoryId:        category.CategoryID,
+			CategoryName:      category.CategoryName,
+			Amount:            category.Amount,
+			TransactionsCount: category.TransactionsCount,
+		})
+	}
+
+	byUser := make([]api.UserSpendingDTO, 0, len(analytics.ByUser))
+	for _, user := range analytics.ByUser {
+		byUser = append(byUser, api.UserSpendingDTO{
+			UserId:   user.UserID,
+			Paid:     user.Paid,
+			Consumed: user.Consumed,
+			Net:      user.Net,
+		})
+	}
+
+	timeSeries := make([]api.SpendingPointDTO, 0, len(analytics.TimeSeries))
+	for _, point := range analytics.TimeSeries {
+		timeSeries = append(timeSeries, api.SpendingPointDTO{
+			PeriodStart:       point.PeriodStart,
+			Amount:            point.Amount,
+			TransactionsCount: point.TransactionsCount,
+		})
+	}
+
+	topExpenses := make([]api.TopExpenseDTO, 0, len(analytics.TopExpenses))
+	for _, expense := range analytics.TopExpenses {
+		topExpenses = append(topExpenses, api.TopExpenseDTO{
+			TransactionId: expense.TransactionID,
+			Name:          expense.Name,
+			CategoryId:    expense.CategoryID,
+			Datetime:      expense.Datetime,
+			PayerId:       expense.PayerID,
+			Amount:        expense.Amount,
+		})
+	}
+
+	return api.EventAnalyticsResponse{
+		EventId:           analytics.EventID,
+		Currency:          analytics.Currency,
+		Interval:          api.AnalyticsInterval(analytics.Interval),
+		Total:             analytics.Total,
+		TransactionsCount: analytics.TransactionsCount,
+		ByCategory:        byCategory,
+		ByUser:            byUser,
+		TimeSeries:        timeSeries,
+		TopExpenses:       topExpenses,
+	}
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-auth/pkg/auth"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+)
+
+// CreateDummyClaim подает заявку авторизованного пользователя на dummy-пользователя мероприятия
+func (s *ServerHandler) CreateDummyClaim(c *gin.Context, idEvent int64, idUser int64) {
+	ctx := c.Request.Context()
+
+	userData, exists := auth.GetUserData(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "unauthorized",
+				Message: "пользователь не авторизован",
+			},
+		})
+		return
+	}
+
+	user, err := s.userService.GetUserByExternalUserID(ctx, userData.UserID)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении пользователя: %w", err))
+		return
+	}
+
+	claim, err := s.claimService.CreateClaim(ctx, idEvent, idUser, user.ID)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при создании заявки: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, convertDummyClaimToAPI(claim))
+}
+
+// GetDummyClaims возвращает нерассмотренные заявки на dummy-пользователей мероприятия
+func (s *ServerHandler) GetDummyClaims(c *gin.Context, idEvent int64) {
+	claims, err := s.claimService.GetClaimsByEventID(c.Request.Context(), idEvent)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении заявок: %w", err))
+		return
+	}
+
+	apiClaims := make([]api.DummyClaimDTO, 0, len(claims))
+	for _, claim := range claims {
+		apiClaims = append(apiClaims, convertDummyClaimToAPI(&claim))
+	}
+
+	c.JSON(http.StatusOK, api.DummyClaimListResponse{Claims: &apiClaims})
+}
+
+// ApproveDummyClaim одобряет заявку и объединяет dummy-пользователя с заявителем
+func (s *ServerHandler) ApproveDummyClaim(c *gin.Context, idEvent int64, idClaim int) {
+	claim, err := s.claimService.ApproveClaim(c.Request.Context(), idEvent, idClaim)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при одобрении заявки: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertDummyClaimToAPI(claim))
+}
+
+// RejectDummyClaim отклоняет заявку на dummy-пользователя
+func (s *ServerHandler) RejectDummyClaim(c *gin.Context, idEvent int64, idClaim int) {
+	claim, err := s.claimService.RejectClaim(c.Request.Context(), idEvent, idClaim)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при отклонении заявки: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertDummyClaimToAPI(claim))
+}
+
+// Helper functions
+
+func convertDummyClaimToAPI(claim *models.DummyClaim) api.DummyClaimDTO {
+	return api.DummyClaimDTO{
+		Id:             claim.ID,
+		EventId:        claim.EventID,
+		DummyUserId:    claim.DummyUserID,
+		DummyName:      claim.DummyName,
+		ClaimantUserId: claim.ClaimantUserID,
+		Status:         api.DummyClaimDTOStatus(claim.Status),
+		ResolvedBy:     claim.ResolvedBy,
+		ResolvedAt:     claim.ResolvedAt,
+		CreatedAt:      &claim.CreatedAt,
+	}
+}
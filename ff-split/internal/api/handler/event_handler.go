@@ -3,17 +3,17 @@ package handler
 import (
 	"fmt"
 	"net/http"
-	"slices"
 
 	"github.com/gin-gonic/gin"
 	"github.com/ivasnev/FinFlow/ff-auth/pkg/auth"
 	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"github.com/ivasnev/FinFlow/ff-split/internal/service"
 	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
 )
 
 // GetEvents обрабатывает запрос на получение списка мероприятий
-func (s *ServerHandler) GetEvents(c *gin.Context) {
+func (s *ServerHandler) GetEvents(c *gin.Context, params api.GetEventsParams) {
 	ctx := c.Request.Context()
 
 	// Получаем данные пользователя из контекста
@@ -37,31 +37,69 @@ func (s *ServerHandler) GetEvents(c *gin.Context) {
 	}
 
 	// Получаем события пользователя с балансами
-	serviceEvents, err := s.eventService.GetEventsByUserID(ctx, user.ID)
+	includeArchived := params.IncludeArchived != nil && *params.IncludeArchived
+	serviceEvents, err := s.eventService.GetEventsByUserID(ctx, user.ID, includeArchived)
 	if err != nil {
 		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении мероприятий: %w", err))
 		return
 	}
 
-	// Преобразуем service типы в API типы
-	apiEvents := make([]api.EventResponse, 0, len(serviceEvents))
-	for _, event := range serviceEvents {
-		apiEvent := api.EventResponse{
-			Id:          &event.ID,
-			Name:        &event.Name,
-			Description: &event.Description,
-			CategoryId:  event.CategoryID,
-			PhotoId:     &event.PhotoID,
-			Balance:     event.Balance,
-		}
-		apiEvents = append(apiEvents, apiEvent)
+	c.JSON(http.StatusOK, convertEventsToAPI(serviceEvents))
+}
+
+// GetDeletedEvents обрабатывает запрос на получение мероприятий пользователя из корзины
+func (s *ServerHandler) GetDeletedEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userData, exists := auth.GetUserData(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "unauthorized",
+				Message: "пользователь не авторизован",
+			},
+		})
+		return
 	}
 
-	response := api.EventListResponse{
-		Events: &apiEvents,
+	// Преобразуем внешний ID во внутренний
+	user, err := s.userService.GetUserByExternalUserID(ctx, userData.UserID)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении пользователя: %w", err))
+		return
+	}
+
+	serviceEvents, err := s.eventService.GetDeletedEventsByUserID(ctx, user.ID)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении удаленных мероприятий: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertEventsToAPI(serviceEvents))
+}
+
+// RestoreEvent обрабатывает запрос на восстановление мероприятия из корзины
+func (s *ServerHandler) RestoreEvent(c *gin.Context, idDeletedEvent int64) {
+	userData, exists := auth.GetUserData(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "unauthorized",
+				Message: "пользователь не авторизован",
+			},
+		})
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	event, err := s.eventService.RestoreEvent(c.Request.Context(), idDeletedEvent, userData.UserID)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при восстановлении мероприятия: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertEventToAPI(event))
 }
 
 // GetEventByID обрабатывает запрос на получение мероприятия по ID
@@ -112,16 +150,16 @@ func (s *ServerHandler) GetEventByID(c *gin.Context, idEvent int64) {
 		return
 	}
 
-	balanceFloat := balances
-	balanceInt := int(balanceFloat)
-
 	c.JSON(http.StatusOK, api.EventResponse{
-		Id:          &event.ID,
-		Name:        &event.Name,
-		Description: &event.Description,
-		CategoryId:  event.CategoryID,
-		PhotoId:     &event.ImageID,
-		Balance:     &balanceInt,
+		Id:                    &event.ID,
+		Name:                  &event.Name,
+		Description:           &event.Description,
+		CategoryId:            event.CategoryID,
+		PhotoId:               &event.ImageID,
+		Currency:              &event.Currency,
+		OptimizationAlgorithm: convertOptimizationAlgorithmToAPI(event.OptimizationAlgorithm),
+		Status:                convertEventStatusToAPI(event.Status),
+		Balance:               &balances,
 	})
 }
 
@@ -152,6 +190,14 @@ func (s *ServerHandler) CreateEvent(c *gin.Context) {
 		dtoRequest.Description = *apiRequest.Description
 	}
 
+	if apiRequest.Currency != nil {
+		dtoRequest.Currency = *apiRequest.Currency
+	}
+
+	if apiRequest.OptimizationAlgorithm != nil {
+		dtoRequest.OptimizationAlgorithm = string(*apiRequest.OptimizationAlgorithm)
+	}
+
 	if apiRequest.Members != nil {
 		if apiRequest.Members.UserIds != nil {
 			dtoRequest.Members.UserIDs = *apiRequest.Members.UserIds
@@ -161,11 +207,9 @@ func (s *ServerHandler) CreateEvent(c *gin.Context) {
 		}
 	}
 
-	// Добавляем текущего пользователя к members, если его там нет
-	if rawID, ok := c.Get("user_id"); ok {
-		if idInt, ok := rawID.(int64); ok && !slices.Contains(dtoRequest.Members.UserIDs, idInt) {
-			dtoRequest.Members.UserIDs = append(dtoRequest.Members.UserIDs, idInt)
-		}
+	// Создатель становится участником и владельцем мероприятия
+	if userData, ok := auth.GetUserData(c); ok {
+		dtoRequest.CreatorID = &userData.UserID
 	}
 
 	eventResponse, err := s.eventService.CreateEvent(ctx, &dtoRequest)
@@ -176,12 +220,15 @@ func (s *ServerHandler) CreateEvent(c *gin.Context) {
 
 	// Конвертируем DTO ответ в API типы
 	apiResponse := api.EventResponse{
-		Id:          &eventResponse.ID,
-		Name:        &eventResponse.Name,
-		Description: &eventResponse.Description,
-		CategoryId:  eventResponse.CategoryID,
-		PhotoId:     &eventResponse.PhotoID,
-		Balance:     eventResponse.Balance,
+		Id:                    &eventResponse.ID,
+		Name:                  &eventResponse.Name,
+		Description:           &eventResponse.Description,
+		CategoryId:            eventResponse.CategoryID,
+		PhotoId:               &eventResponse.PhotoID,
+		Currency:              &eventResponse.Currency,
+		OptimizationAlgorithm: convertOptimizationAlgorithmToAPI(eventResponse.OptimizationAlgorithm),
+		Status:                convertEventStatusToAPI(eventResponse.Status),
+		Balance:               eventResponse.Balance,
 	}
 
 	c.JSON(http.StatusCreated, apiResponse)
@@ -214,6 +261,14 @@ func (s *ServerHandler) UpdateEvent(c *gin.Context, idEvent int64) {
 		dtoRequest.Description = *apiRequest.Description
 	}
 
+	if apiRequest.Currency != nil {
+		dtoRequest.Currency = *apiRequest.Currency
+	}
+
+	if apiRequest.OptimizationAlgorithm != nil {
+		dtoRequest.OptimizationAlgorithm = string(*apiRequest.OptimizationAlgorithm)
+	}
+
 	if apiRequest.Members != nil {
 		if apiRequest.Members.UserIds != nil {
 			dtoRequest.Members.UserIDs = *apiRequest.Members.UserIds
@@ -231,12 +286,15 @@ func (s *ServerHandler) UpdateEvent(c *gin.Context, idEvent int64) {
 
 	// Конвертируем DTO ответ в API типы
 	apiResponse := api.EventResponse{
-		Id:          &eventResponse.ID,
-		Name:        &eventResponse.Name,
-		Description: &eventResponse.Description,
-		CategoryId:  eventResponse.CategoryID,
-		PhotoId:     &eventResponse.PhotoID,
-		Balance:     eventResponse.Balance,
+		Id:                    &eventResponse.ID,
+		Name:                  &eventResponse.Name,
+		Description:           &eventResponse.Description,
+		CategoryId:            eventResponse.CategoryID,
+		PhotoId:               &eventResponse.PhotoID,
+		Currency:              &eventResponse.Currency,
+		OptimizationAlgorithm: convertOptimizationAlgorithmToAPI(eventResponse.OptimizationAlgorithm),
+		Status:                convertEventStatusToAPI(eventResponse.Status),
+		Balance:               eventResponse.Balance,
 	}
 
 	c.JSON(http.StatusOK, apiResponse)
@@ -255,3 +313,94 @@ func (s *ServerHandler) DeleteEvent(c *gin.Context, idEvent int64) {
 		Success: true,
 	})
 }
+
+// SettleEvent начинает расчеты по мероприятию: транзакции замораживаются
+func (s *ServerHandler) SettleEvent(c *gin.Context, idEvent int64) {
+	s.changeEventStatus(c, idEvent, models.EventStatusSettling)
+}
+
+// CloseEvent закрывает мероприятие и возвращает зафиксированный план переводов
+func (s *ServerHandler) CloseEvent(c *gin.Context, idEvent int64) {
+	result, err := s.transactionService.CloseEvent(c.Request.Context(), idEvent)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при закрытии мероприятия: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertOptimizationResultToAPI(result))
+}
+
+// ArchiveEvent отправляет закрытое мероприятие в архив
+func (s *ServerHandler) ArchiveEvent(c *gin.Context, idEvent int64) {
+	s.changeEventStatus(c, idEvent, models.EventStatusArchived)
+}
+
+// ReopenEvent возобновляет мероприятие
+func (s *ServerHandler) ReopenEvent(c *gin.Context, idEvent int64) {
+	s.changeEventStatus(c, idEvent, models.EventStatusActive)
+}
+
+// changeEventStatus переводит мероприятие в статус и возвращает его данные
+func (s *ServerHandler) changeEventStatus(c *gin.Context, idEvent int64, status string) {
+	event, err := s.eventService.ChangeStatus(c.Request.Context(), idEvent, status)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при смене статуса мероприятия: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.EventResponse{
+		Id:                    &event.ID,
+		Name:                  &event.Name,
+		Description:           &event.Description,
+		CategoryId:            event.CategoryID,
+		PhotoId:               &event.ImageID,
+		Currency:              &event.Currency,
+		OptimizationAlgorithm: convertOptimizationAlgorithmToAPI(event.OptimizationAlgorithm),
+		Status:                convertEventStatusToAPI(event.Status),
+	})
+}
+
+// convertEventToAPI конвертирует DTO мероприятия в API тип
+func convertEventToAPI(event *service.EventResponse) api.EventResponse {
+	return api.EventResponse{
+		Id:                    &event.ID,
+		Name:                  &event.Name,
+		Description:           &event.Description,
+		CategoryId:            event.CategoryID,
+		PhotoId:               &event.PhotoID,
+		Currency:              &event.Currency,
+		OptimizationAlgorithm: convertOptimizationAlgorithmToAPI(event.OptimizationAlgorithm),
+		Status:                convertEventStatusToAPI(event.Status),
+		Balance:               event.Balance,
+		DeletedAt:             event.DeletedAt,
+	}
+}
+
+// convertEventsToAPI конвертирует список DTO мероприятий в API ответ
+func convertEventsToAPI(events []service.EventResponse) api.EventListResponse {
+	apiEvents := make([]api.EventResponse, 0, len(events))
+	for i := range events {
+		apiEvents = append(apiEvents, convertEventToAPI(&events[i]))
+	}
+	return api.EventListResponse{
+		Events: &apiEvents,
+	}
+}
+
+// convertOptimizationAlgorithmToAPI конвертирует имя алгоритма оптимизации в API тип
+func convertOptimizationAlgorithmToAPI(algorithm string) *api.OptimizationAlgorithm {
+	if algorithm == "" {
+		return nil
+	}
+	apiAlgorithm := api.OptimizationAlgorithm(algorithm)
+	return &apiAlgorithm
+}
+
+// convertEventStatusToAPI конвертирует статус мероприятия в API тип
+func convertEventStatusToAPI(status string) *api.EventStatus {
+	if status == "" {
+		return nil
+	}
+	apiStatus := api.EventStatus(status)
+	return &apiStatus
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// Участие в мероприятии проверяет middleware.EventAccessMiddleware, но вложенные
+// сущности адресуются собственными ID. Проверки ниже не дают участнику одного
+// мероприятия обратиться к транзакциям, задачам и активностям другого.
+
+// ensureTransactionInEvent проверяет, что транзакция относится к мероприятию.
+// При ошибке пишет ответ и возвращает false.
+func (s *ServerHandler) ensureTransactionInEvent(c *gin.Context, idEvent int64, idTransaction int) bool {
+	transaction, err := s.transactionService.GetTransactionByID(c.Request.Context(), idTransaction)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении транзакции: %w", err))
+		return false
+	}
+	if transaction.EventID != idEvent {
+		errors.HTTPErrorHandler(c, errors.NewEntityNotFoundError(strconv.Itoa(idTransaction), "transaction"))
+		return false
+	}
+	return true
+}
+
+// ensureTaskInEvent проверяет, что задача относится к мероприятию.
+// При ошибке пишет ответ и возвращает false.
+func (s *ServerHandler) ensureTaskInEvent(c *gin.Context, idEvent int64, idTask int) bool {
+	_, ok := s.getTaskInEvent(c, idEvent, idTask)
+	return ok
+}
+
+// getTaskInEvent возвращает задачу, если она относится к мероприятию.
+// При ошибке пишет ответ и возвращает false.
+func (s *ServerHandler) getTaskInEvent(c *gin.Context, idEvent int64, idTask int) (*service.TaskDTO, bool) {
+	task, err := s.taskService.GetTaskByID(c.Request.Context(), uint(idTask))
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении задачи: %w", err))
+		return nil, false
+	}
+	if task == nil || task.EventID != idEvent {
+		errors.HTTPErrorHandler(c, errors.NewEntityNotFoundError(strconv.Itoa(idTask), "task"))
+		return nil, false
+	}
+	return task, true
+}
+
+// ensureActivityInEvent проверяет, что активность относится к мероприятию.
+// При ошибке пишет ответ и возвращает false.
+func (s *ServerHandler) ensureActivityInEvent(c *gin.Context, idEvent int64, idActivity int) bool {
+	_, ok := s.getActivityInEvent(c, idEvent, idActivity)
+	return ok
+}
+
+// getActivityInEvent возвращает активность, если она относится к мероприятию.
+// При ошибке пишет ответ и возвращает false.
+func (s *ServerHandler) getActivityInEvent(c *gin.Context, idEvent int64, idActivity int) (*models.Activity, bool) {
+	activity, err := s.activityService.GetActivityByID(c.Request.Context(), idActivity)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении активности: %w", err))
+		return nil, false
+	}
+	if activity == nil || activity.EventID == nil || *activity.EventID != idEvent {
+		errors.HTTPErrorHandler(c, errors.NewEntityNotFoundError(strconv.Itoa(idActivity), "activity"))
+		return nil, false
+	}
+	return activity, true
+}
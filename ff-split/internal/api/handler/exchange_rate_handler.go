@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+)
+
+// GetExchangeRates возвращает список курсов валют
+func (s *ServerHandler) GetExchangeRates(c *gin.Context) {
+	rates, err := s.exchangeRateService.GetRates(c.Request.Context())
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении курсов валют: %w", err))
+		return
+	}
+
+	apiRates := make([]api.ExchangeRateDTO, 0, len(rates))
+	for _, rate := range rates {
+		apiRates = append(apiRates, convertExchangeRateToAPI(&rate))
+	}
+
+	c.JSON(http.StatusOK, apiRates)
+}
+
+// SetExchangeRate создает или обновляет курс для пары валют
+func (s *ServerHandler) SetExchangeRate(c *gin.Context) {
+	var apiRequest api.ExchangeRateRequest
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
+		return
+	}
+
+	rate, err := s.exchangeRateService.SetRate(c.Request.Context(), &service.ExchangeRateDTO{
+		CurrencyFrom: apiRequest.CurrencyFrom,
+		CurrencyTo:   apiRequest.CurrencyTo,
+		Rate:         apiRequest.Rate,
+	})
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при сохранении курса: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertExchangeRateToAPI(rate))
+}
+
+// DeleteExchangeRate удаляет курс для пары валют
+func (s *ServerHandler) DeleteExchangeRate(c *gin.Context, currencyFrom string, currencyTo string) {
+	if err := s.exchangeRateService.DeleteRate(c.Request.Context(), currencyFrom, currencyTo); err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при удалении курса: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.SuccessResponse{Success: true})
+}
+
+// Helper functions
+
+func convertExchangeRateToAPI(rate *service.ExchangeRateDTO) api.ExchangeRateDTO {
+	return api.ExchangeRateDTO{
+		CurrencyFrom: rate.CurrencyFrom,
+		CurrencyTo:   rate.CurrencyTo,
+		Rate:         rate.Rate,
+		UpdatedAt:    &rate.UpdatedAt,
+	}
+}
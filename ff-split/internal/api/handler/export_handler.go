@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+)
+
+// ExportEvent выгружает мероприятие в файл и передает его клиенту
+func (s *ServerHandler) ExportEvent(c *gin.Context, idEvent int64, params api.ExportEventParams) {
+	format := service.ExportFormatCSV
+	if params.Format != nil {
+		format = string(*params.Format)
+	}
+
+	file, err := s.exportService.ExportEvent(c.Request.Context(), idEvent, format)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при выгрузке мероприятия: %w", err))
+		return
+	}
+
+	// Файл формируется целиком до отправки: так ошибку формирования можно вернуть клиенту
+	var buf bytes.Buffer
+	if err := file.Write(&buf); err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при формировании выгрузки: %w", err))
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+	c.Data(http.StatusOK, file.ContentType, buf.Bytes())
+}
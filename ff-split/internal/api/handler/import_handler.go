@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+)
+
+// maxImportSize - максимальный размер импортируемого файла
+const maxImportSize = 10 << 20
+
+// ImportTransactions импортирует транзакции мероприятия из CSV-файла
+func (s *ServerHandler) ImportTransactions(c *gin.Context, idEvent int64, params api.ImportTransactionsParams) {
+	dtoRequest := &service.ImportRequest{
+		DryRun: true,
+		Data:   http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize),
+	}
+	if params.Format != nil {
+		dtoRequest.Format = string(*params.Format)
+	}
+	if params.DryRun != nil {
+		dtoRequest.DryRun = *params.DryRun
+	}
+
+	report, err := s.importService.ImportTransactions(c.Request.Context(), idEvent, dtoRequest)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при импорте транзакций: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertImportReportToAPI(report))
+}
+
+// Helper functions
+
+// convertImportReportToAPI преобразует отчет об импорте в API ответ
+func convertImportReportToAPI(report *service.ImportReport) api.ImportReportResponse {
+	rows := make([]api.ImportRowDTO, 0, len(report.Rows))
+	for _, row := range report.Rows {
+		apiRow := api.ImportRowDTO{
+			Line:          row.Line,
+			Name:          row.Name,
+			Datetime:      row.Datetime,
+			Amount:        row.Amount,
+			Status:        api.ImportRowDTOStatus(row.Status),
+			TransactionId: row.TransactionID,
+		}
+		if row.Currency != "" {
+			apiRow.Currency = &row.Currency
+		}
+		if row.Error != "" {
+			apiRow.Error = &row.Error
+		}
+		if row.Warning != "" {
+			apiRow.Warning = &row.Warning
+		}
+		rows = append(rows, apiRow)
+	}
+
+	createdUsers := report.CreatedUsers
+	if createdUsers == nil {
+		createdUsers = []string{}
+	}
+
+	return api.ImportReportResponse{
+		Format:       api.ImportFormat(report.Format),
+		DryRun:       report.DryRun,
+		Imported:     report.Imported,
+		Failed:       report.Failed,
+		Skipped:      report.Skipped,
+		CreatedUsers: createdUsers,
+		Rows:         rows,
+	}
+}
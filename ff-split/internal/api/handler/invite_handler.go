@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-auth/pkg/auth"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+)
+
+// GetEventInvites возвращает действующие приглашения мероприятия
+func (s *ServerHandler) GetEventInvites(c *gin.Context, idEvent int64) {
+	invites, err := s.inviteService.GetInvitesByEventID(c.Request.Context(), idEvent)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении приглашений: %w", err))
+		return
+	}
+
+	apiInvites := make([]api.EventInviteDTO, 0, len(invites))
+	for _, invite := range invites {
+		apiInvites = append(apiInvites, convertInviteToAPI(&invite))
+	}
+
+	c.JSON(http.StatusOK, api.EventInviteListResponse{Invites: &apiInvites})
+}
+
+// CreateEventInvite создает приглашение в мероприятие
+func (s *ServerHandler) CreateEventInvite(c *gin.Context, idEvent int64) {
+	var apiRequest api.EventInviteRequest
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
+		return
+	}
+
+	dtoRequest := service.InviteRequest{
+		MaxUses:   apiRequest.MaxUses,
+		ExpiresAt: apiRequest.ExpiresAt,
+	}
+	if apiRequest.Role != nil {
+		dtoRequest.Role = models.EventRole(*apiRequest.Role)
+	}
+
+	invite, err := s.inviteService.CreateInvite(c.Request.Context(), idEvent, &dtoRequest)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при создании приглашения: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, convertInviteToAPI(invite))
+}
+
+// RevokeEventInvite отзывает приглашение в мероприятие
+func (s *ServerHandler) RevokeEventInvite(c *gin.Context, idEvent int64, idInvite int) {
+	if err := s.inviteService.RevokeInvite(c.Request.Context(), idEvent, idInvite); err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при отзыве приглашения: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.SuccessResponse{Success: true})
+}
+
+// JoinEventByInvite добавляет авторизованного пользователя в мероприятие по приглашению
+func (s *ServerHandler) JoinEventByInvite(c *gin.Context, token string) {
+	userData, exists := auth.GetUserData(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "unauthorized",
+				Message: "пользователь не авторизован",
+			},
+		})
+		return
+	}
+
+	member, err := s.inviteService.JoinEvent(c.Request.Context(), token, userData.UserID)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при присоединении к мероприятию: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.JoinEventResponse{
+		EventId: member.EventID,
+		UserId:  member.UserID,
+		Role:    api.EventRole(member.Role),
+	})
+}
+
+// Helper functions
+
+func convertInviteToAPI(invite *models.EventInvite) api.EventInviteDTO {
+	return api.EventInviteDTO{
+		Id:        invite.ID,
+		EventId:   invite.EventID,
+		Token:     invite.Token,
+		Role:      api.EventRole(invite.Role),
+		MaxUses:   invite.MaxUses,
+		Uses:      invite.Uses,
+		ExpiresAt: invite.ExpiresAt,
+		CreatedBy: invite.CreatedBy,
+		CreatedAt: &invite.CreatedAt,
+	}
+}
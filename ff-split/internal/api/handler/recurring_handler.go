@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+)
+
+// GetRecurringTransactions возвращает регулярные транзакции мероприятия
+func (s *ServerHandler) GetRecurringTransactions(c *gin.Context, idEvent int64) {
+	recurrings, err := s.recurringService.GetRecurringByEventID(c.Request.Context(), idEvent)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении регулярных транзакций: %w", err))
+		return
+	}
+
+	apiRecurrings := make([]api.RecurringTransactionDTO, 0, len(recurrings))
+	for _, recurring := range recurrings {
+		apiRecurrings = append(apiRecurrings, convertRecurringToAPI(&recurring))
+	}
+
+	c.JSON(http.StatusOK, api.RecurringTransactionListResponse{Recurring: &apiRecurrings})
+}
+
+// CreateRecurringTransaction создает регулярную транзакцию
+func (s *ServerHandler) CreateRecurringTransaction(c *gin.Context, idEvent int64) {
+	dtoRequest, ok := bindRecurringRequest(c)
+	if !ok {
+		return
+	}
+
+	recurring, err := s.recurringService.CreateRecurring(c.Request.Context(), idEvent, dtoRequest)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при создании регулярной транзакции: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, convertRecurringToAPI(recurring))
+}
+
+// UpdateRecurringTransaction изменяет регулярную транзакцию
+func (s *ServerHandler) UpdateRecurringTransaction(c *gin.Context, idEvent int64, idRecurring int) {
+	dtoRequest, ok := bindRecurringRequest(c)
+	if !ok {
+		return
+	}
+
+	recurring, err := s.recurringService.UpdateRecurring(c.Request.Context(), idEvent, idRecurring, dtoRequest)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при изменении регулярной транзакции: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertRecurringToAPI(recurring))
+}
+
+// DeleteRecurringTransaction удаляет регулярную транзакцию
+func (s *ServerHandler) DeleteRecurringTransaction(c *gin.Context, idEvent int64, idRecurring int) {
+	if err := s.recurringService.DeleteRecurring(c.Request.Context(), idEvent, idRecurring); err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при удалении регулярной транзакции: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.SuccessResponse{Success: true})
+}
+
+// PauseRecurringTransaction приостанавливает регулярную транзакцию
+func (s *ServerHandler) PauseRecurringTransaction(c *gin.Context, idEvent int64, idRecurring int) {
+	recurring, err := s.recurringService.SetRecurringPaused(c.Request.Context(), idEvent, idRecurring, true)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при приостановке регулярной транзакции: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertRecurringToAPI(recurring))
+}
+
+// ResumeRecurringTransaction возобновляет регулярную транзакцию
+func (s *ServerHandler) ResumeRecurringTransaction(c *gin.Context, idEvent int64, idRecurring int) {
+	recurring, err := s.recurringService.SetRecurringPaused(c.Request.Context(), idEvent, idRecurring, false)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при возобновлении регулярной транзакции: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertRecurringToAPI(recurring))
+}
+
+// Helper functions
+
+// bindRecurringRequest разбирает тело запроса регулярной транзакции; при ошибке отвечает 400
+func bindRecurringRequest(c *gin.Context) (*service.RecurringRequest, bool) {
+	var apiRequest api.RecurringTransactionRequest
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
+		return nil, false
+	}
+
+	dtoRequest := &service.RecurringRequest{
+		Frequency:   string(apiRequest.Frequency),
+		StartDate:   apiRequest.StartDate,
+		EndDate:     apiRequest.EndDate,
+		Transaction: convertTransactionRequestToDTO(&apiRequest.Transaction),
+	}
+	if apiRequest.Interval != nil {
+		dtoRequest.Interval = *apiRequest.Interval
+	}
+	return dtoRequest, true
+}
+
+func convertRecurringToAPI(recurring *service.RecurringDTO) api.RecurringTransactionDTO {
+	return api.RecurringTransactionDTO{
+		Id:          recurring.ID,
+		EventId:     recurring.EventID,
+		Frequency:   api.RecurrenceFrequency(recurring.Frequency),
+		Interval:    recurring.Interval,
+		StartDate:   recurring.StartDate,
+		EndDate:     recurring.EndDate,
+		Paused:      recurring.Paused,
+		NextRunAt:   recurring.NextRunAt,
+		CreatedBy:   recurring.CreatedBy,
+		CreatedAt:   &recurring.CreatedAt,
+		Transaction: convertTransactionDTOToRequest(&recurring.Transaction),
+	}
+}
+
+// convertTransactionDTOToRequest выполняет обратное convertTransactionRequestToDTO преобразование
+func convertTransactionDTOToRequest(req *service.TransactionRequest) api.TransactionRequest {
+	apiReq := api.TransactionRequest{
+		Name:                  req.Name,
+		Amount:                req.Amount,
+		FromUser:              req.FromUser,
+		Type:                  api.TransactionRequestType(req.Type),
+		Users:                 req.Users,
+		ExchangeRate:          req.ExchangeRate,
+		TransactionCategoryId: req.TransactionCategoryID,
+	}
+
+	if req.Portion != nil {
+		apiReq.Portion = &req.Portion
+	}
+	if req.Amounts != nil {
+		apiReq.Amounts = &req.Amounts
+	}
+	if req.ExcludePayer {
+		apiReq.ExcludePayer = &req.ExcludePayer
+	}
+	if req.Currency != "" {
+		apiReq.Currency = &req.Currency
+	}
+
+	if len(req.Payers) > 0 {
+		payers := make([]api.PayerDTO, 0, len(req.Payers))
+		for _, p := range req.Payers {
+			payers = append(payers, api.PayerDTO{UserId: p.UserID, Amount: p.Amount})
+		}
+		apiReq.Payers = &payers
+	}
+
+	if len(req.Items) > 0 {
+		items := make([]api.ItemRequest, 0, len(req.Items))
+		for _, item := range req.Items {
+			quantity := item.Quantity
+			items = append(items, api.ItemRequest{
+				Name:      item.Name,
+				Price:     item.Price,
+				Quantity:  &quantity,
+				Consumers: item.Consumers,
+			})
+		}
+		apiReq.Items = &items
+	}
+
+	if len(req.Charges) > 0 {
+		charges := make([]api.ChargeDTO, 0, len(req.Charges))
+		for _, ch := range req.Charges {
+			charges = append(charges, api.ChargeDTO{Name: ch.Name, Amount: ch.Amount})
+		}
+		apiReq.Charges = &charges
+	}
+
+	return apiReq
+}
@@ -6,13 +6,20 @@ import (
 
 // ServerHandler реализует сгенерированный интерфейс api.ServerInterface
 type ServerHandler struct {
-	eventService       service.Event
-	userService        service.User
-	transactionService service.Transaction
-	activityService    service.Activity
-	taskService        service.Task
-	categoryService    service.Category
-	iconService        service.Icon
+	eventService        service.Event
+	userService         service.User
+	transactionService  service.Transaction
+	activityService     service.Activity
+	taskService         service.Task
+	categoryService     service.Category
+	iconService         service.Icon
+	exchangeRateService service.ExchangeRate
+	inviteService       service.Invite
+	claimService        service.DummyClaim
+	recurringService    service.Recurring
+	analyticsService    service.Analytics
+	exportService       service.Export
+	importService       service.Import
 }
 
 // NewServerHandler создает новый экземпляр ServerHandler
@@ -24,14 +31,28 @@ func NewServerHandler(
 	taskService service.Task,
 	categoryService service.Category,
 	iconService service.Icon,
+	exchangeRateService service.ExchangeRate,
+	inviteService service.Invite,
+	claimService service.DummyClaim,
+	recurringService service.Recurring,
+	analyticsService service.Analytics,
+	exportService service.Export,
+	importService service.Import,
 ) *ServerHandler {
 	return &ServerHandler{
-		eventService:       eventService,
-		userService:        userService,
-		transactionService: transactionService,
-		activityService:    activityService,
-		taskService:        taskService,
-		categoryService:    categoryService,
-		iconService:        iconService,
+		eventService:        eventService,
+		userService:         userService,
+		transactionService:  transactionService,
+		activityService:     activityService,
+		taskService:         taskService,
+		categoryService:     categoryService,
+		iconService:         iconService,
+		exchangeRateService: exchangeRateService,
+		inviteService:       inviteService,
+		claimService:        claimService,
+		recurringService:    recurringService,
+		analyticsService:    analyticsService,
+		exportService:       exportService,
+		importService:       importService,
 	}
 }
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+)
+
+// GetSettlementsByEventID возвращает список погашений мероприятия
+func (s *ServerHandler) GetSettlementsByEventID(c *gin.Context, idEvent int64) {
+	settlements, err := s.transactionService.GetSettlementsByEventID(c.Request.Context(), idEvent)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении погашений: %w", err))
+		return
+	}
+
+	// Конвертируем DTO в API типы
+	apiSettlements := make([]api.SettlementDTO, 0, len(settlements))
+	for _, settlement := range settlements {
+		apiSettlements = append(apiSettlements, convertSettlementToAPI(&settlement))
+	}
+
+	c.JSON(http.StatusOK, api.SettlementListResponse{Settlements: &apiSettlements})
+}
+
+// CreateSettlement фиксирует погашение долга
+func (s *ServerHandler) CreateSettlement(c *gin.Context, idEvent int64) {
+	var apiRequest api.SettlementRequest
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
+		return
+	}
+
+	dtoRequest := service.SettlementRequest{
+		FromUserID:      apiRequest.FromUserId,
+		ToUserID:        apiRequest.ToUserId,
+		Amount:          apiRequest.Amount,
+		OptimizedDebtID: apiRequest.OptimizedDebtId,
+	}
+	if apiRequest.Comment != nil {
+		dtoRequest.Comment = *apiRequest.Comment
+	}
+
+	settlement, err := s.transactionService.CreateSettlement(c.Request.Context(), idEvent, &dtoRequest)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при создании погашения: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, convertSettlementToAPI(settlement))
+}
+
+// DeleteSettlement отменяет погашение долга
+func (s *ServerHandler) DeleteSettlement(c *gin.Context, idEvent int64, idSettlement int) {
+	if err := s.transactionService.DeleteSettlement(c.Request.Context(), idEvent, idSettlement); err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при удалении погашения: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.SuccessResponse{Success: true})
+}
+
+// Helper functions
+
+func convertSettlementToAPI(settlement *service.SettlementDTO) api.SettlementDTO {
+	apiSettlement := api.SettlementDTO{
+		Id:              settlement.ID,
+		EventId:         settlement.EventID,
+		FromUserId:      settlement.FromUserID,
+		ToUserId:        settlement.ToUserID,
+		Amount:          settlement.Amount,
+		OptimizedDebtId: settlement.OptimizedDebtID,
+		CreatedAt:       &settlement.CreatedAt,
+	}
+	if settlement.Comment != "" {
+		apiSettlement.Comment = &settlement.Comment
+	}
+	return apiSettlement
+}
@@ -28,20 +28,8 @@ func (s *ServerHandler) GetTasksByEventID(c *gin.Context, idEvent int64) {
 
 // GetTaskByID возвращает задачу по ID
 func (s *ServerHandler) GetTaskByID(c *gin.Context, idEvent int64, idTask int) {
-	task, err := s.taskService.GetTaskByID(c.Request.Context(), uint(idTask))
-	if err != nil {
-		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении задачи: %w", err))
-		return
-	}
-
-	if task == nil {
-		c.JSON(http.StatusNotFound, api.ErrorResponse{
-		Id: c.GetHeader("X-Request-ID"),
-		Error: api.ErrorResponseDetail{
-			Code:    "not_found",
-			Message: "задача не найдена",
-		},
-	})
+	task, ok := s.getTaskInEvent(c, idEvent, idTask)
+	if !ok {
 		return
 	}
 
@@ -53,12 +41,12 @@ func (s *ServerHandler) CreateTask(c *gin.Context, idEvent int64) {
 	var apiRequest api.TaskRequest
 	if err := c.ShouldBindJSON(&apiRequest); err != nil {
 		c.JSON(http.StatusBadRequest, api.ErrorResponse{
-		Id: c.GetHeader("X-Request-ID"),
-		Error: api.ErrorResponseDetail{
-			Code:    "validation",
-			Message: "некорректные данные запроса",
-		},
-	})
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
 		return
 	}
 
@@ -86,15 +74,19 @@ func (s *ServerHandler) CreateTask(c *gin.Context, idEvent int64) {
 
 // UpdateTask обновляет задачу
 func (s *ServerHandler) UpdateTask(c *gin.Context, idEvent int64, idTask int) {
+	if !s.ensureTaskInEvent(c, idEvent, idTask) {
+		return
+	}
+
 	var apiRequest api.TaskRequest
 	if err := c.ShouldBindJSON(&apiRequest); err != nil {
 		c.JSON(http.StatusBadRequest, api.ErrorResponse{
-		Id: c.GetHeader("X-Request-ID"),
-		Error: api.ErrorResponseDetail{
-			Code:    "validation",
-			Message: "некорректные данные запроса",
-		},
-	})
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
 		return
 	}
 
@@ -122,6 +114,10 @@ func (s *ServerHandler) UpdateTask(c *gin.Context, idEvent int64, idTask int) {
 
 // DeleteTask удаляет задачу
 func (s *ServerHandler) DeleteTask(c *gin.Context, idEvent int64, idTask int) {
+	if !s.ensureTaskInEvent(c, idEvent, idTask) {
+		return
+	}
+
 	err := s.taskService.DeleteTask(c.Request.Context(), uint(idTask))
 	if err != nil {
 		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при удалении задачи: %w", err))
@@ -3,28 +3,65 @@ package handler
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	"github.com/ivasnev/FinFlow/ff-split/internal/service"
 	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
 )
 
-// GetTransactionsByEventID возвращает список транзакций мероприятия
-func (s *ServerHandler) GetTransactionsByEventID(c *gin.Context, idEvent int64) {
-	transactions, err := s.transactionService.GetTransactionsByEventID(c.Request.Context(), idEvent)
+// GetTransactionsByEventID возвращает страницу транзакций мероприятия с фильтрами и сортировкой
+func (s *ServerHandler) GetTransactionsByEventID(c *gin.Context, idEvent int64, params api.GetTransactionsByEventIDParams) {
+	dtoRequest := &service.TransactionListRequest{
+		From:          params.From,
+		To:            params.To,
+		CategoryID:    params.CategoryId,
+		PayerID:       params.PayerId,
+		ParticipantID: params.ParticipantId,
+	}
+	if params.AmountMin != nil {
+		amount := money.FromFloat(*params.AmountMin)
+		dtoRequest.AmountMin = &amount
+	}
+	if params.AmountMax != nil {
+		amount := money.FromFloat(*params.AmountMax)
+		dtoRequest.AmountMax = &amount
+	}
+	if params.Q != nil {
+		dtoRequest.Search = *params.Q
+	}
+	if params.Sort != nil {
+		dtoRequest.SortBy = string(*params.Sort)
+	}
+	if params.Order != nil {
+		dtoRequest.SortOrder = string(*params.Order)
+	}
+	if params.Cursor != nil {
+		dtoRequest.Cursor = *params.Cursor
+	}
+	if params.Limit != nil {
+		dtoRequest.Limit = *params.Limit
+	}
+
+	page, err := s.transactionService.ListTransactions(c.Request.Context(), idEvent, dtoRequest)
 	if err != nil {
 		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении транзакций: %w", err))
 		return
 	}
 
 	// Конвертируем DTO в API типы
-	apiTransactions := make([]api.TransactionResponse, 0, len(transactions))
-	for _, t := range transactions {
+	apiTransactions := make([]api.TransactionResponse, 0, len(page.Transactions))
+	for _, t := range page.Transactions {
 		apiTransactions = append(apiTransactions, convertTransactionToAPI(&t))
 	}
 
-	c.JSON(http.StatusOK, api.TransactionListResponse{Transactions: &apiTransactions})
+	c.JSON(http.StatusOK, api.TransactionListResponse{
+		Transactions: &apiTransactions,
+		NextCursor:   page.NextCursor,
+	})
 }
 
 // GetTransactionByID возвращает транзакцию по ID
@@ -34,6 +71,10 @@ func (s *ServerHandler) GetTransactionByID(c *gin.Context, idEvent int64, idTran
 		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении транзакции: %w", err))
 		return
 	}
+	if transaction.EventID != idEvent {
+		errors.HTTPErrorHandler(c, errors.NewEntityNotFoundError(strconv.Itoa(idTransaction), "transaction"))
+		return
+	}
 
 	c.JSON(http.StatusOK, convertTransactionToAPI(transaction))
 }
@@ -42,13 +83,13 @@ func (s *ServerHandler) GetTransactionByID(c *gin.Context, idEvent int64, idTran
 func (s *ServerHandler) CreateTransaction(c *gin.Context, idEvent int64) {
 	var apiRequest api.TransactionRequest
 	if err := c.ShouldBindJSON(&apiRequest); err != nil {
-	c.JSON(http.StatusBadRequest, api.ErrorResponse{
-		Id: c.GetHeader("X-Request-ID"),
-		Error: api.ErrorResponseDetail{
-			Code:    "validation",
-			Message: "некорректные данные запроса",
-		},
-	})
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
 		return
 	}
 
@@ -66,15 +107,19 @@ func (s *ServerHandler) CreateTransaction(c *gin.Context, idEvent int64) {
 
 // UpdateTransaction обновляет существующую транзакцию
 func (s *ServerHandler) UpdateTransaction(c *gin.Context, idEvent int64, idTransaction int) {
+	if !s.ensureTransactionInEvent(c, idEvent, idTransaction) {
+		return
+	}
+
 	var apiRequest api.TransactionRequest
 	if err := c.ShouldBindJSON(&apiRequest); err != nil {
-	c.JSON(http.StatusBadRequest, api.ErrorResponse{
-		Id: c.GetHeader("X-Request-ID"),
-		Error: api.ErrorResponseDetail{
-			Code:    "validation",
-			Message: "некорректные данные запроса",
-		},
-	})
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
 		return
 	}
 
@@ -90,8 +135,12 @@ func (s *ServerHandler) UpdateTransaction(c *gin.Context, idEvent int64, idTrans
 	c.JSON(http.StatusOK, convertTransactionToAPI(transaction))
 }
 
-// DeleteTransaction удаляет транзакцию
+// DeleteTransaction переносит транзакцию в корзину
 func (s *ServerHandler) DeleteTransaction(c *gin.Context, idEvent int64, idTransaction int) {
+	if !s.ensureTransactionInEvent(c, idEvent, idTransaction) {
+		return
+	}
+
 	err := s.transactionService.DeleteTransaction(c.Request.Context(), idTransaction)
 	if err != nil {
 		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при удалении транзакции: %w", err))
@@ -101,6 +150,197 @@ func (s *ServerHandler) DeleteTransaction(c *gin.Context, idEvent int64, idTrans
 	c.JSON(http.StatusOK, api.SuccessResponse{Success: true})
 }
 
+// GetTransactionHistory возвращает историю изменений транзакции
+func (s *ServerHandler) GetTransactionHistory(c *gin.Context, idEvent int64, idTransaction int) {
+	revisions, err := s.transactionService.GetTransactionHistory(c.Request.Context(), idEvent, idTransaction)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении истории транзакции: %w", err))
+		return
+	}
+
+	apiRevisions := make([]api.TransactionRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		apiRevisions = append(apiRevisions, convertRevisionToAPI(&revision))
+	}
+
+	c.JSON(http.StatusOK, api.TransactionHistoryResponse{Revisions: apiRevisions})
+}
+
+// RevertTransaction откатывает транзакцию к ревизии из истории изменений
+func (s *ServerHandler) RevertTransaction(c *gin.Context, idEvent int64, idTransaction int, idRevision int) {
+	transaction, err := s.transactionService.RevertTransaction(c.Request.Context(), idEvent, idTransaction, idRevision)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при откате транзакции: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertTransactionToAPI(transaction))
+}
+
+// GetEventTrash возвращает удаленные транзакции мероприятия
+func (s *ServerHandler) GetEventTrash(c *gin.Context, idEvent int64) {
+	transactions, err := s.transactionService.GetDeletedTransactions(c.Request.Context(), idEvent)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении корзины мероприятия: %w", err))
+		return
+	}
+
+	apiTransactions := make([]api.TransactionResponse, 0, len(transactions))
+	for _, t := range transactions {
+		apiTransactions = append(apiTransactions, convertTransactionToAPI(&t))
+	}
+
+	c.JSON(http.StatusOK, api.EventTrashResponse{Transactions: apiTransactions})
+}
+
+// RestoreTransaction возвращает транзакцию из корзины мероприятия
+func (s *ServerHandler) RestoreTransaction(c *gin.Context, idEvent int64, idTransaction int) {
+	transaction, err := s.transactionService.RestoreTransaction(c.Request.Context(), idEvent, idTransaction)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при восстановлении транзакции: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertTransactionToAPI(transaction))
+}
+
+// AttachTransactionFile прикрепляет к транзакции файл из ff-files
+func (s *ServerHandler) AttachTransactionFile(c *gin.Context, idEvent int64, idTransaction int) {
+	var apiRequest api.AttachmentRequest
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
+		return
+	}
+
+	attachment, err := s.transactionService.AttachFile(c.Request.Context(), idEvent, idTransaction, apiRequest.ObjectId.String())
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при прикреплении файла к транзакции: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, convertAttachmentToAPI(attachment))
+}
+
+// GetTransactionAttachmentUrl возвращает вложение транзакции со временной ссылкой на файл
+func (s *ServerHandler) GetTransactionAttachmentUrl(c *gin.Context, idEvent int64, idTransaction int, idAttachment int) {
+	attachment, err := s.transactionService.GetAttachmentURL(c.Request.Context(), idEvent, idTransaction, idAttachment)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении ссылки на вложение: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertAttachmentToAPI(attachment))
+}
+
+// DetachTransactionFile открепляет файл от транзакции
+func (s *ServerHandler) DetachTransactionFile(c *gin.Context, idEvent int64, idTransaction int, idAttachment int) {
+	err := s.transactionService.DetachFile(c.Request.Context(), idEvent, idTransaction, idAttachment)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при откреплении файла от транзакции: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.SuccessResponse{Success: true})
+}
+
+// GetTransactionItems возвращает позиции чека транзакции
+func (s *ServerHandler) GetTransactionItems(c *gin.Context, idEvent int64, idTransaction int) {
+	if !s.ensureTransactionInEvent(c, idEvent, idTransaction) {
+		return
+	}
+
+	items, err := s.transactionService.GetTransactionItems(c.Request.Context(), idTransaction)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении позиций чека: %w", err))
+		return
+	}
+
+	// Конвертируем DTO в API типы
+	apiItems := make([]api.ItemDTO, 0, len(items))
+	for _, item := range items {
+		apiItems = append(apiItems, convertItemToAPI(&item))
+	}
+
+	c.JSON(http.StatusOK, api.ItemListResponse{Items: &apiItems})
+}
+
+// CreateTransactionItem добавляет позицию в чек транзакции
+func (s *ServerHandler) CreateTransactionItem(c *gin.Context, idEvent int64, idTransaction int) {
+	if !s.ensureTransactionInEvent(c, idEvent, idTransaction) {
+		return
+	}
+
+	var apiRequest api.ItemRequest
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
+		return
+	}
+
+	item := convertItemRequestToDTO(&apiRequest)
+	transaction, err := s.transactionService.CreateTransactionItem(c.Request.Context(), idTransaction, &item)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при добавлении позиции чека: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, convertTransactionToAPI(transaction))
+}
+
+// UpdateTransactionItem обновляет позицию чека транзакции
+func (s *ServerHandler) UpdateTransactionItem(c *gin.Context, idEvent int64, idTransaction int, idItem int) {
+	if !s.ensureTransactionInEvent(c, idEvent, idTransaction) {
+		return
+	}
+
+	var apiRequest api.ItemRequest
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
+		return
+	}
+
+	item := convertItemRequestToDTO(&apiRequest)
+	transaction, err := s.transactionService.UpdateTransactionItem(c.Request.Context(), idTransaction, idItem, &item)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при обновлении позиции чека: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertTransactionToAPI(transaction))
+}
+
+// DeleteTransactionItem удаляет позицию чека транзакции
+func (s *ServerHandler) DeleteTransactionItem(c *gin.Context, idEvent int64, idTransaction int, idItem int) {
+	if !s.ensureTransactionInEvent(c, idEvent, idTransaction) {
+		return
+	}
+
+	transaction, err := s.transactionService.DeleteTransactionItem(c.Request.Context(), idTransaction, idItem)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при удалении позиции чека: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, convertTransactionToAPI(transaction))
+}
+
 // GetDebtsByEventID возвращает список долгов мероприятия
 func (s *ServerHandler) GetDebtsByEventID(c *gin.Context, idEvent int64) {
 	debts, err := s.transactionService.GetDebtsByEventID(c.Request.Context(), idEvent, nil)
@@ -136,20 +376,19 @@ func (s *ServerHandler) GetOptimizedDebtsByEventID(c *gin.Context, idEvent int64
 }
 
 // OptimizeDebts оптимизирует долги мероприятия
-func (s *ServerHandler) OptimizeDebts(c *gin.Context, idEvent int64) {
-	debts, err := s.transactionService.OptimizeDebts(c.Request.Context(), idEvent)
+func (s *ServerHandler) OptimizeDebts(c *gin.Context, idEvent int64, params api.OptimizeDebtsParams) {
+	var algorithm string
+	if params.Algorithm != nil {
+		algorithm = string(*params.Algorithm)
+	}
+
+	result, err := s.transactionService.OptimizeDebtsWithAlgorithm(c.Request.Context(), idEvent, algorithm)
 	if err != nil {
 		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при оптимизации долгов: %w", err))
 		return
 	}
 
-	// Конвертируем DTO в API типы
-	apiDebts := make([]api.OptimizedDebtDTO, 0, len(debts))
-	for _, d := range debts {
-		apiDebts = append(apiDebts, convertOptimizedDebtToAPI(&d))
-	}
-
-	c.JSON(http.StatusOK, api.OptimizedDebtListResponse{OptimizedDebts: &apiDebts})
+	c.JSON(http.StatusOK, convertOptimizationResultToAPI(result))
 }
 
 // GetOptimizedDebtsByUserID возвращает оптимизированные долги пользователя
@@ -171,6 +410,62 @@ func (s *ServerHandler) GetOptimizedDebtsByUserID(c *gin.Context, idEvent int64,
 
 // Helper functions для конвертации типов
 
+// convertOptimizationResultToAPI конвертирует результат оптимизации долгов в API тип
+func convertOptimizationResultToAPI(result *service.OptimizationResultDTO) api.OptimizationResultResponse {
+	apiDebts := make([]api.OptimizedDebtDTO, 0, len(result.OptimizedDebts))
+	for _, d := range result.OptimizedDebts {
+		apiDebts = append(apiDebts, convertOptimizedDebtToAPI(&d))
+	}
+
+	return api.OptimizationResultResponse{
+		Algorithm:         convertOptimizationAlgorithmToAPI(result.Algorithm),
+		OriginalTransfers: &result.OriginalTransfers,
+		RemovedTransfers:  &result.RemovedTransfers,
+		OptimizedDebts:    &apiDebts,
+	}
+}
+
+// convertRevisionToAPI конвертирует DTO ревизии транзакции в API тип
+func convertRevisionToAPI(revision *service.TransactionRevisionDTO) api.TransactionRevision {
+	diff := make([]api.FieldChange, len(revision.Diff))
+	for i, change := range revision.Diff {
+		diff[i] = api.FieldChange{
+			Field: change.Field,
+			Old:   change.Old,
+			New:   change.New,
+		}
+	}
+
+	return api.TransactionRevision{
+		Id:            revision.ID,
+		TransactionId: revision.TransactionID,
+		Action:        api.RevisionAction(revision.Action),
+		ActorId:       revision.ActorID,
+		RevertedFrom:  revision.RevertedFrom,
+		CreatedAt:     revision.CreatedAt,
+		Snapshot:      convertTransactionToAPI(&revision.Snapshot),
+		Diff:          diff,
+	}
+}
+
+func convertAttachmentToAPI(a *service.AttachmentDTO) api.TransactionAttachment {
+	// ID объекта хранится в БД в формате uuid, поэтому ошибки разбора не бывает
+	objectID, _ := uuid.Parse(a.ObjectID)
+
+	attachment := api.TransactionAttachment{
+		Id:        a.ID,
+		ObjectId:  objectID,
+		ExpiresAt: a.ExpiresAt,
+		CreatedBy: a.CreatedBy,
+		CreatedAt: a.CreatedAt,
+	}
+	if a.URL != "" {
+		url := a.URL
+		attachment.Url = &url
+	}
+	return attachment
+}
+
 func convertTransactionToAPI(t *service.TransactionResponse) api.TransactionResponse {
 	// Конвертируем shares
 	var shares *[]api.ShareDTO
@@ -197,17 +492,71 @@ func convertTransactionToAPI(t *service.TransactionResponse) api.TransactionResp
 		debts = &apiDebts
 	}
 
+	// Конвертируем payers
+	var payers *[]api.PayerDTO
+	if len(t.Payers) > 0 {
+		apiPayers := make([]api.PayerDTO, 0, len(t.Payers))
+		for _, p := range t.Payers {
+			apiPayers = append(apiPayers, api.PayerDTO{
+				UserId: p.UserID,
+				Amount: p.Amount,
+			})
+		}
+		payers = &apiPayers
+	}
+
+	// Конвертируем items и charges
+	var items *[]api.ItemDTO
+	if len(t.Items) > 0 {
+		apiItems := make([]api.ItemDTO, 0, len(t.Items))
+		for _, item := range t.Items {
+			apiItems = append(apiItems, convertItemToAPI(&item))
+		}
+		items = &apiItems
+	}
+
+	var charges *[]api.ChargeDTO
+	if len(t.Charges) > 0 {
+		apiCharges := make([]api.ChargeDTO, 0, len(t.Charges))
+		for _, ch := range t.Charges {
+			id := ch.ID
+			apiCharges = append(apiCharges, api.ChargeDTO{
+				Id:     &id,
+				Name:   ch.Name,
+				Amount: ch.Amount,
+			})
+		}
+		charges = &apiCharges
+	}
+
+	// Конвертируем attachments
+	var attachments *[]api.TransactionAttachment
+	if len(t.Attachments) > 0 {
+		apiAttachments := make([]api.TransactionAttachment, 0, len(t.Attachments))
+		for _, a := range t.Attachments {
+			apiAttachments = append(apiAttachments, convertAttachmentToAPI(&a))
+		}
+		attachments = &apiAttachments
+	}
+
 	return api.TransactionResponse{
 		Id:                    &t.ID,
 		EventId:               &t.EventID,
 		Name:                  &t.Name,
 		Amount:                &t.Amount,
+		Currency:              &t.Currency,
+		ExchangeRate:          &t.ExchangeRate,
 		FromUser:              &t.FromUser,
 		Type:                  &t.Type,
 		TransactionCategoryId: t.TransactionCategoryID,
 		Datetime:              &t.Datetime,
+		Payers:                payers,
+		Items:                 items,
+		Charges:               charges,
 		Shares:                shares,
 		Debts:                 debts,
+		Attachments:           attachments,
+		DeletedAt:             t.DeletedAt,
 	}
 }
 
@@ -227,6 +576,46 @@ func convertTransactionRequestToDTO(req *api.TransactionRequest) service.Transac
 		dtoReq.Portion = *req.Portion
 	}
 
+	if req.Amounts != nil {
+		dtoReq.Amounts = *req.Amounts
+	}
+
+	if req.ExcludePayer != nil {
+		dtoReq.ExcludePayer = *req.ExcludePayer
+	}
+
+	if req.Currency != nil {
+		dtoReq.Currency = *req.Currency
+	}
+	dtoReq.ExchangeRate = req.ExchangeRate
+
+	if req.Payers != nil {
+		dtoReq.Payers = make([]service.PayerDTO, 0, len(*req.Payers))
+		for _, p := range *req.Payers {
+			dtoReq.Payers = append(dtoReq.Payers, service.PayerDTO{
+				UserID: p.UserId,
+				Amount: p.Amount,
+			})
+		}
+	}
+
+	if req.Items != nil {
+		dtoReq.Items = make([]service.ItemDTO, 0, len(*req.Items))
+		for _, item := range *req.Items {
+			dtoReq.Items = append(dtoReq.Items, convertItemRequestToDTO(&item))
+		}
+	}
+
+	if req.Charges != nil {
+		dtoReq.Charges = make([]service.ChargeDTO, 0, len(*req.Charges))
+		for _, ch := range *req.Charges {
+			dtoReq.Charges = append(dtoReq.Charges, service.ChargeDTO{
+				Name:   ch.Name,
+				Amount: ch.Amount,
+			})
+		}
+	}
+
 	if req.TransactionCategoryId != nil {
 		dtoReq.TransactionCategoryID = req.TransactionCategoryId
 	}
@@ -234,6 +623,29 @@ func convertTransactionRequestToDTO(req *api.TransactionRequest) service.Transac
 	return dtoReq
 }
 
+func convertItemRequestToDTO(req *api.ItemRequest) service.ItemDTO {
+	item := service.ItemDTO{
+		Name:      req.Name,
+		Price:     req.Price,
+		Consumers: req.Consumers,
+	}
+	if req.Quantity != nil {
+		item.Quantity = *req.Quantity
+	}
+	return item
+}
+
+func convertItemToAPI(item *service.ItemDTO) api.ItemDTO {
+	return api.ItemDTO{
+		Id:        &item.ID,
+		Name:      &item.Name,
+		Price:     &item.Price,
+		Quantity:  &item.Quantity,
+		Consumers: &item.Consumers,
+		Total:     &item.Total,
+	}
+}
+
 func convertDebtToAPI(d *service.DebtDTO) api.DebtDTO {
 	return api.DebtDTO{
 		Id:            &d.ID,
@@ -245,11 +657,14 @@ func convertDebtToAPI(d *service.DebtDTO) api.DebtDTO {
 }
 
 func convertOptimizedDebtToAPI(d *service.OptimizedDebtDTO) api.OptimizedDebtDTO {
+	status := api.OptimizedDebtDTOStatus(d.Status)
 	return api.OptimizedDebtDTO{
-		Id:         &d.ID,
-		EventId:    &d.EventID,
-		FromUserId: &d.FromUserID,
-		ToUserId:   &d.ToUserID,
-		Amount:     &d.Amount,
+		Id:            &d.ID,
+		EventId:       &d.EventID,
+		FromUserId:    &d.FromUserID,
+		ToUserId:      &d.ToUserID,
+		Amount:        &d.Amount,
+		SettledAmount: &d.SettledAmount,
+		Status:        &status,
 	}
 }
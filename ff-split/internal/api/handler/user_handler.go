@@ -56,9 +56,23 @@ func (s *ServerHandler) GetUsersByEventID(c *gin.Context, idEvent int64) {
 		return
 	}
 
+	members, err := s.userService.GetEventMembers(c.Request.Context(), idEvent)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при получении участников: %w", err))
+		return
+	}
+	roles := make(map[int64]api.EventRole, len(members))
+	for _, member := range members {
+		roles[member.UserID] = api.EventRole(member.Role)
+	}
+
 	apiUsers := make([]api.UserProfileDTO, 0, len(users))
 	for i := range users {
-		apiUsers = append(apiUsers, convertUserToProfileAPI(&users[i]))
+		apiUser := convertUserToProfileAPI(&users[i])
+		if role, ok := roles[users[i].ID]; ok {
+			apiUser.Role = &role
+		}
+		apiUsers = append(apiUsers, apiUser)
 	}
 
 	c.JSON(http.StatusOK, api.UserListResponse{Users: &apiUsers})
@@ -148,6 +162,52 @@ func (s *ServerHandler) RemoveUserFromEvent(c *gin.Context, idEvent int64, idUse
 	c.JSON(http.StatusOK, api.SuccessResponse{Success: true})
 }
 
+// UpdateEventUserRole меняет роль участника мероприятия
+func (s *ServerHandler) UpdateEventUserRole(c *gin.Context, idEvent int64, idUser int64) {
+	var apiRequest api.EventRoleRequest
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
+		return
+	}
+
+	err := s.userService.ChangeEventRole(c.Request.Context(), idEvent, idUser, models.EventRole(apiRequest.Role))
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при изменении роли участника: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.SuccessResponse{Success: true})
+}
+
+// TransferEventOwnership передает владение мероприятием другому участнику
+func (s *ServerHandler) TransferEventOwnership(c *gin.Context, idEvent int64) {
+	var apiRequest api.TransferOwnershipRequest
+	if err := c.ShouldBindJSON(&apiRequest); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{
+			Id: c.GetHeader("X-Request-ID"),
+			Error: api.ErrorResponseDetail{
+				Code:    "validation",
+				Message: "некорректные данные запроса",
+			},
+		})
+		return
+	}
+
+	err := s.userService.TransferEventOwnership(c.Request.Context(), idEvent, apiRequest.UserId)
+	if err != nil {
+		errors.HTTPErrorHandler(c, fmt.Errorf("ошибка при передаче владения мероприятием: %w", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.SuccessResponse{Success: true})
+}
+
 // SyncUsers синхронизирует пользователей с ff-id сервисом
 func (s *ServerHandler) SyncUsers(c *gin.Context) {
 	var apiRequest api.SyncUsersRequest
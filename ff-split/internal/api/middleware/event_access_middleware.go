@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-auth/pkg/auth"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+)
+
+// eventIDParam - имя параметра пути с ID мероприятия
+const eventIDParam = "id_event"
+
+// EventAccessMiddleware пропускает к маршрутам мероприятия только его участников
+// и сохраняет участие с ролью в контексте запроса.
+// Маршруты без параметра id_event не проверяются.
+func EventAccessMiddleware(eventService service.Event) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rawEventID := c.Param(eventIDParam)
+		if rawEventID == "" {
+			c.Next()
+			return
+		}
+
+		// Некорректный ID отклонит сгенерированный обработчик с ошибкой 400
+		eventID, err := strconv.ParseInt(rawEventID, 10, 64)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		userData, exists := auth.GetUserData(c)
+		if !exists {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, api.ErrorResponse{
+				Id: c.GetHeader("X-Request-ID"),
+				Error: api.ErrorResponseDetail{
+					Code:    "unauthorized",
+					Message: "пользователь не авторизован",
+				},
+			})
+			return
+		}
+
+		member, err := eventService.CheckAccess(c.Request.Context(), eventID, userData.UserID)
+		if err != nil {
+			errors.HTTPErrorHandler(c, err)
+			c.Abort()
+			return
+		}
+
+		// Роль участника проверяют сервисы при изменении данных мероприятия
+		c.Request = c.Request.WithContext(access.WithMember(c.Request.Context(), member))
+		c.Next()
+	}
+}
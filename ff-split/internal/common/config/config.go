@@ -61,6 +61,15 @@ type Config struct {
 		ServiceSecret string `yaml:"service_secret" env:"TVM_SERVICE_SECRET" env-default:"secret"`
 	} `yaml:"tvm"`
 
+	Recurring struct {
+		Interval int `yaml:"interval" env:"RECURRING_INTERVAL" env-default:"60"` // Период проверки регулярных транзакций в секундах
+	} `yaml:"recurring"`
+
+	Trash struct {
+		RetentionDays int `yaml:"retention_days" env:"TRASH_RETENTION_DAYS" env-default:"30"`   // Срок хранения удаленных транзакций и мероприятий в днях
+		PurgeInterval int `yaml:"purge_interval" env:"TRASH_PURGE_INTERVAL" env-default:"3600"` // Период очистки корзины в секундах
+	} `yaml:"trash"`
+
 	Migrations struct {
 		Path string `yaml:"path" env:"MIGRATIONS_PATH" env-default:"migrations"`
 	} `yaml:"migrations"`
@@ -130,6 +139,11 @@ func loadFromEnv(cfg *Config) {
 	cfg.TVM.ServiceID = getEnvAsInt("TVM_SERVICE_ID", cfg.TVM.ServiceID)
 	cfg.TVM.ServiceSecret = getEnv("TVM_SERVICE_SECRET", cfg.TVM.ServiceSecret)
 
+	cfg.Recurring.Interval = getEnvAsInt("RECURRING_INTERVAL", cfg.Recurring.Interval)
+
+	cfg.Trash.RetentionDays = getEnvAsInt("TRASH_RETENTION_DAYS", cfg.Trash.RetentionDays)
+	cfg.Trash.PurgeInterval = getEnvAsInt("TRASH_PURGE_INTERVAL", cfg.Trash.PurgeInterval)
+
 	cfg.Migrations.Path = getEnv("MIGRATIONS_PATH", cfg.Migrations.Path)
 
 	// Устанавливаем уровень логирования
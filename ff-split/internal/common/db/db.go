@@ -28,12 +28,21 @@ func WithTx(ctx context.Context, db *gorm.DB, txFunc func(ctx context.Context) e
 // WithTxIsolation - метод поднимает транзакцию и передает в контекст вложенной функции
 // Данный метод помогает забирать транзакцию базы данных без передачи явной транзакции.
 // Метод ExtractConn помогает забрать из контекста транзакцию.
+// Если в контексте уже есть транзакция, вложенная функция выполняется в ней через точку
+// сохранения: ошибка откатывает только вложенные изменения, а фиксируются они вместе
+// с внешней транзакцией. Уровень изоляции в этом случае задает внешняя транзакция.
 func WithTxIsolation(
 	ctx context.Context,
 	db *gorm.DB,
 	isolation sql.IsolationLevel,
 	txFunc func(ctx context.Context) error,
 ) (err error) {
+	if outer, ok := ctx.Value(TxContextKey{}).(*gorm.DB); ok {
+		return outer.Transaction(func(tx *gorm.DB) error {
+			return txFunc(context.WithValue(ctx, TxContextKey{}, tx))
+		})
+	}
+
 	tx := db.Begin(&sql.TxOptions{Isolation: isolation})
 	defer func() {
 		if recoverErr := recover(); recoverErr != nil {
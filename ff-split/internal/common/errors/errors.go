@@ -50,6 +50,20 @@ func (e *AlreadyExistsError) Error() string {
 	return fmt.Sprintf("Object %s already exists: %s", e.EntityName, e.Message)
 }
 
+type ForbiddenError struct {
+	Message string
+}
+
+func NewForbiddenError(message string) *ForbiddenError {
+	return &ForbiddenError{
+		Message: message,
+	}
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("Access denied: %s", e.Message)
+}
+
 type LogicError struct {
 	Message string
 }
@@ -32,6 +32,7 @@ func HTTPErrorHandler(c *gin.Context, err error) {
 	var validationError *ValidationError
 	var alreadyExistsError *AlreadyExistsError
 	var entityNotFoundError *EntityNotFoundError
+	var forbiddenError *ForbiddenError
 	var logicError *LogicError
 
 	switch {
@@ -44,6 +45,9 @@ func HTTPErrorHandler(c *gin.Context, err error) {
 	case errors.As(err, &entityNotFoundError):
 		errorResponse = NewNotFoundErrorResponse(c.Request, entityNotFoundError.Error())
 		code = http.StatusNotFound
+	case errors.As(err, &forbiddenError):
+		errorResponse = NewForbiddenErrorResponse(c.Request, forbiddenError.Error())
+		code = http.StatusForbidden
 	case errors.Is(err, gorm.ErrRecordNotFound):
 		errorResponse = NewNotFoundErrorResponse(c.Request, "запись не найдена")
 		code = http.StatusNotFound
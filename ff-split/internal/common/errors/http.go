@@ -6,6 +6,7 @@ const (
 	ErrCodeNotFound      = "not_found"
 	ErrCodeAlreadyExists = "already_exists"
 	ErrCodeValidation    = "validation"
+	ErrCodeForbidden     = "forbidden"
 	ErrCodeLogic         = "error_logic"
 	ErrCodeDatabase      = "error_database"
 	ErrCodeInternal      = "error_internal"
@@ -48,6 +49,10 @@ func NewNotFoundErrorResponse(r *http.Request, message string) *ErrorResponse {
 	return NewErrorResponse(r, ErrCodeNotFound, message)
 }
 
+func NewForbiddenErrorResponse(r *http.Request, message string) *ErrorResponse {
+	return NewErrorResponse(r, ErrCodeForbidden, message)
+}
+
 func NewLogicErrorResponse(r *http.Request, message string, slug string) *ErrorResponse {
 	errorResponse := NewErrorResponse(r, ErrCodeLogic, message)
 	if slug != "" {
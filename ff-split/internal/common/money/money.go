@@ -0,0 +1,313 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Scale количество минорных единиц (копеек) в одной основной единице валюты
+const Scale = 100
+
+// Money представляет денежную сумму в минорных единицах (копейках).
+// Все вычисления выполняются в целых числах, поэтому суммы не теряют копейки
+// при сложении, а значения из колонок numeric(10,2) читаются без округления.
+type Money int64
+
+// Zero нулевая сумма
+const Zero Money = 0
+
+// FromMinor создает сумму из количества минорных единиц
+func FromMinor(minor int64) Money {
+	return Money(minor)
+}
+
+// FromFloat создает сумму из значения в основных единицах с округлением до копейки
+func FromFloat(value float64) Money {
+	return Money(math.Round(value * Scale))
+}
+
+// Parse разбирает десятичную строку вида "-123.45" без потери точности
+func Parse(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("пустое значение суммы")
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	// После знака допускаются только цифры: strconv.ParseInt сам принимает
+	// знак, из-за чего строки вида "--5" разбирались бы как корректные
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return 0, fmt.Errorf("неверный формат суммы: %q", s)
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	major, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("неверный формат суммы: %q", s)
+	}
+
+	var minor int64
+	for i := 0; i < 2; i++ {
+		minor *= 10
+		if i < len(fracPart) {
+			minor += int64(fracPart[i] - '0')
+		}
+	}
+	// Округляем по третьему знаку после запятой, остальные игнорируем
+	if len(fracPart) > 2 && fracPart[2] >= '5' {
+		minor++
+	}
+
+	result := major*Scale + minor
+	if negative {
+		result = -result
+	}
+	return Money(result), nil
+}
+
+// isDigits проверяет, что строка состоит только из десятичных цифр
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Minor возвращает сумму в минорных единицах
+func (m Money) Minor() int64 {
+	return int64(m)
+}
+
+// Float64 возвращает сумму в основных единицах.
+// Используется только на границе API, где суммы передаются числами.
+func (m Money) Float64() float64 {
+	return float64(m) / Scale
+}
+
+// Neg возвращает сумму с противоположным знаком
+func (m Money) Neg() Money {
+	return -m
+}
+
+// Abs возвращает абсолютное значение суммы
+func (m Money) Abs() Money {
+	if m < 0 {
+		return -m
+	}
+	return m
+}
+
+// MulFloat умножает сумму на коэффициент с округлением до копейки
+func (m Money) MulFloat(factor float64) Money {
+	return Money(math.Round(float64(m) * factor))
+}
+
+// Allocate распределяет сумму пропорционально весам методом наибольшего остатка.
+// Каждая часть сначала округляется вниз до копейки, а оставшиеся копейки
+// достаются частям с наибольшим дробным остатком. При равных остатках
+// приоритет у части с меньшим индексом, поэтому порядок весов задает
+// детерминированный tie-break. Сумма частей всегда равна исходной сумме.
+func (m Money) Allocate(weights []float64) ([]Money, error) {
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("нет весов для распределения суммы")
+	}
+
+	var totalWeight float64
+	for _, w := range weights {
+		if w < 0 {
+			return nil, fmt.Errorf("вес для распределения суммы не может быть отрицательным")
+		}
+		totalWeight += w
+	}
+	if totalWeight <= 0 {
+		return nil, fmt.Errorf("сумма весов для распределения должна быть положительной")
+	}
+
+	sign := int64(1)
+	total := int64(m)
+	if total < 0 {
+		sign = -1
+		total = -total
+	}
+
+	parts := make([]Money, len(weights))
+	remainders := make([]float64, len(weights))
+	var allocated int64
+	for i, w := range weights {
+		exact := float64(total) * w / totalWeight
+		floor := int64(math.Floor(exact))
+		parts[i] = Money(floor)
+		remainders[i] = exact - float64(floor)
+		allocated += floor
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+
+	for i := int64(0); i < total-allocated; i++ {
+		parts[order[int(i)%len(order)]]++
+	}
+
+	if sign < 0 {
+		for i := range parts {
+			parts[i] = -parts[i]
+		}
+	}
+	return parts, nil
+}
+
+// AllocateByAmounts распределяет сумму пропорционально другим суммам (например, надбавку
+// пропорционально стоимости позиций). В отличие от Allocate, веса не проходят через float64:
+// доли и остатки считаются в целых копейках, а оставшиеся копейки распределяются
+// методом наибольшего остатка с тем же tie-break по индексу.
+func (m Money) AllocateByAmounts(weights []Money) ([]Money, error) {
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("нет весов для распределения суммы")
+	}
+
+	var totalWeight uint64
+	for _, w := range weights {
+		if w < 0 {
+			return nil, fmt.Errorf("вес для распределения суммы не может быть отрицательным")
+		}
+		totalWeight += uint64(w)
+	}
+	if totalWeight == 0 {
+		return nil, fmt.Errorf("сумма весов для распределения должна быть положительной")
+	}
+
+	sign := int64(1)
+	total := int64(m)
+	if total < 0 {
+		sign = -1
+		total = -total
+	}
+
+	parts := make([]Money, len(weights))
+	remainders := make([]uint64, len(weights))
+	var allocated int64
+	for i, w := range weights {
+		// total * w / totalWeight без переполнения: w <= totalWeight, поэтому частное умещается в uint64
+		hi, lo := bits.Mul64(uint64(total), uint64(w))
+		quo, rem := bits.Div64(hi, lo, totalWeight)
+		parts[i] = Money(quo)
+		remainders[i] = rem
+		allocated += int64(quo)
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+
+	for i := int64(0); i < total-allocated; i++ {
+		parts[order[int(i)%len(order)]]++
+	}
+
+	if sign < 0 {
+		for i := range parts {
+			parts[i] = -parts[i]
+		}
+	}
+	return parts, nil
+}
+
+// String возвращает десятичное представление суммы с двумя знаками после запятой
+func (m Money) String() string {
+	sign := ""
+	value := int64(m)
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, value/Scale, value%Scale)
+}
+
+// MarshalJSON сериализует сумму как JSON-число с двумя знаками после запятой
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON разбирает сумму из JSON-числа или строки
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "null" {
+		return nil
+	}
+	// Числа в экспоненциальной записи разбираем как float64
+	if strings.ContainsAny(s, "eE") {
+		value, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("неверный формат суммы: %q", s)
+		}
+		*m = FromFloat(value)
+		return nil
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value реализует driver.Valuer для записи в колонки numeric
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan реализует sql.Scanner для чтения из колонок numeric
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = 0
+		return nil
+	case string:
+		parsed, err := Parse(v)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	case []byte:
+		parsed, err := Parse(string(v))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	case int64:
+		*m = Money(v * Scale)
+		return nil
+	case float64:
+		*m = FromFloat(v)
+		return nil
+	default:
+		return fmt.Errorf("невозможно преобразовать %T в денежную сумму", src)
+	}
+}
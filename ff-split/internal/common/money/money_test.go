@@ -0,0 +1,125 @@
+package money
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		in   string
+		want Money
+	}{
+		{"100.50", 10050},
+		{"100.5", 10050},
+		{"100", 10000},
+		{"0.01", 1},
+		{"-33.33", -3333},
+		{".5", 50},
+		{"1.005", 101},
+		{"1.004", 100},
+	}
+	for _, c := range cases {
+		got, err := Parse(c.in)
+		assert.NoError(t, err, c.in)
+		assert.Equal(t, c.want, got, c.in)
+	}
+
+	for _, in := range []string{"abc", "", "--5", "-+5", "+-5", "5-", "1.-5", "1.2.3", ".", "-"} {
+		_, err := Parse(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	assert.Equal(t, Money(10050), FromFloat(100.50))
+	assert.Equal(t, Money(29), FromFloat(0.29))
+	assert.Equal(t, Money(-1), FromFloat(-0.01))
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "100.50", Money(10050).String())
+	assert.Equal(t, "-0.05", Money(-5).String())
+	assert.Equal(t, "0.00", Zero.String())
+}
+
+func TestScan(t *testing.T) {
+	var m Money
+	assert.NoError(t, m.Scan("123.45"))
+	assert.Equal(t, Money(12345), m)
+	assert.NoError(t, m.Scan([]byte("-0.10")))
+	assert.Equal(t, Money(-10), m)
+	assert.NoError(t, m.Scan(nil))
+	assert.Equal(t, Zero, m)
+	assert.Error(t, m.Scan(true))
+}
+
+func TestJSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Amount Money `json:"amount"`
+	}{Amount: 10050})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":100.50}`, string(data))
+
+	var parsed struct {
+		Amount Money `json:"amount"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount":1e2}`), &parsed))
+	assert.Equal(t, Money(10000), parsed.Amount)
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount":"7.07"}`), &parsed))
+	assert.Equal(t, Money(707), parsed.Amount)
+	// Числа из тела запроса разбираются из десятичной записи, минуя float64
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount":1.005}`), &parsed))
+	assert.Equal(t, Money(101), parsed.Amount)
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":"--5"}`), &parsed))
+}
+
+func TestAllocate(t *testing.T) {
+	parts, err := Money(10000).Allocate([]float64{1, 1, 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []Money{3334, 3333, 3333}, parts)
+
+	parts, err = Money(-100).Allocate([]float64{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []Money{-33, -67}, parts)
+
+	parts, err = Money(1001).Allocate([]float64{50, 0, 50})
+	assert.NoError(t, err)
+	assert.Equal(t, []Money{501, 0, 500}, parts)
+
+	_, err = Money(100).Allocate(nil)
+	assert.Error(t, err)
+	_, err = Money(100).Allocate([]float64{1, -1})
+	assert.Error(t, err)
+	_, err = Money(100).Allocate([]float64{0, 0})
+	assert.Error(t, err)
+}
+
+func TestAllocateByAmounts(t *testing.T) {
+	parts, err := Money(1000).AllocateByAmounts([]Money{3333, 3333, 3334})
+	assert.NoError(t, err)
+	assert.Equal(t, []Money{333, 333, 334}, parts)
+
+	parts, err = Money(-101).AllocateByAmounts([]Money{100, 100})
+	assert.NoError(t, err)
+	assert.Equal(t, []Money{-51, -50}, parts)
+
+	parts, err = Money(1).AllocateByAmounts([]Money{0, 5})
+	assert.NoError(t, err)
+	assert.Equal(t, []Money{0, 1}, parts)
+
+	// Большие суммы не переполняются при умножении
+	parts, err = Money(math.MaxInt64 / 2).AllocateByAmounts([]Money{math.MaxInt64 / 4, math.MaxInt64 / 4})
+	assert.NoError(t, err)
+	assert.Equal(t, Money(math.MaxInt64/2), parts[0]+parts[1])
+
+	_, err = Money(100).AllocateByAmounts(nil)
+	assert.Error(t, err)
+	_, err = Money(100).AllocateByAmounts([]Money{1, -1})
+	assert.Error(t, err)
+	_, err = Money(100).AllocateByAmounts([]Money{0, 0})
+	assert.Error(t, err)
+}
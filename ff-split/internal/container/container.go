@@ -12,6 +12,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/ivasnev/FinFlow/ff-auth/pkg/auth"
+	fffilesadapter "github.com/ivasnev/FinFlow/ff-split/internal/adapters/fffiles"
 	ffidadapter "github.com/ivasnev/FinFlow/ff-split/internal/adapters/ffid"
 	handler "github.com/ivasnev/FinFlow/ff-split/internal/api/handler"
 	"github.com/ivasnev/FinFlow/ff-split/internal/api/middleware"
@@ -20,17 +21,31 @@ import (
 	activity_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/activity"
 	category_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/category"
 	event_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/event"
+	exchange_rate_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/exchange_rate"
+	settlement_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/settlement"
 	icon_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/icon"
+	invite_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/invite"
+	claim_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/claim"
+	recurring_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/recurring"
+	analytics_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/analytics"
 	task_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/task"
 	transaction_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/transaction"
 	user_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/user"
 	"github.com/ivasnev/FinFlow/ff-split/internal/service"
 	activity_service "github.com/ivasnev/FinFlow/ff-split/internal/service/activity"
 	category_service "github.com/ivasnev/FinFlow/ff-split/internal/service/category"
+	currency_service "github.com/ivasnev/FinFlow/ff-split/internal/service/currency"
 	event_service "github.com/ivasnev/FinFlow/ff-split/internal/service/event"
 	icon_service "github.com/ivasnev/FinFlow/ff-split/internal/service/icon"
+	invite_service "github.com/ivasnev/FinFlow/ff-split/internal/service/invite"
+	claim_service "github.com/ivasnev/FinFlow/ff-split/internal/service/claim"
+	recurring_service "github.com/ivasnev/FinFlow/ff-split/internal/service/recurring"
+	analytics_service "github.com/ivasnev/FinFlow/ff-split/internal/service/analytics"
+	export_service "github.com/ivasnev/FinFlow/ff-split/internal/service/export"
+	import_service "github.com/ivasnev/FinFlow/ff-split/internal/service/importer"
 	task_service "github.com/ivasnev/FinFlow/ff-split/internal/service/task"
 	transaction_service "github.com/ivasnev/FinFlow/ff-split/internal/service/transaction"
+	trash_service "github.com/ivasnev/FinFlow/ff-split/internal/service/trash"
 	user_service "github.com/ivasnev/FinFlow/ff-split/internal/service/user"
 	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
 	tvmclient "github.com/ivasnev/FinFlow/ff-tvm/pkg/client"
@@ -53,29 +68,48 @@ type Container struct {
 	DB     *gorm.DB
 
 	// Репозитории
-	CategoryRepository    repository.Category
-	EventRepository       repository.Event
-	ActivityRepository    repository.Activity
-	UserRepository        repository.User
-	IconRepository        repository.Icon
-	TaskRepository        repository.Task
-	TransactionRepository repository.Transaction
+	CategoryRepository     repository.Category
+	EventRepository        repository.Event
+	ActivityRepository     repository.Activity
+	UserRepository         repository.User
+	IconRepository         repository.Icon
+	TaskRepository         repository.Task
+	TransactionRepository  repository.Transaction
+	ExchangeRateRepository repository.ExchangeRate
+	SettlementRepository   repository.Settlement
+	InviteRepository       repository.Invite
+	DummyClaimRepository   repository.DummyClaim
+	RecurringRepository    repository.Recurring
+	AnalyticsRepository    repository.Analytics
 
 	// Сервисы
-	CategoryService    service.Category
-	EventService       service.Event
-	ActivityService    service.Activity
-	UserService        service.User
-	IconService        service.Icon
-	TaskService        service.Task
-	TransactionService service.Transaction
+	CategoryService     service.Category
+	EventService        service.Event
+	ActivityService     service.Activity
+	UserService         service.User
+	IconService         service.Icon
+	TaskService         service.Task
+	TransactionService  service.Transaction
+	ExchangeRateService service.ExchangeRate
+	InviteService       service.Invite
+	DummyClaimService   service.DummyClaim
+	RecurringService    service.Recurring
+	AnalyticsService    service.Analytics
+	ExportService       service.Export
+	ImportService       service.Import
+	TrashService        service.Trash
 
 	// Адаптеры
-	IDAdapter *ffidadapter.Adapter
+	IDAdapter    *ffidadapter.Adapter
+	FilesAdapter *fffilesadapter.Adapter
 
 	// Обработчик
 	ServerHandler *handler.ServerHandler
 
+	// Фоновые обработчики
+	RecurringWorker *recurring_service.Worker
+	TrashWorker     *trash_service.Worker
+
 	// Клиенты внешних сервисов
 	AuthClient *auth.Client
 	TVMClient  *tvmclient.TVMClient
@@ -124,6 +158,21 @@ func NewContainer(cfg *config.Config, router *gin.Engine) (*Container, error) {
 		return nil, fmt.Errorf("ошибка инициализации ff-id адаптера: %w", err)
 	}
 
+	// Инициализируем адаптер ff-files с собственным TVM транспортом
+	filesHTTPClient := &http.Client{
+		Transport: tvmtransport.NewTVMTransport(
+			container.TVMClient,
+			http.DefaultTransport,
+			cfg.TVM.ServiceID,
+			cfg.FileService.ServiceID,
+		),
+		Timeout: 10 * time.Second,
+	}
+	container.FilesAdapter, err = fffilesadapter.NewAdapter(cfg.FileService.BaseURL, filesHTTPClient)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка инициализации ff-files адаптера: %w", err)
+	}
+
 	container.initRepositories()
 	container.initServices()
 	container.initHandler()
@@ -140,17 +189,33 @@ func (c *Container) initRepositories() {
 	c.IconRepository = icon_repository.NewIconRepository(c.DB)
 	c.TaskRepository = task_repository.NewTaskRepository(c.DB)
 	c.TransactionRepository = transaction_repository.NewTransactionRepository(c.DB)
+	c.ExchangeRateRepository = exchange_rate_repository.NewExchangeRateRepository(c.DB)
+	c.SettlementRepository = settlement_repository.NewSettlementRepository(c.DB)
+	c.InviteRepository = invite_repository.NewInviteRepository(c.DB)
+	c.DummyClaimRepository = claim_repository.NewDummyClaimRepository(c.DB)
+	c.RecurringRepository = recurring_repository.NewRecurringRepository(c.DB)
+	c.AnalyticsRepository = analytics_repository.NewAnalyticsRepository(c.DB)
 }
 
 // initServices инициализирует сервисы
 func (c *Container) initServices() {
-	c.UserService = user_service.NewUserService(c.UserRepository, c.IDAdapter)
-	c.CategoryService = category_service.NewCategoryService(c.CategoryRepository)
-	c.EventService = event_service.NewEventService(c.EventRepository, c.DB, c.UserService, c.CategoryService)
 	c.ActivityService = activity_service.NewActivityService(c.ActivityRepository)
+	c.UserService = user_service.NewUserService(c.UserRepository, c.IDAdapter, c.ActivityService)
+	c.CategoryService = category_service.NewCategoryService(c.CategoryRepository)
+	c.EventService = event_service.NewEventService(c.EventRepository, c.DB, c.UserService, c.CategoryService, c.ActivityService)
 	c.IconService = icon_service.NewIconService(c.IconRepository)
-	c.TaskService = task_service.NewTaskService(c.TaskRepository, c.UserService)
-	c.TransactionService = transaction_service.NewTransactionService(c.DB, c.TransactionRepository, c.UserService, c.EventService)
+	c.TaskService = task_service.NewTaskService(c.TaskRepository, c.UserService, c.ActivityService)
+	c.ExchangeRateService = currency_service.NewExchangeRateService(c.ExchangeRateRepository)
+	c.TransactionService = transaction_service.NewTransactionService(c.DB, c.TransactionRepository, c.SettlementRepository, c.UserService, c.EventService, c.ExchangeRateService, c.ActivityService, c.FilesAdapter)
+	c.InviteService = invite_service.NewInviteService(c.DB, c.InviteRepository, c.UserService, c.EventService)
+	c.RecurringService = recurring_service.NewRecurringService(c.DB, c.RecurringRepository, c.TransactionService)
+	c.DummyClaimService = claim_service.NewDummyClaimService(c.DB, c.DummyClaimRepository, c.UserService, c.RecurringService)
+	c.AnalyticsService = analytics_service.NewAnalyticsService(c.AnalyticsRepository, c.EventService)
+	c.ExportService = export_service.NewExportService(c.EventService, c.UserService, c.TransactionService, c.AnalyticsService, c.CategoryService)
+	c.ImportService = import_service.NewImportService(c.DB, c.TransactionService, c.UserService, c.CategoryService)
+	c.TrashService = trash_service.NewTrashService(c.TransactionRepository, c.EventRepository, c.FilesAdapter, 24*time.Hour*time.Duration(c.Config.Trash.RetentionDays))
+	c.RecurringWorker = recurring_service.NewWorker(c.RecurringService, time.Second*time.Duration(c.Config.Recurring.Interval))
+	c.TrashWorker = trash_service.NewWorker(c.TrashService, time.Second*time.Duration(c.Config.Trash.PurgeInterval))
 }
 
 // initHandler инициализирует ServerHandler
@@ -163,6 +228,13 @@ func (c *Container) initHandler() {
 		c.TaskService,
 		c.CategoryService,
 		c.IconService,
+		c.ExchangeRateService,
+		c.InviteService,
+		c.DummyClaimService,
+		c.RecurringService,
+		c.AnalyticsService,
+		c.ExportService,
+		c.ImportService,
 	)
 }
 
@@ -226,6 +298,8 @@ func (c *Container) RegisterRoutes() {
 
 	// Middleware для авторизации
 	authMiddleware := auth.AuthMiddleware(c.AuthClient)
+	// Middleware для проверки участия в мероприятии
+	eventAccessMiddleware := middleware.EventAccessMiddleware(c.EventService)
 
 	// Регистрируем маршруты с помощью сгенерированного кода
 	api.RegisterHandlersWithOptions(c.Router, c.ServerHandler, api.GinServerOptions{
@@ -234,6 +308,9 @@ func (c *Container) RegisterRoutes() {
 			func(c *gin.Context) {
 				authMiddleware(c)
 			},
+			func(c *gin.Context) {
+				eventAccessMiddleware(c)
+			},
 		},
 	})
 
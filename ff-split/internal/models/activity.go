@@ -1,12 +1,51 @@
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
+
+// Типы активностей мероприятия
+const (
+	ActivityTypeCustom              = "custom" // Активность, созданная клиентом вручную
+	ActivityTypeTransactionCreated  = "transaction_created"
+	ActivityTypeTransactionUpdated  = "transaction_updated"
+	ActivityTypeTransactionDeleted  = "transaction_deleted"
+	ActivityTypeTransactionRestored = "transaction_restored"
+	ActivityTypeMemberJoined        = "member_joined"
+	ActivityTypeMemberLeft          = "member_left"
+	ActivityTypeDebtsOptimized      = "debts_optimized"
+	ActivityTypeTaskCreated         = "task_created"
+	ActivityTypeTaskUpdated         = "task_updated"
+	ActivityTypeTaskDeleted         = "task_deleted"
+	ActivityTypeEventStatusChanged  = "event_status_changed"
+	ActivityTypeEventRestored       = "event_restored"
+)
+
+// ActivityTypes - все известные типы активностей
+var ActivityTypes = []string{
+	ActivityTypeCustom,
+	ActivityTypeTransactionCreated,
+	ActivityTypeTransactionUpdated,
+	ActivityTypeTransactionDeleted,
+	ActivityTypeTransactionRestored,
+	ActivityTypeMemberJoined,
+	ActivityTypeMemberLeft,
+	ActivityTypeDebtsOptimized,
+	ActivityTypeTaskCreated,
+	ActivityTypeTaskUpdated,
+	ActivityTypeTaskDeleted,
+	ActivityTypeEventStatusChanged,
+	ActivityTypeEventRestored,
+}
 
 // Activity представляет действие в системе
 type Activity struct {
 	ID          int
 	EventID     *int64
-	UserID      *int64
+	UserID      *int64 // Автор действия (внутренний ID пользователя)
+	Type        string
+	Payload     json.RawMessage // Данные события, структура зависит от Type
 	Description string
 	IconID      int
 	CreatedAt   time.Time
@@ -16,3 +55,46 @@ type Activity struct {
 	Event *Event
 	User  *User
 }
+
+// ActivityFilter задает фильтры и страницу ленты активности мероприятия.
+// Лента отдается от новых активностей к старым.
+type ActivityFilter struct {
+	Types    []string
+	UserID   *int64
+	From     *time.Time
+	To       *time.Time
+	BeforeID *int // Курсор: только активности с ID меньше указанного
+	Limit    int
+}
+
+// TransactionActivityPayload - данные активностей transaction_*
+type TransactionActivityPayload struct {
+	TransactionID int    `json:"transaction_id"`
+	Name          string `json:"name"`
+	Amount        string `json:"amount"`
+	Currency      string `json:"currency"`
+}
+
+// MemberActivityPayload - данные активностей member_*
+type MemberActivityPayload struct {
+	UserID int64  `json:"user_id"`
+	Role   string `json:"role,omitempty"`
+}
+
+// DebtsOptimizedActivityPayload - данные активности debts_optimized
+type DebtsOptimizedActivityPayload struct {
+	Algorithm  string `json:"algorithm"`
+	DebtsCount int    `json:"debts_count"`
+}
+
+// TaskActivityPayload - данные активностей task_*
+type TaskActivityPayload struct {
+	TaskID int    `json:"task_id"`
+	Title  string `json:"title"`
+}
+
+// EventStatusActivityPayload - данные активности event_status_changed
+type EventStatusActivityPayload struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
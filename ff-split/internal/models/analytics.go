@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+)
+
+// Шаг временного ряда аналитики
+const (
+	AnalyticsIntervalDay  = "day"
+	AnalyticsIntervalWeek = "week"
+)
+
+// AnalyticsFilter ограничивает транзакции, по которым строится аналитика
+type AnalyticsFilter struct {
+	From *time.Time // Начало периода включительно; nil - без ограничения
+	To   *time.Time // Конец периода не включительно; nil - без ограничения
+}
+
+// SpendingTotal представляет общие расходы мероприятия
+type SpendingTotal struct {
+	Amount            money.Money
+	TransactionsCount int
+}
+
+// CategorySpending представляет расходы по категории транзакций
+type CategorySpending struct {
+	CategoryID        *int // nil - транзакции без категории
+	CategoryName      string
+	Amount            money.Money
+	TransactionsCount int
+}
+
+// UserSpending представляет сумму, оплаченную или потребленную пользователем
+type UserSpending struct {
+	UserID int64
+	Amount money.Money
+}
+
+// SpendingPoint представляет расходы за один период временного ряда
+type SpendingPoint struct {
+	PeriodStart       time.Time
+	Amount            money.Money
+	TransactionsCount int
+}
+
+// TopExpense представляет одну из самых крупных транзакций мероприятия
+type TopExpense struct {
+	TransactionID int
+	Name          string
+	CategoryID    *int
+	Datetime      time.Time
+	PayerID       *int64
+	Amount        money.Money
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// Статусы заявки на dummy-пользователя
+const (
+	DummyClaimStatusPending  = "pending"  // Заявка ожидает решения администратора
+	DummyClaimStatusApproved = "approved" // Заявка одобрена, dummy-пользователь объединен с заявителем
+	DummyClaimStatusRejected = "rejected" // Заявка отклонена
+)
+
+// DummyClaim представляет заявку участника мероприятия на dummy-пользователя.
+// После одобрения доли, долги, задачи и активности dummy-пользователя переходят заявителю.
+type DummyClaim struct {
+	ID             int
+	EventID        int64
+	DummyUserID    *int64 // Внутренний ID dummy-пользователя; nil после объединения
+	DummyName      string // Имя dummy-пользователя на момент подачи заявки
+	ClaimantUserID int64  // Внутренний ID заявителя
+	Status         string
+	ResolvedBy     *int64 // Внутренний ID участника, принявшего решение
+	ResolvedAt     *time.Time
+	CreatedAt      time.Time
+}
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+)
 
 // Debt представляет долг одного пользователя другому
 type Debt struct {
@@ -8,7 +12,7 @@ type Debt struct {
 	TransactionID int
 	FromUserID    int64
 	ToUserID      int64
-	Amount        float64
+	Amount        money.Money
 
 	// Отношения
 	Transaction *Transaction
@@ -18,13 +22,39 @@ type Debt struct {
 
 // OptimizedDebt представляет оптимизированные долги между пользователями
 type OptimizedDebt struct {
-	ID         int
-	EventID    int64
-	FromUserID int64
-	ToUserID   int64
-	Amount     float64
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	ID            int
+	EventID       int64
+	FromUserID    int64
+	ToUserID      int64
+	Amount        money.Money
+	SettledAmount money.Money
+	Status        string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	// Отношения
+	FromUser *User
+	ToUser   *User
+}
+
+// Статусы погашения оптимизированного долга
+const (
+	OptimizedDebtStatusPending = "pending" // Долг не погашен
+	OptimizedDebtStatusPartial = "partial" // Долг погашен частично
+	OptimizedDebtStatusSettled = "settled" // Долг погашен полностью
+)
+
+// Settlement представляет погашение долга: FromUserID вернул ToUserID сумму Amount
+// в базовой валюте мероприятия
+type Settlement struct {
+	ID              int
+	EventID         int64
+	FromUserID      int64
+	ToUserID        int64
+	Amount          money.Money
+	OptimizedDebtID *int
+	Comment         string
+	CreatedAt       time.Time
 
 	// Отношения
 	FromUser *User
@@ -1,13 +1,18 @@
 package models
 
+import "time"
+
 // Event представляет модель мероприятия
 type Event struct {
-	ID          int64
-	Name        string
-	Description string
-	CategoryID  *int
-	ImageID     string
-	Status      string
+	ID                    int64
+	Name                  string
+	Description           string
+	CategoryID            *int
+	ImageID               string
+	Status                string
+	Currency              string
+	OptimizationAlgorithm string
+	DeletedAt             *time.Time // Время удаления в корзину; nil для неудаленных мероприятий
 
 	// Отношения
 	Category     *EventCategory
@@ -17,6 +22,14 @@ type Event struct {
 	Tasks        []Task
 }
 
+// Статусы жизненного цикла мероприятия
+const (
+	EventStatusActive   = "active"   // Мероприятие ведется: транзакции можно добавлять и менять
+	EventStatusSettling = "settling" // Идут расчеты: транзакции заморожены, участники гасят долги
+	EventStatusClosed   = "closed"   // Мероприятие закрыто: план переводов зафиксирован
+	EventStatusArchived = "archived" // Мероприятие в архиве: скрыто из списка мероприятий
+)
+
 // EventCategory представляет категорию мероприятия
 type EventCategory struct {
 	ID     int
@@ -28,8 +41,19 @@ type EventCategory struct {
 	Events []Event
 }
 
+// EventRole представляет роль участника в мероприятии
+type EventRole string
+
+const (
+	EventRoleOwner  EventRole = "owner"  // Владелец: полный доступ, единственный на мероприятие
+	EventRoleAdmin  EventRole = "admin"  // Администратор: управление мероприятием и участниками
+	EventRoleMember EventRole = "member" // Участник: ведение транзакций и погашений
+	EventRoleViewer EventRole = "viewer" // Наблюдатель: только просмотр
+)
+
 // UserEvent представляет связь между пользователем и мероприятием
 type UserEvent struct {
 	UserID  int64
 	EventID int64
+	Role    EventRole
 }
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// ExchangeRate представляет курс обмена одной валюты на другую
+type ExchangeRate struct {
+	ID           int
+	CurrencyFrom string
+	CurrencyTo   string
+	Rate         float64
+	UpdatedAt    time.Time
+}
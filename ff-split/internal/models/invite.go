@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// EventInvite представляет приглашение в мероприятие по ссылке.
+// Присоединившийся по приглашению пользователь получает роль Role.
+type EventInvite struct {
+	ID        int
+	EventID   int64
+	Token     string
+	Role      EventRole
+	MaxUses   *int       // Сколько раз можно воспользоваться приглашением; nil - без ограничения
+	Uses      int        // Сколько раз приглашением уже воспользовались
+	ExpiresAt *time.Time // Срок действия; nil - бессрочно
+	CreatedBy *int64     // Внутренний ID создавшего приглашение участника
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// IsExpired проверяет, истек ли срок действия приглашения на момент now
+func (i *EventInvite) IsExpired(now time.Time) bool {
+	return i.ExpiresAt != nil && !now.Before(*i.ExpiresAt)
+}
+
+// IsExhausted проверяет, исчерпан ли лимит использований приглашения
+func (i *EventInvite) IsExhausted() bool {
+	return i.MaxUses != nil && i.Uses >= *i.MaxUses
+}
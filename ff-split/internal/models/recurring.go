@@ -0,0 +1,43 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Частоты повторения регулярной транзакции
+const (
+	RecurrenceDaily   = "daily"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly" // Если в месяце нет дня StartDate, используется последний день месяца
+	RecurrenceYearly  = "yearly"
+)
+
+// RecurringTransaction представляет шаблон регулярной транзакции (аренда, интернет, подписки).
+// Повторы выпадают на StartDate + k * Interval периодов Frequency и создаются фоновым обработчиком.
+type RecurringTransaction struct {
+	ID        int
+	EventID   int64
+	Frequency string
+	Interval  int             // Каждые Interval периодов (например, каждые 2 недели)
+	StartDate time.Time       // Дата и время первого повтора
+	EndDate   *time.Time      // Последний момент, на который может выпасть повтор; nil - бессрочно
+	Body      json.RawMessage // Тело запроса на создание транзакции (service.TransactionRequest)
+	Paused    bool
+	NextIndex int        // Номер следующего повтора k
+	NextRunAt *time.Time // Время следующего повтора; nil - повторы закончились
+	CreatedBy *int64     // Внутренний ID создавшего шаблон участника
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RecurringOccurrence представляет созданный повтор регулярной транзакции.
+// Уникальность (RecurringID, ScheduledAt) не дает создать повтор дважды.
+type RecurringOccurrence struct {
+	ID            int
+	RecurringID   int
+	ScheduledAt   time.Time
+	TransactionID *int    // Созданная транзакция; nil, если создать не удалось
+	Error         *string // Причина, по которой транзакция не создана
+	CreatedAt     time.Time
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Действия с транзакцией, после которых записывается ревизия
+const (
+	RevisionActionCreated  = "created"
+	RevisionActionUpdated  = "updated"
+	RevisionActionDeleted  = "deleted"
+	RevisionActionRestored = "restored"
+	RevisionActionReverted = "reverted"
+)
+
+// TransactionRevision представляет неизменяемую ревизию транзакции
+type TransactionRevision struct {
+	ID            int
+	TransactionID int
+	EventID       int64
+	Action        string
+	ActorID       *int64          // Автор изменения (внутренний ID пользователя)
+	RevertedFrom  *int            // Ревизия, к которой откатили транзакцию
+	Snapshot      json.RawMessage // Транзакция с долями, оплатами, позициями и долгами после изменения
+	Diff          json.RawMessage // Поля, измененные относительно предыдущей ревизии
+	CreatedAt     time.Time
+}
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+)
 
 // Transaction представляет транзакцию
 type Transaction struct {
@@ -9,16 +13,47 @@ type Transaction struct {
 	Name                  string
 	TransactionCategoryID *int
 	Datetime              time.Time
-	TotalPaid             float64
+	TotalPaid             money.Money
 	PayerID               *int64
 	SplitType             int
+	Currency              string
+	ExchangeRate          float64
+	DeletedAt             *time.Time // Время удаления в корзину; nil для неудаленных транзакций
 
 	// Отношения
 	Event               *Event
 	TransactionCategory *TransactionCategory
 	Payer               *User
+	Payers              []TransactionPayer
+	Items               []TransactionItem
+	Charges             []TransactionCharge
 	Shares              []TransactionShare
 	Debts               []Debt
+	Attachments         []TransactionAttachment
+}
+
+// Поля сортировки списка транзакций
+const (
+	TransactionSortDate   = "date"
+	TransactionSortAmount = "amount"
+)
+
+// TransactionFilter задает фильтры, сортировку и страницу списка транзакций мероприятия.
+// Суммы сравниваются в базовой валюте мероприятия. При равных значениях поля сортировки
+// транзакции упорядочиваются по ID в том же направлении.
+type TransactionFilter struct {
+	From          *time.Time
+	To            *time.Time
+	CategoryID    *int
+	PayerID       *int64 // Плательщик: основной или один из нескольких
+	ParticipantID *int64 // Участник, на которого приходится доля
+	AmountMin     *money.Money
+	AmountMax     *money.Money
+	Search        string // Подстрока названия без учета регистра
+	SortBy        string
+	Descending    bool
+	AfterID       *int // Курсор: только транзакции после транзакции с этим ID в порядке сортировки
+	Limit         int
 }
 
 // TransactionCategory представляет категорию транзакции
@@ -37,9 +72,69 @@ type TransactionShare struct {
 	ID            int
 	TransactionID int
 	UserID        int64
-	Value         float64
+	Value         money.Money
+
+	// Отношения
+	Transaction *Transaction
+	User        *User
+}
+
+// TransactionPayer представляет сумму, оплаченную пользователем в транзакции
+type TransactionPayer struct {
+	ID            int
+	TransactionID int
+	UserID        int64
+	Amount        money.Money
 
 	// Отношения
 	Transaction *Transaction
 	User        *User
 }
+
+// TransactionItem представляет позицию чека в транзакции
+type TransactionItem struct {
+	ID            int
+	TransactionID int
+	Name          string
+	Price         money.Money
+	Quantity      float64
+
+	// Отношения
+	Transaction *Transaction
+	Consumers   []TransactionItemConsumer
+}
+
+// TransactionItemConsumer представляет пользователя, разделяющего позицию чека
+type TransactionItemConsumer struct {
+	ID     int
+	ItemID int
+	UserID int64
+
+	// Отношения
+	Item *TransactionItem
+	User *User
+}
+
+// TransactionCharge представляет общую надбавку чека (налог, чаевые, сервисный сбор)
+type TransactionCharge struct {
+	ID            int
+	TransactionID int
+	Name          string
+	Amount        money.Money
+
+	// Отношения
+	Transaction *Transaction
+}
+
+// TransactionAttachment представляет файл, прикрепленный к транзакции (например, фото чека).
+// Сам файл хранится в ff-files, здесь только ID объекта
+type TransactionAttachment struct {
+	ID            int
+	TransactionID int
+	ObjectID      string
+	CreatedBy     *int64 // Внутренний ID пользователя, прикрепившего файл
+	CreatedAt     time.Time
+
+	// Отношения
+	Transaction *Transaction
+}
@@ -9,6 +9,7 @@ import (
 // Activity определяет методы для работы с активностями
 type Activity interface {
 	GetByEventID(ctx context.Context, eventID int64) ([]models.Activity, error)
+	GetFeed(ctx context.Context, eventID int64, filter *models.ActivityFilter) ([]models.Activity, error)
 	GetByID(ctx context.Context, id int) (*models.Activity, error)
 	Create(ctx context.Context, activity *models.Activity) (*models.Activity, error)
 	Update(ctx context.Context, id int, activity *models.Activity) (*models.Activity, error)
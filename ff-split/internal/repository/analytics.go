@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// Analytics определяет методы агрегации расходов мероприятия.
+// Все суммы возвращаются в базовой валюте мероприятия.
+type Analytics interface {
+	// GetTotal возвращает общую сумму расходов и число транзакций
+	GetTotal(ctx context.Context, eventID int64, filter models.AnalyticsFilter) (*models.SpendingTotal, error)
+
+	// GetByCategory возвращает расходы в разрезе категорий, по убыванию суммы
+	GetByCategory(ctx context.Context, eventID int64, filter models.AnalyticsFilter) ([]models.CategorySpending, error)
+
+	// GetPaidByUser возвращает суммы, оплаченные каждым пользователем
+	GetPaidByUser(ctx context.Context, eventID int64, filter models.AnalyticsFilter) ([]models.UserSpending, error)
+
+	// GetConsumedByUser возвращает суммы, приходящиеся на долю каждого участника
+	GetConsumedByUser(ctx context.Context, eventID int64, filter models.AnalyticsFilter) ([]models.UserSpending, error)
+
+	// GetTimeSeries возвращает расходы по периодам interval (day | week) в порядке возрастания
+	GetTimeSeries(ctx context.Context, eventID int64, filter models.AnalyticsFilter, interval string) ([]models.SpendingPoint, error)
+
+	// GetTopExpenses возвращает не более limit самых крупных транзакций
+	GetTopExpenses(ctx context.Context, eventID int64, filter models.AnalyticsFilter, limit int) ([]models.TopExpense, error)
+}
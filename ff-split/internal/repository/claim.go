@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// DummyClaim определяет методы для работы с заявками на dummy-пользователей
+type DummyClaim interface {
+	// Create создает заявку
+	Create(ctx context.Context, claim *models.DummyClaim) error
+
+	// GetByID возвращает заявку по ID или nil, если заявка не найдена
+	GetByID(ctx context.Context, id int) (*models.DummyClaim, error)
+
+	// GetPendingByDummyUserID возвращает нерассмотренную заявку на dummy-пользователя или nil
+	GetPendingByDummyUserID(ctx context.Context, dummyUserID int64) (*models.DummyClaim, error)
+
+	// GetPendingByEventID возвращает нерассмотренные заявки мероприятия
+	GetPendingByEventID(ctx context.Context, eventID int64) ([]models.DummyClaim, error)
+
+	// Resolve переводит нерассмотренную заявку в статус status.
+	// Возвращает false, если заявка уже рассмотрена.
+	Resolve(ctx context.Context, id int, status string, resolvedBy *int64) (bool, error)
+}
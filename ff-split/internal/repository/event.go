@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 )
 
@@ -10,9 +12,15 @@ import (
 type Event interface {
 	GetAll(ctx context.Context) ([]models.Event, error)
 	GetByID(ctx context.Context, id int64) (*models.Event, error)
-	GetByUserID(ctx context.Context, userID int64) ([]models.Event, error)
-	CalculateUserBalances(ctx context.Context, userID int64, eventIDs []int64) (map[int64]float64, error)
+	GetByUserID(ctx context.Context, userID int64, includeArchived bool) ([]models.Event, error)
+	GetMemberByExternalUserID(ctx context.Context, eventID, externalUserID int64) (*models.UserEvent, error)
+	CalculateUserBalances(ctx context.Context, userID int64, eventIDs []int64) (map[int64]money.Money, error)
 	Create(ctx context.Context, event *models.Event) error
 	Update(ctx context.Context, id int64, event *models.Event) error
 	Delete(ctx context.Context, id int64) error
+
+	// Корзина: удаленные мероприятия хранятся до окончательной очистки
+	GetDeletedByUserID(ctx context.Context, userID int64) ([]models.Event, error)
+	Restore(ctx context.Context, id int64) error
+	PurgeDeleted(ctx context.Context, before time.Time) (int, error)
 }
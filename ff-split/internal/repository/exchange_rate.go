@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// ExchangeRate определяет методы для работы с курсами валют
+type ExchangeRate interface {
+	GetAll(ctx context.Context) ([]models.ExchangeRate, error)
+	GetRate(ctx context.Context, from, to string) (*models.ExchangeRate, error)
+	Upsert(ctx context.Context, rate *models.ExchangeRate) error
+	Delete(ctx context.Context, from, to string) error
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// Invite определяет методы для работы с приглашениями в мероприятия
+type Invite interface {
+	// Create создает приглашение
+	Create(ctx context.Context, invite *models.EventInvite) error
+
+	// GetByID возвращает приглашение по ID или nil, если приглашение не найдено
+	GetByID(ctx context.Context, id int) (*models.EventInvite, error)
+
+	// GetByToken возвращает приглашение по коду или nil, если приглашение не найдено
+	GetByToken(ctx context.Context, token string) (*models.EventInvite, error)
+
+	// GetActiveByEventID возвращает неотозванные, непросроченные и неисчерпанные приглашения мероприятия
+	GetActiveByEventID(ctx context.Context, eventID int64) ([]models.EventInvite, error)
+
+	// IncrementUses учитывает использование приглашения.
+	// Возвращает false, если лимит использований уже исчерпан.
+	IncrementUses(ctx context.Context, id int) (bool, error)
+
+	// Revoke отзывает приглашение
+	Revoke(ctx context.Context, id int) error
+}
@@ -0,0 +1,2 @@
+drop index if exists idx_transaction_payers_tx_id;
+drop table if exists transaction_payers cascade;
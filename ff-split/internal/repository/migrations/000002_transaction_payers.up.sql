@@ -0,0 +1,17 @@
+-- Суммы, оплаченные пользователями в транзакции (несколько плательщиков)
+create table transaction_payers
+(
+    id             serial primary key,                               -- ID записи
+    transaction_id integer references transactions on delete cascade,-- Транзакция
+    user_id        bigint references users (id),                     -- Плательщик
+    amount         numeric(10, 2) not null,                          -- Оплаченная сумма
+    constraint uniq_tx_payer unique (transaction_id, user_id)        -- Один пользователь — одна оплата в одной транзакции
+);
+
+create index idx_transaction_payers_tx_id on transaction_payers (transaction_id);
+
+-- Переносим существующие транзакции: весь платеж вносил payer_id
+insert into transaction_payers (transaction_id, user_id, amount)
+select id, payer_id, total_paid
+from transactions
+where payer_id is not null;
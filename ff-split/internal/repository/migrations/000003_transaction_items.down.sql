@@ -0,0 +1,6 @@
+drop index if exists idx_transaction_charges_tx_id;
+drop index if exists idx_transaction_item_consumers_item_id;
+drop index if exists idx_transaction_items_tx_id;
+drop table if exists transaction_charges cascade;
+drop table if exists transaction_item_consumers cascade;
+drop table if exists transaction_items cascade;
@@ -0,0 +1,33 @@
+-- Позиции чека (построчное деление транзакции)
+create table transaction_items
+(
+    id             serial primary key,                               -- ID позиции
+    transaction_id integer references transactions on delete cascade,-- Транзакция
+    name           varchar(255)   not null,                          -- Название позиции
+    price          numeric(10, 2) not null,                          -- Цена за единицу
+    quantity       numeric(10, 3) not null default 1                 -- Количество
+);
+
+create index idx_transaction_items_tx_id on transaction_items (transaction_id);
+
+-- Потребители позиции чека (стоимость позиции делится между ними поровну)
+create table transaction_item_consumers
+(
+    id      serial primary key,                                      -- ID записи
+    item_id integer references transaction_items on delete cascade,  -- Позиция чека
+    user_id bigint references users (id),                            -- Пользователь-потребитель
+    constraint uniq_item_consumer unique (item_id, user_id)
+);
+
+create index idx_transaction_item_consumers_item_id on transaction_item_consumers (item_id);
+
+-- Общие надбавки чека (налог, чаевые, сервисный сбор), делятся пропорционально позициям
+create table transaction_charges
+(
+    id             serial primary key,                               -- ID надбавки
+    transaction_id integer references transactions on delete cascade,-- Транзакция
+    name           varchar(255)   not null,                          -- Название надбавки
+    amount         numeric(10, 2) not null                           -- Сумма надбавки
+);
+
+create index idx_transaction_charges_tx_id on transaction_charges (transaction_id);
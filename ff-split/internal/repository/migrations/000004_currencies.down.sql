@@ -0,0 +1,8 @@
+drop table if exists exchange_rates cascade;
+
+alter table transactions
+    drop column if exists exchange_rate,
+    drop column if exists currency;
+
+alter table events
+    drop column if exists currency;
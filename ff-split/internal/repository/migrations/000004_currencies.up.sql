@@ -0,0 +1,19 @@
+-- Базовая валюта мероприятия
+alter table events
+    add column currency varchar(3) not null default 'RUB';
+
+-- Валюта транзакции и курс к базовой валюте мероприятия на момент ввода
+alter table transactions
+    add column currency      varchar(3)     not null default 'RUB',
+    add column exchange_rate numeric(18, 8) not null default 1;
+
+-- Курсы валют, которые задаются вручную
+create table exchange_rates
+(
+    id            serial primary key,                  -- ID курса
+    currency_from varchar(3)     not null,             -- Исходная валюта
+    currency_to   varchar(3)     not null,             -- Целевая валюта
+    rate          numeric(18, 8) not null,             -- Сколько единиц currency_to стоит одна единица currency_from
+    updated_at    timestamp default CURRENT_TIMESTAMP, -- Время обновления курса
+    constraint uniq_exchange_rate unique (currency_from, currency_to)
+);
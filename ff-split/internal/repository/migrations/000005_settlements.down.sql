@@ -0,0 +1,5 @@
+drop table if exists settlements cascade;
+
+alter table optimized_debts
+    drop column if exists status,
+    drop column if exists settled_amount;
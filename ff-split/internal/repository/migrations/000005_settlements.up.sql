@@ -0,0 +1,20 @@
+-- Погашенная часть оптимизированного долга
+alter table optimized_debts
+    add column settled_amount numeric(10, 2) not null default 0,
+    add column status         varchar(16)    not null default 'pending';
+
+-- Погашения долгов: from_user_id вернул to_user_id сумму amount в базовой валюте мероприятия
+create table settlements
+(
+    id                serial primary key,                                     -- ID погашения
+    event_id          bigint         not null references events on delete cascade, -- Событие
+    from_user_id      bigint         not null references users (id),           -- Кто вернул деньги
+    to_user_id        bigint         not null references users (id),           -- Кому вернули деньги
+    amount            numeric(10, 2) not null check (amount > 0),              -- Сумма погашения
+    optimized_debt_id integer references optimized_debts on delete set null,   -- Погашаемый оптимизированный долг
+    comment           text,                                                    -- Комментарий
+    created_at        timestamp default CURRENT_TIMESTAMP                      -- Время создания
+);
+
+create index idx_settlements_event_id on settlements (event_id);
+create index idx_settlements_optimized_debt_id on settlements (optimized_debt_id);
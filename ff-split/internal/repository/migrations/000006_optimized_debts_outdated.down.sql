@@ -0,0 +1,2 @@
+alter table events
+    drop column if exists optimized_debts_outdated;
@@ -0,0 +1,3 @@
+-- Признак устаревших оптимизированных долгов: выставляется при любом изменении долгов мероприятия
+alter table events
+    add column optimized_debts_outdated boolean not null default true;
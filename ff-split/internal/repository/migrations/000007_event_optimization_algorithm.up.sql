@@ -0,0 +1,3 @@
+-- Алгоритм оптимизации долгов мероприятия (имя из реестра оптимизаторов ff-common)
+alter table events
+    add column optimization_algorithm varchar(32) not null default 'dinic';
@@ -0,0 +1,4 @@
+drop index if exists uniq_user_event_owner;
+
+alter table user_event
+    drop column if exists role;
@@ -0,0 +1,18 @@
+-- Роль участника в мероприятии: owner, admin, member, viewer
+alter table user_event
+    add column role varchar(16) not null default 'member'
+        check (role in ('owner', 'admin', 'member', 'viewer'));
+
+-- Владельцем существующих мероприятий становится первый реальный участник
+update user_event ue
+set role = 'owner'
+from (select ue2.event_id, min(ue2.user_id) as user_id
+      from user_event ue2
+               join users u on u.id = ue2.user_id
+      where not coalesce(u.is_dummy, false)
+      group by ue2.event_id) owners
+where ue.event_id = owners.event_id
+  and ue.user_id = owners.user_id;
+
+-- У мероприятия не больше одного владельца
+create unique index uniq_user_event_owner on user_event (event_id) where role = 'owner';
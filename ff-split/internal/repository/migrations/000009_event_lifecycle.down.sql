@@ -0,0 +1,9 @@
+alter table events
+    drop constraint if exists events_status_check;
+
+update events
+set status = case status when 'active' then 'active' else 'archive' end;
+
+alter table events
+    add constraint events_status_check
+        check (status in ('active', 'archive'));
@@ -0,0 +1,11 @@
+-- Жизненный цикл мероприятия: active -> settling -> closed -> archived
+alter table events
+    drop constraint if exists events_status_check;
+
+update events
+set status = 'archived'
+where status = 'archive';
+
+alter table events
+    add constraint events_status_check
+        check (status in ('active', 'settling', 'closed', 'archived'));
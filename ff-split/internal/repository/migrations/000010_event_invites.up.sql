@@ -0,0 +1,17 @@
+-- Приглашения в мероприятие по ссылке: присоединившийся получает роль role
+create table event_invites
+(
+    id         serial primary key,                                         -- ID приглашения
+    event_id   bigint      not null references events on delete cascade,   -- Событие
+    token      varchar(64) not null unique,                                -- Код приглашения
+    role       varchar(16) not null default 'member'
+        check (role in ('admin', 'member', 'viewer')),                      -- Роль присоединившегося
+    max_uses   integer check (max_uses > 0),                               -- Лимит использований
+    uses       integer     not null default 0,                             -- Число использований
+    expires_at timestamp,                                                  -- Срок действия
+    created_by bigint references users (id) on delete set null,           -- Кто создал приглашение
+    revoked_at timestamp,                                                  -- Время отзыва
+    created_at timestamp default CURRENT_TIMESTAMP                         -- Время создания
+);
+
+create index idx_event_invites_event_id on event_invites (event_id);
@@ -0,0 +1 @@
+drop table if exists dummy_claims cascade;
@@ -0,0 +1,18 @@
+-- Заявки участников на dummy-пользователей: после одобрения dummy объединяется с заявителем
+create table dummy_claims
+(
+    id               serial primary key,                                       -- ID заявки
+    event_id         bigint       not null references events on delete cascade, -- Событие
+    dummy_user_id    bigint references users (id) on delete set null,          -- Dummy-пользователь
+    dummy_name       varchar(100) not null,                                    -- Имя dummy-пользователя
+    claimant_user_id bigint       not null references users (id) on delete cascade, -- Заявитель
+    status           varchar(16)  not null default 'pending'
+        check (status in ('pending', 'approved', 'rejected')),                 -- Статус заявки
+    resolved_by      bigint references users (id) on delete set null,          -- Кто принял решение
+    resolved_at      timestamp,                                                -- Время решения
+    created_at       timestamp default CURRENT_TIMESTAMP                       -- Время подачи
+);
+
+create index idx_dummy_claims_event_id on dummy_claims (event_id);
+-- На одного dummy-пользователя может быть только одна нерассмотренная заявка
+create unique index uniq_dummy_claims_pending on dummy_claims (dummy_user_id) where status = 'pending';
@@ -0,0 +1,2 @@
+drop table if exists recurring_occurrences cascade;
+drop table if exists recurring_transactions cascade;
@@ -0,0 +1,33 @@
+-- Шаблоны регулярных транзакций: повторы выпадают на start_date + k * interval_count периодов frequency
+create table recurring_transactions
+(
+    id          serial primary key,                                       -- ID шаблона
+    event_id    bigint      not null references events on delete cascade, -- Событие
+    frequency   varchar(16) not null
+        check (frequency in ('daily', 'weekly', 'monthly', 'yearly')),    -- Частота
+    interval_count integer  not null default 1 check (interval_count > 0), -- Каждые interval_count периодов
+    start_date  timestamp   not null,                                     -- Первый повтор
+    end_date    timestamp,                                                -- Последний возможный повтор
+    body        jsonb       not null,                                     -- Тело запроса на создание транзакции
+    paused      boolean     not null default false,                       -- Шаблон приостановлен
+    next_index  integer     not null default 0,                           -- Номер следующего повтора
+    next_run_at timestamp,                                                -- Время следующего повтора
+    created_by  bigint references users (id) on delete set null,         -- Кто создал шаблон
+    created_at  timestamp default CURRENT_TIMESTAMP,                      -- Время создания
+    updated_at  timestamp default CURRENT_TIMESTAMP                       -- Время обновления
+);
+
+create index idx_recurring_transactions_event_id on recurring_transactions (event_id);
+create index idx_recurring_transactions_next_run_at on recurring_transactions (next_run_at) where not paused;
+
+-- Созданные повторы регулярных транзакций
+create table recurring_occurrences
+(
+    id             serial primary key,                                                    -- ID повтора
+    recurring_id   integer   not null references recurring_transactions on delete cascade, -- Шаблон
+    scheduled_at   timestamp not null,                                                    -- Время повтора по расписанию
+    transaction_id integer references transactions on delete set null,                   -- Созданная транзакция
+    error          text,                                                                  -- Ошибка создания транзакции
+    created_at     timestamp default CURRENT_TIMESTAMP,                                   -- Время создания
+    constraint uniq_recurring_occurrence unique (recurring_id, scheduled_at)               -- Повтор создается один раз
+);
@@ -0,0 +1,5 @@
+drop index if exists idx_activities_event_feed;
+
+alter table activities
+    drop column if exists payload,
+    drop column if exists type;
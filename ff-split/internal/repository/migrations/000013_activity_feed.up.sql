@@ -0,0 +1,7 @@
+-- Лента активности: тип события и его данные в JSON. Автор события хранится в user_id.
+-- Активности, которые записывает сам ff-split, создаются без иконки.
+alter table activities
+    add column type    varchar(32) not null default 'custom',
+    add column payload jsonb;
+
+create index idx_activities_event_feed on activities (event_id, id desc);
@@ -0,0 +1,8 @@
+drop index if exists idx_events_deleted_at;
+drop index if exists idx_transactions_deleted_at;
+
+alter table events
+    drop column if exists deleted_at;
+
+alter table transactions
+    drop column if exists deleted_at;
@@ -0,0 +1,10 @@
+-- Мягкое удаление транзакций и мероприятий: удаленные записи попадают в корзину
+-- и окончательно удаляются фоновой очисткой после срока хранения
+alter table transactions
+    add column deleted_at timestamp;
+
+alter table events
+    add column deleted_at timestamp;
+
+create index idx_transactions_deleted_at on transactions (deleted_at) where deleted_at is not null;
+create index idx_events_deleted_at on events (deleted_at) where deleted_at is not null;
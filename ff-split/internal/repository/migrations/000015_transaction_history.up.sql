@@ -0,0 +1,16 @@
+-- История изменений транзакций: неизменяемые ревизии с полным снимком транзакции
+-- и списком измененных полей относительно предыдущей ревизии
+create table transaction_revisions
+(
+    id             serial primary key,                                             -- ID ревизии
+    transaction_id integer     not null references transactions on delete cascade, -- Транзакция
+    event_id       bigint      not null,                                           -- Мероприятие транзакции
+    action         varchar(16) not null,                                           -- created | updated | deleted | restored | reverted
+    actor_id       bigint references users (id) on delete set null,                -- Автор изменения (внутренний ID)
+    reverted_from  integer references transaction_revisions (id),                  -- Ревизия, к которой откатили транзакцию
+    snapshot       jsonb       not null,                                           -- Транзакция с долями, оплатами, позициями и долгами
+    diff           jsonb,                                                          -- Измененные поля
+    created_at     timestamp default CURRENT_TIMESTAMP                             -- Время изменения
+);
+
+create index idx_transaction_revisions_tx on transaction_revisions (transaction_id, id);
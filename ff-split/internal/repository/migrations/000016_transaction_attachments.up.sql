@@ -0,0 +1,10 @@
+-- Вложения транзакций: фотографии чеков хранятся в ff-files, здесь только ID объектов
+create table transaction_attachments
+(
+    id             serial primary key,                                             -- ID вложения
+    transaction_id integer not null references transactions on delete cascade,     -- Транзакция
+    object_id      uuid    not null,                                               -- ID файла в ff-files
+    created_by     bigint references users (id) on delete set null,                -- Кто прикрепил файл (внутренний ID)
+    created_at     timestamp default CURRENT_TIMESTAMP,                             -- Время прикрепления
+    unique (transaction_id, object_id)
+);
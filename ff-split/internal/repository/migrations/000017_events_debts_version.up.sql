@@ -0,0 +1,4 @@
+-- Версия долгов мероприятия: увеличивается вместе с признаком optimized_debts_outdated.
+-- План переводов сохраняется, только если версия не изменилась с момента чтения долгов
+alter table events
+    add column debts_version bigint not null default 0;
@@ -0,0 +1,6 @@
+delete from transaction_revisions r
+where not exists (select 1 from transactions t where t.id = r.transaction_id);
+
+alter table transaction_revisions
+    add constraint transaction_revisions_transaction_id_fkey
+        foreign key (transaction_id) references transactions on delete cascade;
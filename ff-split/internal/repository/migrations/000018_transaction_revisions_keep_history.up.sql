@@ -0,0 +1,4 @@
+-- История изменений переживает очистку корзины: ревизии не удаляются вместе с транзакцией
+-- и удаляются только при очистке всего мероприятия
+alter table transaction_revisions
+    drop constraint if exists transaction_revisions_transaction_id_fkey;
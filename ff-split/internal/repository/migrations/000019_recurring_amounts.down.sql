@@ -0,0 +1,4 @@
+update recurring_transactions
+set body = (body - 'amounts') || jsonb_build_object('portion', body -> 'amounts')
+where body ->> 'type' = 'amount'
+  and body ? 'amounts';
@@ -0,0 +1,7 @@
+-- Суммы участников для типа amount хранятся в поле amounts, а не в portion:
+-- числа переносятся из JSON как есть, поэтому копейки не проходят через float
+update recurring_transactions
+set body = (body - 'portion') || jsonb_build_object('amounts', body -> 'portion')
+where body ->> 'type' = 'amount'
+  and body ? 'portion'
+  and not body ? 'amounts';
@@ -94,6 +94,21 @@ func (mr *MockActivityMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockActivity)(nil).GetByID), ctx, id)
 }
 
+// GetFeed mocks base method.
+func (m *MockActivity) GetFeed(ctx context.Context, eventID int64, filter *models.ActivityFilter) ([]models.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, eventID, filter)
+	ret0, _ := ret[0].([]models.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockActivityMockRecorder) GetFeed(ctx, eventID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockActivity)(nil).GetFeed), ctx, eventID, filter)
+}
+
 // Update mocks base method.
 func (m *MockActivity) Update(ctx context.Context, id int, activity *models.Activity) (*models.Activity, error) {
 	m.ctrl.T.Helper()
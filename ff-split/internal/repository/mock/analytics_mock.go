@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/analytics.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// MockAnalytics is a mock of Analytics interface.
+type MockAnalytics struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyticsMockRecorder
+}
+
+// MockAnalyticsMockRecorder is the mock recorder for MockAnalytics.
+type MockAnalyticsMockRecorder struct {
+	mock *MockAnalytics
+}
+
+// NewMockAnalytics creates a new mock instance.
+func NewMockAnalytics(ctrl *gomock.Controller) *MockAnalytics {
+	mock := &MockAnalytics{ctrl: ctrl}
+	mock.recorder = &MockAnalyticsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalytics) EXPECT() *MockAnalyticsMockRecorder {
+	return m.recorder
+}
+
+// GetByCategory mocks base method.
+func (m *MockAnalytics) GetByCategory(ctx context.Context, eventID int64, filter models.AnalyticsFilter) ([]models.CategorySpending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCategory", ctx, eventID, filter)
+	ret0, _ := ret[0].([]models.CategorySpending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCategory indicates an expected call of GetByCategory.
+func (mr *MockAnalyticsMockRecorder) GetByCategory(ctx, eventID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCategory", reflect.TypeOf((*MockAnalytics)(nil).GetByCategory), ctx, eventID, filter)
+}
+
+// GetConsumedByUser mocks base method.
+func (m *MockAnalytics) GetConsumedByUser(ctx context.Context, eventID int64, filter models.AnalyticsFilter) ([]models.UserSpending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumedByUser", ctx, eventID, filter)
+	ret0, _ := ret[0].([]models.UserSpending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumedByUser indicates an expected call of GetConsumedByUser.
+func (mr *MockAnalyticsMockRecorder) GetConsumedByUser(ctx, eventID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumedByUser", reflect.TypeOf((*MockAnalytics)(nil).GetConsumedByUser), ctx, eventID, filter)
+}
+
+// GetPaidByUser mocks base method.
+func (m *MockAnalytics) GetPaidByUser(ctx context.Context, eventID int64, filter models.AnalyticsFilter) ([]models.UserSpending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaidByUser", ctx, eventID, filter)
+	ret0, _ := ret[0].([]models.UserSpending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaidByUser indicates an expected call of GetPaidByUser.
+func (mr *MockAnalyticsMockRecorder) GetPaidByUser(ctx, eventID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaidByUser", reflect.TypeOf((*MockAnalytics)(nil).GetPaidByUser), ctx, eventID, filter)
+}
+
+// GetTimeSeries mocks base method.
+func (m *MockAnalytics) GetTimeSeries(ctx context.Context, eventID int64, filter models.AnalyticsFilter, interval string) ([]models.SpendingPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeSeries", ctx, eventID, filter, interval)
+	ret0, _ := ret[0].([]models.SpendingPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeSeries indicates an expected call of GetTimeSeries.
+func (mr *MockAnalyticsMockRecorder) GetTimeSeries(ctx, eventID, filter, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeSeries", reflect.TypeOf((*MockAnalytics)(nil).GetTimeSeries), ctx, eventID, filter, interval)
+}
+
+// GetTopExpenses mocks base method.
+func (m *MockAnalytics) GetTopExpenses(ctx context.Context, eventID int64, filter models.AnalyticsFilter, limit int) ([]models.TopExpense, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopExpenses", ctx, eventID, filter, limit)
+	ret0, _ := ret[0].([]models.TopExpense)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopExpenses indicates an expected call of GetTopExpenses.
+func (mr *MockAnalyticsMockRecorder) GetTopExpenses(ctx, eventID, filter, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopExpenses", reflect.TypeOf((*MockAnalytics)(nil).GetTopExpenses), ctx, eventID, filter, limit)
+}
+
+// GetTotal mocks base method.
+func (m *MockAnalytics) GetTotal(ctx context.Context, eventID int64, filter models.AnalyticsFilter) (*models.SpendingTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotal", ctx, eventID, filter)
+	ret0, _ := ret[0].(*models.SpendingTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotal indicates an expected call of GetTotal.
+func (mr *MockAnalyticsMockRecorder) GetTotal(ctx, eventID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotal", reflect.TypeOf((*MockAnalytics)(nil).GetTotal), ctx, eventID, filter)
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/claim.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// MockDummyClaim is a mock of DummyClaim interface.
+type MockDummyClaim struct {
+	ctrl     *gomock.Controller
+	recorder *MockDummyClaimMockRecorder
+}
+
+// MockDummyClaimMockRecorder is the mock recorder for MockDummyClaim.
+type MockDummyClaimMockRecorder struct {
+	mock *MockDummyClaim
+}
+
+// NewMockDummyClaim creates a new mock instance.
+func NewMockDummyClaim(ctrl *gomock.Controller) *MockDummyClaim {
+	mock := &MockDummyClaim{ctrl: ctrl}
+	mock.recorder = &MockDummyClaimMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDummyClaim) EXPECT() *MockDummyClaimMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDummyClaim) Create(ctx context.Context, claim *models.DummyClaim) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, claim)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDummyClaimMockRecorder) Create(ctx, claim interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDummyClaim)(nil).Create), ctx, claim)
+}
+
+// GetByID mocks base method.
+func (m *MockDummyClaim) GetByID(ctx context.Context, id int) (*models.DummyClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.DummyClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockDummyClaimMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockDummyClaim)(nil).GetByID), ctx, id)
+}
+
+// GetPendingByDummyUserID mocks base method.
+func (m *MockDummyClaim) GetPendingByDummyUserID(ctx context.Context, dummyUserID int64) (*models.DummyClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingByDummyUserID", ctx, dummyUserID)
+	ret0, _ := ret[0].(*models.DummyClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingByDummyUserID indicates an expected call of GetPendingByDummyUserID.
+func (mr *MockDummyClaimMockRecorder) GetPendingByDummyUserID(ctx, dummyUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingByDummyUserID", reflect.TypeOf((*MockDummyClaim)(nil).GetPendingByDummyUserID), ctx, dummyUserID)
+}
+
+// GetPendingByEventID mocks base method.
+func (m *MockDummyClaim) GetPendingByEventID(ctx context.Context, eventID int64) ([]models.DummyClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingByEventID", ctx, eventID)
+	ret0, _ := ret[0].([]models.DummyClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingByEventID indicates an expected call of GetPendingByEventID.
+func (mr *MockDummyClaimMockRecorder) GetPendingByEventID(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingByEventID", reflect.TypeOf((*MockDummyClaim)(nil).GetPendingByEventID), ctx, eventID)
+}
+
+// Resolve mocks base method.
+func (m *MockDummyClaim) Resolve(ctx context.Context, id int, status string, resolvedBy *int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, id, status, resolvedBy)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockDummyClaimMockRecorder) Resolve(ctx, id, status, resolvedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockDummyClaim)(nil).Resolve), ctx, id, status, resolvedBy)
+}
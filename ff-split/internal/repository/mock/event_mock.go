@@ -7,8 +7,10 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	money "github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	models "github.com/ivasnev/FinFlow/ff-split/internal/models"
 )
 
@@ -36,10 +38,10 @@ func (m *MockEvent) EXPECT() *MockEventMockRecorder {
 }
 
 // CalculateUserBalances mocks base method.
-func (m *MockEvent) CalculateUserBalances(ctx context.Context, userID int64, eventIDs []int64) (map[int64]float64, error) {
+func (m *MockEvent) CalculateUserBalances(ctx context.Context, userID int64, eventIDs []int64) (map[int64]money.Money, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CalculateUserBalances", ctx, userID, eventIDs)
-	ret0, _ := ret[0].(map[int64]float64)
+	ret0, _ := ret[0].(map[int64]money.Money)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -109,18 +111,77 @@ func (mr *MockEventMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 }
 
 // GetByUserID mocks base method.
-func (m *MockEvent) GetByUserID(ctx context.Context, userID int64) ([]models.Event, error) {
+func (m *MockEvent) GetByUserID(ctx context.Context, userID int64, includeArchived bool) ([]models.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID, includeArchived)
 	ret0, _ := ret[0].([]models.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByUserID indicates an expected call of GetByUserID.
-func (mr *MockEventMockRecorder) GetByUserID(ctx, userID interface{}) *gomock.Call {
+func (mr *MockEventMockRecorder) GetByUserID(ctx, userID, includeArchived interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockEvent)(nil).GetByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockEvent)(nil).GetByUserID), ctx, userID, includeArchived)
+}
+
+// GetDeletedByUserID mocks base method.
+func (m *MockEvent) GetDeletedByUserID(ctx context.Context, userID int64) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByUserID", ctx, userID)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByUserID indicates an expected call of GetDeletedByUserID.
+func (mr *MockEventMockRecorder) GetDeletedByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByUserID", reflect.TypeOf((*MockEvent)(nil).GetDeletedByUserID), ctx, userID)
+}
+
+// GetMemberByExternalUserID mocks base method.
+func (m *MockEvent) GetMemberByExternalUserID(ctx context.Context, eventID, externalUserID int64) (*models.UserEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberByExternalUserID", ctx, eventID, externalUserID)
+	ret0, _ := ret[0].(*models.UserEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberByExternalUserID indicates an expected call of GetMemberByExternalUserID.
+func (mr *MockEventMockRecorder) GetMemberByExternalUserID(ctx, eventID, externalUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberByExternalUserID", reflect.TypeOf((*MockEvent)(nil).GetMemberByExternalUserID), ctx, eventID, externalUserID)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockEvent) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockEventMockRecorder) PurgeDeleted(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockEvent)(nil).PurgeDeleted), ctx, before)
+}
+
+// Restore mocks base method.
+func (m *MockEvent) Restore(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockEventMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockEvent)(nil).Restore), ctx, id)
 }
 
 // Update mocks base method.
@@ -0,0 +1,183 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/recurring.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// MockRecurring is a mock of Recurring interface.
+type MockRecurring struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecurringMockRecorder
+}
+
+// MockRecurringMockRecorder is the mock recorder for MockRecurring.
+type MockRecurringMockRecorder struct {
+	mock *MockRecurring
+}
+
+// NewMockRecurring creates a new mock instance.
+func NewMockRecurring(ctrl *gomock.Controller) *MockRecurring {
+	mock := &MockRecurring{ctrl: ctrl}
+	mock.recorder = &MockRecurringMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecurring) EXPECT() *MockRecurringMockRecorder {
+	return m.recorder
+}
+
+// Advance mocks base method.
+func (m *MockRecurring) Advance(ctx context.Context, id, fromIndex, nextIndex int, nextRunAt *time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Advance", ctx, id, fromIndex, nextIndex, nextRunAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Advance indicates an expected call of Advance.
+func (mr *MockRecurringMockRecorder) Advance(ctx, id, fromIndex, nextIndex, nextRunAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Advance", reflect.TypeOf((*MockRecurring)(nil).Advance), ctx, id, fromIndex, nextIndex, nextRunAt)
+}
+
+// Create mocks base method.
+func (m *MockRecurring) Create(ctx context.Context, recurring *models.RecurringTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, recurring)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecurringMockRecorder) Create(ctx, recurring interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecurring)(nil).Create), ctx, recurring)
+}
+
+// CreateOccurrence mocks base method.
+func (m *MockRecurring) CreateOccurrence(ctx context.Context, occurrence *models.RecurringOccurrence) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOccurrence", ctx, occurrence)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOccurrence indicates an expected call of CreateOccurrence.
+func (mr *MockRecurringMockRecorder) CreateOccurrence(ctx, occurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOccurrence", reflect.TypeOf((*MockRecurring)(nil).CreateOccurrence), ctx, occurrence)
+}
+
+// Delete mocks base method.
+func (m *MockRecurring) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRecurringMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecurring)(nil).Delete), ctx, id)
+}
+
+// GetByEventID mocks base method.
+func (m *MockRecurring) GetByEventID(ctx context.Context, eventID int64) ([]models.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEventID", ctx, eventID)
+	ret0, _ := ret[0].([]models.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEventID indicates an expected call of GetByEventID.
+func (mr *MockRecurringMockRecorder) GetByEventID(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEventID", reflect.TypeOf((*MockRecurring)(nil).GetByEventID), ctx, eventID)
+}
+
+// GetByID mocks base method.
+func (m *MockRecurring) GetByID(ctx context.Context, id int) (*models.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRecurringMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRecurring)(nil).GetByID), ctx, id)
+}
+
+// GetByParticipant mocks base method.
+func (m *MockRecurring) GetByParticipant(ctx context.Context, userID int64) ([]models.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParticipant", ctx, userID)
+	ret0, _ := ret[0].([]models.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByParticipant indicates an expected call of GetByParticipant.
+func (mr *MockRecurringMockRecorder) GetByParticipant(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParticipant", reflect.TypeOf((*MockRecurring)(nil).GetByParticipant), ctx, userID)
+}
+
+// GetDue mocks base method.
+func (m *MockRecurring) GetDue(ctx context.Context, now time.Time, limit int) ([]models.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDue", ctx, now, limit)
+	ret0, _ := ret[0].([]models.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDue indicates an expected call of GetDue.
+func (mr *MockRecurringMockRecorder) GetDue(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDue", reflect.TypeOf((*MockRecurring)(nil).GetDue), ctx, now, limit)
+}
+
+// SetOccurrenceResult mocks base method.
+func (m *MockRecurring) SetOccurrenceResult(ctx context.Context, id int, transactionID *int, errMsg *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOccurrenceResult", ctx, id, transactionID, errMsg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOccurrenceResult indicates an expected call of SetOccurrenceResult.
+func (mr *MockRecurringMockRecorder) SetOccurrenceResult(ctx, id, transactionID, errMsg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOccurrenceResult", reflect.TypeOf((*MockRecurring)(nil).SetOccurrenceResult), ctx, id, transactionID, errMsg)
+}
+
+// Update mocks base method.
+func (m *MockRecurring) Update(ctx context.Context, recurring *models.RecurringTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, recurring)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRecurringMockRecorder) Update(ctx, recurring interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRecurring)(nil).Update), ctx, recurring)
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/settlement.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// MockSettlement is a mock of Settlement interface.
+type MockSettlement struct {
+	ctrl     *gomock.Controller
+	recorder *MockSettlementMockRecorder
+}
+
+// MockSettlementMockRecorder is the mock recorder for MockSettlement.
+type MockSettlementMockRecorder struct {
+	mock *MockSettlement
+}
+
+// NewMockSettlement creates a new mock instance.
+func NewMockSettlement(ctrl *gomock.Controller) *MockSettlement {
+	mock := &MockSettlement{ctrl: ctrl}
+	mock.recorder = &MockSettlementMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSettlement) EXPECT() *MockSettlementMockRecorder {
+	return m.recorder
+}
+
+// CreateSettlement mocks base method.
+func (m *MockSettlement) CreateSettlement(ctx context.Context, settlement *models.Settlement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSettlement", ctx, settlement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSettlement indicates an expected call of CreateSettlement.
+func (mr *MockSettlementMockRecorder) CreateSettlement(ctx, settlement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSettlement", reflect.TypeOf((*MockSettlement)(nil).CreateSettlement), ctx, settlement)
+}
+
+// DeleteSettlement mocks base method.
+func (m *MockSettlement) DeleteSettlement(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSettlement", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSettlement indicates an expected call of DeleteSettlement.
+func (mr *MockSettlementMockRecorder) DeleteSettlement(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSettlement", reflect.TypeOf((*MockSettlement)(nil).DeleteSettlement), ctx, id)
+}
+
+// GetSettlementByID mocks base method.
+func (m *MockSettlement) GetSettlementByID(ctx context.Context, id int) (*models.Settlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettlementByID", ctx, id)
+	ret0, _ := ret[0].(*models.Settlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettlementByID indicates an expected call of GetSettlementByID.
+func (mr *MockSettlementMockRecorder) GetSettlementByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlementByID", reflect.TypeOf((*MockSettlement)(nil).GetSettlementByID), ctx, id)
+}
+
+// GetSettlementsByEventID mocks base method.
+func (m *MockSettlement) GetSettlementsByEventID(ctx context.Context, eventID int64) ([]models.Settlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettlementsByEventID", ctx, eventID)
+	ret0, _ := ret[0].([]models.Settlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettlementsByEventID indicates an expected call of GetSettlementsByEventID.
+func (mr *MockSettlementMockRecorder) GetSettlementsByEventID(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlementsByEventID", reflect.TypeOf((*MockSettlement)(nil).GetSettlementsByEventID), ctx, eventID)
+}
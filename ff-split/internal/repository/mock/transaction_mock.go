@@ -5,7 +5,9 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	models "github.com/ivasnev/FinFlow/ff-split/internal/models"
@@ -34,293 +36,743 @@ func (m *MockTransaction) EXPECT() *MockTransactionMockRecorder {
 	return m.recorder
 }
 
+// CreateAttachment mocks base method.
+func (m *MockTransaction) CreateAttachment(ctx context.Context, attachment *models.TransactionAttachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachment", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockTransactionMockRecorder) CreateAttachment(ctx, attachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockTransaction)(nil).CreateAttachment), ctx, attachment)
+}
+
 // CreateDebts mocks base method.
-func (m *MockTransaction) CreateDebts(debts []models.Debt) error {
+func (m *MockTransaction) CreateDebts(ctx context.Context, debts []models.Debt) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateDebts", debts)
+	ret := m.ctrl.Call(m, "CreateDebts", ctx, debts)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateDebts indicates an expected call of CreateDebts.
-func (mr *MockTransactionMockRecorder) CreateDebts(debts interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) CreateDebts(ctx, debts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDebts", reflect.TypeOf((*MockTransaction)(nil).CreateDebts), ctx, debts)
+}
+
+// CreateRevision mocks base method.
+func (m *MockTransaction) CreateRevision(ctx context.Context, revision *models.TransactionRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRevision", ctx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRevision indicates an expected call of CreateRevision.
+func (mr *MockTransactionMockRecorder) CreateRevision(ctx, revision interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDebts", reflect.TypeOf((*MockTransaction)(nil).CreateDebts), debts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRevision", reflect.TypeOf((*MockTransaction)(nil).CreateRevision), ctx, revision)
 }
 
 // CreateTransaction mocks base method.
-func (m *MockTransaction) CreateTransaction(tx *models.Transaction) error {
+func (m *MockTransaction) CreateTransaction(ctx context.Context, tx *models.Transaction) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransaction", tx)
+	ret := m.ctrl.Call(m, "CreateTransaction", ctx, tx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateTransaction indicates an expected call of CreateTransaction.
-func (mr *MockTransactionMockRecorder) CreateTransaction(tx interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) CreateTransaction(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockTransaction)(nil).CreateTransaction), ctx, tx)
+}
+
+// CreateTransactionCharges mocks base method.
+func (m *MockTransaction) CreateTransactionCharges(ctx context.Context, charges []models.TransactionCharge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionCharges", ctx, charges)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransactionCharges indicates an expected call of CreateTransactionCharges.
+func (mr *MockTransactionMockRecorder) CreateTransactionCharges(ctx, charges interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionCharges", reflect.TypeOf((*MockTransaction)(nil).CreateTransactionCharges), ctx, charges)
+}
+
+// CreateTransactionItem mocks base method.
+func (m *MockTransaction) CreateTransactionItem(ctx context.Context, item *models.TransactionItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransactionItem indicates an expected call of CreateTransactionItem.
+func (mr *MockTransactionMockRecorder) CreateTransactionItem(ctx, item interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockTransaction)(nil).CreateTransaction), tx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionItem", reflect.TypeOf((*MockTransaction)(nil).CreateTransactionItem), ctx, item)
+}
+
+// CreateTransactionPayers mocks base method.
+func (m *MockTransaction) CreateTransactionPayers(ctx context.Context, payers []models.TransactionPayer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionPayers", ctx, payers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransactionPayers indicates an expected call of CreateTransactionPayers.
+func (mr *MockTransactionMockRecorder) CreateTransactionPayers(ctx, payers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionPayers", reflect.TypeOf((*MockTransaction)(nil).CreateTransactionPayers), ctx, payers)
 }
 
 // CreateTransactionShares mocks base method.
-func (m *MockTransaction) CreateTransactionShares(shares []models.TransactionShare) error {
+func (m *MockTransaction) CreateTransactionShares(ctx context.Context, shares []models.TransactionShare) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransactionShares", shares)
+	ret := m.ctrl.Call(m, "CreateTransactionShares", ctx, shares)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateTransactionShares indicates an expected call of CreateTransactionShares.
-func (mr *MockTransactionMockRecorder) CreateTransactionShares(shares interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) CreateTransactionShares(ctx, shares interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionShares", reflect.TypeOf((*MockTransaction)(nil).CreateTransactionShares), ctx, shares)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockTransaction) DeleteAttachment(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockTransactionMockRecorder) DeleteAttachment(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionShares", reflect.TypeOf((*MockTransaction)(nil).CreateTransactionShares), shares)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockTransaction)(nil).DeleteAttachment), ctx, id)
+}
+
+// DeleteChargesByTransactionID mocks base method.
+func (m *MockTransaction) DeleteChargesByTransactionID(ctx context.Context, transactionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChargesByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChargesByTransactionID indicates an expected call of DeleteChargesByTransactionID.
+func (mr *MockTransactionMockRecorder) DeleteChargesByTransactionID(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChargesByTransactionID", reflect.TypeOf((*MockTransaction)(nil).DeleteChargesByTransactionID), ctx, transactionID)
 }
 
 // DeleteDebtsByTransactionID mocks base method.
-func (m *MockTransaction) DeleteDebtsByTransactionID(transactionID int) error {
+func (m *MockTransaction) DeleteDebtsByTransactionID(ctx context.Context, transactionID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDebtsByTransactionID", transactionID)
+	ret := m.ctrl.Call(m, "DeleteDebtsByTransactionID", ctx, transactionID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteDebtsByTransactionID indicates an expected call of DeleteDebtsByTransactionID.
-func (mr *MockTransactionMockRecorder) DeleteDebtsByTransactionID(transactionID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) DeleteDebtsByTransactionID(ctx, transactionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDebtsByTransactionID", reflect.TypeOf((*MockTransaction)(nil).DeleteDebtsByTransactionID), transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDebtsByTransactionID", reflect.TypeOf((*MockTransaction)(nil).DeleteDebtsByTransactionID), ctx, transactionID)
+}
+
+// DeleteItemsByTransactionID mocks base method.
+func (m *MockTransaction) DeleteItemsByTransactionID(ctx context.Context, transactionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItemsByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteItemsByTransactionID indicates an expected call of DeleteItemsByTransactionID.
+func (mr *MockTransactionMockRecorder) DeleteItemsByTransactionID(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItemsByTransactionID", reflect.TypeOf((*MockTransaction)(nil).DeleteItemsByTransactionID), ctx, transactionID)
 }
 
 // DeleteOptimizedDebtsByEventID mocks base method.
-func (m *MockTransaction) DeleteOptimizedDebtsByEventID(eventID int64) error {
+func (m *MockTransaction) DeleteOptimizedDebtsByEventID(ctx context.Context, eventID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOptimizedDebtsByEventID", eventID)
+	ret := m.ctrl.Call(m, "DeleteOptimizedDebtsByEventID", ctx, eventID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteOptimizedDebtsByEventID indicates an expected call of DeleteOptimizedDebtsByEventID.
-func (mr *MockTransactionMockRecorder) DeleteOptimizedDebtsByEventID(eventID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) DeleteOptimizedDebtsByEventID(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOptimizedDebtsByEventID", reflect.TypeOf((*MockTransaction)(nil).DeleteOptimizedDebtsByEventID), ctx, eventID)
+}
+
+// DeletePayersByTransactionID mocks base method.
+func (m *MockTransaction) DeletePayersByTransactionID(ctx context.Context, transactionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePayersByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePayersByTransactionID indicates an expected call of DeletePayersByTransactionID.
+func (mr *MockTransactionMockRecorder) DeletePayersByTransactionID(ctx, transactionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOptimizedDebtsByEventID", reflect.TypeOf((*MockTransaction)(nil).DeleteOptimizedDebtsByEventID), eventID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayersByTransactionID", reflect.TypeOf((*MockTransaction)(nil).DeletePayersByTransactionID), ctx, transactionID)
 }
 
 // DeleteSharesByTransactionID mocks base method.
-func (m *MockTransaction) DeleteSharesByTransactionID(transactionID int) error {
+func (m *MockTransaction) DeleteSharesByTransactionID(ctx context.Context, transactionID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSharesByTransactionID", transactionID)
+	ret := m.ctrl.Call(m, "DeleteSharesByTransactionID", ctx, transactionID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteSharesByTransactionID indicates an expected call of DeleteSharesByTransactionID.
-func (mr *MockTransactionMockRecorder) DeleteSharesByTransactionID(transactionID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) DeleteSharesByTransactionID(ctx, transactionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSharesByTransactionID", reflect.TypeOf((*MockTransaction)(nil).DeleteSharesByTransactionID), transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSharesByTransactionID", reflect.TypeOf((*MockTransaction)(nil).DeleteSharesByTransactionID), ctx, transactionID)
 }
 
 // DeleteTransaction mocks base method.
-func (m *MockTransaction) DeleteTransaction(id int) error {
+func (m *MockTransaction) DeleteTransaction(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTransaction", id)
+	ret := m.ctrl.Call(m, "DeleteTransaction", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteTransaction indicates an expected call of DeleteTransaction.
-func (mr *MockTransactionMockRecorder) DeleteTransaction(id interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) DeleteTransaction(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockTransaction)(nil).DeleteTransaction), ctx, id)
+}
+
+// DeleteTransactionItem mocks base method.
+func (m *MockTransaction) DeleteTransactionItem(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransactionItem", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransactionItem indicates an expected call of DeleteTransactionItem.
+func (mr *MockTransactionMockRecorder) DeleteTransactionItem(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionItem", reflect.TypeOf((*MockTransaction)(nil).DeleteTransactionItem), ctx, id)
+}
+
+// GetAttachmentByID mocks base method.
+func (m *MockTransaction) GetAttachmentByID(ctx context.Context, id int) (*models.TransactionAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentByID", ctx, id)
+	ret0, _ := ret[0].(*models.TransactionAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentByID indicates an expected call of GetAttachmentByID.
+func (mr *MockTransactionMockRecorder) GetAttachmentByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentByID", reflect.TypeOf((*MockTransaction)(nil).GetAttachmentByID), ctx, id)
+}
+
+// GetAttachmentsByTransactionID mocks base method.
+func (m *MockTransaction) GetAttachmentsByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentsByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].([]models.TransactionAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentsByTransactionID indicates an expected call of GetAttachmentsByTransactionID.
+func (mr *MockTransactionMockRecorder) GetAttachmentsByTransactionID(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentsByTransactionID", reflect.TypeOf((*MockTransaction)(nil).GetAttachmentsByTransactionID), ctx, transactionID)
+}
+
+// GetChargesByTransactionID mocks base method.
+func (m *MockTransaction) GetChargesByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionCharge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChargesByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].([]models.TransactionCharge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChargesByTransactionID indicates an expected call of GetChargesByTransactionID.
+func (mr *MockTransactionMockRecorder) GetChargesByTransactionID(ctx, transactionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockTransaction)(nil).DeleteTransaction), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChargesByTransactionID", reflect.TypeOf((*MockTransaction)(nil).GetChargesByTransactionID), ctx, transactionID)
 }
 
 // GetDebtsByEventID mocks base method.
-func (m *MockTransaction) GetDebtsByEventID(eventID int64) ([]models.Debt, error) {
+func (m *MockTransaction) GetDebtsByEventID(ctx context.Context, eventID int64) ([]models.Debt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDebtsByEventID", eventID)
+	ret := m.ctrl.Call(m, "GetDebtsByEventID", ctx, eventID)
 	ret0, _ := ret[0].([]models.Debt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDebtsByEventID indicates an expected call of GetDebtsByEventID.
-func (mr *MockTransactionMockRecorder) GetDebtsByEventID(eventID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetDebtsByEventID(ctx, eventID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByEventID", reflect.TypeOf((*MockTransaction)(nil).GetDebtsByEventID), eventID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByEventID", reflect.TypeOf((*MockTransaction)(nil).GetDebtsByEventID), ctx, eventID)
 }
 
 // GetDebtsByEventIDFromUser mocks base method.
-func (m *MockTransaction) GetDebtsByEventIDFromUser(eventID, userID int64) ([]models.Debt, error) {
+func (m *MockTransaction) GetDebtsByEventIDFromUser(ctx context.Context, eventID, userID int64) ([]models.Debt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDebtsByEventIDFromUser", eventID, userID)
+	ret := m.ctrl.Call(m, "GetDebtsByEventIDFromUser", ctx, eventID, userID)
 	ret0, _ := ret[0].([]models.Debt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDebtsByEventIDFromUser indicates an expected call of GetDebtsByEventIDFromUser.
-func (mr *MockTransactionMockRecorder) GetDebtsByEventIDFromUser(eventID, userID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetDebtsByEventIDFromUser(ctx, eventID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByEventIDFromUser", reflect.TypeOf((*MockTransaction)(nil).GetDebtsByEventIDFromUser), eventID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByEventIDFromUser", reflect.TypeOf((*MockTransaction)(nil).GetDebtsByEventIDFromUser), ctx, eventID, userID)
 }
 
 // GetDebtsByEventIDToUser mocks base method.
-func (m *MockTransaction) GetDebtsByEventIDToUser(eventID, userID int64) ([]models.Debt, error) {
+func (m *MockTransaction) GetDebtsByEventIDToUser(ctx context.Context, eventID, userID int64) ([]models.Debt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDebtsByEventIDToUser", eventID, userID)
+	ret := m.ctrl.Call(m, "GetDebtsByEventIDToUser", ctx, eventID, userID)
 	ret0, _ := ret[0].([]models.Debt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDebtsByEventIDToUser indicates an expected call of GetDebtsByEventIDToUser.
-func (mr *MockTransactionMockRecorder) GetDebtsByEventIDToUser(eventID, userID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetDebtsByEventIDToUser(ctx, eventID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByEventIDToUser", reflect.TypeOf((*MockTransaction)(nil).GetDebtsByEventIDToUser), eventID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByEventIDToUser", reflect.TypeOf((*MockTransaction)(nil).GetDebtsByEventIDToUser), ctx, eventID, userID)
 }
 
 // GetDebtsByTransactionID mocks base method.
-func (m *MockTransaction) GetDebtsByTransactionID(transactionID int) ([]models.Debt, error) {
+func (m *MockTransaction) GetDebtsByTransactionID(ctx context.Context, transactionID int) ([]models.Debt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDebtsByTransactionID", transactionID)
+	ret := m.ctrl.Call(m, "GetDebtsByTransactionID", ctx, transactionID)
 	ret0, _ := ret[0].([]models.Debt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDebtsByTransactionID indicates an expected call of GetDebtsByTransactionID.
-func (mr *MockTransactionMockRecorder) GetDebtsByTransactionID(transactionID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetDebtsByTransactionID(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByTransactionID", reflect.TypeOf((*MockTransaction)(nil).GetDebtsByTransactionID), ctx, transactionID)
+}
+
+// GetDebtsVersion mocks base method.
+func (m *MockTransaction) GetDebtsVersion(ctx context.Context, eventID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebtsVersion", ctx, eventID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebtsVersion indicates an expected call of GetDebtsVersion.
+func (mr *MockTransactionMockRecorder) GetDebtsVersion(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsVersion", reflect.TypeOf((*MockTransaction)(nil).GetDebtsVersion), ctx, eventID)
+}
+
+// GetDeletedTransactionsByEventID mocks base method.
+func (m *MockTransaction) GetDeletedTransactionsByEventID(ctx context.Context, eventID int64) ([]models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedTransactionsByEventID", ctx, eventID)
+	ret0, _ := ret[0].([]models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedTransactionsByEventID indicates an expected call of GetDeletedTransactionsByEventID.
+func (mr *MockTransactionMockRecorder) GetDeletedTransactionsByEventID(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedTransactionsByEventID", reflect.TypeOf((*MockTransaction)(nil).GetDeletedTransactionsByEventID), ctx, eventID)
+}
+
+// GetExpiredAttachments mocks base method.
+func (m *MockTransaction) GetExpiredAttachments(ctx context.Context, before time.Time) ([]models.TransactionAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredAttachments", ctx, before)
+	ret0, _ := ret[0].([]models.TransactionAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredAttachments indicates an expected call of GetExpiredAttachments.
+func (mr *MockTransactionMockRecorder) GetExpiredAttachments(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredAttachments", reflect.TypeOf((*MockTransaction)(nil).GetExpiredAttachments), ctx, before)
+}
+
+// GetItemByID mocks base method.
+func (m *MockTransaction) GetItemByID(ctx context.Context, id int) (*models.TransactionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemByID", ctx, id)
+	ret0, _ := ret[0].(*models.TransactionItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemByID indicates an expected call of GetItemByID.
+func (mr *MockTransactionMockRecorder) GetItemByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemByID", reflect.TypeOf((*MockTransaction)(nil).GetItemByID), ctx, id)
+}
+
+// GetItemsByTransactionID mocks base method.
+func (m *MockTransaction) GetItemsByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemsByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].([]models.TransactionItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemsByTransactionID indicates an expected call of GetItemsByTransactionID.
+func (mr *MockTransactionMockRecorder) GetItemsByTransactionID(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemsByTransactionID", reflect.TypeOf((*MockTransaction)(nil).GetItemsByTransactionID), ctx, transactionID)
+}
+
+// GetLatestRevision mocks base method.
+func (m *MockTransaction) GetLatestRevision(ctx context.Context, transactionID int) (*models.TransactionRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestRevision", ctx, transactionID)
+	ret0, _ := ret[0].(*models.TransactionRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestRevision indicates an expected call of GetLatestRevision.
+func (mr *MockTransactionMockRecorder) GetLatestRevision(ctx, transactionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByTransactionID", reflect.TypeOf((*MockTransaction)(nil).GetDebtsByTransactionID), transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestRevision", reflect.TypeOf((*MockTransaction)(nil).GetLatestRevision), ctx, transactionID)
+}
+
+// GetOptimizedDebtByID mocks base method.
+func (m *MockTransaction) GetOptimizedDebtByID(ctx context.Context, id int) (*models.OptimizedDebt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptimizedDebtByID", ctx, id)
+	ret0, _ := ret[0].(*models.OptimizedDebt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOptimizedDebtByID indicates an expected call of GetOptimizedDebtByID.
+func (mr *MockTransactionMockRecorder) GetOptimizedDebtByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtByID", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtByID), ctx, id)
 }
 
 // GetOptimizedDebtsByEventID mocks base method.
-func (m *MockTransaction) GetOptimizedDebtsByEventID(eventID int64) ([]models.OptimizedDebt, error) {
+func (m *MockTransaction) GetOptimizedDebtsByEventID(ctx context.Context, eventID int64) ([]models.OptimizedDebt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOptimizedDebtsByEventID", eventID)
+	ret := m.ctrl.Call(m, "GetOptimizedDebtsByEventID", ctx, eventID)
 	ret0, _ := ret[0].([]models.OptimizedDebt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOptimizedDebtsByEventID indicates an expected call of GetOptimizedDebtsByEventID.
-func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByEventID(eventID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByEventID(ctx, eventID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByEventID", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByEventID), eventID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByEventID", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByEventID), ctx, eventID)
 }
 
 // GetOptimizedDebtsByEventIDWithUsers mocks base method.
-func (m *MockTransaction) GetOptimizedDebtsByEventIDWithUsers(eventID int64) ([]models.OptimizedDebt, error) {
+func (m *MockTransaction) GetOptimizedDebtsByEventIDWithUsers(ctx context.Context, eventID int64) ([]models.OptimizedDebt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOptimizedDebtsByEventIDWithUsers", eventID)
+	ret := m.ctrl.Call(m, "GetOptimizedDebtsByEventIDWithUsers", ctx, eventID)
 	ret0, _ := ret[0].([]models.OptimizedDebt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOptimizedDebtsByEventIDWithUsers indicates an expected call of GetOptimizedDebtsByEventIDWithUsers.
-func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByEventIDWithUsers(eventID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByEventIDWithUsers(ctx, eventID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByEventIDWithUsers", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByEventIDWithUsers), eventID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByEventIDWithUsers", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByEventIDWithUsers), ctx, eventID)
 }
 
 // GetOptimizedDebtsByUserID mocks base method.
-func (m *MockTransaction) GetOptimizedDebtsByUserID(eventID, userID int64) ([]models.OptimizedDebt, error) {
+func (m *MockTransaction) GetOptimizedDebtsByUserID(ctx context.Context, eventID, userID int64) ([]models.OptimizedDebt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOptimizedDebtsByUserID", eventID, userID)
+	ret := m.ctrl.Call(m, "GetOptimizedDebtsByUserID", ctx, eventID, userID)
 	ret0, _ := ret[0].([]models.OptimizedDebt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOptimizedDebtsByUserID indicates an expected call of GetOptimizedDebtsByUserID.
-func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByUserID(eventID, userID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByUserID(ctx, eventID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByUserID", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByUserID), eventID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByUserID", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByUserID), ctx, eventID, userID)
 }
 
 // GetOptimizedDebtsByUserIDWithUsers mocks base method.
-func (m *MockTransaction) GetOptimizedDebtsByUserIDWithUsers(eventID, userID int64) ([]models.OptimizedDebt, error) {
+func (m *MockTransaction) GetOptimizedDebtsByUserIDWithUsers(ctx context.Context, eventID, userID int64) ([]models.OptimizedDebt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOptimizedDebtsByUserIDWithUsers", eventID, userID)
+	ret := m.ctrl.Call(m, "GetOptimizedDebtsByUserIDWithUsers", ctx, eventID, userID)
 	ret0, _ := ret[0].([]models.OptimizedDebt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOptimizedDebtsByUserIDWithUsers indicates an expected call of GetOptimizedDebtsByUserIDWithUsers.
-func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByUserIDWithUsers(eventID, userID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByUserIDWithUsers(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByUserIDWithUsers", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByUserIDWithUsers), ctx, eventID, userID)
+}
+
+// GetPayersByTransactionID mocks base method.
+func (m *MockTransaction) GetPayersByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionPayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayersByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].([]models.TransactionPayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayersByTransactionID indicates an expected call of GetPayersByTransactionID.
+func (mr *MockTransactionMockRecorder) GetPayersByTransactionID(ctx, transactionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByUserIDWithUsers", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByUserIDWithUsers), eventID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayersByTransactionID", reflect.TypeOf((*MockTransaction)(nil).GetPayersByTransactionID), ctx, transactionID)
+}
+
+// GetRevisionByID mocks base method.
+func (m *MockTransaction) GetRevisionByID(ctx context.Context, id int) (*models.TransactionRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionByID", ctx, id)
+	ret0, _ := ret[0].(*models.TransactionRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionByID indicates an expected call of GetRevisionByID.
+func (mr *MockTransactionMockRecorder) GetRevisionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionByID", reflect.TypeOf((*MockTransaction)(nil).GetRevisionByID), ctx, id)
+}
+
+// GetRevisionsByTransactionID mocks base method.
+func (m *MockTransaction) GetRevisionsByTransactionID(ctx context.Context, eventID int64, transactionID int) ([]models.TransactionRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionsByTransactionID", ctx, eventID, transactionID)
+	ret0, _ := ret[0].([]models.TransactionRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionsByTransactionID indicates an expected call of GetRevisionsByTransactionID.
+func (mr *MockTransactionMockRecorder) GetRevisionsByTransactionID(ctx, eventID, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionsByTransactionID", reflect.TypeOf((*MockTransaction)(nil).GetRevisionsByTransactionID), ctx, eventID, transactionID)
 }
 
 // GetSharesByTransactionID mocks base method.
-func (m *MockTransaction) GetSharesByTransactionID(transactionID int) ([]models.TransactionShare, error) {
+func (m *MockTransaction) GetSharesByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionShare, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSharesByTransactionID", transactionID)
+	ret := m.ctrl.Call(m, "GetSharesByTransactionID", ctx, transactionID)
 	ret0, _ := ret[0].([]models.TransactionShare)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSharesByTransactionID indicates an expected call of GetSharesByTransactionID.
-func (mr *MockTransactionMockRecorder) GetSharesByTransactionID(transactionID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetSharesByTransactionID(ctx, transactionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByTransactionID", reflect.TypeOf((*MockTransaction)(nil).GetSharesByTransactionID), transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByTransactionID", reflect.TypeOf((*MockTransaction)(nil).GetSharesByTransactionID), ctx, transactionID)
 }
 
 // GetTransactionByID mocks base method.
-func (m *MockTransaction) GetTransactionByID(id int) (*models.Transaction, error) {
+func (m *MockTransaction) GetTransactionByID(ctx context.Context, id int) (*models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransactionByID", id)
+	ret := m.ctrl.Call(m, "GetTransactionByID", ctx, id)
 	ret0, _ := ret[0].(*models.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTransactionByID indicates an expected call of GetTransactionByID.
-func (mr *MockTransactionMockRecorder) GetTransactionByID(id interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetTransactionByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByID", reflect.TypeOf((*MockTransaction)(nil).GetTransactionByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByID", reflect.TypeOf((*MockTransaction)(nil).GetTransactionByID), ctx, id)
 }
 
 // GetTransactionsByEventID mocks base method.
-func (m *MockTransaction) GetTransactionsByEventID(eventID int64) ([]models.Transaction, error) {
+func (m *MockTransaction) GetTransactionsByEventID(ctx context.Context, eventID int64) ([]models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransactionsByEventID", eventID)
+	ret := m.ctrl.Call(m, "GetTransactionsByEventID", ctx, eventID)
 	ret0, _ := ret[0].([]models.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTransactionsByEventID indicates an expected call of GetTransactionsByEventID.
-func (mr *MockTransactionMockRecorder) GetTransactionsByEventID(eventID interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetTransactionsByEventID(ctx, eventID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsByEventID", reflect.TypeOf((*MockTransaction)(nil).GetTransactionsByEventID), eventID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsByEventID", reflect.TypeOf((*MockTransaction)(nil).GetTransactionsByEventID), ctx, eventID)
+}
+
+// GetTransactionsPage mocks base method.
+func (m *MockTransaction) GetTransactionsPage(ctx context.Context, eventID int64, filter *models.TransactionFilter) ([]models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionsPage", ctx, eventID, filter)
+	ret0, _ := ret[0].([]models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionsPage indicates an expected call of GetTransactionsPage.
+func (mr *MockTransactionMockRecorder) GetTransactionsPage(ctx, eventID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsPage", reflect.TypeOf((*MockTransaction)(nil).GetTransactionsPage), ctx, eventID, filter)
+}
+
+// IsOptimizedDebtsOutdated mocks base method.
+func (m *MockTransaction) IsOptimizedDebtsOutdated(ctx context.Context, eventID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOptimizedDebtsOutdated", ctx, eventID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOptimizedDebtsOutdated indicates an expected call of IsOptimizedDebtsOutdated.
+func (mr *MockTransactionMockRecorder) IsOptimizedDebtsOutdated(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOptimizedDebtsOutdated", reflect.TypeOf((*MockTransaction)(nil).IsOptimizedDebtsOutdated), ctx, eventID)
+}
+
+// LoadTransactionDetails mocks base method.
+func (m *MockTransaction) LoadTransactionDetails(ctx context.Context, transactions []models.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadTransactionDetails", ctx, transactions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadTransactionDetails indicates an expected call of LoadTransactionDetails.
+func (mr *MockTransactionMockRecorder) LoadTransactionDetails(ctx, transactions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadTransactionDetails", reflect.TypeOf((*MockTransaction)(nil).LoadTransactionDetails), ctx, transactions)
+}
+
+// MarkOptimizedDebtsOutdated mocks base method.
+func (m *MockTransaction) MarkOptimizedDebtsOutdated(ctx context.Context, eventID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOptimizedDebtsOutdated", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOptimizedDebtsOutdated indicates an expected call of MarkOptimizedDebtsOutdated.
+func (mr *MockTransactionMockRecorder) MarkOptimizedDebtsOutdated(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOptimizedDebtsOutdated", reflect.TypeOf((*MockTransaction)(nil).MarkOptimizedDebtsOutdated), ctx, eventID)
+}
+
+// PurgeDeletedTransactions mocks base method.
+func (m *MockTransaction) PurgeDeletedTransactions(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedTransactions", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedTransactions indicates an expected call of PurgeDeletedTransactions.
+func (mr *MockTransactionMockRecorder) PurgeDeletedTransactions(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedTransactions", reflect.TypeOf((*MockTransaction)(nil).PurgeDeletedTransactions), ctx, before)
+}
+
+// RestoreTransaction mocks base method.
+func (m *MockTransaction) RestoreTransaction(ctx context.Context, eventID int64, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTransaction", ctx, eventID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreTransaction indicates an expected call of RestoreTransaction.
+func (mr *MockTransactionMockRecorder) RestoreTransaction(ctx, eventID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTransaction", reflect.TypeOf((*MockTransaction)(nil).RestoreTransaction), ctx, eventID, id)
 }
 
 // SaveOptimizedDebts mocks base method.
-func (m *MockTransaction) SaveOptimizedDebts(eventID int64, debts []models.OptimizedDebt) error {
+func (m *MockTransaction) SaveOptimizedDebts(ctx context.Context, eventID, version int64, debts []models.OptimizedDebt) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveOptimizedDebts", eventID, debts)
+	ret := m.ctrl.Call(m, "SaveOptimizedDebts", ctx, eventID, version, debts)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SaveOptimizedDebts indicates an expected call of SaveOptimizedDebts.
-func (mr *MockTransactionMockRecorder) SaveOptimizedDebts(eventID, debts interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) SaveOptimizedDebts(ctx, eventID, version, debts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOptimizedDebts", reflect.TypeOf((*MockTransaction)(nil).SaveOptimizedDebts), eventID, debts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOptimizedDebts", reflect.TypeOf((*MockTransaction)(nil).SaveOptimizedDebts), ctx, eventID, version, debts)
+}
+
+// UpdateOptimizedDebtSettlement mocks base method.
+func (m *MockTransaction) UpdateOptimizedDebtSettlement(ctx context.Context, debt *models.OptimizedDebt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOptimizedDebtSettlement", ctx, debt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOptimizedDebtSettlement indicates an expected call of UpdateOptimizedDebtSettlement.
+func (mr *MockTransactionMockRecorder) UpdateOptimizedDebtSettlement(ctx, debt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOptimizedDebtSettlement", reflect.TypeOf((*MockTransaction)(nil).UpdateOptimizedDebtSettlement), ctx, debt)
 }
 
 // UpdateTransaction mocks base method.
-func (m *MockTransaction) UpdateTransaction(tx *models.Transaction) error {
+func (m *MockTransaction) UpdateTransaction(ctx context.Context, tx *models.Transaction) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTransaction", tx)
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, tx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateTransaction indicates an expected call of UpdateTransaction.
-func (mr *MockTransactionMockRecorder) UpdateTransaction(tx interface{}) *gomock.Call {
+func (mr *MockTransactionMockRecorder) UpdateTransaction(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockTransaction)(nil).UpdateTransaction), ctx, tx)
+}
+
+// UpdateTransactionItem mocks base method.
+func (m *MockTransaction) UpdateTransactionItem(ctx context.Context, item *models.TransactionItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransactionItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransactionItem indicates an expected call of UpdateTransactionItem.
+func (mr *MockTransactionMockRecorder) UpdateTransactionItem(ctx, item interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockTransaction)(nil).UpdateTransaction), tx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionItem", reflect.TypeOf((*MockTransaction)(nil).UpdateTransactionItem), ctx, item)
 }
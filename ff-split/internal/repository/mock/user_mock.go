@@ -35,6 +35,20 @@ func (m *MockUser) EXPECT() *MockUserMockRecorder {
 	return m.recorder
 }
 
+// AddEventMember mocks base method.
+func (m *MockUser) AddEventMember(ctx context.Context, userID, eventID int64, role models.EventRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEventMember", ctx, userID, eventID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEventMember indicates an expected call of AddEventMember.
+func (mr *MockUserMockRecorder) AddEventMember(ctx, userID, eventID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventMember", reflect.TypeOf((*MockUser)(nil).AddEventMember), ctx, userID, eventID, role)
+}
+
 // AddUserToEvent mocks base method.
 func (m *MockUser) AddUserToEvent(ctx context.Context, userID, eventID int64) error {
 	m.ctrl.T.Helper()
@@ -224,6 +238,50 @@ func (mr *MockUserMockRecorder) GetDummiesByEventID(ctx, eventID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDummiesByEventID", reflect.TypeOf((*MockUser)(nil).GetDummiesByEventID), ctx, eventID)
 }
 
+// GetEventMember mocks base method.
+func (m *MockUser) GetEventMember(ctx context.Context, userID, eventID int64) (*models.UserEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventMember", ctx, userID, eventID)
+	ret0, _ := ret[0].(*models.UserEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventMember indicates an expected call of GetEventMember.
+func (mr *MockUserMockRecorder) GetEventMember(ctx, userID, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventMember", reflect.TypeOf((*MockUser)(nil).GetEventMember), ctx, userID, eventID)
+}
+
+// GetEventMembers mocks base method.
+func (m *MockUser) GetEventMembers(ctx context.Context, eventID int64) ([]models.UserEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventMembers", ctx, eventID)
+	ret0, _ := ret[0].([]models.UserEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventMembers indicates an expected call of GetEventMembers.
+func (mr *MockUserMockRecorder) GetEventMembers(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventMembers", reflect.TypeOf((*MockUser)(nil).GetEventMembers), ctx, eventID)
+}
+
+// MergeUsers mocks base method.
+func (m *MockUser) MergeUsers(ctx context.Context, fromUserID, toUserID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeUsers", ctx, fromUserID, toUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeUsers indicates an expected call of MergeUsers.
+func (mr *MockUserMockRecorder) MergeUsers(ctx, fromUserID, toUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeUsers", reflect.TypeOf((*MockUser)(nil).MergeUsers), ctx, fromUserID, toUserID)
+}
+
 // RemoveUserFromEvent mocks base method.
 func (m *MockUser) RemoveUserFromEvent(ctx context.Context, userID, eventID int64) error {
 	m.ctrl.T.Helper()
@@ -238,6 +296,34 @@ func (mr *MockUserMockRecorder) RemoveUserFromEvent(ctx, userID, eventID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromEvent", reflect.TypeOf((*MockUser)(nil).RemoveUserFromEvent), ctx, userID, eventID)
 }
 
+// SetEventRole mocks base method.
+func (m *MockUser) SetEventRole(ctx context.Context, userID, eventID int64, role models.EventRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEventRole", ctx, userID, eventID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEventRole indicates an expected call of SetEventRole.
+func (mr *MockUserMockRecorder) SetEventRole(ctx, userID, eventID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventRole", reflect.TypeOf((*MockUser)(nil).SetEventRole), ctx, userID, eventID, role)
+}
+
+// TransferEventOwnership mocks base method.
+func (m *MockUser) TransferEventOwnership(ctx context.Context, eventID, newOwnerID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferEventOwnership", ctx, eventID, newOwnerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferEventOwnership indicates an expected call of TransferEventOwnership.
+func (mr *MockUserMockRecorder) TransferEventOwnership(ctx, eventID, newOwnerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferEventOwnership", reflect.TypeOf((*MockUser)(nil).TransferEventOwnership), ctx, eventID, newOwnerID)
+}
+
 // Update mocks base method.
 func (m *MockUser) Update(ctx context.Context, user *models.User) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"gorm.io/gorm"
 )
@@ -30,6 +31,33 @@ func (r *ActivityRepository) GetByEventID(ctx context.Context, eventID int64) ([
 	return extractSlice(dbActivities), nil
 }
 
+// GetFeed возвращает страницу ленты активности мероприятия от новых активностей к старым
+func (r *ActivityRepository) GetFeed(ctx context.Context, eventID int64, filter *models.ActivityFilter) ([]models.Activity, error) {
+	query := db.GetTx(ctx, r.db).WithContext(ctx).Where("event_id = ?", eventID)
+	if len(filter.Types) > 0 {
+		query = query.Where("type IN ?", filter.Types)
+	}
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+	if filter.BeforeID != nil {
+		query = query.Where("id < ?", *filter.BeforeID)
+	}
+
+	var dbActivities []Activity
+	err := query.Order("id DESC").Limit(filter.Limit).Find(&dbActivities).Error
+	if err != nil {
+		return nil, err
+	}
+	return extractSlice(dbActivities), nil
+}
+
 // GetByID возвращает активность по ID
 func (r *ActivityRepository) GetByID(ctx context.Context, id int) (*models.Activity, error) {
 	var dbActivity Activity
@@ -46,7 +74,7 @@ func (r *ActivityRepository) GetByID(ctx context.Context, id int) (*models.Activ
 // Create создает новую активность
 func (r *ActivityRepository) Create(ctx context.Context, activity *models.Activity) (*models.Activity, error) {
 	dbActivity := load(activity)
-	err := r.db.WithContext(ctx).Create(dbActivity).Error
+	err := db.GetTx(ctx, r.db).WithContext(ctx).Create(dbActivity).Error
 	if err != nil {
 		return nil, err
 	}
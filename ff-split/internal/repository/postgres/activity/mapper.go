@@ -1,6 +1,8 @@
 package activity
 
 import (
+	"encoding/json"
+
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 )
 
@@ -10,14 +12,21 @@ func extract(dbActivity *Activity) *models.Activity {
 		return nil
 	}
 
-	return &models.Activity{
+	activity := &models.Activity{
 		ID:          dbActivity.ID,
 		EventID:     dbActivity.EventID,
 		UserID:      dbActivity.UserID,
+		Type:        dbActivity.Type,
 		Description: dbActivity.Description,
-		IconID:      dbActivity.IconID,
 		CreatedAt:   dbActivity.CreatedAt,
 	}
+	if dbActivity.Payload != nil {
+		activity.Payload = json.RawMessage(*dbActivity.Payload)
+	}
+	if dbActivity.IconID != nil {
+		activity.IconID = *dbActivity.IconID
+	}
+	return activity
 }
 
 // extractSlice преобразует слайс моделей активностей БД в бизнес-модели
@@ -37,12 +46,22 @@ func load(activity *models.Activity) *Activity {
 		return nil
 	}
 
-	return &Activity{
+	dbActivity := &Activity{
 		ID:          activity.ID,
 		EventID:     activity.EventID,
 		UserID:      activity.UserID,
+		Type:        activity.Type,
 		Description: activity.Description,
-		IconID:      activity.IconID,
 		CreatedAt:   activity.CreatedAt,
 	}
+	if len(activity.Payload) > 0 {
+		payload := string(activity.Payload)
+		dbActivity.Payload = &payload
+	}
+	// Нулевой ID иконки означает активность без иконки
+	if activity.IconID != 0 {
+		iconID := activity.IconID
+		dbActivity.IconID = &iconID
+	}
+	return dbActivity
 }
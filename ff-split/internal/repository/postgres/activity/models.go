@@ -7,8 +7,10 @@ type Activity struct {
 	ID          int       `gorm:"column:id;primaryKey;autoIncrement"`
 	EventID     *int64    `gorm:"column:event_id"`
 	UserID      *int64    `gorm:"column:user_id"`
+	Type        string    `gorm:"column:type;type:varchar(32);not null;default:custom"`
+	Payload     *string   `gorm:"column:payload;type:jsonb"`
 	Description string    `gorm:"column:description"`
-	IconID      int       `gorm:"column:icon_id"`
+	IconID      *int      `gorm:"column:icon_id"`
 	CreatedAt   time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
 }
 
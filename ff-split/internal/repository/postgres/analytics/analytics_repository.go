@@ -0,0 +1,197 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"gorm.io/gorm"
+)
+
+// AnalyticsRepository реализует интерфейс repository.Analytics.
+// Суммы считаются в базе данных и переводятся в валюту мероприятия по курсу транзакции.
+type AnalyticsRepository struct {
+	db *gorm.DB
+}
+
+// NewAnalyticsRepository создает новый экземпляр AnalyticsRepository
+func NewAnalyticsRepository(db *gorm.DB) *AnalyticsRepository {
+	return &AnalyticsRepository{
+		db: db,
+	}
+}
+
+// filterCondition строит условие отбора транзакций мероприятия с псевдонимом t; транзакции из корзины не учитываются
+func filterCondition(eventID int64, filter models.AnalyticsFilter) (string, []interface{}) {
+	conditions := []string{"t.event_id = ?", "t.deleted_at IS NULL"}
+	args := []interface{}{eventID}
+	if filter.From != nil {
+		conditions = append(conditions, "t.datetime >= ?")
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "t.datetime < ?")
+		args = append(args, *filter.To)
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// GetTotal возвращает общую сумму расходов и число транзакций
+func (r *AnalyticsRepository) GetTotal(ctx context.Context, eventID int64, filter models.AnalyticsFilter) (*models.SpendingTotal, error) {
+	where, args := filterCondition(eventID, filter)
+
+	var result totalRow
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Raw(`
+			SELECT
+				COALESCE(ROUND(SUM(t.total_paid * t.exchange_rate), 2), 0) AS amount,
+				COUNT(*) AS transactions_count
+			FROM transactions t
+			WHERE `+where, args...).
+		Scan(&result).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при расчете общей суммы расходов: %w", err)
+	}
+
+	return &models.SpendingTotal{
+		Amount:            result.Amount,
+		TransactionsCount: result.TransactionsCount,
+	}, nil
+}
+
+// GetByCategory возвращает расходы в разрезе категорий, по убыванию суммы
+func (r *AnalyticsRepository) GetByCategory(ctx context.Context, eventID int64, filter models.AnalyticsFilter) ([]models.CategorySpending, error) {
+	where, args := filterCondition(eventID, filter)
+
+	var rows []categoryRow
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Raw(`
+			SELECT
+				t.transaction_category_id AS category_id,
+				COALESCE(c.name, '') AS category_name,
+				ROUND(SUM(t.total_paid * t.exchange_rate), 2) AS amount,
+				COUNT(*) AS transactions_count
+			FROM transactions t
+			LEFT JOIN transaction_categories c ON c.id = t.transaction_category_id
+			WHERE `+where+`
+			GROUP BY t.transaction_category_id, c.name
+			ORDER BY amount DESC, t.transaction_category_id
+		`, args...).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при расчете расходов по категориям: %w", err)
+	}
+
+	return extractCategories(rows), nil
+}
+
+// GetPaidByUser возвращает суммы, оплаченные каждым пользователем.
+// Для транзакций без записей о плательщиках учитывается основной плательщик.
+func (r *AnalyticsRepository) GetPaidByUser(ctx context.Context, eventID int64, filter models.AnalyticsFilter) ([]models.UserSpending, error) {
+	where, args := filterCondition(eventID, filter)
+
+	var rows []userRow
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Raw(`
+			SELECT
+				p.user_id,
+				ROUND(SUM(p.amount), 2) AS amount
+			FROM (
+				SELECT tp.user_id, tp.amount * t.exchange_rate AS amount
+				FROM transaction_payers tp
+				JOIN transactions t ON t.id = tp.transaction_id
+				WHERE `+where+`
+				UNION ALL
+				SELECT t.payer_id, t.total_paid * t.exchange_rate
+				FROM transactions t
+				WHERE `+where+`
+					AND t.payer_id IS NOT NULL
+					AND NOT EXISTS (SELECT 1 FROM transaction_payers tp WHERE tp.transaction_id = t.id)
+			) p
+			GROUP BY p.user_id
+			ORDER BY amount DESC, p.user_id
+		`, append(args, args...)...).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при расчете оплаченных сумм: %w", err)
+	}
+
+	return extractUsers(rows), nil
+}
+
+// GetConsumedByUser возвращает суммы, приходящиеся на долю каждого участника
+func (r *AnalyticsRepository) GetConsumedByUser(ctx context.Context, eventID int64, filter models.AnalyticsFilter) ([]models.UserSpending, error) {
+	where, args := filterCondition(eventID, filter)
+
+	var rows []userRow
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Raw(`
+			SELECT
+				s.user_id,
+				ROUND(SUM(s.value * t.exchange_rate), 2) AS amount
+			FROM transaction_shares s
+			JOIN transactions t ON t.id = s.transaction_id
+			WHERE `+where+`
+			GROUP BY s.user_id
+			ORDER BY amount DESC, s.user_id
+		`, args...).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при расчете потребленных сумм: %w", err)
+	}
+
+	return extractUsers(rows), nil
+}
+
+// GetTimeSeries возвращает расходы по периодам interval (day | week) в порядке возрастания
+func (r *AnalyticsRepository) GetTimeSeries(ctx context.Context, eventID int64, filter models.AnalyticsFilter, interval string) ([]models.SpendingPoint, error) {
+	where, args := filterCondition(eventID, filter)
+
+	var rows []pointRow
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Raw(`
+			SELECT
+				date_trunc(?, t.datetime) AS period_start,
+				ROUND(SUM(t.total_paid * t.exchange_rate), 2) AS amount,
+				COUNT(*) AS transactions_count
+			FROM transactions t
+			WHERE `+where+`
+			GROUP BY period_start
+			ORDER BY period_start
+		`, append([]interface{}{interval}, args...)...).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при расчете временного ряда расходов: %w", err)
+	}
+
+	return extractPoints(rows), nil
+}
+
+// GetTopExpenses возвращает не более limit самых крупных транзакций
+func (r *AnalyticsRepository) GetTopExpenses(ctx context.Context, eventID int64, filter models.AnalyticsFilter, limit int) ([]models.TopExpense, error) {
+	where, args := filterCondition(eventID, filter)
+
+	var rows []expenseRow
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Raw(`
+			SELECT
+				t.id AS transaction_id,
+				t.name,
+				t.transaction_category_id AS category_id,
+				t.datetime,
+				t.payer_id,
+				ROUND(t.total_paid * t.exchange_rate, 2) AS amount
+			FROM transactions t
+			WHERE `+where+`
+			ORDER BY amount DESC, t.datetime DESC, t.id DESC
+			LIMIT ?
+		`, append(args, limit)...).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении крупнейших расходов: %w", err)
+	}
+
+	return extractExpenses(rows), nil
+}
@@ -0,0 +1,58 @@
+package analytics
+
+import "github.com/ivasnev/FinFlow/ff-split/internal/models"
+
+// extractCategories преобразует строки результата в расходы по категориям
+func extractCategories(rows []categoryRow) []models.CategorySpending {
+	result := make([]models.CategorySpending, len(rows))
+	for i, row := range rows {
+		result[i] = models.CategorySpending{
+			CategoryID:        row.CategoryID,
+			CategoryName:      row.CategoryName,
+			Amount:            row.Amount,
+			TransactionsCount: row.TransactionsCount,
+		}
+	}
+	return result
+}
+
+// extractUsers преобразует строки результата в суммы по пользователям
+func extractUsers(rows []userRow) []models.UserSpending {
+	result := make([]models.UserSpending, len(rows))
+	for i, row := range rows {
+		result[i] = models.UserSpending{
+			UserID: row.UserID,
+			Amount: row.Amount,
+		}
+	}
+	return result
+}
+
+// extractPoints преобразует строки результата во временной ряд
+func extractPoints(rows []pointRow) []models.SpendingPoint {
+	result := make([]models.SpendingPoint, len(rows))
+	for i, row := range rows {
+		result[i] = models.SpendingPoint{
+			PeriodStart:       row.PeriodStart,
+			Amount:            row.Amount,
+			TransactionsCount: row.TransactionsCount,
+		}
+	}
+	return result
+}
+
+// extractExpenses преобразует строки результата в крупнейшие транзакции
+func extractExpenses(rows []expenseRow) []models.TopExpense {
+	result := make([]models.TopExpense, len(rows))
+	for i, row := range rows {
+		result[i] = models.TopExpense{
+			TransactionID: row.TransactionID,
+			Name:          row.Name,
+			CategoryID:    row.CategoryID,
+			Datetime:      row.Datetime,
+			PayerID:       row.PayerID,
+			Amount:        row.Amount,
+		}
+	}
+	return result
+}
@@ -0,0 +1,44 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+)
+
+// totalRow - результат запроса общей суммы расходов
+type totalRow struct {
+	Amount            money.Money `gorm:"column:amount"`
+	TransactionsCount int         `gorm:"column:transactions_count"`
+}
+
+// categoryRow - результат запроса расходов по категориям
+type categoryRow struct {
+	CategoryID        *int        `gorm:"column:category_id"`
+	CategoryName      string      `gorm:"column:category_name"`
+	Amount            money.Money `gorm:"column:amount"`
+	TransactionsCount int         `gorm:"column:transactions_count"`
+}
+
+// userRow - результат запроса сумм по пользователям
+type userRow struct {
+	UserID int64       `gorm:"column:user_id"`
+	Amount money.Money `gorm:"column:amount"`
+}
+
+// pointRow - результат запроса временного ряда
+type pointRow struct {
+	PeriodStart       time.Time   `gorm:"column:period_start"`
+	Amount            money.Money `gorm:"column:amount"`
+	TransactionsCount int         `gorm:"column:transactions_count"`
+}
+
+// expenseRow - результат запроса крупнейших транзакций
+type expenseRow struct {
+	TransactionID int         `gorm:"column:transaction_id"`
+	Name          string      `gorm:"column:name"`
+	CategoryID    *int        `gorm:"column:category_id"`
+	Datetime      time.Time   `gorm:"column:datetime"`
+	PayerID       *int64      `gorm:"column:payer_id"`
+	Amount        money.Money `gorm:"column:amount"`
+}
@@ -0,0 +1,94 @@
+package claim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"gorm.io/gorm"
+)
+
+// DummyClaimRepository реализует интерфейс repository.DummyClaim
+type DummyClaimRepository struct {
+	db *gorm.DB
+}
+
+// NewDummyClaimRepository создает новый экземпляр DummyClaimRepository
+func NewDummyClaimRepository(db *gorm.DB) *DummyClaimRepository {
+	return &DummyClaimRepository{
+		db: db,
+	}
+}
+
+// Create создает заявку
+func (r *DummyClaimRepository) Create(ctx context.Context, claim *models.DummyClaim) error {
+	dbClaim := load(claim)
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Create(dbClaim).Error; err != nil {
+		return fmt.Errorf("ошибка при создании заявки: %w", err)
+	}
+	claim.ID = dbClaim.ID
+	claim.Status = dbClaim.Status
+	claim.CreatedAt = dbClaim.CreatedAt
+	return nil
+}
+
+// GetByID возвращает заявку по ID или nil, если заявка не найдена
+func (r *DummyClaimRepository) GetByID(ctx context.Context, id int) (*models.DummyClaim, error) {
+	var dbClaim DummyClaim
+	err := db.GetTx(ctx, r.db).WithContext(ctx).First(&dbClaim, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ошибка при получении заявки: %w", err)
+	}
+	return extract(&dbClaim), nil
+}
+
+// GetPendingByDummyUserID возвращает нерассмотренную заявку на dummy-пользователя или nil
+func (r *DummyClaimRepository) GetPendingByDummyUserID(ctx context.Context, dummyUserID int64) (*models.DummyClaim, error) {
+	var dbClaim DummyClaim
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Where("dummy_user_id = ? AND status = ?", dummyUserID, models.DummyClaimStatusPending).
+		First(&dbClaim).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ошибка при получении заявки: %w", err)
+	}
+	return extract(&dbClaim), nil
+}
+
+// GetPendingByEventID возвращает нерассмотренные заявки мероприятия
+func (r *DummyClaimRepository) GetPendingByEventID(ctx context.Context, eventID int64) ([]models.DummyClaim, error) {
+	var dbClaims []DummyClaim
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Where("event_id = ? AND status = ?", eventID, models.DummyClaimStatusPending).
+		Order("created_at, id").
+		Find(&dbClaims).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении заявок мероприятия: %w", err)
+	}
+	return extractSlice(dbClaims), nil
+}
+
+// Resolve переводит нерассмотренную заявку в статус status.
+// Условие на статус проверяется в самом UPDATE, чтобы заявку не рассмотрели дважды.
+func (r *DummyClaimRepository) Resolve(ctx context.Context, id int, status string, resolvedBy *int64) (bool, error) {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&DummyClaim{}).
+		Where("id = ? AND status = ?", id, models.DummyClaimStatusPending).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"resolved_by": resolvedBy,
+			"resolved_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("ошибка при рассмотрении заявки: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
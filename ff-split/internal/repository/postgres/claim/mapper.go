@@ -0,0 +1,54 @@
+package claim
+
+import (
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// extract преобразует модель заявки БД в бизнес-модель
+func extract(dbClaim *DummyClaim) *models.DummyClaim {
+	if dbClaim == nil {
+		return nil
+	}
+
+	return &models.DummyClaim{
+		ID:             dbClaim.ID,
+		EventID:        dbClaim.EventID,
+		DummyUserID:    dbClaim.DummyUserID,
+		DummyName:      dbClaim.DummyName,
+		ClaimantUserID: dbClaim.ClaimantUserID,
+		Status:         dbClaim.Status,
+		ResolvedBy:     dbClaim.ResolvedBy,
+		ResolvedAt:     dbClaim.ResolvedAt,
+		CreatedAt:      dbClaim.CreatedAt,
+	}
+}
+
+// extractSlice преобразует слайс моделей заявок БД в бизнес-модели
+func extractSlice(dbClaims []DummyClaim) []models.DummyClaim {
+	claims := make([]models.DummyClaim, len(dbClaims))
+	for i, dbClaim := range dbClaims {
+		if extracted := extract(&dbClaim); extracted != nil {
+			claims[i] = *extracted
+		}
+	}
+	return claims
+}
+
+// load преобразует бизнес-модель заявки в модель БД
+func load(claim *models.DummyClaim) *DummyClaim {
+	if claim == nil {
+		return nil
+	}
+
+	return &DummyClaim{
+		ID:             claim.ID,
+		EventID:        claim.EventID,
+		DummyUserID:    claim.DummyUserID,
+		DummyName:      claim.DummyName,
+		ClaimantUserID: claim.ClaimantUserID,
+		Status:         claim.Status,
+		ResolvedBy:     claim.ResolvedBy,
+		ResolvedAt:     claim.ResolvedAt,
+		CreatedAt:      claim.CreatedAt,
+	}
+}
@@ -0,0 +1,21 @@
+package claim
+
+import "time"
+
+// DummyClaim представляет заявку на dummy-пользователя в БД
+type DummyClaim struct {
+	ID             int        `gorm:"column:id;primaryKey;autoIncrement"`
+	EventID        int64      `gorm:"column:event_id;not null"`
+	DummyUserID    *int64     `gorm:"column:dummy_user_id"`
+	DummyName      string     `gorm:"column:dummy_name;type:varchar(100);not null"`
+	ClaimantUserID int64      `gorm:"column:claimant_user_id;not null"`
+	Status         string     `gorm:"column:status;type:varchar(16);not null;default:pending"`
+	ResolvedBy     *int64     `gorm:"column:resolved_by"`
+	ResolvedAt     *time.Time `gorm:"column:resolved_at"`
+	CreatedAt      time.Time  `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName задает имя таблицы для модели DummyClaim
+func (DummyClaim) TableName() string {
+	return "dummy_claims"
+}
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
 	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"gorm.io/gorm"
 )
@@ -82,52 +84,99 @@ func (r *EventRepository) Update(ctx context.Context, id int64, event *models.Ev
 	return nil
 }
 
-// GetByUserID возвращает мероприятия пользователя
-func (r *EventRepository) GetByUserID(ctx context.Context, userID int64) ([]models.Event, error) {
+// GetByUserID возвращает мероприятия пользователя; архивные - только если includeArchived
+func (r *EventRepository) GetByUserID(ctx context.Context, userID int64, includeArchived bool) ([]models.Event, error) {
 	var dbEvents []Event
-	err := r.db.WithContext(ctx).
+	query := r.db.WithContext(ctx).
 		Joins("JOIN user_event ON events.id = user_event.event_id").
-		Where("user_event.user_id = ?", userID).
-		Find(&dbEvents).Error
+		Where("user_event.user_id = ?", userID)
+	if !includeArchived {
+		query = query.Where("events.status <> ?", models.EventStatusArchived)
+	}
+	err := query.Find(&dbEvents).Error
 	if err != nil {
 		return nil, err
 	}
 	return extractSlice(dbEvents), nil
 }
 
+// GetMemberByExternalUserID возвращает участие пользователя с внешним ID в мероприятии
+// или nil, если пользователь не участник
+func (r *EventRepository) GetMemberByExternalUserID(ctx context.Context, eventID, externalUserID int64) (*models.UserEvent, error) {
+	var rows []struct {
+		UserID  int64
+		EventID int64
+		Role    string
+	}
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Table("user_event").
+		Select("user_event.user_id, user_event.event_id, user_event.role").
+		Joins("JOIN users ON users.id = user_event.user_id").
+		Where("user_event.event_id = ? AND users.user_id = ?", eventID, externalUserID).
+		Limit(1).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при проверке участия в мероприятии: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return &models.UserEvent{
+		UserID:  rows[0].UserID,
+		EventID: rows[0].EventID,
+		Role:    models.EventRole(rows[0].Role),
+	}, nil
+}
+
 // CalculateUserBalances рассчитывает баланс пользователя по событиям
-func (r *EventRepository) CalculateUserBalances(ctx context.Context, userID int64, eventIDs []int64) (map[int64]float64, error) {
+func (r *EventRepository) CalculateUserBalances(ctx context.Context, userID int64, eventIDs []int64) (map[int64]money.Money, error) {
 	if len(eventIDs) == 0 {
-		return make(map[int64]float64), nil
+		return make(map[int64]money.Money), nil
 	}
 
 	type BalanceResult struct {
-		EventID int64   `gorm:"column:event_id"`
-		Balance float64 `gorm:"column:balance"`
+		EventID int64       `gorm:"column:event_id"`
+		Balance money.Money `gorm:"column:balance"`
 	}
 
 	var results []BalanceResult
 
 	// Используем прямой SQL запрос для расчета баланса
 	// Баланс = что ему должны (to_user_id = userID) - что он должен (from_user_id = userID)
-	err := r.db.WithContext(ctx).
+	// + что он вернул по погашениям - что вернули ему.
+	// Транзакции и мероприятия из корзины не учитываются
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
 		Raw(`
-			SELECT 
-				t.event_id,
-				COALESCE(SUM(CASE WHEN d.to_user_id = ? THEN d.amount ELSE 0 END), 0) - 
-				COALESCE(SUM(CASE WHEN d.from_user_id = ? THEN d.amount ELSE 0 END), 0) as balance
-			FROM debts d
-			JOIN transactions t ON d.transaction_id = t.id
-			WHERE t.event_id IN ?
-			GROUP BY t.event_id
-		`, userID, userID, eventIDs).
+			SELECT
+				b.event_id,
+				COALESCE(SUM(b.delta), 0) as balance
+			FROM (
+				SELECT
+					t.event_id,
+					CASE WHEN d.to_user_id = ? THEN d.amount ELSE 0 END -
+					CASE WHEN d.from_user_id = ? THEN d.amount ELSE 0 END as delta
+				FROM debts d
+				JOIN transactions t ON d.transaction_id = t.id
+				JOIN events e ON e.id = t.event_id
+				WHERE t.event_id IN ? AND t.deleted_at IS NULL AND e.deleted_at IS NULL
+				UNION ALL
+				SELECT
+					s.event_id,
+					CASE WHEN s.from_user_id = ? THEN s.amount ELSE 0 END -
+					CASE WHEN s.to_user_id = ? THEN s.amount ELSE 0 END as delta
+				FROM settlements s
+				JOIN events e ON e.id = s.event_id
+				WHERE s.event_id IN ? AND e.deleted_at IS NULL
+			) b
+			GROUP BY b.event_id
+		`, userID, userID, eventIDs, userID, userID, eventIDs).
 		Scan(&results).Error
 
 	if err != nil {
 		return nil, err
 	}
 
-	balances := make(map[int64]float64, len(results))
+	balances := make(map[int64]money.Money, len(results))
 	for _, result := range results {
 		balances[result.EventID] = result.Balance
 	}
@@ -142,8 +191,67 @@ func (r *EventRepository) CalculateUserBalances(ctx context.Context, userID int6
 	return balances, nil
 }
 
-// Delete удаляет мероприятие и все связанные данные
+// Delete переносит мероприятие в корзину. Данные мероприятия сохраняются до окончательной очистки
 func (r *EventRepository) Delete(ctx context.Context, id int64) error {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).Delete(&Event{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return customErrors.NewEntityNotFoundError(strconv.FormatInt(id, 10), "event")
+	}
+	return nil
+}
+
+// GetDeletedByUserID возвращает мероприятия пользователя из корзины, начиная с удаленных последними
+func (r *EventRepository) GetDeletedByUserID(ctx context.Context, userID int64) ([]models.Event, error) {
+	var dbEvents []Event
+	err := r.db.WithContext(ctx).Unscoped().
+		Joins("JOIN user_event ON events.id = user_event.event_id").
+		Where("user_event.user_id = ? AND events.deleted_at IS NOT NULL", userID).
+		Order("events.deleted_at DESC, events.id DESC").
+		Find(&dbEvents).Error
+	if err != nil {
+		return nil, err
+	}
+	return extractSlice(dbEvents), nil
+}
+
+// Restore возвращает мероприятие из корзины
+func (r *EventRepository) Restore(ctx context.Context, id int64) error {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).Unscoped().Model(&Event{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return customErrors.NewEntityNotFoundError(strconv.FormatInt(id, 10), "event")
+	}
+	return nil
+}
+
+// PurgeDeleted окончательно удаляет мероприятия, перенесенные в корзину раньше before,
+// и возвращает число удаленных мероприятий
+func (r *EventRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	var ids []int64
+	err := r.db.WithContext(ctx).Unscoped().Model(&Event{}).
+		Where("deleted_at < ?", before).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return 0, err
+	}
+
+	for i, id := range ids {
+		if err := r.purge(ctx, id); err != nil {
+			return i, fmt.Errorf("ошибка при очистке мероприятия %d: %w", id, err)
+		}
+	}
+	return len(ids), nil
+}
+
+// purge окончательно удаляет мероприятие и все связанные данные, включая транзакции из корзины
+func (r *EventRepository) purge(ctx context.Context, id int64) error {
 	err := db.WithTx(ctx, r.db, func(ctx context.Context) error {
 		tx := db.GetTx(ctx, r.db).WithContext(ctx)
 
@@ -159,12 +267,24 @@ func (r *EventRepository) Delete(ctx context.Context, id int64) error {
 			return result.Error
 		}
 
+		// История изменений переживает очистку транзакций, но не самого мероприятия
+		result = tx.Exec("DELETE FROM transaction_revisions WHERE event_id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+
 		// Удаляем транзакции
 		result = tx.Exec("DELETE FROM transactions WHERE event_id = ?", id)
 		if result.Error != nil {
 			return result.Error
 		}
 
+		// Удаляем погашения долгов
+		result = tx.Exec("DELETE FROM settlements WHERE event_id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+
 		// Удаляем оптимизированные долги
 		result = tx.Exec("DELETE FROM optimized_debts WHERE event_id = ?", id)
 		if result.Error != nil {
@@ -190,7 +310,7 @@ func (r *EventRepository) Delete(ctx context.Context, id int64) error {
 		}
 
 		// Наконец, удаляем само мероприятие
-		result = tx.Delete(&Event{}, id)
+		result = tx.Unscoped().Delete(&Event{}, id)
 		if result.Error != nil {
 			return result.Error
 		}
@@ -1,7 +1,10 @@
 package event
 
 import (
+	"time"
+
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"gorm.io/gorm"
 )
 
 // extract преобразует модель мероприятия БД в бизнес-модель
@@ -11,12 +14,15 @@ func extract(dbEvent *Event) *models.Event {
 	}
 
 	return &models.Event{
-		ID:          dbEvent.ID,
-		Name:        dbEvent.Name,
-		Description: dbEvent.Description,
-		CategoryID:  dbEvent.CategoryID,
-		ImageID:     dbEvent.ImageID,
-		Status:      dbEvent.Status,
+		ID:                    dbEvent.ID,
+		Name:                  dbEvent.Name,
+		Description:           dbEvent.Description,
+		CategoryID:            dbEvent.CategoryID,
+		ImageID:               dbEvent.ImageID,
+		Status:                dbEvent.Status,
+		Currency:              dbEvent.Currency,
+		OptimizationAlgorithm: dbEvent.OptimizationAlgorithm,
+		DeletedAt:             extractDeletedAt(dbEvent.DeletedAt),
 	}
 }
 
@@ -31,6 +37,14 @@ func extractSlice(dbEvents []Event) []models.Event {
 	return events
 }
 
+// extractDeletedAt возвращает время удаления в корзину или nil для неудаленной записи
+func extractDeletedAt(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return &deletedAt.Time
+}
+
 // load преобразует бизнес-модель мероприятия в модель БД
 func load(event *models.Event) *Event {
 	if event == nil {
@@ -38,12 +52,14 @@ func load(event *models.Event) *Event {
 	}
 
 	return &Event{
-		ID:          event.ID,
-		Name:        event.Name,
-		Description: event.Description,
-		CategoryID:  event.CategoryID,
-		ImageID:     event.ImageID,
-		Status:      event.Status,
+		ID:                    event.ID,
+		Name:                  event.Name,
+		Description:           event.Description,
+		CategoryID:            event.CategoryID,
+		ImageID:               event.ImageID,
+		Status:                event.Status,
+		Currency:              event.Currency,
+		OptimizationAlgorithm: event.OptimizationAlgorithm,
 	}
 }
 
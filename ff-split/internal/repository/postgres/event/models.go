@@ -1,13 +1,18 @@
 package event
 
+import "gorm.io/gorm"
+
 // Event представляет модель мероприятия в БД
 type Event struct {
-	ID          int64  `gorm:"column:id;primaryKey;autoIncrement"`
-	Name        string `gorm:"column:name;not null"`
-	Description string `gorm:"column:description"`
-	CategoryID  *int   `gorm:"column:category_id"`
-	ImageID     string `gorm:"column:image_id"`
-	Status      string `gorm:"column:status;default:active"`
+	ID                    int64          `gorm:"column:id;primaryKey;autoIncrement"`
+	Name                  string         `gorm:"column:name;not null"`
+	Description           string         `gorm:"column:description"`
+	CategoryID            *int           `gorm:"column:category_id"`
+	ImageID               string         `gorm:"column:image_id"`
+	Status                string         `gorm:"column:status;default:active"`
+	Currency              string         `gorm:"column:currency;type:varchar(3);default:RUB;not null"`
+	OptimizationAlgorithm string         `gorm:"column:optimization_algorithm;type:varchar(32);default:dinic;not null"`
+	DeletedAt             gorm.DeletedAt `gorm:"column:deleted_at"`
 }
 
 // TableName задает имя таблицы для модели Event
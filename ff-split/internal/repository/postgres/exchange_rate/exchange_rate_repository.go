@@ -0,0 +1,76 @@
+package exchange_rate
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ExchangeRateRepository реализует интерфейс repository.ExchangeRate
+type ExchangeRateRepository struct {
+	db *gorm.DB
+}
+
+// NewExchangeRateRepository создает новый экземпляр ExchangeRateRepository
+func NewExchangeRateRepository(db *gorm.DB) *ExchangeRateRepository {
+	return &ExchangeRateRepository{
+		db: db,
+	}
+}
+
+// GetAll возвращает все сохраненные курсы
+func (r *ExchangeRateRepository) GetAll(ctx context.Context) ([]models.ExchangeRate, error) {
+	var dbRates []ExchangeRate
+	err := r.db.WithContext(ctx).
+		Order("currency_from, currency_to").
+		Find(&dbRates).Error
+	if err != nil {
+		return nil, err
+	}
+	return extractSlice(dbRates), nil
+}
+
+// GetRate возвращает курс для пары валют или nil, если курс не задан
+func (r *ExchangeRateRepository) GetRate(ctx context.Context, from, to string) (*models.ExchangeRate, error) {
+	var dbRate ExchangeRate
+	err := r.db.WithContext(ctx).
+		Where("currency_from = ? AND currency_to = ?", from, to).
+		First(&dbRate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return extract(&dbRate), nil
+}
+
+// Upsert создает курс для пары валют или обновляет существующий
+func (r *ExchangeRateRepository) Upsert(ctx context.Context, rate *models.ExchangeRate) error {
+	dbRate := load(rate)
+	dbRate.UpdatedAt = time.Now()
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "currency_from"}, {Name: "currency_to"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+		}).
+		Create(dbRate).Error
+	if err != nil {
+		return err
+	}
+	rate.ID = dbRate.ID
+	rate.UpdatedAt = dbRate.UpdatedAt
+	return nil
+}
+
+// Delete удаляет курс для пары валют
+func (r *ExchangeRateRepository) Delete(ctx context.Context, from, to string) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).
+		Where("currency_from = ? AND currency_to = ?", from, to).
+		Delete(&ExchangeRate{}).Error
+}
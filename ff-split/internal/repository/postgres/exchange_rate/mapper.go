@@ -0,0 +1,46 @@
+package exchange_rate
+
+import (
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// extract преобразует модель курса БД в бизнес-модель
+func extract(dbRate *ExchangeRate) *models.ExchangeRate {
+	if dbRate == nil {
+		return nil
+	}
+
+	return &models.ExchangeRate{
+		ID:           dbRate.ID,
+		CurrencyFrom: dbRate.CurrencyFrom,
+		CurrencyTo:   dbRate.CurrencyTo,
+		Rate:         dbRate.Rate,
+		UpdatedAt:    dbRate.UpdatedAt,
+	}
+}
+
+// extractSlice преобразует слайс моделей курсов БД в бизнес-модели
+func extractSlice(dbRates []ExchangeRate) []models.ExchangeRate {
+	rates := make([]models.ExchangeRate, len(dbRates))
+	for i, dbRate := range dbRates {
+		if extracted := extract(&dbRate); extracted != nil {
+			rates[i] = *extracted
+		}
+	}
+	return rates
+}
+
+// load преобразует бизнес-модель курса в модель БД
+func load(rate *models.ExchangeRate) *ExchangeRate {
+	if rate == nil {
+		return nil
+	}
+
+	return &ExchangeRate{
+		ID:           rate.ID,
+		CurrencyFrom: rate.CurrencyFrom,
+		CurrencyTo:   rate.CurrencyTo,
+		Rate:         rate.Rate,
+		UpdatedAt:    rate.UpdatedAt,
+	}
+}
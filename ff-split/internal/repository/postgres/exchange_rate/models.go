@@ -0,0 +1,17 @@
+package exchange_rate
+
+import "time"
+
+// ExchangeRate представляет курс валюты в БД
+type ExchangeRate struct {
+	ID           int       `gorm:"column:id;primaryKey;autoIncrement"`
+	CurrencyFrom string    `gorm:"column:currency_from;type:varchar(3);not null;uniqueIndex:uniq_exchange_rate"`
+	CurrencyTo   string    `gorm:"column:currency_to;type:varchar(3);not null;uniqueIndex:uniq_exchange_rate"`
+	Rate         float64   `gorm:"column:rate;type:numeric(18,8);not null"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName задает имя таблицы для модели ExchangeRate
+func (ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
@@ -0,0 +1,106 @@
+package invite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"gorm.io/gorm"
+)
+
+// InviteRepository реализует интерфейс repository.Invite
+type InviteRepository struct {
+	db *gorm.DB
+}
+
+// NewInviteRepository создает новый экземпляр InviteRepository
+func NewInviteRepository(db *gorm.DB) *InviteRepository {
+	return &InviteRepository{
+		db: db,
+	}
+}
+
+// Create создает приглашение
+func (r *InviteRepository) Create(ctx context.Context, invite *models.EventInvite) error {
+	dbInvite := load(invite)
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Create(dbInvite).Error; err != nil {
+		return fmt.Errorf("ошибка при создании приглашения: %w", err)
+	}
+	invite.ID = dbInvite.ID
+	invite.CreatedAt = dbInvite.CreatedAt
+	return nil
+}
+
+// GetByID возвращает приглашение по ID или nil, если приглашение не найдено
+func (r *InviteRepository) GetByID(ctx context.Context, id int) (*models.EventInvite, error) {
+	var dbInvite EventInvite
+	err := db.GetTx(ctx, r.db).WithContext(ctx).First(&dbInvite, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ошибка при получении приглашения: %w", err)
+	}
+	return extract(&dbInvite), nil
+}
+
+// GetByToken возвращает приглашение по коду или nil, если приглашение не найдено
+func (r *InviteRepository) GetByToken(ctx context.Context, token string) (*models.EventInvite, error) {
+	var dbInvite EventInvite
+	err := db.GetTx(ctx, r.db).WithContext(ctx).Where("token = ?", token).First(&dbInvite).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ошибка при получении приглашения: %w", err)
+	}
+	return extract(&dbInvite), nil
+}
+
+// GetActiveByEventID возвращает неотозванные, непросроченные и неисчерпанные приглашения мероприятия
+func (r *InviteRepository) GetActiveByEventID(ctx context.Context, eventID int64) ([]models.EventInvite, error) {
+	var dbInvites []EventInvite
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Where("event_id = ? AND revoked_at IS NULL", eventID).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Where("max_uses IS NULL OR uses < max_uses").
+		Order("created_at DESC, id DESC").
+		Find(&dbInvites).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении приглашений мероприятия: %w", err)
+	}
+	return extractSlice(dbInvites), nil
+}
+
+// IncrementUses учитывает использование приглашения.
+// Условие на лимит проверяется в самом UPDATE, чтобы параллельные присоединения не превысили его.
+func (r *InviteRepository) IncrementUses(ctx context.Context, id int) (bool, error) {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&EventInvite{}).
+		Where("id = ? AND (max_uses IS NULL OR uses < max_uses)", id).
+		Update("uses", gorm.Expr("uses + 1"))
+	if result.Error != nil {
+		return false, fmt.Errorf("ошибка при учете использования приглашения: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Revoke отзывает приглашение
+func (r *InviteRepository) Revoke(ctx context.Context, id int) error {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&EventInvite{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("ошибка при отзыве приглашения: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return customErrors.NewEntityNotFoundError(strconv.Itoa(id), "invite")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package invite
+
+import (
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// extract преобразует модель приглашения БД в бизнес-модель
+func extract(dbInvite *EventInvite) *models.EventInvite {
+	if dbInvite == nil {
+		return nil
+	}
+
+	return &models.EventInvite{
+		ID:        dbInvite.ID,
+		EventID:   dbInvite.EventID,
+		Token:     dbInvite.Token,
+		Role:      models.EventRole(dbInvite.Role),
+		MaxUses:   dbInvite.MaxUses,
+		Uses:      dbInvite.Uses,
+		ExpiresAt: dbInvite.ExpiresAt,
+		CreatedBy: dbInvite.CreatedBy,
+		RevokedAt: dbInvite.RevokedAt,
+		CreatedAt: dbInvite.CreatedAt,
+	}
+}
+
+// extractSlice преобразует слайс моделей приглашений БД в бизнес-модели
+func extractSlice(dbInvites []EventInvite) []models.EventInvite {
+	invites := make([]models.EventInvite, len(dbInvites))
+	for i, dbInvite := range dbInvites {
+		if extracted := extract(&dbInvite); extracted != nil {
+			invites[i] = *extracted
+		}
+	}
+	return invites
+}
+
+// load преобразует бизнес-модель приглашения в модель БД
+func load(invite *models.EventInvite) *EventInvite {
+	if invite == nil {
+		return nil
+	}
+
+	return &EventInvite{
+		ID:        invite.ID,
+		EventID:   invite.EventID,
+		Token:     invite.Token,
+		Role:      string(invite.Role),
+		MaxUses:   invite.MaxUses,
+		Uses:      invite.Uses,
+		ExpiresAt: invite.ExpiresAt,
+		CreatedBy: invite.CreatedBy,
+		RevokedAt: invite.RevokedAt,
+		CreatedAt: invite.CreatedAt,
+	}
+}
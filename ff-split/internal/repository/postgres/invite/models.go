@@ -0,0 +1,22 @@
+package invite
+
+import "time"
+
+// EventInvite представляет приглашение в мероприятие в БД
+type EventInvite struct {
+	ID        int        `gorm:"column:id;primaryKey;autoIncrement"`
+	EventID   int64      `gorm:"column:event_id;not null"`
+	Token     string     `gorm:"column:token;type:varchar(64);not null;uniqueIndex"`
+	Role      string     `gorm:"column:role;type:varchar(16);not null;default:member"`
+	MaxUses   *int       `gorm:"column:max_uses"`
+	Uses      int        `gorm:"column:uses;not null;default:0"`
+	ExpiresAt *time.Time `gorm:"column:expires_at"`
+	CreatedBy *int64     `gorm:"column:created_by"`
+	RevokedAt *time.Time `gorm:"column:revoked_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName задает имя таблицы для модели EventInvite
+func (EventInvite) TableName() string {
+	return "event_invites"
+}
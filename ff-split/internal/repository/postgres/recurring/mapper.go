@@ -0,0 +1,80 @@
+package recurring
+
+import (
+	"encoding/json"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// extract преобразует модель шаблона БД в бизнес-модель
+func extract(dbRecurring *RecurringTransaction) *models.RecurringTransaction {
+	if dbRecurring == nil {
+		return nil
+	}
+
+	return &models.RecurringTransaction{
+		ID:        dbRecurring.ID,
+		EventID:   dbRecurring.EventID,
+		Frequency: dbRecurring.Frequency,
+		Interval:  dbRecurring.IntervalCount,
+		StartDate: dbRecurring.StartDate,
+		EndDate:   dbRecurring.EndDate,
+		Body:      json.RawMessage(dbRecurring.Body),
+		Paused:    dbRecurring.Paused,
+		NextIndex: dbRecurring.NextIndex,
+		NextRunAt: dbRecurring.NextRunAt,
+		CreatedBy: dbRecurring.CreatedBy,
+		CreatedAt: dbRecurring.CreatedAt,
+		UpdatedAt: dbRecurring.UpdatedAt,
+	}
+}
+
+// extractSlice преобразует слайс моделей шаблонов БД в бизнес-модели
+func extractSlice(dbRecurrings []RecurringTransaction) []models.RecurringTransaction {
+	recurrings := make([]models.RecurringTransaction, len(dbRecurrings))
+	for i, dbRecurring := range dbRecurrings {
+		if extracted := extract(&dbRecurring); extracted != nil {
+			recurrings[i] = *extracted
+		}
+	}
+	return recurrings
+}
+
+// load преобразует бизнес-модель шаблона в модель БД
+func load(recurring *models.RecurringTransaction) *RecurringTransaction {
+	if recurring == nil {
+		return nil
+	}
+
+	return &RecurringTransaction{
+		ID:            recurring.ID,
+		EventID:       recurring.EventID,
+		Frequency:     recurring.Frequency,
+		IntervalCount: recurring.Interval,
+		StartDate:     recurring.StartDate,
+		EndDate:       recurring.EndDate,
+		Body:          string(recurring.Body),
+		Paused:        recurring.Paused,
+		NextIndex:     recurring.NextIndex,
+		NextRunAt:     recurring.NextRunAt,
+		CreatedBy:     recurring.CreatedBy,
+		CreatedAt:     recurring.CreatedAt,
+		UpdatedAt:     recurring.UpdatedAt,
+	}
+}
+
+// loadOccurrence преобразует бизнес-модель повтора в модель БД
+func loadOccurrence(occurrence *models.RecurringOccurrence) *RecurringOccurrence {
+	if occurrence == nil {
+		return nil
+	}
+
+	return &RecurringOccurrence{
+		ID:            occurrence.ID,
+		RecurringID:   occurrence.RecurringID,
+		ScheduledAt:   occurrence.ScheduledAt,
+		TransactionID: occurrence.TransactionID,
+		Error:         occurrence.Error,
+		CreatedAt:     occurrence.CreatedAt,
+	}
+}
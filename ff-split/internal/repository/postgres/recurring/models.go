@@ -0,0 +1,40 @@
+package recurring
+
+import "time"
+
+// RecurringTransaction представляет шаблон регулярной транзакции в БД
+type RecurringTransaction struct {
+	ID            int        `gorm:"column:id;primaryKey;autoIncrement"`
+	EventID       int64      `gorm:"column:event_id;not null"`
+	Frequency     string     `gorm:"column:frequency;type:varchar(16);not null"`
+	IntervalCount int        `gorm:"column:interval_count;not null;default:1"`
+	StartDate     time.Time  `gorm:"column:start_date;not null"`
+	EndDate       *time.Time `gorm:"column:end_date"`
+	Body          string     `gorm:"column:body;type:jsonb;not null"`
+	Paused        bool       `gorm:"column:paused;not null;default:false"`
+	NextIndex     int        `gorm:"column:next_index;not null;default:0"`
+	NextRunAt     *time.Time `gorm:"column:next_run_at"`
+	CreatedBy     *int64     `gorm:"column:created_by"`
+	CreatedAt     time.Time  `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName задает имя таблицы для модели RecurringTransaction
+func (RecurringTransaction) TableName() string {
+	return "recurring_transactions"
+}
+
+// RecurringOccurrence представляет повтор регулярной транзакции в БД
+type RecurringOccurrence struct {
+	ID            int       `gorm:"column:id;primaryKey;autoIncrement"`
+	RecurringID   int       `gorm:"column:recurring_id;not null"`
+	ScheduledAt   time.Time `gorm:"column:scheduled_at;not null"`
+	TransactionID *int      `gorm:"column:transaction_id"`
+	Error         *string   `gorm:"column:error"`
+	CreatedAt     time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName задает имя таблицы для модели RecurringOccurrence
+func (RecurringOccurrence) TableName() string {
+	return "recurring_occurrences"
+}
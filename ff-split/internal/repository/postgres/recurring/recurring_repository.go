@@ -0,0 +1,180 @@
+package recurring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RecurringRepository реализует интерфейс repository.Recurring
+type RecurringRepository struct {
+	db *gorm.DB
+}
+
+// NewRecurringRepository создает новый экземпляр RecurringRepository
+func NewRecurringRepository(db *gorm.DB) *RecurringRepository {
+	return &RecurringRepository{
+		db: db,
+	}
+}
+
+// Create создает шаблон регулярной транзакции
+func (r *RecurringRepository) Create(ctx context.Context, recurring *models.RecurringTransaction) error {
+	dbRecurring := load(recurring)
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Create(dbRecurring).Error; err != nil {
+		return fmt.Errorf("ошибка при создании регулярной транзакции: %w", err)
+	}
+	recurring.ID = dbRecurring.ID
+	recurring.CreatedAt = dbRecurring.CreatedAt
+	recurring.UpdatedAt = dbRecurring.UpdatedAt
+	return nil
+}
+
+// GetByID возвращает шаблон по ID или nil, если шаблон не найден
+func (r *RecurringRepository) GetByID(ctx context.Context, id int) (*models.RecurringTransaction, error) {
+	var dbRecurring RecurringTransaction
+	err := db.GetTx(ctx, r.db).WithContext(ctx).First(&dbRecurring, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ошибка при получении регулярной транзакции: %w", err)
+	}
+	return extract(&dbRecurring), nil
+}
+
+// GetByEventID возвращает шаблоны мероприятия
+func (r *RecurringRepository) GetByEventID(ctx context.Context, eventID int64) ([]models.RecurringTransaction, error) {
+	var dbRecurrings []RecurringTransaction
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("created_at, id").
+		Find(&dbRecurrings).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении регулярных транзакций мероприятия: %w", err)
+	}
+	return extractSlice(dbRecurrings), nil
+}
+
+// GetByParticipant возвращает шаблоны мероприятий, в которых участвует пользователь
+func (r *RecurringRepository) GetByParticipant(ctx context.Context, userID int64) ([]models.RecurringTransaction, error) {
+	var dbRecurrings []RecurringTransaction
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Where("event_id IN (SELECT event_id FROM user_event WHERE user_id = ?)", userID).
+		Order("id").
+		Find(&dbRecurrings).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении регулярных транзакций участника: %w", err)
+	}
+	return extractSlice(dbRecurrings), nil
+}
+
+// GetDue возвращает не более limit активных шаблонов, следующий повтор которых наступил к моменту now.
+// Шаблоны мероприятий из корзины пропускаются
+func (r *RecurringRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]models.RecurringTransaction, error) {
+	var dbRecurrings []RecurringTransaction
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Where("NOT paused AND next_run_at IS NOT NULL AND next_run_at <= ?", now).
+		Where("event_id NOT IN (SELECT id FROM events WHERE deleted_at IS NOT NULL)").
+		Order("next_run_at, id").
+		Limit(limit).
+		Find(&dbRecurrings).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении регулярных транзакций к выполнению: %w", err)
+	}
+	return extractSlice(dbRecurrings), nil
+}
+
+// Update сохраняет расписание, тело запроса и состояние шаблона
+func (r *RecurringRepository) Update(ctx context.Context, recurring *models.RecurringTransaction) error {
+	dbRecurring := load(recurring)
+	result := db.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&RecurringTransaction{}).
+		Where("id = ?", recurring.ID).
+		Updates(map[string]interface{}{
+			"frequency":      dbRecurring.Frequency,
+			"interval_count": dbRecurring.IntervalCount,
+			"start_date":     dbRecurring.StartDate,
+			"end_date":       dbRecurring.EndDate,
+			"body":           dbRecurring.Body,
+			"paused":         dbRecurring.Paused,
+			"next_index":     dbRecurring.NextIndex,
+			"next_run_at":    dbRecurring.NextRunAt,
+			"updated_at":     time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("ошибка при обновлении регулярной транзакции: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return customErrors.NewEntityNotFoundError(strconv.Itoa(recurring.ID), "recurring transaction")
+	}
+	return nil
+}
+
+// Delete удаляет шаблон вместе с историей повторов
+func (r *RecurringRepository) Delete(ctx context.Context, id int) error {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).Delete(&RecurringTransaction{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("ошибка при удалении регулярной транзакции: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return customErrors.NewEntityNotFoundError(strconv.Itoa(id), "recurring transaction")
+	}
+	return nil
+}
+
+// Advance переводит активный шаблон с повтора fromIndex на повтор nextIndex.
+// Условие на текущий номер повтора проверяется в самом UPDATE, чтобы параллельные обработчики не создали повтор дважды.
+func (r *RecurringRepository) Advance(ctx context.Context, id, fromIndex, nextIndex int, nextRunAt *time.Time) (bool, error) {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&RecurringTransaction{}).
+		Where("id = ? AND next_index = ? AND NOT paused", id, fromIndex).
+		Updates(map[string]interface{}{
+			"next_index":  nextIndex,
+			"next_run_at": nextRunAt,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("ошибка при переходе к следующему повтору: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CreateOccurrence записывает повтор. Возвращает false, если повтор на это время уже записан.
+func (r *RecurringRepository) CreateOccurrence(ctx context.Context, occurrence *models.RecurringOccurrence) (bool, error) {
+	dbOccurrence := loadOccurrence(occurrence)
+	result := db.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(dbOccurrence)
+	if result.Error != nil {
+		return false, fmt.Errorf("ошибка при записи повтора: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	occurrence.ID = dbOccurrence.ID
+	occurrence.CreatedAt = dbOccurrence.CreatedAt
+	return true, nil
+}
+
+// SetOccurrenceResult сохраняет созданную транзакцию или ошибку создания повтора
+func (r *RecurringRepository) SetOccurrenceResult(ctx context.Context, id int, transactionID *int, errMsg *string) error {
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&RecurringOccurrence{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"transaction_id": transactionID,
+			"error":          errMsg,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("ошибка при сохранении результата повтора: %w", err)
+	}
+	return nil
+}
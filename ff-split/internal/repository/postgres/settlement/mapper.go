@@ -0,0 +1,71 @@
+package settlement
+
+import (
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/user"
+)
+
+// extract преобразует модель погашения БД в бизнес-модель
+func extract(dbSettlement *Settlement) *models.Settlement {
+	if dbSettlement == nil {
+		return nil
+	}
+
+	return &models.Settlement{
+		ID:              dbSettlement.ID,
+		EventID:         dbSettlement.EventID,
+		FromUserID:      dbSettlement.FromUserID,
+		ToUserID:        dbSettlement.ToUserID,
+		Amount:          dbSettlement.Amount,
+		OptimizedDebtID: dbSettlement.OptimizedDebtID,
+		Comment:         dbSettlement.Comment,
+		CreatedAt:       dbSettlement.CreatedAt,
+		FromUser:        extractUser(dbSettlement.FromUser),
+		ToUser:          extractUser(dbSettlement.ToUser),
+	}
+}
+
+// extractSlice преобразует слайс моделей погашений БД в бизнес-модели
+func extractSlice(dbSettlements []Settlement) []models.Settlement {
+	settlements := make([]models.Settlement, len(dbSettlements))
+	for i, dbSettlement := range dbSettlements {
+		if extracted := extract(&dbSettlement); extracted != nil {
+			settlements[i] = *extracted
+		}
+	}
+	return settlements
+}
+
+// load преобразует бизнес-модель погашения в модель БД
+func load(settlement *models.Settlement) *Settlement {
+	if settlement == nil {
+		return nil
+	}
+
+	return &Settlement{
+		ID:              settlement.ID,
+		EventID:         settlement.EventID,
+		FromUserID:      settlement.FromUserID,
+		ToUserID:        settlement.ToUserID,
+		Amount:          settlement.Amount,
+		OptimizedDebtID: settlement.OptimizedDebtID,
+		Comment:         settlement.Comment,
+		CreatedAt:       settlement.CreatedAt,
+	}
+}
+
+// extractUser преобразует модель пользователя БД в бизнес-модель
+func extractUser(dbUser *user.User) *models.User {
+	if dbUser == nil {
+		return nil
+	}
+
+	return &models.User{
+		ID:              dbUser.ID,
+		UserID:          dbUser.UserID,
+		NicknameCashed:  dbUser.NicknameCashed,
+		NameCashed:      dbUser.NameCashed,
+		PhotoUUIDCashed: dbUser.PhotoUUIDCashed,
+		IsDummy:         dbUser.IsDummy,
+	}
+}
@@ -0,0 +1,28 @@
+package settlement
+
+import (
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/user"
+)
+
+// Settlement представляет погашение долга в БД
+type Settlement struct {
+	ID              int         `gorm:"column:id;primaryKey;autoIncrement"`
+	EventID         int64       `gorm:"column:event_id;not null"`
+	FromUserID      int64       `gorm:"column:from_user_id;not null"`
+	ToUserID        int64       `gorm:"column:to_user_id;not null"`
+	Amount          money.Money `gorm:"column:amount;type:numeric(10,2);not null"`
+	OptimizedDebtID *int        `gorm:"column:optimized_debt_id"`
+	Comment         string      `gorm:"column:comment"`
+	CreatedAt       time.Time   `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+
+	FromUser *user.User `gorm:"foreignKey:FromUserID"`
+	ToUser   *user.User `gorm:"foreignKey:ToUserID"`
+}
+
+// TableName задает имя таблицы для модели Settlement
+func (Settlement) TableName() string {
+	return "settlements"
+}
@@ -0,0 +1,75 @@
+package settlement
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"gorm.io/gorm"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// SettlementRepository репозиторий для работы с погашениями долгов
+type SettlementRepository struct {
+	db *gorm.DB
+}
+
+// NewSettlementRepository создает новый репозиторий погашений
+func NewSettlementRepository(db *gorm.DB) *SettlementRepository {
+	return &SettlementRepository{db: db}
+}
+
+// withUsers загружает участников погашения
+func (r *SettlementRepository) withUsers(ctx context.Context) *gorm.DB {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Preload("FromUser").Preload("ToUser")
+}
+
+// GetSettlementsByEventID возвращает погашения мероприятия
+func (r *SettlementRepository) GetSettlementsByEventID(ctx context.Context, eventID int64) ([]models.Settlement, error) {
+	var dbSettlements []Settlement
+	if err := r.withUsers(ctx).
+		Where("event_id = ?", eventID).
+		Order("created_at, id").
+		Find(&dbSettlements).Error; err != nil {
+		return nil, err
+	}
+	return extractSlice(dbSettlements), nil
+}
+
+// GetSettlementByID возвращает погашение по ID
+func (r *SettlementRepository) GetSettlementByID(ctx context.Context, id int) (*models.Settlement, error) {
+	var dbSettlement Settlement
+	if err := r.withUsers(ctx).First(&dbSettlement, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, customErrors.NewEntityNotFoundError(strconv.Itoa(id), "settlement")
+		}
+		return nil, err
+	}
+	return extract(&dbSettlement), nil
+}
+
+// CreateSettlement создает погашение
+func (r *SettlementRepository) CreateSettlement(ctx context.Context, settlement *models.Settlement) error {
+	dbSettlement := load(settlement)
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Omit("FromUser", "ToUser").Create(dbSettlement).Error; err != nil {
+		return err
+	}
+	settlement.ID = dbSettlement.ID
+	settlement.CreatedAt = dbSettlement.CreatedAt
+	return nil
+}
+
+// DeleteSettlement удаляет погашение по ID
+func (r *SettlementRepository) DeleteSettlement(ctx context.Context, id int) error {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).Delete(&Settlement{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return customErrors.NewEntityNotFoundError(strconv.Itoa(id), "settlement")
+	}
+	return nil
+}
@@ -1,7 +1,11 @@
 package transaction
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"gorm.io/gorm"
 )
 
 // extract преобразует модель транзакции БД в бизнес-модель
@@ -19,6 +23,9 @@ func extract(dbTransaction *Transaction) *models.Transaction {
 		TotalPaid:             dbTransaction.TotalPaid,
 		PayerID:               dbTransaction.PayerID,
 		SplitType:             dbTransaction.SplitType,
+		Currency:              dbTransaction.Currency,
+		ExchangeRate:          dbTransaction.ExchangeRate,
+		DeletedAt:             extractDeletedAt(dbTransaction.DeletedAt),
 	}
 }
 
@@ -33,6 +40,14 @@ func extractSlice(dbTransactions []Transaction) []models.Transaction {
 	return transactions
 }
 
+// extractDeletedAt возвращает время удаления в корзину или nil для неудаленной записи
+func extractDeletedAt(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return &deletedAt.Time
+}
+
 // load преобразует бизнес-модель транзакции в модель БД
 func load(transaction *models.Transaction) *Transaction {
 	if transaction == nil {
@@ -48,6 +63,8 @@ func load(transaction *models.Transaction) *Transaction {
 		TotalPaid:             transaction.TotalPaid,
 		PayerID:               transaction.PayerID,
 		SplitType:             transaction.SplitType,
+		Currency:              transaction.Currency,
+		ExchangeRate:          transaction.ExchangeRate,
 	}
 }
 
@@ -105,6 +122,147 @@ func loadTransactionShare(share *models.TransactionShare) *TransactionShare {
 	}
 }
 
+// extractTransactionPayer преобразует модель плательщика транзакции БД в бизнес-модель
+func extractTransactionPayer(dbPayer *TransactionPayer) *models.TransactionPayer {
+	if dbPayer == nil {
+		return nil
+	}
+
+	return &models.TransactionPayer{
+		ID:            dbPayer.ID,
+		TransactionID: dbPayer.TransactionID,
+		UserID:        dbPayer.UserID,
+		Amount:        dbPayer.Amount,
+	}
+}
+
+// extractTransactionPayerSlice преобразует слайс моделей плательщиков БД в бизнес-модели
+func extractTransactionPayerSlice(dbPayers []TransactionPayer) []models.TransactionPayer {
+	payers := make([]models.TransactionPayer, len(dbPayers))
+	for i, dbPayer := range dbPayers {
+		if extracted := extractTransactionPayer(&dbPayer); extracted != nil {
+			payers[i] = *extracted
+		}
+	}
+	return payers
+}
+
+// loadTransactionPayer преобразует бизнес-модель плательщика транзакции в модель БД
+func loadTransactionPayer(payer *models.TransactionPayer) *TransactionPayer {
+	if payer == nil {
+		return nil
+	}
+
+	return &TransactionPayer{
+		ID:            payer.ID,
+		TransactionID: payer.TransactionID,
+		UserID:        payer.UserID,
+		Amount:        payer.Amount,
+	}
+}
+
+// extractTransactionItem преобразует модель позиции чека БД в бизнес-модель
+func extractTransactionItem(dbItem *TransactionItem) *models.TransactionItem {
+	if dbItem == nil {
+		return nil
+	}
+
+	consumers := make([]models.TransactionItemConsumer, len(dbItem.Consumers))
+	for i, dbConsumer := range dbItem.Consumers {
+		consumers[i] = models.TransactionItemConsumer{
+			ID:     dbConsumer.ID,
+			ItemID: dbConsumer.ItemID,
+			UserID: dbConsumer.UserID,
+		}
+	}
+
+	return &models.TransactionItem{
+		ID:            dbItem.ID,
+		TransactionID: dbItem.TransactionID,
+		Name:          dbItem.Name,
+		Price:         dbItem.Price,
+		Quantity:      dbItem.Quantity,
+		Consumers:     consumers,
+	}
+}
+
+// extractTransactionItemSlice преобразует слайс моделей позиций чека БД в бизнес-модели
+func extractTransactionItemSlice(dbItems []TransactionItem) []models.TransactionItem {
+	items := make([]models.TransactionItem, len(dbItems))
+	for i, dbItem := range dbItems {
+		if extracted := extractTransactionItem(&dbItem); extracted != nil {
+			items[i] = *extracted
+		}
+	}
+	return items
+}
+
+// loadTransactionItem преобразует бизнес-модель позиции чека в модель БД (без потребителей)
+func loadTransactionItem(item *models.TransactionItem) *TransactionItem {
+	if item == nil {
+		return nil
+	}
+
+	return &TransactionItem{
+		ID:            item.ID,
+		TransactionID: item.TransactionID,
+		Name:          item.Name,
+		Price:         item.Price,
+		Quantity:      item.Quantity,
+	}
+}
+
+// loadTransactionItemConsumers преобразует потребителей позиции чека в модели БД
+func loadTransactionItemConsumers(itemID int, consumers []models.TransactionItemConsumer) []TransactionItemConsumer {
+	dbConsumers := make([]TransactionItemConsumer, len(consumers))
+	for i, consumer := range consumers {
+		dbConsumers[i] = TransactionItemConsumer{
+			ItemID: itemID,
+			UserID: consumer.UserID,
+		}
+	}
+	return dbConsumers
+}
+
+// extractTransactionCharge преобразует модель надбавки чека БД в бизнес-модель
+func extractTransactionCharge(dbCharge *TransactionCharge) *models.TransactionCharge {
+	if dbCharge == nil {
+		return nil
+	}
+
+	return &models.TransactionCharge{
+		ID:            dbCharge.ID,
+		TransactionID: dbCharge.TransactionID,
+		Name:          dbCharge.Name,
+		Amount:        dbCharge.Amount,
+	}
+}
+
+// extractTransactionChargeSlice преобразует слайс моделей надбавок БД в бизнес-модели
+func extractTransactionChargeSlice(dbCharges []TransactionCharge) []models.TransactionCharge {
+	charges := make([]models.TransactionCharge, len(dbCharges))
+	for i, dbCharge := range dbCharges {
+		if extracted := extractTransactionCharge(&dbCharge); extracted != nil {
+			charges[i] = *extracted
+		}
+	}
+	return charges
+}
+
+// loadTransactionCharge преобразует бизнес-модель надбавки чека в модель БД
+func loadTransactionCharge(charge *models.TransactionCharge) *TransactionCharge {
+	if charge == nil {
+		return nil
+	}
+
+	return &TransactionCharge{
+		ID:            charge.ID,
+		TransactionID: charge.TransactionID,
+		Name:          charge.Name,
+		Amount:        charge.Amount,
+	}
+}
+
 // extractDebt преобразует модель долга БД в бизнес-модель
 func extractDebt(dbDebt *Debt) *models.Debt {
 	if dbDebt == nil {
@@ -157,9 +315,11 @@ func extractOptimizedDebt(dbDebt *OptimizedDebt) *models.OptimizedDebt {
 		EventID:    dbDebt.EventID,
 		FromUserID: dbDebt.FromUserID,
 		ToUserID:   dbDebt.ToUserID,
-		Amount:     dbDebt.Amount,
-		CreatedAt:  dbDebt.CreatedAt,
-		UpdatedAt:  dbDebt.UpdatedAt,
+		Amount:        dbDebt.Amount,
+		SettledAmount: dbDebt.SettledAmount,
+		Status:        dbDebt.Status,
+		CreatedAt:     dbDebt.CreatedAt,
+		UpdatedAt:     dbDebt.UpdatedAt,
 	}
 }
 
@@ -185,8 +345,107 @@ func loadOptimizedDebt(debt *models.OptimizedDebt) *OptimizedDebt {
 		EventID:    debt.EventID,
 		FromUserID: debt.FromUserID,
 		ToUserID:   debt.ToUserID,
-		Amount:     debt.Amount,
-		CreatedAt:  debt.CreatedAt,
-		UpdatedAt:  debt.UpdatedAt,
+		Amount:        debt.Amount,
+		SettledAmount: debt.SettledAmount,
+		Status:        debt.Status,
+		CreatedAt:     debt.CreatedAt,
+		UpdatedAt:     debt.UpdatedAt,
+	}
+}
+
+// extractRevision преобразует модель ревизии транзакции БД в бизнес-модель
+func extractRevision(dbRevision *TransactionRevision) *models.TransactionRevision {
+	if dbRevision == nil {
+		return nil
+	}
+
+	revision := &models.TransactionRevision{
+		ID:            dbRevision.ID,
+		TransactionID: dbRevision.TransactionID,
+		EventID:       dbRevision.EventID,
+		Action:        dbRevision.Action,
+		ActorID:       dbRevision.ActorID,
+		RevertedFrom:  dbRevision.RevertedFrom,
+		Snapshot:      json.RawMessage(dbRevision.Snapshot),
+		CreatedAt:     dbRevision.CreatedAt,
+	}
+	if dbRevision.Diff != nil {
+		revision.Diff = json.RawMessage(*dbRevision.Diff)
+	}
+	return revision
+}
+
+// extractRevisionSlice преобразует слайс моделей ревизий БД в бизнес-модели
+func extractRevisionSlice(dbRevisions []TransactionRevision) []models.TransactionRevision {
+	revisions := make([]models.TransactionRevision, len(dbRevisions))
+	for i, dbRevision := range dbRevisions {
+		if extracted := extractRevision(&dbRevision); extracted != nil {
+			revisions[i] = *extracted
+		}
+	}
+	return revisions
+}
+
+// loadRevision преобразует бизнес-модель ревизии транзакции в модель БД
+func loadRevision(revision *models.TransactionRevision) *TransactionRevision {
+	if revision == nil {
+		return nil
+	}
+
+	dbRevision := &TransactionRevision{
+		ID:            revision.ID,
+		TransactionID: revision.TransactionID,
+		EventID:       revision.EventID,
+		Action:        revision.Action,
+		ActorID:       revision.ActorID,
+		RevertedFrom:  revision.RevertedFrom,
+		Snapshot:      string(revision.Snapshot),
+		CreatedAt:     revision.CreatedAt,
+	}
+	if len(revision.Diff) > 0 {
+		diff := string(revision.Diff)
+		dbRevision.Diff = &diff
+	}
+	return dbRevision
+}
+
+// extractAttachment преобразует модель вложения транзакции БД в бизнес-модель
+func extractAttachment(dbAttachment *TransactionAttachment) *models.TransactionAttachment {
+	if dbAttachment == nil {
+		return nil
+	}
+
+	return &models.TransactionAttachment{
+		ID:            dbAttachment.ID,
+		TransactionID: dbAttachment.TransactionID,
+		ObjectID:      dbAttachment.ObjectID,
+		CreatedBy:     dbAttachment.CreatedBy,
+		CreatedAt:     dbAttachment.CreatedAt,
+	}
+}
+
+// extractAttachmentSlice преобразует слайс моделей вложений БД в бизнес-модели
+func extractAttachmentSlice(dbAttachments []TransactionAttachment) []models.TransactionAttachment {
+	attachments := make([]models.TransactionAttachment, len(dbAttachments))
+	for i, dbAttachment := range dbAttachments {
+		if extracted := extractAttachment(&dbAttachment); extracted != nil {
+			attachments[i] = *extracted
+		}
+	}
+	return attachments
+}
+
+// loadAttachment преобразует бизнес-модель вложения транзакции в модель БД
+func loadAttachment(attachment *models.TransactionAttachment) *TransactionAttachment {
+	if attachment == nil {
+		return nil
+	}
+
+	return &TransactionAttachment{
+		ID:            attachment.ID,
+		TransactionID: attachment.TransactionID,
+		ObjectID:      attachment.ObjectID,
+		CreatedBy:     attachment.CreatedBy,
+		CreatedAt:     attachment.CreatedAt,
 	}
 }
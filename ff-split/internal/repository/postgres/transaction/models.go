@@ -1,17 +1,25 @@
 package transaction
 
-import "time"
+import (
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"gorm.io/gorm"
+)
 
 // Transaction представляет транзакцию в БД
 type Transaction struct {
-	ID                    int       `gorm:"column:id;primaryKey;autoIncrement"`
-	EventID               *int64    `gorm:"column:event_id"`
-	Name                  string    `gorm:"column:name;not null"`
-	TransactionCategoryID *int      `gorm:"column:transaction_category_id"`
-	Datetime              time.Time `gorm:"column:datetime;default:CURRENT_TIMESTAMP"`
-	TotalPaid             float64   `gorm:"column:total_paid;type:numeric(10,2);not null"`
-	PayerID               *int64    `gorm:"column:payer_id"`
-	SplitType             int       `gorm:"column:split_type;default:0;not null"`
+	ID                    int            `gorm:"column:id;primaryKey;autoIncrement"`
+	EventID               *int64         `gorm:"column:event_id"`
+	Name                  string         `gorm:"column:name;not null"`
+	TransactionCategoryID *int           `gorm:"column:transaction_category_id"`
+	Datetime              time.Time      `gorm:"column:datetime;default:CURRENT_TIMESTAMP"`
+	TotalPaid             money.Money    `gorm:"column:total_paid;type:numeric(10,2);not null"`
+	PayerID               *int64         `gorm:"column:payer_id"`
+	SplitType             int            `gorm:"column:split_type;default:0;not null"`
+	Currency              string         `gorm:"column:currency;type:varchar(3);default:RUB;not null"`
+	ExchangeRate          float64        `gorm:"column:exchange_rate;type:numeric(18,8);default:1;not null"`
+	DeletedAt             gorm.DeletedAt `gorm:"column:deleted_at"`
 }
 
 // TableName задает имя таблицы для модели Transaction
@@ -33,10 +41,10 @@ func (TransactionCategory) TableName() string {
 
 // TransactionShare представляет долю пользователя в транзакции в БД
 type TransactionShare struct {
-	ID            int     `gorm:"column:id;primaryKey;autoIncrement"`
-	TransactionID int     `gorm:"column:transaction_id;uniqueIndex:uniq_tx_user"`
-	UserID        int64   `gorm:"column:user_id;uniqueIndex:uniq_tx_user"`
-	Value         float64 `gorm:"column:value;type:numeric(10,2);not null"`
+	ID            int         `gorm:"column:id;primaryKey;autoIncrement"`
+	TransactionID int         `gorm:"column:transaction_id;uniqueIndex:uniq_tx_user"`
+	UserID        int64       `gorm:"column:user_id;uniqueIndex:uniq_tx_user"`
+	Value         money.Money `gorm:"column:value;type:numeric(10,2);not null"`
 }
 
 // TableName задает имя таблицы для модели TransactionShare
@@ -44,13 +52,66 @@ func (TransactionShare) TableName() string {
 	return "transaction_shares"
 }
 
+// TransactionPayer представляет сумму, оплаченную пользователем в транзакции, в БД
+type TransactionPayer struct {
+	ID            int         `gorm:"column:id;primaryKey;autoIncrement"`
+	TransactionID int         `gorm:"column:transaction_id;uniqueIndex:uniq_tx_payer"`
+	UserID        int64       `gorm:"column:user_id;uniqueIndex:uniq_tx_payer"`
+	Amount        money.Money `gorm:"column:amount;type:numeric(10,2);not null"`
+}
+
+// TableName задает имя таблицы для модели TransactionPayer
+func (TransactionPayer) TableName() string {
+	return "transaction_payers"
+}
+
+// TransactionItem представляет позицию чека в БД
+type TransactionItem struct {
+	ID            int                       `gorm:"column:id;primaryKey;autoIncrement"`
+	TransactionID int                       `gorm:"column:transaction_id"`
+	Name          string                    `gorm:"column:name;not null"`
+	Price         money.Money               `gorm:"column:price;type:numeric(10,2);not null"`
+	Quantity      float64                   `gorm:"column:quantity;type:numeric(10,3);default:1;not null"`
+	Consumers     []TransactionItemConsumer `gorm:"foreignKey:ItemID"`
+}
+
+// TableName задает имя таблицы для модели TransactionItem
+func (TransactionItem) TableName() string {
+	return "transaction_items"
+}
+
+// TransactionItemConsumer представляет потребителя позиции чека в БД
+type TransactionItemConsumer struct {
+	ID     int   `gorm:"column:id;primaryKey;autoIncrement"`
+	ItemID int   `gorm:"column:item_id;uniqueIndex:uniq_item_consumer"`
+	UserID int64 `gorm:"column:user_id;uniqueIndex:uniq_item_consumer"`
+}
+
+// TableName задает имя таблицы для модели TransactionItemConsumer
+func (TransactionItemConsumer) TableName() string {
+	return "transaction_item_consumers"
+}
+
+// TransactionCharge представляет общую надбавку чека в БД
+type TransactionCharge struct {
+	ID            int         `gorm:"column:id;primaryKey;autoIncrement"`
+	TransactionID int         `gorm:"column:transaction_id"`
+	Name          string      `gorm:"column:name;not null"`
+	Amount        money.Money `gorm:"column:amount;type:numeric(10,2);not null"`
+}
+
+// TableName задает имя таблицы для модели TransactionCharge
+func (TransactionCharge) TableName() string {
+	return "transaction_charges"
+}
+
 // Debt представляет долг одного пользователя другому в БД
 type Debt struct {
-	ID            int     `gorm:"column:id;primaryKey;autoIncrement"`
-	TransactionID int     `gorm:"column:transaction_id;uniqueIndex:uniq_debt"`
-	FromUserID    int64   `gorm:"column:from_user_id;uniqueIndex:uniq_debt"`
-	ToUserID      int64   `gorm:"column:to_user_id;uniqueIndex:uniq_debt"`
-	Amount        float64 `gorm:"column:amount;type:numeric(10,2);not null"`
+	ID            int         `gorm:"column:id;primaryKey;autoIncrement"`
+	TransactionID int         `gorm:"column:transaction_id;uniqueIndex:uniq_debt"`
+	FromUserID    int64       `gorm:"column:from_user_id;uniqueIndex:uniq_debt"`
+	ToUserID      int64       `gorm:"column:to_user_id;uniqueIndex:uniq_debt"`
+	Amount        money.Money `gorm:"column:amount;type:numeric(10,2);not null"`
 }
 
 // TableName задает имя таблицы для модели Debt
@@ -60,16 +121,50 @@ func (Debt) TableName() string {
 
 // OptimizedDebt представляет оптимизированные долги между пользователями в БД
 type OptimizedDebt struct {
-	ID         int       `gorm:"column:id;primaryKey;autoIncrement"`
-	EventID    int64     `gorm:"column:event_id"`
-	FromUserID int64     `gorm:"column:from_user_id"`
-	ToUserID   int64     `gorm:"column:to_user_id"`
-	Amount     float64   `gorm:"column:amount;type:numeric(10,2);not null"`
-	CreatedAt  time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
-	UpdatedAt  time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP"`
+	ID         int         `gorm:"column:id;primaryKey;autoIncrement"`
+	EventID    int64       `gorm:"column:event_id"`
+	FromUserID int64       `gorm:"column:from_user_id"`
+	ToUserID   int64       `gorm:"column:to_user_id"`
+	Amount        money.Money `gorm:"column:amount;type:numeric(10,2);not null"`
+	SettledAmount money.Money `gorm:"column:settled_amount;type:numeric(10,2);default:0;not null"`
+	Status        string      `gorm:"column:status;default:pending;not null"`
+	CreatedAt     time.Time   `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time   `gorm:"column:updated_at;default:CURRENT_TIMESTAMP"`
 }
 
 // TableName задает имя таблицы для модели OptimizedDebt
 func (OptimizedDebt) TableName() string {
 	return "optimized_debts"
 }
+
+// TransactionRevision представляет ревизию транзакции в БД
+type TransactionRevision struct {
+	ID            int       `gorm:"column:id;primaryKey;autoIncrement"`
+	TransactionID int       `gorm:"column:transaction_id;not null"`
+	EventID       int64     `gorm:"column:event_id;not null"`
+	Action        string    `gorm:"column:action;type:varchar(16);not null"`
+	ActorID       *int64    `gorm:"column:actor_id"`
+	RevertedFrom  *int      `gorm:"column:reverted_from"`
+	Snapshot      string    `gorm:"column:snapshot;type:jsonb;not null"`
+	Diff          *string   `gorm:"column:diff;type:jsonb"`
+	CreatedAt     time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName задает имя таблицы для модели TransactionRevision
+func (TransactionRevision) TableName() string {
+	return "transaction_revisions"
+}
+
+// TransactionAttachment представляет вложение транзакции в БД
+type TransactionAttachment struct {
+	ID            int       `gorm:"column:id;primaryKey;autoIncrement"`
+	TransactionID int       `gorm:"column:transaction_id;not null"`
+	ObjectID      string    `gorm:"column:object_id;type:uuid;not null"`
+	CreatedBy     *int64    `gorm:"column:created_by"`
+	CreatedAt     time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName задает имя таблицы для модели TransactionAttachment
+func (TransactionAttachment) TableName() string {
+	return "transaction_attachments"
+}
@@ -1,11 +1,20 @@
 package transaction
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
 )
 
 // TransactionRepository репозиторий для работы с транзакциями
@@ -19,20 +28,102 @@ func NewTransactionRepository(db *gorm.DB) *TransactionRepository {
 }
 
 // GetTransactionsByEventID возвращает список транзакций мероприятия
-func (r *TransactionRepository) GetTransactionsByEventID(eventID int64) ([]models.Transaction, error) {
+func (r *TransactionRepository) GetTransactionsByEventID(ctx context.Context, eventID int64) ([]models.Transaction, error) {
 	var dbTransactions []Transaction
-	if err := r.db.Where("event_id = ?", eventID).Find(&dbTransactions).Error; err != nil {
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("event_id = ?", eventID).Find(&dbTransactions).Error; err != nil {
 		return nil, err
 	}
 	return extractSlice(dbTransactions), nil
 }
 
+// sortColumns - выражения сортировки списка транзакций; сумма сравнивается в валюте мероприятия
+var sortColumns = map[string]string{
+	models.TransactionSortDate:   "datetime",
+	models.TransactionSortAmount: "total_paid * exchange_rate",
+}
+
+// GetTransactionsPage возвращает страницу транзакций мероприятия с фильтрами и сортировкой.
+// Страница строится по ключу (поле сортировки, id): значения курсора берутся из самой
+// транзакции-курсора, поэтому порядок не зависит от округления сумм.
+func (r *TransactionRepository) GetTransactionsPage(ctx context.Context, eventID int64, filter *models.TransactionFilter) ([]models.Transaction, error) {
+	sortColumn, ok := sortColumns[filter.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("неизвестное поле сортировки транзакций %q", filter.SortBy)
+	}
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	query := db.GetTx(ctx, r.db).WithContext(ctx).Where("event_id = ?", eventID)
+	if filter.From != nil {
+		query = query.Where("datetime >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("datetime < ?", *filter.To)
+	}
+	if filter.CategoryID != nil {
+		query = query.Where("transaction_category_id = ?", *filter.CategoryID)
+	}
+	if filter.PayerID != nil {
+		query = query.Where(`(payer_id = ? OR EXISTS (
+			SELECT 1 FROM transaction_payers p WHERE p.transaction_id = transactions.id AND p.user_id = ?))`,
+			*filter.PayerID, *filter.PayerID)
+	}
+	if filter.ParticipantID != nil {
+		query = query.Where(`EXISTS (
+			SELECT 1 FROM transaction_shares s WHERE s.transaction_id = transactions.id AND s.user_id = ?)`,
+			*filter.ParticipantID)
+	}
+	if filter.AmountMin != nil {
+		query = query.Where("total_paid * exchange_rate >= ?", *filter.AmountMin)
+	}
+	if filter.AmountMax != nil {
+		query = query.Where("total_paid * exchange_rate <= ?", *filter.AmountMax)
+	}
+	if filter.Search != "" {
+		query = query.Where(`name ILIKE ? ESCAPE '\'`, "%"+escapeLike(filter.Search)+"%")
+	}
+	if filter.AfterID != nil {
+		// Курсор должен указывать на видимую транзакцию того же мероприятия,
+		// иначе подзапрос вернет NULL и страница окажется пустой
+		var cursorCount int64
+		err := db.GetTx(ctx, r.db).WithContext(ctx).Model(&Transaction{}).
+			Where("id = ? AND event_id = ?", *filter.AfterID, eventID).
+			Count(&cursorCount).Error
+		if err != nil {
+			return nil, err
+		}
+		if cursorCount == 0 {
+			return nil, customErrors.NewValidationError("cursor", "транзакция курсора не найдена в мероприятии")
+		}
+		query = query.Where(
+			fmt.Sprintf("(%[1]s, id) %[2]s (SELECT %[1]s, id FROM transactions WHERE id = ? AND event_id = ? AND deleted_at IS NULL)", sortColumn, comparison),
+			*filter.AfterID, eventID)
+	}
+
+	var dbTransactions []Transaction
+	err := query.
+		Order(fmt.Sprintf("%s %s, id %s", sortColumn, direction, direction)).
+		Limit(filter.Limit).
+		Find(&dbTransactions).Error
+	if err != nil {
+		return nil, err
+	}
+	return extractSlice(dbTransactions), nil
+}
+
+// escapeLike экранирует спецсимволы шаблона LIKE
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 // GetTransactionByID возвращает транзакцию по ID
-func (r *TransactionRepository) GetTransactionByID(id int) (*models.Transaction, error) {
+func (r *TransactionRepository) GetTransactionByID(ctx context.Context, id int) (*models.Transaction, error) {
 	var dbTransaction Transaction
-	if err := r.db.First(&dbTransaction, id).Error; err != nil {
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).First(&dbTransaction, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("транзакция не найдена")
+			return nil, customErrors.NewEntityNotFoundError(strconv.Itoa(id), "transaction")
 		}
 		return nil, err
 	}
@@ -40,9 +131,9 @@ func (r *TransactionRepository) GetTransactionByID(id int) (*models.Transaction,
 }
 
 // CreateTransaction создает новую транзакцию
-func (r *TransactionRepository) CreateTransaction(tx *models.Transaction) error {
+func (r *TransactionRepository) CreateTransaction(ctx context.Context, tx *models.Transaction) error {
 	dbTx := load(tx)
-	if err := r.db.Create(dbTx).Error; err != nil {
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Create(dbTx).Error; err != nil {
 		return err
 	}
 	tx.ID = dbTx.ID
@@ -50,9 +141,10 @@ func (r *TransactionRepository) CreateTransaction(tx *models.Transaction) error
 }
 
 // UpdateTransaction обновляет существующую транзакцию
-func (r *TransactionRepository) UpdateTransaction(tx *models.Transaction) error {
+func (r *TransactionRepository) UpdateTransaction(ctx context.Context, tx *models.Transaction) error {
 	dbTx := load(tx)
-	result := r.db.Save(dbTx)
+	// Явный список полей не дает Save вставить заново транзакцию, которую уже перенесли в корзину
+	result := db.GetTx(ctx, r.db).WithContext(ctx).Select("*").Omit("deleted_at").Save(dbTx)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -62,21 +154,15 @@ func (r *TransactionRepository) UpdateTransaction(tx *models.Transaction) error
 	return nil
 }
 
-// DeleteTransaction удаляет транзакцию и связанные с ней доли и долги
-func (r *TransactionRepository) DeleteTransaction(id int) error {
-	// Выполняем операции в транзакции
-	return r.db.Transaction(func(tx *gorm.DB) error {
-		// Удаляем связанные долги
-		if err := tx.Where("transaction_id = ?", id).Delete(&Debt{}).Error; err != nil {
-			return err
-		}
-
-		// Удаляем связанные доли
-		if err := tx.Where("transaction_id = ?", id).Delete(&TransactionShare{}).Error; err != nil {
+// DeleteTransaction переносит транзакцию в корзину.
+// Доли, оплаты, позиции чека и долги сохраняются, чтобы транзакцию можно было восстановить.
+func (r *TransactionRepository) DeleteTransaction(ctx context.Context, id int) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Помечаем оптимизированные долги мероприятия устаревшими
+		if err := markOutdatedByTransactions(tx, []int{id}); err != nil {
 			return err
 		}
 
-		// Удаляем саму транзакцию
 		result := tx.Delete(&Transaction{}, id)
 		if result.Error != nil {
 			return result.Error
@@ -89,10 +175,240 @@ func (r *TransactionRepository) DeleteTransaction(id int) error {
 	})
 }
 
+// GetDeletedTransactionsByEventID возвращает транзакции мероприятия из корзины, начиная с удаленных последними
+func (r *TransactionRepository) GetDeletedTransactionsByEventID(ctx context.Context, eventID int64) ([]models.Transaction, error) {
+	var dbTransactions []Transaction
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Unscoped().
+		Where("event_id = ? AND deleted_at IS NOT NULL", eventID).
+		Order("deleted_at DESC, id DESC").
+		Find(&dbTransactions).Error; err != nil {
+		return nil, err
+	}
+	return extractSlice(dbTransactions), nil
+}
+
+// RestoreTransaction возвращает транзакцию мероприятия из корзины
+func (r *TransactionRepository) RestoreTransaction(ctx context.Context, eventID int64, id int) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(&Transaction{}).
+			Where("id = ? AND event_id = ? AND deleted_at IS NOT NULL", id, eventID).
+			Update("deleted_at", nil)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return customErrors.NewEntityNotFoundError(strconv.Itoa(id), "transaction")
+		}
+
+		// Долги восстановленной транзакции снова учитываются в оптимизации
+		return markOutdatedByTransactions(tx, []int{id})
+	})
+}
+
+// PurgeDeletedTransactions окончательно удаляет транзакции, перенесенные в корзину раньше before.
+// Доли, оплаты, позиции чека и долги удаляются каскадно.
+func (r *TransactionRepository) PurgeDeletedTransactions(ctx context.Context, before time.Time) (int64, error) {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).Unscoped().Where("deleted_at < ?", before).Delete(&Transaction{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// CreateRevision сохраняет ревизию транзакции
+func (r *TransactionRepository) CreateRevision(ctx context.Context, revision *models.TransactionRevision) error {
+	dbRevision := loadRevision(revision)
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Create(dbRevision).Error; err != nil {
+		return err
+	}
+	revision.ID = dbRevision.ID
+	revision.CreatedAt = dbRevision.CreatedAt
+	return nil
+}
+
+// GetRevisionsByTransactionID возвращает ревизии транзакции мероприятия, начиная с последней.
+// История доступна и для транзакций из корзины.
+func (r *TransactionRepository) GetRevisionsByTransactionID(ctx context.Context, eventID int64, transactionID int) ([]models.TransactionRevision, error) {
+	var dbRevisions []TransactionRevision
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Where("event_id = ? AND transaction_id = ?", eventID, transactionID).
+		Order("id DESC").
+		Find(&dbRevisions).Error; err != nil {
+		return nil, err
+	}
+	return extractRevisionSlice(dbRevisions), nil
+}
+
+// GetRevisionByID возвращает ревизию транзакции по ID
+func (r *TransactionRepository) GetRevisionByID(ctx context.Context, id int) (*models.TransactionRevision, error) {
+	var dbRevision TransactionRevision
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).First(&dbRevision, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, customErrors.NewEntityNotFoundError(strconv.Itoa(id), "transaction_revision")
+		}
+		return nil, err
+	}
+	return extractRevision(&dbRevision), nil
+}
+
+// GetLatestRevision возвращает последнюю ревизию транзакции или nil, если истории еще нет
+func (r *TransactionRepository) GetLatestRevision(ctx context.Context, transactionID int) (*models.TransactionRevision, error) {
+	var dbRevisions []TransactionRevision
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Where("transaction_id = ?", transactionID).
+		Order("id DESC").
+		Limit(1).
+		Find(&dbRevisions).Error; err != nil {
+		return nil, err
+	}
+	if len(dbRevisions) == 0 {
+		return nil, nil
+	}
+	return extractRevision(&dbRevisions[0]), nil
+}
+
+// GetAttachmentsByTransactionID возвращает вложения транзакции в порядке прикрепления
+func (r *TransactionRepository) GetAttachmentsByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionAttachment, error) {
+	var dbAttachments []TransactionAttachment
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id = ?", transactionID).Order("id").Find(&dbAttachments).Error; err != nil {
+		return nil, err
+	}
+	return extractAttachmentSlice(dbAttachments), nil
+}
+
+// GetAttachmentByID возвращает вложение транзакции по ID
+func (r *TransactionRepository) GetAttachmentByID(ctx context.Context, id int) (*models.TransactionAttachment, error) {
+	var dbAttachment TransactionAttachment
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).First(&dbAttachment, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, customErrors.NewEntityNotFoundError(strconv.Itoa(id), "transaction_attachment")
+		}
+		return nil, err
+	}
+	return extractAttachment(&dbAttachment), nil
+}
+
+// GetExpiredAttachments возвращает вложения транзакций, которые будут окончательно удалены
+// при очистке корзины: транзакция или ее мероприятие перенесены в корзину раньше before
+func (r *TransactionRepository) GetExpiredAttachments(ctx context.Context, before time.Time) ([]models.TransactionAttachment, error) {
+	var dbAttachments []TransactionAttachment
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Joins("JOIN transactions ON transactions.id = transaction_attachments.transaction_id").
+		Joins("LEFT JOIN events ON events.id = transactions.event_id").
+		Where("transactions.deleted_at < ? OR events.deleted_at < ?", before, before).
+		Order("transaction_attachments.id").
+		Find(&dbAttachments).Error; err != nil {
+		return nil, err
+	}
+	return extractAttachmentSlice(dbAttachments), nil
+}
+
+// CreateAttachment сохраняет вложение транзакции
+func (r *TransactionRepository) CreateAttachment(ctx context.Context, attachment *models.TransactionAttachment) error {
+	dbAttachment := loadAttachment(attachment)
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Create(dbAttachment).Error; err != nil {
+		return err
+	}
+	attachment.ID = dbAttachment.ID
+	attachment.CreatedAt = dbAttachment.CreatedAt
+	return nil
+}
+
+// DeleteAttachment удаляет вложение транзакции
+func (r *TransactionRepository) DeleteAttachment(ctx context.Context, id int) error {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).Delete(&TransactionAttachment{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return customErrors.NewEntityNotFoundError(strconv.Itoa(id), "transaction_attachment")
+	}
+	return nil
+}
+
+// LoadTransactionDetails заполняет доли, плательщиков, позиции, надбавки, долги и вложения сразу для списка транзакций.
+// Каждая связанная таблица читается одним запросом независимо от количества транзакций.
+func (r *TransactionRepository) LoadTransactionDetails(ctx context.Context, transactions []models.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	ids := make([]int, len(transactions))
+	byID := make(map[int]*models.Transaction, len(transactions))
+	for i := range transactions {
+		ids[i] = transactions[i].ID
+		byID[transactions[i].ID] = &transactions[i]
+		transactions[i].Shares = nil
+		transactions[i].Payers = nil
+		transactions[i].Items = nil
+		transactions[i].Charges = nil
+		transactions[i].Debts = nil
+		transactions[i].Attachments = nil
+	}
+
+	var dbShares []TransactionShare
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id IN ?", ids).Order("id").Find(&dbShares).Error; err != nil {
+		return err
+	}
+	for i := range dbShares {
+		tx := byID[dbShares[i].TransactionID]
+		tx.Shares = append(tx.Shares, *extractTransactionShare(&dbShares[i]))
+	}
+
+	var dbPayers []TransactionPayer
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id IN ?", ids).Order("id").Find(&dbPayers).Error; err != nil {
+		return err
+	}
+	for _, payer := range extractTransactionPayerSlice(dbPayers) {
+		tx := byID[payer.TransactionID]
+		tx.Payers = append(tx.Payers, payer)
+	}
+
+	var dbItems []TransactionItem
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Preload("Consumers", func(db *gorm.DB) *gorm.DB {
+		return db.Order("user_id")
+	}).Where("transaction_id IN ?", ids).Order("id").Find(&dbItems).Error; err != nil {
+		return err
+	}
+	for _, item := range extractTransactionItemSlice(dbItems) {
+		tx := byID[item.TransactionID]
+		tx.Items = append(tx.Items, item)
+	}
+
+	var dbCharges []TransactionCharge
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id IN ?", ids).Order("id").Find(&dbCharges).Error; err != nil {
+		return err
+	}
+	for _, charge := range extractTransactionChargeSlice(dbCharges) {
+		tx := byID[charge.TransactionID]
+		tx.Charges = append(tx.Charges, charge)
+	}
+
+	var dbDebts []Debt
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id IN ?", ids).Order("id").Find(&dbDebts).Error; err != nil {
+		return err
+	}
+	for _, debt := range extractDebtSlice(dbDebts) {
+		tx := byID[debt.TransactionID]
+		tx.Debts = append(tx.Debts, debt)
+	}
+
+	var dbAttachments []TransactionAttachment
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id IN ?", ids).Order("id").Find(&dbAttachments).Error; err != nil {
+		return err
+	}
+	for _, attachment := range extractAttachmentSlice(dbAttachments) {
+		tx := byID[attachment.TransactionID]
+		tx.Attachments = append(tx.Attachments, attachment)
+	}
+
+	return nil
+}
+
 // GetSharesByTransactionID возвращает доли пользователей в транзакции
-func (r *TransactionRepository) GetSharesByTransactionID(transactionID int) ([]models.TransactionShare, error) {
+func (r *TransactionRepository) GetSharesByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionShare, error) {
 	var dbShares []TransactionShare
-	if err := r.db.Where("transaction_id = ?", transactionID).Find(&dbShares).Error; err != nil {
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id = ?", transactionID).Find(&dbShares).Error; err != nil {
 		return nil, err
 	}
 	// Преобразуем в бизнес-модели
@@ -105,20 +421,134 @@ func (r *TransactionRepository) GetSharesByTransactionID(transactionID int) ([]m
 	return shares, nil
 }
 
+// GetPayersByTransactionID возвращает плательщиков транзакции
+func (r *TransactionRepository) GetPayersByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionPayer, error) {
+	var dbPayers []TransactionPayer
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id = ?", transactionID).Order("id").Find(&dbPayers).Error; err != nil {
+		return nil, err
+	}
+	return extractTransactionPayerSlice(dbPayers), nil
+}
+
+// GetItemsByTransactionID возвращает позиции чека транзакции вместе с потребителями
+func (r *TransactionRepository) GetItemsByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionItem, error) {
+	var dbItems []TransactionItem
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Preload("Consumers", func(db *gorm.DB) *gorm.DB {
+		return db.Order("user_id")
+	}).Where("transaction_id = ?", transactionID).Order("id").Find(&dbItems).Error; err != nil {
+		return nil, err
+	}
+	return extractTransactionItemSlice(dbItems), nil
+}
+
+// GetItemByID возвращает позицию чека по ID
+func (r *TransactionRepository) GetItemByID(ctx context.Context, id int) (*models.TransactionItem, error) {
+	var dbItem TransactionItem
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Preload("Consumers").First(&dbItem, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("позиция чека не найдена")
+		}
+		return nil, err
+	}
+	return extractTransactionItem(&dbItem), nil
+}
+
+// CreateTransactionItem создает позицию чека вместе с потребителями
+func (r *TransactionRepository) CreateTransactionItem(ctx context.Context, item *models.TransactionItem) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dbItem := loadTransactionItem(item)
+		if err := tx.Omit("Consumers").Create(dbItem).Error; err != nil {
+			return err
+		}
+		item.ID = dbItem.ID
+
+		if len(item.Consumers) == 0 {
+			return nil
+		}
+		dbConsumers := loadTransactionItemConsumers(item.ID, item.Consumers)
+		return tx.Create(&dbConsumers).Error
+	})
+}
+
+// UpdateTransactionItem обновляет позицию чека и заменяет список потребителей
+func (r *TransactionRepository) UpdateTransactionItem(ctx context.Context, item *models.TransactionItem) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit("Consumers").Save(loadTransactionItem(item))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("позиция чека не найдена")
+		}
+
+		if err := tx.Where("item_id = ?", item.ID).Delete(&TransactionItemConsumer{}).Error; err != nil {
+			return err
+		}
+		if len(item.Consumers) == 0 {
+			return nil
+		}
+		dbConsumers := loadTransactionItemConsumers(item.ID, item.Consumers)
+		return tx.Create(&dbConsumers).Error
+	})
+}
+
+// DeleteTransactionItem удаляет позицию чека
+func (r *TransactionRepository) DeleteTransactionItem(ctx context.Context, id int) error {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).Delete(&TransactionItem{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("позиция чека не найдена")
+	}
+	return nil
+}
+
+// DeleteItemsByTransactionID удаляет все позиции чека транзакции
+func (r *TransactionRepository) DeleteItemsByTransactionID(ctx context.Context, transactionID int) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id = ?", transactionID).Delete(&TransactionItem{}).Error
+}
+
+// GetChargesByTransactionID возвращает общие надбавки чека транзакции
+func (r *TransactionRepository) GetChargesByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionCharge, error) {
+	var dbCharges []TransactionCharge
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id = ?", transactionID).Order("id").Find(&dbCharges).Error; err != nil {
+		return nil, err
+	}
+	return extractTransactionChargeSlice(dbCharges), nil
+}
+
+// CreateTransactionCharges создает общие надбавки чека
+func (r *TransactionRepository) CreateTransactionCharges(ctx context.Context, charges []models.TransactionCharge) error {
+	if len(charges) == 0 {
+		return nil // Нет надбавок для создания
+	}
+	dbCharges := make([]TransactionCharge, len(charges))
+	for i, charge := range charges {
+		dbCharges[i] = *loadTransactionCharge(&charge)
+	}
+	return db.GetTx(ctx, r.db).WithContext(ctx).Create(&dbCharges).Error
+}
+
+// DeleteChargesByTransactionID удаляет все надбавки чека транзакции
+func (r *TransactionRepository) DeleteChargesByTransactionID(ctx context.Context, transactionID int) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id = ?", transactionID).Delete(&TransactionCharge{}).Error
+}
+
 // GetDebtsByTransactionID возвращает долги в рамках транзакции
-func (r *TransactionRepository) GetDebtsByTransactionID(transactionID int) ([]models.Debt, error) {
+func (r *TransactionRepository) GetDebtsByTransactionID(ctx context.Context, transactionID int) ([]models.Debt, error) {
 	var dbDebts []Debt
-	if err := r.db.Where("transaction_id = ?", transactionID).Find(&dbDebts).Error; err != nil {
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id = ?", transactionID).Find(&dbDebts).Error; err != nil {
 		return nil, err
 	}
 	return extractDebtSlice(dbDebts), nil
 }
 
 // GetDebtsByEventID возвращает долги в рамках мероприятия
-func (r *TransactionRepository) GetDebtsByEventID(eventID int64) ([]models.Debt, error) {
+func (r *TransactionRepository) GetDebtsByEventID(ctx context.Context, eventID int64) ([]models.Debt, error) {
 	var dbDebts []Debt
-	if err := r.db.Joins("JOIN transactions ON transactions.id = debts.transaction_id").
-		Where("transactions.event_id = ?", eventID).
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Joins("JOIN transactions ON transactions.id = debts.transaction_id").
+		Where("transactions.event_id = ? AND transactions.deleted_at IS NULL", eventID).
 		Find(&dbDebts).Error; err != nil {
 		return nil, err
 	}
@@ -126,10 +556,10 @@ func (r *TransactionRepository) GetDebtsByEventID(eventID int64) ([]models.Debt,
 }
 
 // GetDebtsByEventIDToUser возвращает долги в рамках мероприятия для конкретного пользователя
-func (r *TransactionRepository) GetDebtsByEventIDToUser(eventID int64, userID int64) ([]models.Debt, error) {
+func (r *TransactionRepository) GetDebtsByEventIDToUser(ctx context.Context, eventID int64, userID int64) ([]models.Debt, error) {
 	var dbDebts []Debt
-	if err := r.db.Joins("JOIN transactions ON transactions.id = debts.transaction_id").
-		Where("transactions.event_id = ? AND debts.to_user_id = ?", eventID, userID).
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Joins("JOIN transactions ON transactions.id = debts.transaction_id").
+		Where("transactions.event_id = ? AND transactions.deleted_at IS NULL AND debts.to_user_id = ?", eventID, userID).
 		Find(&dbDebts).Error; err != nil {
 		return nil, err
 	}
@@ -137,10 +567,10 @@ func (r *TransactionRepository) GetDebtsByEventIDToUser(eventID int64, userID in
 }
 
 // GetDebtsByEventIDFromUser возвращает долги в рамках мероприятия для конкретного пользователя
-func (r *TransactionRepository) GetDebtsByEventIDFromUser(eventID int64, userID int64) ([]models.Debt, error) {
+func (r *TransactionRepository) GetDebtsByEventIDFromUser(ctx context.Context, eventID int64, userID int64) ([]models.Debt, error) {
 	var dbDebts []Debt
-	if err := r.db.Joins("JOIN transactions ON transactions.id = debts.transaction_id").
-		Where("transactions.event_id = ? AND debts.from_user_id = ?", eventID, userID).
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Joins("JOIN transactions ON transactions.id = debts.transaction_id").
+		Where("transactions.event_id = ? AND transactions.deleted_at IS NULL AND debts.from_user_id = ?", eventID, userID).
 		Find(&dbDebts).Error; err != nil {
 		return nil, err
 	}
@@ -148,7 +578,7 @@ func (r *TransactionRepository) GetDebtsByEventIDFromUser(eventID int64, userID
 }
 
 // CreateTransactionShares создает доли пользователей в транзакции
-func (r *TransactionRepository) CreateTransactionShares(shares []models.TransactionShare) error {
+func (r *TransactionRepository) CreateTransactionShares(ctx context.Context, shares []models.TransactionShare) error {
 	if len(shares) == 0 {
 		return nil // Нет долей для создания
 	}
@@ -156,70 +586,152 @@ func (r *TransactionRepository) CreateTransactionShares(shares []models.Transact
 	for i, share := range shares {
 		dbShares[i] = *loadTransactionShare(&share)
 	}
-	return r.db.Create(&dbShares).Error
+	return db.GetTx(ctx, r.db).WithContext(ctx).Create(&dbShares).Error
+}
+
+// CreateTransactionPayers сохраняет суммы, оплаченные пользователями в транзакции
+func (r *TransactionRepository) CreateTransactionPayers(ctx context.Context, payers []models.TransactionPayer) error {
+	if len(payers) == 0 {
+		return nil // Нет плательщиков для создания
+	}
+	dbPayers := make([]TransactionPayer, len(payers))
+	for i, payer := range payers {
+		dbPayers[i] = *loadTransactionPayer(&payer)
+	}
+	return db.GetTx(ctx, r.db).WithContext(ctx).Create(&dbPayers).Error
 }
 
 // CreateDebts создает долги между пользователями
-func (r *TransactionRepository) CreateDebts(debts []models.Debt) error {
+func (r *TransactionRepository) CreateDebts(ctx context.Context, debts []models.Debt) error {
 	if len(debts) == 0 {
 		return nil // Нет долгов для создания
 	}
 	dbDebts := make([]Debt, len(debts))
+	transactionIDs := make([]int, 0, len(debts))
 	for i, debt := range debts {
 		dbDebts[i] = *loadDebt(&debt)
+		transactionIDs = append(transactionIDs, debt.TransactionID)
 	}
-	return r.db.Create(&dbDebts).Error
+	return db.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&dbDebts).Error; err != nil {
+			return err
+		}
+		return markOutdatedByTransactions(tx, transactionIDs)
+	})
 }
 
 // DeleteSharesByTransactionID удаляет все доли в транзакции
-func (r *TransactionRepository) DeleteSharesByTransactionID(transactionID int) error {
-	return r.db.Where("transaction_id = ?", transactionID).Delete(&TransactionShare{}).Error
+func (r *TransactionRepository) DeleteSharesByTransactionID(ctx context.Context, transactionID int) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id = ?", transactionID).Delete(&TransactionShare{}).Error
+}
+
+// DeletePayersByTransactionID удаляет всех плательщиков транзакции
+func (r *TransactionRepository) DeletePayersByTransactionID(ctx context.Context, transactionID int) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Where("transaction_id = ?", transactionID).Delete(&TransactionPayer{}).Error
 }
 
 // DeleteDebtsByTransactionID удаляет все долги в транзакции
-func (r *TransactionRepository) DeleteDebtsByTransactionID(transactionID int) error {
-	return r.db.Where("transaction_id = ?", transactionID).Delete(&Debt{}).Error
+func (r *TransactionRepository) DeleteDebtsByTransactionID(ctx context.Context, transactionID int) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("transaction_id = ?", transactionID).Delete(&Debt{}).Error; err != nil {
+			return err
+		}
+		return markOutdatedByTransactions(tx, []int{transactionID})
+	})
 }
 
 // GetOptimizedDebtsByEventID возвращает оптимизированные долги по ID мероприятия
-func (r *TransactionRepository) GetOptimizedDebtsByEventID(eventID int64) ([]models.OptimizedDebt, error) {
+func (r *TransactionRepository) GetOptimizedDebtsByEventID(ctx context.Context, eventID int64) ([]models.OptimizedDebt, error) {
 	var dbDebts []OptimizedDebt
-	if err := r.db.Where("event_id = ?", eventID).Find(&dbDebts).Error; err != nil {
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("event_id = ?", eventID).Find(&dbDebts).Error; err != nil {
 		return nil, err
 	}
 	return extractOptimizedDebtSlice(dbDebts), nil
 }
 
 // GetOptimizedDebtsByEventIDWithUsers возвращает оптимизированные долги по ID мероприятия с загрузкой пользователей
-func (r *TransactionRepository) GetOptimizedDebtsByEventIDWithUsers(eventID int64) ([]models.OptimizedDebt, error) {
+func (r *TransactionRepository) GetOptimizedDebtsByEventIDWithUsers(ctx context.Context, eventID int64) ([]models.OptimizedDebt, error) {
 	var dbDebts []OptimizedDebt
-	if err := r.db.Where("event_id = ?", eventID).Find(&dbDebts).Error; err != nil {
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("event_id = ?", eventID).Find(&dbDebts).Error; err != nil {
 		return nil, err
 	}
 	return extractOptimizedDebtSlice(dbDebts), nil
 }
 
 // GetOptimizedDebtsByUserID возвращает оптимизированные долги по ID пользователя в мероприятии
-func (r *TransactionRepository) GetOptimizedDebtsByUserID(eventID, userID int64) ([]models.OptimizedDebt, error) {
+func (r *TransactionRepository) GetOptimizedDebtsByUserID(ctx context.Context, eventID, userID int64) ([]models.OptimizedDebt, error) {
 	var dbDebts []OptimizedDebt
-	if err := r.db.Where("event_id = ? AND (from_user_id = ? OR to_user_id = ?)", eventID, userID, userID).Find(&dbDebts).Error; err != nil {
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("event_id = ? AND (from_user_id = ? OR to_user_id = ?)", eventID, userID, userID).Find(&dbDebts).Error; err != nil {
 		return nil, err
 	}
 	return extractOptimizedDebtSlice(dbDebts), nil
 }
 
 // GetOptimizedDebtsByUserIDWithUsers возвращает оптимизированные долги по ID пользователя в мероприятии с загрузкой пользователей
-func (r *TransactionRepository) GetOptimizedDebtsByUserIDWithUsers(eventID, userID int64) ([]models.OptimizedDebt, error) {
+func (r *TransactionRepository) GetOptimizedDebtsByUserIDWithUsers(ctx context.Context, eventID, userID int64) ([]models.OptimizedDebt, error) {
 	var dbDebts []OptimizedDebt
-	if err := r.db.Where("event_id = ? AND (from_user_id = ? OR to_user_id = ?)", eventID, userID, userID).Find(&dbDebts).Error; err != nil {
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Where("event_id = ? AND (from_user_id = ? OR to_user_id = ?)", eventID, userID, userID).Find(&dbDebts).Error; err != nil {
 		return nil, err
 	}
 	return extractOptimizedDebtSlice(dbDebts), nil
 }
 
-// SaveOptimizedDebts сохраняет оптимизированные долги для мероприятия (удаляет старые и сохраняет новые)
-func (r *TransactionRepository) SaveOptimizedDebts(eventID int64, debts []models.OptimizedDebt) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
+// GetOptimizedDebtByID возвращает оптимизированный долг по ID
+func (r *TransactionRepository) GetOptimizedDebtByID(ctx context.Context, id int) (*models.OptimizedDebt, error) {
+	var dbDebt OptimizedDebt
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).First(&dbDebt, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, customErrors.NewEntityNotFoundError(strconv.Itoa(id), "optimized debt")
+		}
+		return nil, err
+	}
+	return extractOptimizedDebt(&dbDebt), nil
+}
+
+// UpdateOptimizedDebtSettlement обновляет погашенную сумму и статус оптимизированного долга
+func (r *TransactionRepository) UpdateOptimizedDebtSettlement(ctx context.Context, debt *models.OptimizedDebt) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Model(&OptimizedDebt{}).
+		Where("id = ?", debt.ID).
+		Updates(map[string]interface{}{
+			"settled_amount": debt.SettledAmount,
+			"status":         debt.Status,
+			"updated_at":     time.Now(),
+		}).Error
+}
+
+// GetDebtsVersion возвращает версию долгов мероприятия
+func (r *TransactionRepository) GetDebtsVersion(ctx context.Context, eventID int64) (int64, error) {
+	var versions []int64
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Table("events").
+		Where("id = ?", eventID).
+		Pluck("debts_version", &versions).Error; err != nil {
+		return 0, err
+	}
+	if len(versions) == 0 {
+		return 0, customErrors.NewEntityNotFoundError(strconv.FormatInt(eventID, 10), "event")
+	}
+	return versions[0], nil
+}
+
+// SaveOptimizedDebts сохраняет оптимизированные долги для мероприятия (удаляет старые и сохраняет новые).
+// Строка мероприятия блокируется до конца транзакции: параллельные сохранения плана выполняются
+// по очереди, а план, построенный по устаревшей версии долгов, не сохраняется
+func (r *TransactionRepository) SaveOptimizedDebts(ctx context.Context, eventID int64, version int64, debts []models.OptimizedDebt) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var versions []int64
+		if err := tx.Table("events").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", eventID).
+			Pluck("debts_version", &versions).Error; err != nil {
+			return err
+		}
+		if len(versions) == 0 {
+			return customErrors.NewEntityNotFoundError(strconv.FormatInt(eventID, 10), "event")
+		}
+		if versions[0] != version {
+			return repository.ErrDebtsChanged
+		}
+
 		// Удаляем старые оптимизированные долги
 		if err := tx.Where("event_id = ?", eventID).Delete(&OptimizedDebt{}).Error; err != nil {
 			return err
@@ -236,13 +748,53 @@ func (r *TransactionRepository) SaveOptimizedDebts(eventID int64, debts []models
 			}
 		}
 
-		return nil
+		// Сохраненный результат соответствует текущим долгам
+		return tx.Table("events").
+			Where("id = ?", eventID).
+			Update("optimized_debts_outdated", false).Error
 	})
 }
 
+// IsOptimizedDebtsOutdated проверяет, изменились ли долги мероприятия после последней оптимизации
+func (r *TransactionRepository) IsOptimizedDebtsOutdated(ctx context.Context, eventID int64) (bool, error) {
+	var outdated []bool
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Table("events").
+		Where("id = ?", eventID).
+		Pluck("optimized_debts_outdated", &outdated).Error; err != nil {
+		return false, err
+	}
+	if len(outdated) == 0 {
+		return false, customErrors.NewEntityNotFoundError(strconv.FormatInt(eventID, 10), "event")
+	}
+	return outdated[0], nil
+}
+
+// MarkOptimizedDebtsOutdated помечает оптимизированные долги мероприятия устаревшими
+func (r *TransactionRepository) MarkOptimizedDebtsOutdated(ctx context.Context, eventID int64) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Table("events").
+		Where("id = ?", eventID).
+		Updates(outdatedDebtsColumns()).Error
+}
+
+// markOutdatedByTransactions помечает устаревшими оптимизированные долги мероприятий указанных транзакций
+func markOutdatedByTransactions(tx *gorm.DB, transactionIDs []int) error {
+	return tx.Table("events").
+		Where("id IN (?)", tx.Table("transactions").Select("event_id").Where("id IN ?", transactionIDs)).
+		Updates(outdatedDebtsColumns()).Error
+}
+
+// outdatedDebtsColumns возвращает изменения мероприятия при изменении его долгов:
+// план переводов устаревает, а версия долгов увеличивается
+func outdatedDebtsColumns() map[string]interface{} {
+	return map[string]interface{}{
+		"optimized_debts_outdated": true,
+		"debts_version":            gorm.Expr("debts_version + 1"),
+	}
+}
+
 // DeleteOptimizedDebtsByEventID удаляет оптимизированные долги по ID мероприятия
-func (r *TransactionRepository) DeleteOptimizedDebtsByEventID(eventID int64) error {
-	result := r.db.Where("event_id = ?", eventID).Delete(&OptimizedDebt{})
+func (r *TransactionRepository) DeleteOptimizedDebtsByEventID(ctx context.Context, eventID int64) error {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).Where("event_id = ?", eventID).Delete(&OptimizedDebt{})
 	if result.Error != nil {
 		return result.Error
 	}
@@ -56,6 +56,7 @@ func extractUserEvent(dbUserEvent *UserEvent) *models.UserEvent {
 	return &models.UserEvent{
 		UserID:  dbUserEvent.UserID,
 		EventID: dbUserEvent.EventID,
+		Role:    models.EventRole(dbUserEvent.Role),
 	}
 }
 
@@ -68,5 +69,6 @@ func loadUserEvent(userEvent *models.UserEvent) *UserEvent {
 	return &UserEvent{
 		UserID:  userEvent.UserID,
 		EventID: userEvent.EventID,
+		Role:    string(userEvent.Role),
 	}
 }
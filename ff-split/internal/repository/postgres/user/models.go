@@ -17,8 +17,9 @@ func (User) TableName() string {
 
 // UserEvent представляет связь между пользователем и мероприятием в БД
 type UserEvent struct {
-	UserID  int64 `gorm:"column:user_id;primaryKey"`
-	EventID int64 `gorm:"column:event_id;primaryKey"`
+	UserID  int64  `gorm:"column:user_id;primaryKey"`
+	EventID int64  `gorm:"column:event_id;primaryKey"`
+	Role    string `gorm:"column:role;default:member"`
 }
 
 // TableName задает имя таблицы для модели UserEvent
@@ -188,6 +188,15 @@ func (r *UserRepository) AddUserToEvent(ctx context.Context, userID, eventID int
 	return nil
 }
 
+// AddEventMember добавляет пользователя в мероприятие с ролью
+func (r *UserRepository) AddEventMember(ctx context.Context, userID, eventID int64, role models.EventRole) error {
+	entry := UserEvent{UserID: userID, EventID: eventID, Role: string(role)}
+	if err := db.GetTx(ctx, r.db).WithContext(ctx).Create(&entry).Error; err != nil {
+		return fmt.Errorf("ошибка при добавлении участника в мероприятие: %w", err)
+	}
+	return nil
+}
+
 // AddUsersToEvent добавляет пользователя в мероприятие
 func (r *UserRepository) AddUsersToEvent(ctx context.Context, ids []int64, eventID int64) error {
 	if len(ids) == 0 {
@@ -196,7 +205,7 @@ func (r *UserRepository) AddUsersToEvent(ctx context.Context, ids []int64, event
 
 	var entries []UserEvent
 	for _, id := range ids {
-		entries = append(entries, UserEvent{UserID: id, EventID: eventID})
+		entries = append(entries, UserEvent{UserID: id, EventID: eventID, Role: string(models.EventRoleMember)})
 	}
 
 	err := db.GetTx(ctx, r.db).WithContext(ctx).
@@ -219,3 +228,190 @@ func (r *UserRepository) RemoveUserFromEvent(ctx context.Context, userID, eventI
 	}
 	return nil
 }
+
+// GetEventMember возвращает участие пользователя в мероприятии или nil, если пользователь не участник
+func (r *UserRepository) GetEventMember(ctx context.Context, userID, eventID int64) (*models.UserEvent, error) {
+	var dbUserEvents []UserEvent
+	err := db.GetTx(ctx, r.db).WithContext(ctx).
+		Where("user_id = ? AND event_id = ?", userID, eventID).
+		Limit(1).
+		Find(&dbUserEvents).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении участника мероприятия: %w", err)
+	}
+	if len(dbUserEvents) == 0 {
+		return nil, nil
+	}
+	return extractUserEvent(&dbUserEvents[0]), nil
+}
+
+// GetEventMembers возвращает всех участников мероприятия с ролями
+func (r *UserRepository) GetEventMembers(ctx context.Context, eventID int64) ([]models.UserEvent, error) {
+	var dbUserEvents []UserEvent
+	err := r.db.WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("user_id").
+		Find(&dbUserEvents).Error
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении участников мероприятия: %w", err)
+	}
+	members := make([]models.UserEvent, len(dbUserEvents))
+	for i := range dbUserEvents {
+		members[i] = *extractUserEvent(&dbUserEvents[i])
+	}
+	return members, nil
+}
+
+// SetEventRole меняет роль участника мероприятия
+func (r *UserRepository) SetEventRole(ctx context.Context, userID, eventID int64, role models.EventRole) error {
+	result := db.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&UserEvent{}).
+		Where("user_id = ? AND event_id = ?", userID, eventID).
+		Update("role", string(role))
+	if result.Error != nil {
+		return fmt.Errorf("ошибка при изменении роли участника: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return customErrors.NewEntityNotFoundError(strconv.FormatInt(userID, 10), "event member")
+	}
+	return nil
+}
+
+// TransferEventOwnership делает участника владельцем мероприятия.
+// Прежний владелец, если он был, становится администратором.
+func (r *UserRepository) TransferEventOwnership(ctx context.Context, eventID, newOwnerID int64) error {
+	return db.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Сначала снимаем прежнего владельца, иначе сработает уникальный индекс uniq_user_event_owner
+		err := tx.Model(&UserEvent{}).
+			Where("event_id = ? AND role = ?", eventID, string(models.EventRoleOwner)).
+			Update("role", string(models.EventRoleAdmin)).Error
+		if err != nil {
+			return fmt.Errorf("ошибка при снятии прежнего владельца: %w", err)
+		}
+
+		result := tx.Model(&UserEvent{}).
+			Where("user_id = ? AND event_id = ?", newOwnerID, eventID).
+			Update("role", string(models.EventRoleOwner))
+		if result.Error != nil {
+			return fmt.Errorf("ошибка при назначении владельца: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return customErrors.NewEntityNotFoundError(strconv.FormatInt(newOwnerID, 10), "event member")
+		}
+		return nil
+	})
+}
+
+// mergeStatements - шаги переноса данных пользователя @from на пользователя @to.
+// Записи, которые после переноса нарушили бы уникальные ограничения (uniq_tx_user, uniq_tx_payer,
+// uniq_debt, uniq_item_consumer, первичный ключ user_event), сливаются с записями @to:
+// суммы складываются, дубликаты удаляются. Долги и погашения между @from и @to
+// после слияния становятся долгами пользователя самому себе и удаляются.
+var mergeStatements = []struct {
+	name  string
+	query string
+}{
+	{"доли транзакций", `
+		UPDATE transaction_shares t SET value = t.value + d.value
+		FROM transaction_shares d
+		WHERE d.user_id = @from AND t.user_id = @to AND t.transaction_id = d.transaction_id`},
+	{"доли транзакций", `
+		DELETE FROM transaction_shares d
+		WHERE d.user_id = @from AND EXISTS (
+			SELECT 1 FROM transaction_shares t WHERE t.user_id = @to AND t.transaction_id = d.transaction_id)`},
+	{"доли транзакций", `UPDATE transaction_shares SET user_id = @to WHERE user_id = @from`},
+
+	{"плательщики транзакций", `
+		UPDATE transaction_payers t SET amount = t.amount + d.amount
+		FROM transaction_payers d
+		WHERE d.user_id = @from AND t.user_id = @to AND t.transaction_id = d.transaction_id`},
+	{"плательщики транзакций", `
+		DELETE FROM transaction_payers d
+		WHERE d.user_id = @from AND EXISTS (
+			SELECT 1 FROM transaction_payers t WHERE t.user_id = @to AND t.transaction_id = d.transaction_id)`},
+	{"плательщики транзакций", `UPDATE transaction_payers SET user_id = @to WHERE user_id = @from`},
+	{"плательщики транзакций", `UPDATE transactions SET payer_id = @to WHERE payer_id = @from`},
+
+	{"потребители позиций", `
+		DELETE FROM transaction_item_consumers d
+		WHERE d.user_id = @from AND EXISTS (
+			SELECT 1 FROM transaction_item_consumers t WHERE t.user_id = @to AND t.item_id = d.item_id)`},
+	{"потребители позиций", `UPDATE transaction_item_consumers SET user_id = @to WHERE user_id = @from`},
+
+	// Перенос долгов меняет баланс мероприятий пользователя: планы переводов устаревают.
+	// Мероприятия собираются до переноса, пока долги еще ссылаются на fromUserID
+	{"планы переводов", `
+		UPDATE events SET optimized_debts_outdated = true, debts_version = debts_version + 1
+		WHERE id IN (
+			SELECT t.event_id FROM debts d JOIN transactions t ON t.id = d.transaction_id
+			WHERE d.from_user_id = @from OR d.to_user_id = @from
+			UNION
+			SELECT event_id FROM settlements WHERE from_user_id = @from OR to_user_id = @from
+			UNION
+			SELECT event_id FROM optimized_debts WHERE from_user_id = @from OR to_user_id = @from)`},
+
+	{"долги", `
+		DELETE FROM debts
+		WHERE (from_user_id = @from AND to_user_id = @to) OR (from_user_id = @to AND to_user_id = @from)`},
+	{"долги", `
+		UPDATE debts t SET amount = t.amount + d.amount
+		FROM debts d
+		WHERE d.from_user_id = @from AND t.from_user_id = @to
+		  AND t.transaction_id = d.transaction_id AND t.to_user_id = d.to_user_id`},
+	{"долги", `
+		DELETE FROM debts d
+		WHERE d.from_user_id = @from AND EXISTS (
+			SELECT 1 FROM debts t
+			WHERE t.from_user_id = @to AND t.transaction_id = d.transaction_id AND t.to_user_id = d.to_user_id)`},
+	{"долги", `UPDATE debts SET from_user_id = @to WHERE from_user_id = @from`},
+	{"долги", `
+		UPDATE debts t SET amount = t.amount + d.amount
+		FROM debts d
+		WHERE d.to_user_id = @from AND t.to_user_id = @to
+		  AND t.transaction_id = d.transaction_id AND t.from_user_id = d.from_user_id`},
+	{"долги", `
+		DELETE FROM debts d
+		WHERE d.to_user_id = @from AND EXISTS (
+			SELECT 1 FROM debts t
+			WHERE t.to_user_id = @to AND t.transaction_id = d.transaction_id AND t.from_user_id = d.from_user_id)`},
+	{"долги", `UPDATE debts SET to_user_id = @to WHERE to_user_id = @from`},
+
+	{"оптимизированные долги", `
+		DELETE FROM optimized_debts
+		WHERE (from_user_id = @from AND to_user_id = @to) OR (from_user_id = @to AND to_user_id = @from)`},
+	{"оптимизированные долги", `UPDATE optimized_debts SET from_user_id = @to WHERE from_user_id = @from`},
+	{"оптимизированные долги", `UPDATE optimized_debts SET to_user_id = @to WHERE to_user_id = @from`},
+
+	{"погашения", `
+		DELETE FROM settlements
+		WHERE (from_user_id = @from AND to_user_id = @to) OR (from_user_id = @to AND to_user_id = @from)`},
+	{"погашения", `UPDATE settlements SET from_user_id = @to WHERE from_user_id = @from`},
+	{"погашения", `UPDATE settlements SET to_user_id = @to WHERE to_user_id = @from`},
+
+	{"задачи", `UPDATE tasks SET user_id = @to WHERE user_id = @from`},
+	{"активности", `UPDATE activities SET user_id = @to WHERE user_id = @from`},
+	// Участники в теле шаблона переносятся сервисом регулярных транзакций до объединения
+	{"шаблоны регулярных транзакций", `UPDATE recurring_transactions SET created_by = @to WHERE created_by = @from`},
+
+	{"участие в мероприятиях", `
+		DELETE FROM user_event d
+		WHERE d.user_id = @from AND EXISTS (
+			SELECT 1 FROM user_event t WHERE t.user_id = @to AND t.event_id = d.event_id)`},
+	{"участие в мероприятиях", `UPDATE user_event SET user_id = @to WHERE user_id = @from`},
+
+	{"пользователь", `DELETE FROM users WHERE id = @from`},
+}
+
+// MergeUsers переносит все данные пользователя fromUserID на пользователя toUserID
+// и удаляет fromUserID. Все шаги выполняются в одной транзакции.
+func (r *UserRepository) MergeUsers(ctx context.Context, fromUserID, toUserID int64) error {
+	params := map[string]interface{}{"from": fromUserID, "to": toUserID}
+	return db.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, stmt := range mergeStatements {
+			if err := tx.Exec(stmt.query, params).Error; err != nil {
+				return fmt.Errorf("ошибка при переносе данных пользователя (%s): %w", stmt.name, err)
+			}
+		}
+		return nil
+	})
+}
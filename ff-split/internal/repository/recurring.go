@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// Recurring определяет методы для работы с шаблонами регулярных транзакций
+type Recurring interface {
+	// Create создает шаблон регулярной транзакции
+	Create(ctx context.Context, recurring *models.RecurringTransaction) error
+
+	// GetByID возвращает шаблон по ID или nil, если шаблон не найден
+	GetByID(ctx context.Context, id int) (*models.RecurringTransaction, error)
+
+	// GetByEventID возвращает шаблоны мероприятия
+	GetByEventID(ctx context.Context, eventID int64) ([]models.RecurringTransaction, error)
+
+	// GetByParticipant возвращает шаблоны мероприятий, в которых участвует пользователь
+	GetByParticipant(ctx context.Context, userID int64) ([]models.RecurringTransaction, error)
+
+	// GetDue возвращает не более limit активных шаблонов, следующий повтор которых наступил к моменту now
+	GetDue(ctx context.Context, now time.Time, limit int) ([]models.RecurringTransaction, error)
+
+	// Update сохраняет расписание, тело запроса и состояние шаблона
+	Update(ctx context.Context, recurring *models.RecurringTransaction) error
+
+	// Delete удаляет шаблон вместе с историей повторов
+	Delete(ctx context.Context, id int) error
+
+	// Advance переводит активный шаблон с повтора fromIndex на повтор nextIndex.
+	// Возвращает false, если шаблон уже продвинут другим обработчиком, изменен или приостановлен.
+	Advance(ctx context.Context, id, fromIndex, nextIndex int, nextRunAt *time.Time) (bool, error)
+
+	// CreateOccurrence записывает повтор. Возвращает false, если повтор на это время уже записан.
+	CreateOccurrence(ctx context.Context, occurrence *models.RecurringOccurrence) (bool, error)
+
+	// SetOccurrenceResult сохраняет созданную транзакцию или ошибку создания повтора
+	SetOccurrenceResult(ctx context.Context, id int, transactionID *int, errMsg *string) error
+}
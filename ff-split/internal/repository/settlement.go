@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// Settlement определяет методы для работы с погашениями долгов
+type Settlement interface {
+	GetSettlementsByEventID(ctx context.Context, eventID int64) ([]models.Settlement, error)
+	GetSettlementByID(ctx context.Context, id int) (*models.Settlement, error)
+	CreateSettlement(ctx context.Context, settlement *models.Settlement) error
+	DeleteSettlement(ctx context.Context, id int) error
+}
@@ -1,39 +1,90 @@
 package repository
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 )
 
+// ErrDebtsChanged - долги мероприятия изменились, пока строился план переводов
+var ErrDebtsChanged = errors.New("долги мероприятия изменились во время оптимизации")
+
 // Transaction определяет методы для работы с транзакциями
 type Transaction interface {
 	// Получение транзакций
-	GetTransactionsByEventID(eventID int64) ([]models.Transaction, error)
-	GetTransactionByID(id int) (*models.Transaction, error)
+	GetTransactionsByEventID(ctx context.Context, eventID int64) ([]models.Transaction, error)
+	GetTransactionsPage(ctx context.Context, eventID int64, filter *models.TransactionFilter) ([]models.Transaction, error)
+	LoadTransactionDetails(ctx context.Context, transactions []models.Transaction) error
+	GetTransactionByID(ctx context.Context, id int) (*models.Transaction, error)
 
 	// Управление транзакциями
-	CreateTransaction(tx *models.Transaction) error
-	UpdateTransaction(tx *models.Transaction) error
-	DeleteTransaction(id int) error
+	CreateTransaction(ctx context.Context, tx *models.Transaction) error
+	UpdateTransaction(ctx context.Context, tx *models.Transaction) error
+	DeleteTransaction(ctx context.Context, id int) error
+
+	// Корзина: удаленные транзакции хранятся до окончательной очистки
+	GetDeletedTransactionsByEventID(ctx context.Context, eventID int64) ([]models.Transaction, error)
+	RestoreTransaction(ctx context.Context, eventID int64, id int) error
+	PurgeDeletedTransactions(ctx context.Context, before time.Time) (int64, error)
+
+	// История изменений: ревизии только добавляются и не изменяются
+	CreateRevision(ctx context.Context, revision *models.TransactionRevision) error
+	GetRevisionsByTransactionID(ctx context.Context, eventID int64, transactionID int) ([]models.TransactionRevision, error)
+	GetRevisionByID(ctx context.Context, id int) (*models.TransactionRevision, error)
+	GetLatestRevision(ctx context.Context, transactionID int) (*models.TransactionRevision, error)
+
+	// Вложения: файлы хранятся в ff-files, в БД только ID объектов
+	GetAttachmentsByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionAttachment, error)
+	GetAttachmentByID(ctx context.Context, id int) (*models.TransactionAttachment, error)
+	GetExpiredAttachments(ctx context.Context, before time.Time) ([]models.TransactionAttachment, error)
+	CreateAttachment(ctx context.Context, attachment *models.TransactionAttachment) error
+	DeleteAttachment(ctx context.Context, id int) error
 
 	// Работа с долями транзакций
-	GetSharesByTransactionID(transactionID int) ([]models.TransactionShare, error)
-	CreateTransactionShares(shares []models.TransactionShare) error
-	DeleteSharesByTransactionID(transactionID int) error
+	GetSharesByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionShare, error)
+	CreateTransactionShares(ctx context.Context, shares []models.TransactionShare) error
+	DeleteSharesByTransactionID(ctx context.Context, transactionID int) error
+
+	// Работа с плательщиками транзакций
+	GetPayersByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionPayer, error)
+	CreateTransactionPayers(ctx context.Context, payers []models.TransactionPayer) error
+	DeletePayersByTransactionID(ctx context.Context, transactionID int) error
+
+	// Работа с позициями и надбавками чека
+	GetItemsByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionItem, error)
+	GetItemByID(ctx context.Context, id int) (*models.TransactionItem, error)
+	CreateTransactionItem(ctx context.Context, item *models.TransactionItem) error
+	UpdateTransactionItem(ctx context.Context, item *models.TransactionItem) error
+	DeleteTransactionItem(ctx context.Context, id int) error
+	DeleteItemsByTransactionID(ctx context.Context, transactionID int) error
+	GetChargesByTransactionID(ctx context.Context, transactionID int) ([]models.TransactionCharge, error)
+	CreateTransactionCharges(ctx context.Context, charges []models.TransactionCharge) error
+	DeleteChargesByTransactionID(ctx context.Context, transactionID int) error
 
 	// Работа с долгами
-	GetDebtsByTransactionID(transactionID int) ([]models.Debt, error)
-	GetDebtsByEventID(eventID int64) ([]models.Debt, error)
-	GetDebtsByEventIDFromUser(eventID int64, userID int64) ([]models.Debt, error)
-	GetDebtsByEventIDToUser(eventID int64, userID int64) ([]models.Debt, error)
-	CreateDebts(debts []models.Debt) error
-	DeleteDebtsByTransactionID(transactionID int) error
+	GetDebtsByTransactionID(ctx context.Context, transactionID int) ([]models.Debt, error)
+	GetDebtsByEventID(ctx context.Context, eventID int64) ([]models.Debt, error)
+	GetDebtsByEventIDFromUser(ctx context.Context, eventID int64, userID int64) ([]models.Debt, error)
+	GetDebtsByEventIDToUser(ctx context.Context, eventID int64, userID int64) ([]models.Debt, error)
+	CreateDebts(ctx context.Context, debts []models.Debt) error
+	DeleteDebtsByTransactionID(ctx context.Context, transactionID int) error
 
 	// Работа с оптимизированными долгами
-	GetOptimizedDebtsByEventID(eventID int64) ([]models.OptimizedDebt, error)
-	GetOptimizedDebtsByEventIDWithUsers(eventID int64) ([]models.OptimizedDebt, error)
-	GetOptimizedDebtsByUserID(eventID, userID int64) ([]models.OptimizedDebt, error)
-	GetOptimizedDebtsByUserIDWithUsers(eventID, userID int64) ([]models.OptimizedDebt, error)
-	SaveOptimizedDebts(eventID int64, debts []models.OptimizedDebt) error
-	DeleteOptimizedDebtsByEventID(eventID int64) error
+	GetOptimizedDebtsByEventID(ctx context.Context, eventID int64) ([]models.OptimizedDebt, error)
+	GetOptimizedDebtsByEventIDWithUsers(ctx context.Context, eventID int64) ([]models.OptimizedDebt, error)
+	GetOptimizedDebtsByUserID(ctx context.Context, eventID, userID int64) ([]models.OptimizedDebt, error)
+	GetOptimizedDebtsByUserIDWithUsers(ctx context.Context, eventID, userID int64) ([]models.OptimizedDebt, error)
+	GetOptimizedDebtByID(ctx context.Context, id int) (*models.OptimizedDebt, error)
+	UpdateOptimizedDebtSettlement(ctx context.Context, debt *models.OptimizedDebt) error
+	// GetDebtsVersion возвращает версию долгов мероприятия, ее нужно прочитать до самих долгов
+	GetDebtsVersion(ctx context.Context, eventID int64) (int64, error)
+	// SaveOptimizedDebts заменяет план переводов, если версия долгов мероприятия все еще равна version,
+	// иначе возвращает ErrDebtsChanged
+	SaveOptimizedDebts(ctx context.Context, eventID int64, version int64, debts []models.OptimizedDebt) error
+	IsOptimizedDebtsOutdated(ctx context.Context, eventID int64) (bool, error)
+	MarkOptimizedDebtsOutdated(ctx context.Context, eventID int64) error
+	DeleteOptimizedDebtsByEventID(ctx context.Context, eventID int64) error
 }
 
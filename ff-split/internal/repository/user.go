@@ -50,9 +50,27 @@ type User interface {
 	// AddUserToEvent добавляет пользователя в мероприятие
 	AddUserToEvent(ctx context.Context, userID, eventID int64) error
 
+	// AddEventMember добавляет пользователя в мероприятие с ролью
+	AddEventMember(ctx context.Context, userID, eventID int64, role models.EventRole) error
+
 	// AddUsersToEvent добавляет пользователей в мероприятие
 	AddUsersToEvent(ctx context.Context, ids []int64, eventID int64) error
 
 	// RemoveUserFromEvent удаляет пользователя из мероприятия
 	RemoveUserFromEvent(ctx context.Context, userID, eventID int64) error
+
+	// GetEventMember возвращает участие пользователя в мероприятии или nil, если пользователь не участник
+	GetEventMember(ctx context.Context, userID, eventID int64) (*models.UserEvent, error)
+
+	// GetEventMembers возвращает всех участников мероприятия с ролями
+	GetEventMembers(ctx context.Context, eventID int64) ([]models.UserEvent, error)
+
+	// SetEventRole меняет роль участника мероприятия
+	SetEventRole(ctx context.Context, userID, eventID int64, role models.EventRole) error
+
+	// TransferEventOwnership делает участника владельцем мероприятия, прежний владелец становится администратором
+	TransferEventOwnership(ctx context.Context, eventID, newOwnerID int64) error
+
+	// MergeUsers переносит все данные пользователя fromUserID на toUserID в одной транзакции и удаляет fromUserID
+	MergeUsers(ctx context.Context, fromUserID, toUserID int64) error
 }
@@ -0,0 +1,95 @@
+package access
+
+import (
+	"context"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// Permission - действие над мероприятием, разрешаемое ролью участника
+type Permission string
+
+const (
+	EditEvent        Permission = "edit_event"        // Изменение данных мероприятия
+	DeleteEvent      Permission = "delete_event"      // Удаление мероприятия
+	ReopenEvent      Permission = "reopen_event"      // Возобновление закрытого мероприятия
+	ManageMembers    Permission = "manage_members"    // Добавление и удаление участников
+	ManageRoles      Permission = "manage_roles"      // Смена ролей участников
+	EditTransactions Permission = "edit_transactions" // Ведение транзакций и погашений
+)
+
+// matrix - матрица прав ролей. Чтение данных мероприятия доступно любому участнику.
+var matrix = map[models.EventRole]map[Permission]bool{
+	models.EventRoleOwner: {
+		EditEvent:        true,
+		DeleteEvent:      true,
+		ReopenEvent:      true,
+		ManageMembers:    true,
+		ManageRoles:      true,
+		EditTransactions: true,
+	},
+	models.EventRoleAdmin: {
+		EditEvent:        true,
+		ManageMembers:    true,
+		EditTransactions: true,
+	},
+	models.EventRoleMember: {
+		EditTransactions: true,
+	},
+	models.EventRoleViewer: {},
+}
+
+// ranks - старшинство ролей: участник может удалять только тех, чья роль ниже
+var ranks = map[models.EventRole]int{
+	models.EventRoleViewer: 1,
+	models.EventRoleMember: 2,
+	models.EventRoleAdmin:  3,
+	models.EventRoleOwner:  4,
+}
+
+// IsValidRole проверяет, что роль известна
+func IsValidRole(role models.EventRole) bool {
+	_, ok := matrix[role]
+	return ok
+}
+
+// Can проверяет, разрешено ли роли действие
+func Can(role models.EventRole, perm Permission) bool {
+	return matrix[role][perm]
+}
+
+// Rank возвращает старшинство роли; для неизвестной роли - 0
+func Rank(role models.EventRole) int {
+	return ranks[role]
+}
+
+type memberKey struct{}
+
+// WithMember сохраняет в контексте участника мероприятия, от имени которого выполняется запрос
+func WithMember(ctx context.Context, member *models.UserEvent) context.Context {
+	return context.WithValue(ctx, memberKey{}, member)
+}
+
+// MemberFromContext возвращает участника мероприятия, от имени которого выполняется запрос.
+// Если участник не задан, вызов считается внутренним.
+func MemberFromContext(ctx context.Context) (*models.UserEvent, bool) {
+	member, ok := ctx.Value(memberKey{}).(*models.UserEvent)
+	return member, ok && member != nil
+}
+
+// Require проверяет, что участник из контекста может выполнить действие в мероприятии.
+// Внутренние вызовы без участника в контексте не ограничиваются.
+func Require(ctx context.Context, eventID int64, perm Permission) error {
+	member, ok := MemberFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if member.EventID != eventID {
+		return customErrors.NewForbiddenError("пользователь не является участником мероприятия")
+	}
+	if !Can(member.Role, perm) {
+		return customErrors.NewForbiddenError("недостаточно прав для выполнения действия")
+	}
+	return nil
+}
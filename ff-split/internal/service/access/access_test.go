@@ -0,0 +1,77 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCan(t *testing.T) {
+	tests := []struct {
+		role    models.EventRole
+		allowed []Permission
+	}{
+		{models.EventRoleOwner, []Permission{EditEvent, DeleteEvent, ReopenEvent, ManageMembers, ManageRoles, EditTransactions}},
+		{models.EventRoleAdmin, []Permission{EditEvent, ManageMembers, EditTransactions}},
+		{models.EventRoleMember, []Permission{EditTransactions}},
+		{models.EventRoleViewer, nil},
+		{models.EventRole("unknown"), nil},
+	}
+	all := []Permission{EditEvent, DeleteEvent, ReopenEvent, ManageMembers, ManageRoles, EditTransactions}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			for _, perm := range all {
+				assert.Equal(t, contains(tt.allowed, perm), Can(tt.role, perm), "право %s", perm)
+			}
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	assert.Greater(t, Rank(models.EventRoleOwner), Rank(models.EventRoleAdmin))
+	assert.Greater(t, Rank(models.EventRoleAdmin), Rank(models.EventRoleMember))
+	assert.Greater(t, Rank(models.EventRoleMember), Rank(models.EventRoleViewer))
+	assert.Equal(t, 0, Rank(models.EventRole("unknown")))
+}
+
+func TestRequire(t *testing.T) {
+	const eventID int64 = 1
+
+	t.Run("внутренний вызов без участника разрешен", func(t *testing.T) {
+		require.NoError(t, Require(context.Background(), eventID, DeleteEvent))
+	})
+
+	t.Run("роль с правом", func(t *testing.T) {
+		ctx := WithMember(context.Background(), &models.UserEvent{UserID: 1, EventID: eventID, Role: models.EventRoleAdmin})
+		require.NoError(t, Require(ctx, eventID, ManageMembers))
+	})
+
+	t.Run("роль без права", func(t *testing.T) {
+		ctx := WithMember(context.Background(), &models.UserEvent{UserID: 1, EventID: eventID, Role: models.EventRoleViewer})
+		err := Require(ctx, eventID, EditTransactions)
+		var forbidden *customErrors.ForbiddenError
+		require.True(t, errors.As(err, &forbidden))
+	})
+
+	t.Run("участник другого мероприятия", func(t *testing.T) {
+		ctx := WithMember(context.Background(), &models.UserEvent{UserID: 1, EventID: 2, Role: models.EventRoleOwner})
+		err := Require(ctx, eventID, EditTransactions)
+		var forbidden *customErrors.ForbiddenError
+		require.True(t, errors.As(err, &forbidden))
+	})
+}
+
+func contains(perms []Permission, perm Permission) bool {
+	for _, p := range perms {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
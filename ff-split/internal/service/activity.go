@@ -27,8 +27,33 @@ type ActivityListResponse struct {
 	Activities []ActivityResponse `json:"activities"`
 }
 
+// ActivityFeedRequest представляет фильтры и курсор ленты активности мероприятия
+type ActivityFeedRequest struct {
+	Types  []string
+	UserID *int64
+	From   *time.Time
+	To     *time.Time
+	Cursor string
+	Limit  int
+}
+
+// ActivityFeedDTO представляет страницу ленты активности.
+// NextCursor пуст, если страница последняя.
+type ActivityFeedDTO struct {
+	Activities []models.Activity
+	NextCursor *string
+}
+
+// ActivityRecorder записывает события мероприятия в ленту активности.
+// Автором события считается участник из контекста запроса (access.WithMember).
+type ActivityRecorder interface {
+	RecordActivity(ctx context.Context, eventID int64, activityType string, payload interface{}) error
+}
+
 // Activity определяет методы для работы с активностями
 type Activity interface {
+	ActivityRecorder
+	GetActivityFeed(ctx context.Context, eventID int64, request *ActivityFeedRequest) (*ActivityFeedDTO, error)
 	GetActivitiesByEventID(ctx context.Context, eventID int64) ([]models.Activity, error)
 	GetActivityByID(ctx context.Context, id int) (*models.Activity, error)
 	CreateActivity(ctx context.Context, activity *models.Activity) (*models.Activity, error)
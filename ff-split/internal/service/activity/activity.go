@@ -2,9 +2,21 @@ package activity
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+)
+
+const (
+	defaultFeedLimit = 50
+	maxFeedLimit     = 100
 )
 
 // ActivityService реализует интерфейс service.Activity
@@ -19,6 +31,18 @@ func NewActivityService(repo repository.Activity) *ActivityService {
 	}
 }
 
+// Record записывает активность через recorder после того, как действие уже выполнено,
+// поэтому ошибка записи только логируется и не отменяет действие.
+// Без recorder активность не записывается: так сервисы можно использовать без ленты активности.
+func Record(ctx context.Context, recorder service.ActivityRecorder, eventID int64, activityType string, payload interface{}) {
+	if recorder == nil {
+		return
+	}
+	if err := recorder.RecordActivity(ctx, eventID, activityType, payload); err != nil {
+		fmt.Printf("⛔️ Error recording activity: %v\n", err)
+	}
+}
+
 // GetActivitiesByEventID получает активности по ID мероприятия
 func (s *ActivityService) GetActivitiesByEventID(ctx context.Context, eventID int64) ([]models.Activity, error) {
 	return s.repo.GetByEventID(ctx, eventID)
@@ -31,6 +55,9 @@ func (s *ActivityService) GetActivityByID(ctx context.Context, id int) (*models.
 
 // CreateActivity создает новую активность
 func (s *ActivityService) CreateActivity(ctx context.Context, activity *models.Activity) (*models.Activity, error) {
+	if activity.Type == "" {
+		activity.Type = models.ActivityTypeCustom
+	}
 	return s.repo.Create(ctx, activity)
 }
 
@@ -43,3 +70,101 @@ func (s *ActivityService) UpdateActivity(ctx context.Context, id int, activity *
 func (s *ActivityService) DeleteActivity(ctx context.Context, id int) error {
 	return s.repo.Delete(ctx, id)
 }
+
+// RecordActivity записывает событие мероприятия в ленту активности.
+// Автор события - участник из контекста; для внутренних вызовов автор не указывается.
+func (s *ActivityService) RecordActivity(ctx context.Context, eventID int64, activityType string, payload interface{}) error {
+	activity := &models.Activity{
+		EventID: &eventID,
+		Type:    activityType,
+	}
+	if member, ok := access.MemberFromContext(ctx); ok {
+		userID := member.UserID
+		activity.UserID = &userID
+	}
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("ошибка при сериализации данных активности: %w", err)
+		}
+		activity.Payload = data
+	}
+
+	if _, err := s.repo.Create(ctx, activity); err != nil {
+		return fmt.Errorf("ошибка при записи активности %s: %w", activityType, err)
+	}
+	return nil
+}
+
+// GetActivityFeed возвращает страницу ленты активности мероприятия от новых активностей к старым
+func (s *ActivityService) GetActivityFeed(ctx context.Context, eventID int64, request *service.ActivityFeedRequest) (*service.ActivityFeedDTO, error) {
+	filter, err := buildFilter(request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Запрашиваем на одну активность больше, чтобы понять, есть ли следующая страница
+	limit := filter.Limit
+	filter.Limit++
+	activities, err := s.repo.GetFeed(ctx, eventID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &service.ActivityFeedDTO{Activities: activities}
+	if len(activities) > limit {
+		result.Activities = activities[:limit]
+		cursor := strconv.Itoa(result.Activities[limit-1].ID)
+		result.NextCursor = &cursor
+	}
+	return result, nil
+}
+
+// buildFilter проверяет параметры ленты и преобразует их в фильтр репозитория
+func buildFilter(req *service.ActivityFeedRequest) (*models.ActivityFilter, error) {
+	filter := &models.ActivityFilter{
+		UserID: req.UserID,
+		From:   req.From,
+		To:     req.To,
+		Limit:  req.Limit,
+	}
+
+	for _, activityType := range req.Types {
+		if !isKnownType(activityType) {
+			return nil, customErrors.NewValidationError("type",
+				fmt.Sprintf("неизвестный тип активности %q, доступны: %s", activityType, strings.Join(models.ActivityTypes, ", ")))
+		}
+		filter.Types = append(filter.Types, activityType)
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultFeedLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxFeedLimit {
+		return nil, customErrors.NewValidationError("limit", "значение должно быть от 1 до 100")
+	}
+
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, customErrors.NewValidationError("to", "конец периода должен быть позже начала")
+	}
+
+	if req.Cursor != "" {
+		beforeID, err := strconv.Atoi(req.Cursor)
+		if err != nil || beforeID <= 0 {
+			return nil, customErrors.NewValidationError("cursor", "некорректный курсор")
+		}
+		filter.BeforeID = &beforeID
+	}
+
+	return filter, nil
+}
+
+// isKnownType проверяет, что тип активности известен
+func isKnownType(activityType string) bool {
+	for _, known := range models.ActivityTypes {
+		if known == activityType {
+			return true
+		}
+	}
+	return false
+}
@@ -8,8 +8,11 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
 )
 
 func TestActivityService_GetActivitiesByEventID(t *testing.T) {
@@ -267,3 +270,113 @@ func TestActivityService_DeleteActivity(t *testing.T) {
 	})
 }
 
+
+func TestActivityService_RecordActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockActivity(ctrl)
+	activityService := NewActivityService(mockRepo)
+
+	eventID := int64(1)
+
+	t.Run("автор берется из участника в контексте", func(t *testing.T) {
+		ctx := access.WithMember(context.Background(), &models.UserEvent{UserID: 7, EventID: eventID, Role: models.EventRoleMember})
+
+		mockRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, activity *models.Activity) (*models.Activity, error) {
+				assert.Equal(t, eventID, *activity.EventID)
+				assert.Equal(t, int64(7), *activity.UserID)
+				assert.Equal(t, models.ActivityTypeTaskCreated, activity.Type)
+				assert.JSONEq(t, `{"task_id": 3, "title": "Купить билеты"}`, string(activity.Payload))
+				return activity, nil
+			}).
+			Times(1)
+
+		err := activityService.RecordActivity(ctx, eventID, models.ActivityTypeTaskCreated,
+			models.TaskActivityPayload{TaskID: 3, Title: "Купить билеты"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("внутренний вызов без автора", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, activity *models.Activity) (*models.Activity, error) {
+				assert.Nil(t, activity.UserID)
+				return activity, nil
+			}).
+			Times(1)
+
+		err := activityService.RecordActivity(ctx, eventID, models.ActivityTypeDebtsOptimized, nil)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestActivityService_GetActivityFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockActivity(ctrl)
+	activityService := NewActivityService(mockRepo)
+
+	ctx := context.Background()
+	eventID := int64(1)
+
+	t.Run("есть следующая страница", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetFeed(ctx, eventID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int64, filter *models.ActivityFilter) ([]models.Activity, error) {
+				assert.Equal(t, 3, filter.Limit, "запрашивается на одну активность больше страницы")
+				assert.Equal(t, 10, *filter.BeforeID)
+				assert.Equal(t, []string{models.ActivityTypeTaskCreated}, filter.Types)
+				return []models.Activity{{ID: 9}, {ID: 8}, {ID: 7}}, nil
+			}).
+			Times(1)
+
+		feed, err := activityService.GetActivityFeed(ctx, eventID, &service.ActivityFeedRequest{
+			Types:  []string{models.ActivityTypeTaskCreated},
+			Cursor: "10",
+			Limit:  2,
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, feed.Activities, 2)
+		if assert.NotNil(t, feed.NextCursor) {
+			assert.Equal(t, "8", *feed.NextCursor)
+		}
+	})
+
+	t.Run("последняя страница", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetFeed(ctx, eventID, gomock.Any()).
+			Return([]models.Activity{{ID: 2}, {ID: 1}}, nil).
+			Times(1)
+
+		feed, err := activityService.GetActivityFeed(ctx, eventID, &service.ActivityFeedRequest{})
+
+		assert.NoError(t, err)
+		assert.Len(t, feed.Activities, 2)
+		assert.Nil(t, feed.NextCursor)
+	})
+
+	t.Run("некорректные параметры", func(t *testing.T) {
+		requests := map[string]*service.ActivityFeedRequest{
+			"type":   {Types: []string{"unknown"}},
+			"limit":  {Limit: 101},
+			"cursor": {Cursor: "abc"},
+		}
+		for field, request := range requests {
+			_, err := activityService.GetActivityFeed(ctx, eventID, request)
+
+			var validationErr *customErrors.ValidationError
+			if assert.ErrorAs(t, err, &validationErr, field) {
+				assert.Equal(t, field, validationErr.Field)
+			}
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// AnalyticsRequest представляет параметры аналитики мероприятия
+type AnalyticsRequest struct {
+	From     *time.Time // Начало периода включительно
+	To       *time.Time // Конец периода не включительно
+	Interval string     // Шаг временного ряда: day | week; по умолчанию day
+	Top      int        // Число крупнейших расходов; по умолчанию 5
+}
+
+// UserSpendingDTO представляет расходы участника мероприятия
+type UserSpendingDTO struct {
+	UserID   int64
+	Paid     money.Money // Оплачено участником
+	Consumed money.Money // Приходится на долю участника
+	Net      money.Money // Paid - Consumed
+}
+
+// EventAnalyticsDTO представляет аналитику расходов мероприятия.
+// Все суммы указаны в базовой валюте мероприятия.
+type EventAnalyticsDTO struct {
+	EventID           int64
+	Currency          string
+	Interval          string
+	Total             money.Money
+	TransactionsCount int
+	ByCategory        []models.CategorySpending
+	ByUser            []UserSpendingDTO
+	TimeSeries        []models.SpendingPoint
+	TopExpenses       []models.TopExpense
+}
+
+// Analytics определяет методы аналитики расходов
+type Analytics interface {
+	// GetEventAnalytics возвращает аналитику расходов мероприятия
+	GetEventAnalytics(ctx context.Context, eventID int64, req *AnalyticsRequest) (*EventAnalyticsDTO, error)
+}
@@ -0,0 +1,168 @@
+package analytics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+const (
+	// defaultTop - число крупнейших расходов по умолчанию
+	defaultTop = 5
+	// maxTop - максимальное число крупнейших расходов
+	maxTop = 100
+)
+
+// AnalyticsService реализует интерфейс service.Analytics
+type AnalyticsService struct {
+	repo         repository.Analytics
+	eventService service.Event
+}
+
+// NewAnalyticsService создает новый сервис аналитики
+func NewAnalyticsService(repo repository.Analytics, eventService service.Event) *AnalyticsService {
+	return &AnalyticsService{
+		repo:         repo,
+		eventService: eventService,
+	}
+}
+
+// GetEventAnalytics возвращает аналитику расходов мероприятия
+func (s *AnalyticsService) GetEventAnalytics(ctx context.Context, eventID int64, req *service.AnalyticsRequest) (*service.EventAnalyticsDTO, error) {
+	interval, top, err := validateRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := models.AnalyticsFilter{From: req.From, To: req.To}
+
+	total, err := s.repo.GetTotal(ctx, eventID, filter)
+	if err != nil {
+		return nil, err
+	}
+	byCategory, err := s.repo.GetByCategory(ctx, eventID, filter)
+	if err != nil {
+		return nil, err
+	}
+	paid, err := s.repo.GetPaidByUser(ctx, eventID, filter)
+	if err != nil {
+		return nil, err
+	}
+	consumed, err := s.repo.GetConsumedByUser(ctx, eventID, filter)
+	if err != nil {
+		return nil, err
+	}
+	points, err := s.repo.GetTimeSeries(ctx, eventID, filter, interval)
+	if err != nil {
+		return nil, err
+	}
+	topExpenses, err := s.repo.GetTopExpenses(ctx, eventID, filter, top)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.EventAnalyticsDTO{
+		EventID:           eventID,
+		Currency:          event.Currency,
+		Interval:          interval,
+		Total:             total.Amount,
+		TransactionsCount: total.TransactionsCount,
+		ByCategory:        byCategory,
+		ByUser:            mergeUserSpending(paid, consumed),
+		TimeSeries:        fillGaps(points, interval),
+		TopExpenses:       topExpenses,
+	}, nil
+}
+
+// validateRequest проверяет параметры аналитики и возвращает шаг ряда и число крупнейших расходов
+func validateRequest(req *service.AnalyticsRequest) (string, int, error) {
+	interval := req.Interval
+	if interval == "" {
+		interval = models.AnalyticsIntervalDay
+	}
+	if interval != models.AnalyticsIntervalDay && interval != models.AnalyticsIntervalWeek {
+		return "", 0, customErrors.NewValidationError("interval", "допустимые значения: day, week")
+	}
+
+	top := req.Top
+	if top == 0 {
+		top = defaultTop
+	}
+	if top < 0 || top > maxTop {
+		return "", 0, customErrors.NewValidationError("top", "значение должно быть от 1 до 100")
+	}
+
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return "", 0, customErrors.NewValidationError("to", "конец периода должен быть позже начала")
+	}
+
+	return interval, top, nil
+}
+
+// mergeUserSpending объединяет оплаченные и потребленные суммы по пользователям.
+// Участники упорядочены по убыванию оплаченной суммы.
+func mergeUserSpending(paid, consumed []models.UserSpending) []service.UserSpendingDTO {
+	byUser := make(map[int64]*service.UserSpendingDTO, len(paid)+len(consumed))
+	get := func(userID int64) *service.UserSpendingDTO {
+		dto, ok := byUser[userID]
+		if !ok {
+			dto = &service.UserSpendingDTO{UserID: userID}
+			byUser[userID] = dto
+		}
+		return dto
+	}
+	for _, p := range paid {
+		get(p.UserID).Paid += p.Amount
+	}
+	for _, c := range consumed {
+		get(c.UserID).Consumed += c.Amount
+	}
+
+	result := make([]service.UserSpendingDTO, 0, len(byUser))
+	for _, dto := range byUser {
+		dto.Net = dto.Paid - dto.Consumed
+		result = append(result, *dto)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Paid != result[j].Paid {
+			return result[i].Paid > result[j].Paid
+		}
+		return result[i].UserID < result[j].UserID
+	})
+	return result
+}
+
+// fillGaps добавляет в ряд пустые периоды между первым и последним периодом с расходами
+func fillGaps(points []models.SpendingPoint, interval string) []models.SpendingPoint {
+	if len(points) < 2 {
+		return points
+	}
+
+	next := func(t time.Time) time.Time {
+		if interval == models.AnalyticsIntervalWeek {
+			return t.AddDate(0, 0, 7)
+		}
+		return t.AddDate(0, 0, 1)
+	}
+
+	result := make([]models.SpendingPoint, 0, len(points))
+	for i, point := range points {
+		if i > 0 {
+			for period := next(points[i-1].PeriodStart); period.Before(point.PeriodStart); period = next(period) {
+				result = append(result, models.SpendingPoint{PeriodStart: period})
+			}
+		}
+		result = append(result, point)
+	}
+	return result
+}
@@ -0,0 +1,112 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsService_GetEventAnalytics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repositoryMock.NewMockAnalytics(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+
+	analyticsService := NewAnalyticsService(mockRepo, mockEventService)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("успешный расчет", func(t *testing.T) {
+		filter := models.AnalyticsFilter{}
+		mockEventService.EXPECT().GetEventByID(ctx, eventID).Return(&models.Event{ID: eventID, Currency: "RUB"}, nil)
+		mockRepo.EXPECT().GetTotal(ctx, eventID, filter).Return(&models.SpendingTotal{Amount: money.FromFloat(300), TransactionsCount: 2}, nil)
+		mockRepo.EXPECT().GetByCategory(ctx, eventID, filter).Return([]models.CategorySpending{
+			{CategoryName: "", Amount: money.FromFloat(300), TransactionsCount: 2},
+		}, nil)
+		mockRepo.EXPECT().GetPaidByUser(ctx, eventID, filter).Return([]models.UserSpending{
+			{UserID: 1, Amount: money.FromFloat(300)},
+		}, nil)
+		mockRepo.EXPECT().GetConsumedByUser(ctx, eventID, filter).Return([]models.UserSpending{
+			{UserID: 1, Amount: money.FromFloat(150)},
+			{UserID: 2, Amount: money.FromFloat(150)},
+		}, nil)
+		mockRepo.EXPECT().GetTimeSeries(ctx, eventID, filter, models.AnalyticsIntervalDay).Return([]models.SpendingPoint{
+			{PeriodStart: day(1), Amount: money.FromFloat(100), TransactionsCount: 1},
+			{PeriodStart: day(4), Amount: money.FromFloat(200), TransactionsCount: 1},
+		}, nil)
+		mockRepo.EXPECT().GetTopExpenses(ctx, eventID, filter, defaultTop).Return([]models.TopExpense{
+			{TransactionID: 2, Amount: money.FromFloat(200)},
+			{TransactionID: 1, Amount: money.FromFloat(100)},
+		}, nil)
+
+		result, err := analyticsService.GetEventAnalytics(ctx, eventID, &service.AnalyticsRequest{})
+		require.NoError(t, err)
+
+		assert.Equal(t, "RUB", result.Currency)
+		assert.Equal(t, models.AnalyticsIntervalDay, result.Interval)
+		assert.Equal(t, money.FromFloat(300), result.Total)
+		assert.Equal(t, 2, result.TransactionsCount)
+		assert.Equal(t, []service.UserSpendingDTO{
+			{UserID: 1, Paid: money.FromFloat(300), Consumed: money.FromFloat(150), Net: money.FromFloat(150)},
+			{UserID: 2, Consumed: money.FromFloat(150), Net: money.FromFloat(-150)},
+		}, result.ByUser)
+		require.Len(t, result.TimeSeries, 4)
+		assert.Equal(t, day(2), result.TimeSeries[1].PeriodStart)
+		assert.Equal(t, money.Money(0), result.TimeSeries[2].Amount)
+		assert.Len(t, result.TopExpenses, 2)
+	})
+
+	t.Run("ошибка репозитория", func(t *testing.T) {
+		mockEventService.EXPECT().GetEventByID(ctx, eventID).Return(&models.Event{ID: eventID}, nil)
+		mockRepo.EXPECT().GetTotal(ctx, eventID, gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := analyticsService.GetEventAnalytics(ctx, eventID, &service.AnalyticsRequest{Interval: models.AnalyticsIntervalWeek})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("некорректные параметры", func(t *testing.T) {
+		from := day(10)
+		to := day(5)
+		requests := []*service.AnalyticsRequest{
+			{Interval: "month"},
+			{Top: -1},
+			{Top: maxTop + 1},
+			{From: &from, To: &to},
+		}
+		for _, req := range requests {
+			result, err := analyticsService.GetEventAnalytics(ctx, eventID, req)
+			var validationErr *customErrors.ValidationError
+			assert.ErrorAs(t, err, &validationErr)
+			assert.Nil(t, result)
+		}
+	})
+}
+
+func TestFillGaps(t *testing.T) {
+	week := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	points := fillGaps([]models.SpendingPoint{
+		{PeriodStart: week(3), Amount: money.FromFloat(10)},
+		{PeriodStart: week(24), Amount: money.FromFloat(20)},
+	}, models.AnalyticsIntervalWeek)
+
+	require.Len(t, points, 4)
+	assert.Equal(t, week(10), points[1].PeriodStart)
+	assert.Equal(t, week(17), points[2].PeriodStart)
+	assert.Equal(t, money.FromFloat(20), points[3].Amount)
+}
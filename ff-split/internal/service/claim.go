@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// DummyClaim определяет методы для работы с заявками на dummy-пользователей
+type DummyClaim interface {
+	// CreateClaim создает заявку участника claimantID на dummy-пользователя dummyID
+	CreateClaim(ctx context.Context, eventID, dummyID, claimantID int64) (*models.DummyClaim, error)
+
+	// GetClaimsByEventID возвращает нерассмотренные заявки мероприятия
+	GetClaimsByEventID(ctx context.Context, eventID int64) ([]models.DummyClaim, error)
+
+	// ApproveClaim одобряет заявку и объединяет dummy-пользователя с заявителем
+	ApproveClaim(ctx context.Context, eventID int64, id int) (*models.DummyClaim, error)
+
+	// RejectClaim отклоняет заявку
+	RejectClaim(ctx context.Context, eventID int64, id int) (*models.DummyClaim, error)
+}
@@ -0,0 +1,186 @@
+package claim
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"gorm.io/gorm"
+)
+
+// DummyClaimService реализует интерфейс service.DummyClaim
+type DummyClaimService struct {
+	db               *gorm.DB
+	repo             repository.DummyClaim
+	userService      service.User
+	recurringService service.Recurring
+}
+
+// NewDummyClaimService создает новый сервис заявок на dummy-пользователей
+func NewDummyClaimService(db *gorm.DB, repo repository.DummyClaim, userService service.User, recurringService service.Recurring) *DummyClaimService {
+	return &DummyClaimService{
+		db:               db,
+		repo:             repo,
+		userService:      userService,
+		recurringService: recurringService,
+	}
+}
+
+// CreateClaim создает заявку участника мероприятия на dummy-пользователя этого же мероприятия.
+// На одного dummy-пользователя может быть только одна нерассмотренная заявка.
+func (s *DummyClaimService) CreateClaim(ctx context.Context, eventID, dummyID, claimantID int64) (*models.DummyClaim, error) {
+	members, err := s.userService.GetEventMembers(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	isMember := false
+	for _, member := range members {
+		if member.UserID == claimantID {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		return nil, customErrors.NewForbiddenError("подать заявку может только участник мероприятия")
+	}
+
+	dummies, err := s.userService.GetDummiesByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	var dummy *models.User
+	for i := range dummies {
+		if dummies[i].ID == dummyID {
+			dummy = &dummies[i]
+			break
+		}
+	}
+	if dummy == nil {
+		return nil, customErrors.NewEntityNotFoundError(strconv.FormatInt(dummyID, 10), "dummy user")
+	}
+
+	pending, err := s.repo.GetPendingByDummyUserID(ctx, dummyID)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		return nil, customErrors.NewAlreadyExistsError("dummy claim", "на этого пользователя уже подана заявка")
+	}
+
+	claim := &models.DummyClaim{
+		EventID:        eventID,
+		DummyUserID:    &dummy.ID,
+		DummyName:      dummy.NameCashed,
+		ClaimantUserID: claimantID,
+		Status:         models.DummyClaimStatusPending,
+	}
+	if err := s.repo.Create(ctx, claim); err != nil {
+		return nil, err
+	}
+	return claim, nil
+}
+
+// GetClaimsByEventID возвращает нерассмотренные заявки мероприятия
+func (s *DummyClaimService) GetClaimsByEventID(ctx context.Context, eventID int64) ([]models.DummyClaim, error) {
+	if err := access.Require(ctx, eventID, access.ManageMembers); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetPendingByEventID(ctx, eventID)
+}
+
+// ApproveClaim одобряет заявку и в той же транзакции переносит данные dummy-пользователя на заявителя.
+// Одобрить собственную заявку может только владелец мероприятия.
+func (s *DummyClaimService) ApproveClaim(ctx context.Context, eventID int64, id int) (*models.DummyClaim, error) {
+	claim, resolvedBy, err := s.getPendingClaim(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	if claim.DummyUserID == nil {
+		return nil, customErrors.NewLogicError("dummy-пользователь заявки уже удален")
+	}
+
+	err = db.WithTx(ctx, s.db, func(ctx context.Context) error {
+		ok, err := s.repo.Resolve(ctx, claim.ID, models.DummyClaimStatusApproved, resolvedBy)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return customErrors.NewLogicError("заявка уже рассмотрена")
+		}
+
+		// Шаблоны регулярных транзакций хранят ID участников в теле запроса,
+		// поэтому переносятся отдельно от сохраненных транзакций
+		if err := s.recurringService.ReassignUser(ctx, *claim.DummyUserID, claim.ClaimantUserID); err != nil {
+			return err
+		}
+		return s.userService.MergeDummyUser(ctx, *claim.DummyUserID, claim.ClaimantUserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.markResolved(claim, models.DummyClaimStatusApproved, resolvedBy)
+	claim.DummyUserID = nil
+	return claim, nil
+}
+
+// RejectClaim отклоняет заявку
+func (s *DummyClaimService) RejectClaim(ctx context.Context, eventID int64, id int) (*models.DummyClaim, error) {
+	claim, resolvedBy, err := s.getPendingClaim(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.repo.Resolve(ctx, claim.ID, models.DummyClaimStatusRejected, resolvedBy)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, customErrors.NewLogicError("заявка уже рассмотрена")
+	}
+
+	s.markResolved(claim, models.DummyClaimStatusRejected, resolvedBy)
+	return claim, nil
+}
+
+// getPendingClaim проверяет права на рассмотрение заявки и возвращает ее вместе с ID рассматривающего участника
+func (s *DummyClaimService) getPendingClaim(ctx context.Context, eventID int64, id int) (*models.DummyClaim, *int64, error) {
+	if err := access.Require(ctx, eventID, access.ManageMembers); err != nil {
+		return nil, nil, err
+	}
+
+	claim, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if claim == nil || claim.EventID != eventID {
+		return nil, nil, customErrors.NewEntityNotFoundError(strconv.Itoa(id), "dummy claim")
+	}
+	if claim.Status != models.DummyClaimStatusPending {
+		return nil, nil, customErrors.NewLogicError("заявка уже рассмотрена")
+	}
+
+	var resolvedBy *int64
+	if actor, ok := access.MemberFromContext(ctx); ok {
+		if actor.UserID == claim.ClaimantUserID && actor.Role != models.EventRoleOwner {
+			return nil, nil, customErrors.NewForbiddenError("рассмотреть собственную заявку может только владелец мероприятия")
+		}
+		resolvedBy = &actor.UserID
+	}
+	return claim, resolvedBy, nil
+}
+
+// markResolved отражает решение по заявке в возвращаемой модели
+func (s *DummyClaimService) markResolved(claim *models.DummyClaim, status string, resolvedBy *int64) {
+	now := time.Now()
+	claim.Status = status
+	claim.ResolvedBy = resolvedBy
+	claim.ResolvedAt = &now
+}
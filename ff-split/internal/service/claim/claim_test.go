@@ -0,0 +1,227 @@
+package claim
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestDummyClaimService_CreateClaim(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClaimRepo := repositoryMock.NewMockDummyClaim(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	var db *gorm.DB
+
+	claimService := NewDummyClaimService(db, mockClaimRepo, mockUserService, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	claimantID := int64(2)
+	dummyID := int64(5)
+	members := []models.UserEvent{
+		{UserID: 1, EventID: eventID, Role: models.EventRoleOwner},
+		{UserID: claimantID, EventID: eventID, Role: models.EventRoleMember},
+		{UserID: dummyID, EventID: eventID, Role: models.EventRoleMember},
+	}
+	dummies := []models.User{{ID: dummyID, NameCashed: "Вася", IsDummy: true}}
+
+	t.Run("успешная подача заявки", func(t *testing.T) {
+		mockUserService.EXPECT().GetEventMembers(ctx, eventID).Return(members, nil)
+		mockUserService.EXPECT().GetDummiesByEventID(ctx, eventID).Return(dummies, nil)
+		mockClaimRepo.EXPECT().GetPendingByDummyUserID(ctx, dummyID).Return(nil, nil)
+		mockClaimRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, claim *models.DummyClaim) error {
+			claim.ID = 1
+			return nil
+		})
+
+		claim, err := claimService.CreateClaim(ctx, eventID, dummyID, claimantID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, claim.ID)
+		assert.Equal(t, models.DummyClaimStatusPending, claim.Status)
+		assert.Equal(t, "Вася", claim.DummyName)
+		assert.Equal(t, &dummyID, claim.DummyUserID)
+	})
+
+	t.Run("заявитель не участник мероприятия", func(t *testing.T) {
+		mockUserService.EXPECT().GetEventMembers(ctx, eventID).Return(members, nil)
+
+		_, err := claimService.CreateClaim(ctx, eventID, dummyID, 42)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("пользователь не dummy", func(t *testing.T) {
+		mockUserService.EXPECT().GetEventMembers(ctx, eventID).Return(members, nil)
+		mockUserService.EXPECT().GetDummiesByEventID(ctx, eventID).Return(dummies, nil)
+
+		_, err := claimService.CreateClaim(ctx, eventID, 1, claimantID)
+
+		var notFoundErr *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("заявка уже подана", func(t *testing.T) {
+		mockUserService.EXPECT().GetEventMembers(ctx, eventID).Return(members, nil)
+		mockUserService.EXPECT().GetDummiesByEventID(ctx, eventID).Return(dummies, nil)
+		mockClaimRepo.EXPECT().GetPendingByDummyUserID(ctx, dummyID).
+			Return(&models.DummyClaim{ID: 1, EventID: eventID, Status: models.DummyClaimStatusPending}, nil)
+
+		_, err := claimService.CreateClaim(ctx, eventID, dummyID, claimantID)
+
+		var alreadyExistsErr *customErrors.AlreadyExistsError
+		assert.ErrorAs(t, err, &alreadyExistsErr)
+	})
+}
+
+func TestDummyClaimService_ApproveClaim(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Создаем in-memory SQLite БД для тестов с транзакциями
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	mockClaimRepo := repositoryMock.NewMockDummyClaim(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockRecurringService := serviceMock.NewMockRecurring(ctrl)
+
+	claimService := NewDummyClaimService(testDB, mockClaimRepo, mockUserService, mockRecurringService)
+
+	eventID := int64(1)
+	dummyID := int64(5)
+	adminCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 3, EventID: eventID, Role: models.EventRoleAdmin})
+	pendingClaim := func(claimantID int64) *models.DummyClaim {
+		id := dummyID
+		return &models.DummyClaim{ID: 1, EventID: eventID, DummyUserID: &id, ClaimantUserID: claimantID, Status: models.DummyClaimStatusPending}
+	}
+
+	t.Run("успешное одобрение", func(t *testing.T) {
+		mockClaimRepo.EXPECT().GetByID(adminCtx, 1).Return(pendingClaim(2), nil)
+		mockClaimRepo.EXPECT().Resolve(gomock.Any(), 1, models.DummyClaimStatusApproved, gomock.Any()).Return(true, nil)
+		mockRecurringService.EXPECT().ReassignUser(gomock.Any(), dummyID, int64(2)).Return(nil)
+		mockUserService.EXPECT().MergeDummyUser(gomock.Any(), dummyID, int64(2)).Return(nil)
+
+		claim, err := claimService.ApproveClaim(adminCtx, eventID, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.DummyClaimStatusApproved, claim.Status)
+		assert.Equal(t, int64(3), *claim.ResolvedBy)
+		assert.Nil(t, claim.DummyUserID)
+	})
+
+	t.Run("администратор не может одобрить свою заявку", func(t *testing.T) {
+		mockClaimRepo.EXPECT().GetByID(adminCtx, 1).Return(pendingClaim(3), nil)
+
+		_, err := claimService.ApproveClaim(adminCtx, eventID, 1)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("владелец одобряет свою заявку", func(t *testing.T) {
+		ownerCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 2, EventID: eventID, Role: models.EventRoleOwner})
+		mockClaimRepo.EXPECT().GetByID(ownerCtx, 1).Return(pendingClaim(2), nil)
+		mockClaimRepo.EXPECT().Resolve(gomock.Any(), 1, models.DummyClaimStatusApproved, gomock.Any()).Return(true, nil)
+		mockRecurringService.EXPECT().ReassignUser(gomock.Any(), dummyID, int64(2)).Return(nil)
+		mockUserService.EXPECT().MergeDummyUser(gomock.Any(), dummyID, int64(2)).Return(nil)
+
+		_, err := claimService.ApproveClaim(ownerCtx, eventID, 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("участник не может одобрять заявки", func(t *testing.T) {
+		memberCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 4, EventID: eventID, Role: models.EventRoleMember})
+
+		_, err := claimService.ApproveClaim(memberCtx, eventID, 1)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("заявка уже рассмотрена", func(t *testing.T) {
+		claim := pendingClaim(2)
+		claim.Status = models.DummyClaimStatusRejected
+		mockClaimRepo.EXPECT().GetByID(adminCtx, 1).Return(claim, nil)
+
+		_, err := claimService.ApproveClaim(adminCtx, eventID, 1)
+
+		var logicErr *customErrors.LogicError
+		assert.ErrorAs(t, err, &logicErr)
+	})
+
+	t.Run("ошибка объединения", func(t *testing.T) {
+		mockClaimRepo.EXPECT().GetByID(adminCtx, 1).Return(pendingClaim(2), nil)
+		mockClaimRepo.EXPECT().Resolve(gomock.Any(), 1, models.DummyClaimStatusApproved, gomock.Any()).Return(true, nil)
+		mockRecurringService.EXPECT().ReassignUser(gomock.Any(), dummyID, int64(2)).Return(nil)
+		mockUserService.EXPECT().MergeDummyUser(gomock.Any(), dummyID, int64(2)).
+			Return(customErrors.NewValidationError("dummy_id", "объединять можно только dummy-пользователя"))
+
+		_, err := claimService.ApproveClaim(adminCtx, eventID, 1)
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("ошибка переноса шаблонов регулярных транзакций", func(t *testing.T) {
+		expectedErr := errors.New("recurring error")
+		mockClaimRepo.EXPECT().GetByID(adminCtx, 1).Return(pendingClaim(2), nil)
+		mockClaimRepo.EXPECT().Resolve(gomock.Any(), 1, models.DummyClaimStatusApproved, gomock.Any()).Return(true, nil)
+		mockRecurringService.EXPECT().ReassignUser(gomock.Any(), dummyID, int64(2)).Return(expectedErr)
+
+		_, err := claimService.ApproveClaim(adminCtx, eventID, 1)
+
+		assert.ErrorIs(t, err, expectedErr)
+	})
+}
+
+func TestDummyClaimService_RejectClaim(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClaimRepo := repositoryMock.NewMockDummyClaim(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	var db *gorm.DB
+
+	claimService := NewDummyClaimService(db, mockClaimRepo, mockUserService, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	dummyID := int64(5)
+
+	t.Run("успешное отклонение", func(t *testing.T) {
+		mockClaimRepo.EXPECT().GetByID(ctx, 1).
+			Return(&models.DummyClaim{ID: 1, EventID: eventID, DummyUserID: &dummyID, ClaimantUserID: 2, Status: models.DummyClaimStatusPending}, nil)
+		mockClaimRepo.EXPECT().Resolve(ctx, 1, models.DummyClaimStatusRejected, nil).Return(true, nil)
+
+		claim, err := claimService.RejectClaim(ctx, eventID, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.DummyClaimStatusRejected, claim.Status)
+		assert.Equal(t, &dummyID, claim.DummyUserID)
+	})
+
+	t.Run("заявка другого мероприятия", func(t *testing.T) {
+		mockClaimRepo.EXPECT().GetByID(ctx, 2).Return(&models.DummyClaim{ID: 2, EventID: 42}, nil)
+
+		_, err := claimService.RejectClaim(ctx, eventID, 2)
+
+		var notFoundErr *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
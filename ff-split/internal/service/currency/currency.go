@@ -0,0 +1,148 @@
+package currency
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// DefaultCurrency - валюта, используемая, если валюта не указана
+const DefaultCurrency = "RUB"
+
+// Normalize приводит код валюты к верхнему регистру и проверяет формат ISO 4217.
+// Пустой код заменяется на DefaultCurrency.
+func Normalize(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return DefaultCurrency, nil
+	}
+	if len(code) != 3 {
+		return "", customErrors.NewValidationError("currency", fmt.Sprintf("некорректный код валюты %q", code))
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", customErrors.NewValidationError("currency", fmt.Sprintf("некорректный код валюты %q", code))
+		}
+	}
+	return code, nil
+}
+
+// ExchangeRateService реализует интерфейс service.ExchangeRate.
+// Курсы задаются вручную и хранятся в таблице exchange_rates.
+type ExchangeRateService struct {
+	repo repository.ExchangeRate
+}
+
+// NewExchangeRateService создает новый экземпляр ExchangeRateService
+func NewExchangeRateService(repo repository.ExchangeRate) *ExchangeRateService {
+	return &ExchangeRateService{repo: repo}
+}
+
+// GetRate возвращает курс пересчета из валюты from в валюту to.
+// Если прямой курс не задан, используется обратный.
+func (s *ExchangeRateService) GetRate(ctx context.Context, from, to string) (float64, error) {
+	from, err := Normalize(from)
+	if err != nil {
+		return 0, err
+	}
+	to, err = Normalize(to)
+	if err != nil {
+		return 0, err
+	}
+	if from == to {
+		return 1, nil
+	}
+
+	rate, err := s.repo.GetRate(ctx, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка при получении курса %s/%s: %w", from, to, err)
+	}
+	if rate != nil {
+		return rate.Rate, nil
+	}
+
+	inverse, err := s.repo.GetRate(ctx, to, from)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка при получении курса %s/%s: %w", to, from, err)
+	}
+	if inverse != nil && inverse.Rate > 0 {
+		return 1 / inverse.Rate, nil
+	}
+
+	return 0, customErrors.NewEntityNotFoundError(from+"/"+to, "exchange rate")
+}
+
+// GetRates возвращает все заданные курсы
+func (s *ExchangeRateService) GetRates(ctx context.Context) ([]service.ExchangeRateDTO, error) {
+	rates, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении курсов валют: %w", err)
+	}
+
+	result := make([]service.ExchangeRateDTO, len(rates))
+	for i, rate := range rates {
+		result[i] = mapRateToDTO(rate)
+	}
+	return result, nil
+}
+
+// SetRate задает курс для пары валют
+func (s *ExchangeRateService) SetRate(ctx context.Context, dto *service.ExchangeRateDTO) (*service.ExchangeRateDTO, error) {
+	from, err := Normalize(dto.CurrencyFrom)
+	if err != nil {
+		return nil, err
+	}
+	to, err := Normalize(dto.CurrencyTo)
+	if err != nil {
+		return nil, err
+	}
+	if from == to {
+		return nil, customErrors.NewValidationError("currency_to", "валюты пары должны различаться")
+	}
+	if dto.Rate <= 0 {
+		return nil, customErrors.NewValidationError("rate", "курс должен быть положительным")
+	}
+
+	rate := &models.ExchangeRate{
+		CurrencyFrom: from,
+		CurrencyTo:   to,
+		Rate:         dto.Rate,
+	}
+	if err := s.repo.Upsert(ctx, rate); err != nil {
+		return nil, fmt.Errorf("ошибка при сохранении курса: %w", err)
+	}
+
+	result := mapRateToDTO(*rate)
+	return &result, nil
+}
+
+// DeleteRate удаляет курс для пары валют
+func (s *ExchangeRateService) DeleteRate(ctx context.Context, from, to string) error {
+	from, err := Normalize(from)
+	if err != nil {
+		return err
+	}
+	to, err = Normalize(to)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, from, to); err != nil {
+		return fmt.Errorf("ошибка при удалении курса: %w", err)
+	}
+	return nil
+}
+
+// mapRateToDTO преобразует модель курса в DTO
+func mapRateToDTO(rate models.ExchangeRate) service.ExchangeRateDTO {
+	return service.ExchangeRateDTO{
+		CurrencyFrom: rate.CurrencyFrom,
+		CurrencyTo:   rate.CurrencyTo,
+		Rate:         rate.Rate,
+		UpdatedAt:    rate.UpdatedAt,
+	}
+}
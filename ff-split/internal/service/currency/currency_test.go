@@ -0,0 +1,127 @@
+package currency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Run("пустой код заменяется валютой по умолчанию", func(t *testing.T) {
+		code, err := Normalize("")
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultCurrency, code)
+	})
+
+	t.Run("код приводится к верхнему регистру", func(t *testing.T) {
+		code, err := Normalize(" usd ")
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", code)
+	})
+
+	t.Run("некорректный код", func(t *testing.T) {
+		for _, code := range []string{"US", "USDT", "U$D"} {
+			_, err := Normalize(code)
+			var validationErr *customErrors.ValidationError
+			assert.ErrorAs(t, err, &validationErr, code)
+		}
+	})
+}
+
+func TestExchangeRateService_GetRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockExchangeRate(ctrl)
+	rateService := NewExchangeRateService(mockRepo)
+
+	ctx := context.Background()
+
+	t.Run("одинаковые валюты", func(t *testing.T) {
+		rate, err := rateService.GetRate(ctx, "eur", "EUR")
+		assert.NoError(t, err)
+		assert.Equal(t, 1.0, rate)
+	})
+
+	t.Run("прямой курс", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetRate(ctx, "EUR", "RUB").
+			Return(&models.ExchangeRate{CurrencyFrom: "EUR", CurrencyTo: "RUB", Rate: 100}, nil).
+			Times(1)
+
+		rate, err := rateService.GetRate(ctx, "EUR", "RUB")
+		assert.NoError(t, err)
+		assert.Equal(t, 100.0, rate)
+	})
+
+	t.Run("обратный курс", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetRate(ctx, "RUB", "EUR").
+			Return(nil, nil).
+			Times(1)
+		mockRepo.EXPECT().
+			GetRate(ctx, "EUR", "RUB").
+			Return(&models.ExchangeRate{CurrencyFrom: "EUR", CurrencyTo: "RUB", Rate: 100}, nil).
+			Times(1)
+
+		rate, err := rateService.GetRate(ctx, "RUB", "EUR")
+		assert.NoError(t, err)
+		assert.InDelta(t, 0.01, rate, 1e-12)
+	})
+
+	t.Run("курс не задан", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetRate(ctx, "USD", "RUB").
+			Return(nil, nil).
+			Times(1)
+		mockRepo.EXPECT().
+			GetRate(ctx, "RUB", "USD").
+			Return(nil, nil).
+			Times(1)
+
+		_, err := rateService.GetRate(ctx, "USD", "RUB")
+		var notFoundErr *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestExchangeRateService_SetRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockExchangeRate(ctrl)
+	rateService := NewExchangeRateService(mockRepo)
+
+	ctx := context.Background()
+
+	t.Run("успешное сохранение курса", func(t *testing.T) {
+		mockRepo.EXPECT().
+			Upsert(ctx, &models.ExchangeRate{CurrencyFrom: "USD", CurrencyTo: "RUB", Rate: 90.5}).
+			Return(nil).
+			Times(1)
+
+		result, err := rateService.SetRate(ctx, &service.ExchangeRateDTO{CurrencyFrom: "usd", CurrencyTo: "rub", Rate: 90.5})
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", result.CurrencyFrom)
+		assert.Equal(t, "RUB", result.CurrencyTo)
+		assert.Equal(t, 90.5, result.Rate)
+	})
+
+	t.Run("неположительный курс", func(t *testing.T) {
+		_, err := rateService.SetRate(ctx, &service.ExchangeRateDTO{CurrencyFrom: "USD", CurrencyTo: "RUB", Rate: 0})
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("одинаковые валюты", func(t *testing.T) {
+		_, err := rateService.SetRate(ctx, &service.ExchangeRateDTO{CurrencyFrom: "USD", CurrencyTo: "usd", Rate: 1})
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
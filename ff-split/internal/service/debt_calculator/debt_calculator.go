@@ -4,15 +4,19 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	"github.com/ivasnev/FinFlow/ff-split/internal/service"
 )
 
 // Константы для типов распределения
 const (
+	EqualType   = "equal"   // Поровну между участниками
 	PercentType = "percent" // Процентное распределение
 	AmountType  = "amount"  // Фиксированные суммы
 	UnitsType   = "units"   // Распределение по долям
+	ItemsType   = "items"   // Построчное распределение по позициям чека
 )
 
 // DebtCalculator интерфейс для стратегий расчета долгов
@@ -24,30 +28,71 @@ type DebtCalculator interface {
 // Share представляет долю пользователя в транзакции (для внутреннего использования)
 type Share struct {
 	UserID int64
-	Value  float64
+	Value  money.Money
+}
+
+// Payer представляет вклад плательщика в транзакцию (для внутреннего использования)
+type Payer struct {
+	UserID int64
+	Amount money.Money
 }
 
 // Debt представляет долг между пользователями (для внутреннего использования)
 type Debt struct {
 	FromUserID int64
 	ToUserID   int64
-	Amount     float64
+	Amount     money.Money
 }
 
 // GetCalculator возвращает стратегию расчета по типу
 func GetCalculator(calculationType string) (DebtCalculator, error) {
 	switch calculationType {
+	case EqualType:
+		return &EqualStrategy{}, nil
 	case PercentType:
 		return &PercentStrategy{}, nil
 	case AmountType:
 		return &AmountStrategy{}, nil
 	case UnitsType:
 		return &UnitsStrategy{}, nil
+	case ItemsType:
+		return &ItemsStrategy{}, nil
 	default:
 		return nil, fmt.Errorf("неизвестный тип расчета долгов: %s", calculationType)
 	}
 }
 
+// EqualStrategy стратегия распределения поровну
+type EqualStrategy struct{}
+
+// Calculate делит сумму поровну между участниками req.Users.
+// Если указан req.ExcludePayer, плательщик не участвует в разделе суммы.
+func (s *EqualStrategy) Calculate(req *service.TransactionRequest, eventID int64) ([]Share, []Debt, error) {
+	weights := make(map[int64]float64, len(req.Users))
+	for _, userID := range req.Users {
+		if req.ExcludePayer && userID == req.FromUser {
+			continue
+		}
+		weights[userID] = 1.0
+	}
+
+	if len(weights) == 0 {
+		return nil, nil, errors.New("нет участников для распределения суммы")
+	}
+
+	shares, err := allocateShares(req.Amount, weights, req.FromUser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Расчет долгов
+	debts, err := calculateDebts(shares, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return shares, debts, nil
+}
+
 // PercentStrategy стратегия распределения на основе процентов
 type PercentStrategy struct{}
 
@@ -64,43 +109,50 @@ func (s *PercentStrategy) Calculate(req *service.TransactionRequest, eventID int
 	}
 
 	// Расчет долей на основе процентов
-	shares := make([]Share, 0, len(req.Portion))
+	weights := make(map[int64]float64, len(req.Portion))
 	for userIDStr, percent := range req.Portion {
 		userID, err := parseUserID(userIDStr)
 		if err != nil {
 			return nil, nil, err
 		}
+		weights[userID] = percent
+	}
 
-		value := req.Amount * percent / 100.0
-		shares = append(shares, Share{
-			UserID: userID,
-			Value:  value,
-		})
+	shares, err := allocateShares(req.Amount, weights, req.FromUser)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Расчет долгов
-	debts := calculateDebts(shares, req.FromUser)
+	debts, err := calculateDebts(shares, req)
+	if err != nil {
+		return nil, nil, err
+	}
 	return shares, debts, nil
 }
 
 // AmountStrategy стратегия фиксированных сумм
 type AmountStrategy struct{}
 
-// Calculate рассчитывает доли и долги по фиксированным суммам
+// Calculate рассчитывает доли и долги по фиксированным суммам req.Amounts
 func (s *AmountStrategy) Calculate(req *service.TransactionRequest, eventID int64) ([]Share, []Debt, error) {
-	// Проверка общей суммы
-	var totalAmount float64
-	for _, amount := range req.Portion {
+	if len(req.Amounts) == 0 {
+		return nil, nil, errors.New("не указаны суммы участников")
+	}
+
+	// Проверка общей суммы (в копейках, без погрешности)
+	var totalAmount money.Money
+	for _, amount := range req.Amounts {
 		totalAmount += amount
 	}
 
-	if math.Abs(totalAmount-req.Amount) > 0.01 {
+	if totalAmount != req.Amount {
 		return nil, nil, errors.New("сумма распределенных долей должна быть равна общей сумме")
 	}
 
 	// Расчет долей
-	shares := make([]Share, 0, len(req.Portion))
-	for userIDStr, amount := range req.Portion {
+	shares := make([]Share, 0, len(req.Amounts))
+	for userIDStr, amount := range req.Amounts {
 		userID, err := parseUserID(userIDStr)
 		if err != nil {
 			return nil, nil, err
@@ -111,9 +163,13 @@ func (s *AmountStrategy) Calculate(req *service.TransactionRequest, eventID int6
 			Value:  amount,
 		})
 	}
+	sortShares(shares, req.FromUser)
 
 	// Расчет долгов
-	debts := calculateDebts(shares, req.FromUser)
+	debts, err := calculateDebts(shares, req)
+	if err != nil {
+		return nil, nil, err
+	}
 	return shares, debts, nil
 }
 
@@ -167,30 +223,108 @@ func (s *UnitsStrategy) Calculate(req *service.TransactionRequest, eventID int64
 		}
 	}
 
-	// Вычисляем общее количество долей
-	var totalUnits float64
-	for _, units := range unitMap {
-		totalUnits += units
+	// Создаем итоговый список долей пропорционально количеству единиц
+	shares, err := allocateShares(req.Amount, unitMap, req.FromUser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Расчет долгов
+	debts, err := calculateDebts(shares, req)
+	if err != nil {
+		return nil, nil, err
 	}
+	return shares, debts, nil
+}
 
-	// Рассчитываем стоимость одной доли
-	unitCost := req.Amount / totalUnits
+// ItemsStrategy стратегия построчного распределения по позициям чека
+type ItemsStrategy struct{}
 
-	// Создаем итоговый список долей
-	shares := make([]Share, 0, len(unitMap))
-	for userID, units := range unitMap {
-		value := units * unitCost
+// Calculate рассчитывает доли и долги по позициям чека.
+// Стоимость каждой позиции делится поровну между ее потребителями, а общие
+// надбавки (налог, чаевые, сервисный сбор) распределяются пропорционально
+// сумме позиций каждого участника.
+func (s *ItemsStrategy) Calculate(req *service.TransactionRequest, eventID int64) ([]Share, []Debt, error) {
+	if len(req.Items) == 0 {
+		return nil, nil, errors.New("не указаны позиции чека")
+	}
+
+	// Сумма позиций по каждому участнику
+	subtotals := make(map[int64]money.Money)
+	var itemsTotal money.Money
+	for _, item := range req.Items {
+		if item.Price < 0 {
+			return nil, nil, fmt.Errorf("цена позиции %q не может быть отрицательной", item.Name)
+		}
+		if item.Quantity < 0 {
+			return nil, nil, fmt.Errorf("количество позиции %q не может быть отрицательным", item.Name)
+		}
+		if len(item.Consumers) == 0 {
+			return nil, nil, fmt.Errorf("у позиции %q не указаны потребители", item.Name)
+		}
+
+		weights := make(map[int64]float64, len(item.Consumers))
+		for _, userID := range item.Consumers {
+			weights[userID] = 1.0
+		}
+
+		total := ItemTotal(item)
+		parts, err := allocateShares(total, weights, req.FromUser)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, part := range parts {
+			subtotals[part.UserID] += part.Value
+		}
+		itemsTotal += total
+	}
+
+	var chargesTotal money.Money
+	for _, charge := range req.Charges {
+		chargesTotal += charge.Amount
+	}
+
+	if itemsTotal+chargesTotal != req.Amount {
+		return nil, nil, errors.New("сумма позиций и надбавок должна быть равна общей сумме")
+	}
+
+	// Надбавки распределяем пропорционально сумме позиций участников
+	chargeParts := make(map[int64]money.Money)
+	if chargesTotal != 0 {
+		parts, err := allocateSharesByAmounts(chargesTotal, subtotals, req.FromUser)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, part := range parts {
+			chargeParts[part.UserID] = part.Value
+		}
+	}
+
+	shares := make([]Share, 0, len(subtotals))
+	for userID, subtotal := range subtotals {
 		shares = append(shares, Share{
 			UserID: userID,
-			Value:  value,
+			Value:  subtotal + chargeParts[userID],
 		})
 	}
+	sortShares(shares, req.FromUser)
 
 	// Расчет долгов
-	debts := calculateDebts(shares, req.FromUser)
+	debts, err := calculateDebts(shares, req)
+	if err != nil {
+		return nil, nil, err
+	}
 	return shares, debts, nil
 }
 
+// ItemTotal возвращает стоимость позиции чека (цена × количество, по умолчанию количество 1)
+func ItemTotal(item service.ItemDTO) money.Money {
+	if item.Quantity == 0 {
+		return item.Price
+	}
+	return item.Price.MulFloat(item.Quantity)
+}
+
 // Вспомогательные функции
 
 // parseUserID преобразует строковый ID пользователя в int64
@@ -203,26 +337,164 @@ func parseUserID(userIDStr string) (int64, error) {
 	return userID, nil
 }
 
-// calculateDebts рассчитывает долги на основе долей
-func calculateDebts(shares []Share, payerID int64) []Debt {
-	debts := make([]Debt, 0)
+// allocateShares распределяет сумму между пользователями пропорционально весам.
+// Копейки, оставшиеся после округления, распределяются методом наибольшего остатка;
+// при равных остатках приоритет у плательщика, затем у пользователя с меньшим ID.
+// Сумма полученных долей всегда равна amount.
+func allocateShares(amount money.Money, weights map[int64]float64, payerID int64) ([]Share, error) {
+	shares := make([]Share, 0, len(weights))
+	for userID := range weights {
+		shares = append(shares, Share{UserID: userID})
+	}
+	sortShares(shares, payerID)
+
+	values := make([]float64, len(shares))
+	for i, share := range shares {
+		values[i] = weights[share.UserID]
+	}
+
+	parts, err := amount.Allocate(values)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка распределения суммы: %w", err)
+	}
+
+	for i := range shares {
+		shares[i].Value = parts[i]
+	}
+	return shares, nil
+}
+
+// allocateSharesByAmounts распределяет сумму между пользователями пропорционально
+// их суммам без перевода в float64. Порядок и tie-break такие же, как в allocateShares.
+func allocateSharesByAmounts(amount money.Money, weights map[int64]money.Money, payerID int64) ([]Share, error) {
+	shares := make([]Share, 0, len(weights))
+	for userID := range weights {
+		shares = append(shares, Share{UserID: userID})
+	}
+	sortShares(shares, payerID)
+
+	values := make([]money.Money, len(shares))
+	for i, share := range shares {
+		values[i] = weights[share.UserID]
+	}
+
+	parts, err := amount.AllocateByAmounts(values)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка распределения суммы: %w", err)
+	}
+
+	for i := range shares {
+		shares[i].Value = parts[i]
+	}
+	return shares, nil
+}
+
+// sortShares упорядочивает доли: сначала плательщик, затем по возрастанию ID пользователя
+func sortShares(shares []Share, payerID int64) {
+	sort.Slice(shares, func(i, j int) bool {
+		if (shares[i].UserID == payerID) != (shares[j].UserID == payerID) {
+			return shares[i].UserID == payerID
+		}
+		return shares[i].UserID < shares[j].UserID
+	})
+}
+
+// GetPayers возвращает список плательщиков транзакции.
+// Если плательщики не указаны явно, вся сумма считается оплаченной req.FromUser.
+// Плательщики упорядочены так же, как доли: сначала req.FromUser, затем по возрастанию ID.
+func GetPayers(req *service.TransactionRequest) ([]Payer, error) {
+	if len(req.Payers) == 0 {
+		return []Payer{{UserID: req.FromUser, Amount: req.Amount}}, nil
+	}
+
+	payers := make([]Payer, 0, len(req.Payers))
+	seen := make(map[int64]struct{}, len(req.Payers))
+	var totalPaid money.Money
+	for _, p := range req.Payers {
+		if p.Amount <= 0 {
+			return nil, fmt.Errorf("сумма оплаты пользователя %d должна быть положительной", p.UserID)
+		}
+		if _, ok := seen[p.UserID]; ok {
+			return nil, fmt.Errorf("пользователь %d указан среди плательщиков несколько раз", p.UserID)
+		}
+		seen[p.UserID] = struct{}{}
+		totalPaid += p.Amount
+		payers = append(payers, Payer{UserID: p.UserID, Amount: p.Amount})
+	}
+
+	if totalPaid != req.Amount {
+		return nil, errors.New("сумма оплат плательщиков должна быть равна общей сумме")
+	}
+
+	sort.Slice(payers, func(i, j int) bool {
+		if (payers[i].UserID == req.FromUser) != (payers[j].UserID == req.FromUser) {
+			return payers[i].UserID == req.FromUser
+		}
+		return payers[i].UserID < payers[j].UserID
+	})
+	return payers, nil
+}
+
+// calculateDebts рассчитывает долги на основе долей и оплат.
+// Для каждого участника доля вычитается из оплаченной им суммы: участники с
+// отрицательным сальдо переводят недостающее участникам с положительным сальдо.
+// Должники и кредиторы обходятся в порядке долей и плательщиков, поэтому результат детерминирован.
+func calculateDebts(shares []Share, req *service.TransactionRequest) ([]Debt, error) {
+	payers, err := GetPayers(req)
+	if err != nil {
+		return nil, err
+	}
 
-	// Рассчитываем долги для каждого участника
+	type balance struct {
+		userID int64
+		amount money.Money
+	}
+
+	paid := make(map[int64]money.Money, len(payers))
+	for _, payer := range payers {
+		paid[payer.UserID] = payer.Amount
+	}
+
+	// Должники: участники, чья доля больше оплаченной суммы
+	debtors := make([]balance, 0, len(shares))
+	owed := make(map[int64]money.Money, len(shares))
 	for _, share := range shares {
-		// Пропускаем плательщика
-		if share.UserID == payerID {
-			continue
+		owed[share.UserID] = share.Value
+		if net := share.Value - paid[share.UserID]; net > 0 {
+			debtors = append(debtors, balance{userID: share.UserID, amount: net})
+		}
+	}
+
+	// Кредиторы: плательщики, оплатившие больше своей доли
+	creditors := make([]balance, 0, len(payers))
+	for _, payer := range payers {
+		if net := payer.Amount - owed[payer.UserID]; net > 0 {
+			creditors = append(creditors, balance{userID: payer.UserID, amount: net})
+		}
+	}
+
+	debts := make([]Debt, 0, len(debtors))
+	for i, j := 0, 0; i < len(debtors) && j < len(creditors); {
+		amount := debtors[i].amount
+		if creditors[j].amount < amount {
+			amount = creditors[j].amount
 		}
 
-		// Создаем долг только если сумма больше нуля
-		if share.Value > 0 {
-			debts = append(debts, Debt{
-				FromUserID: share.UserID,
-				ToUserID:   payerID,
-				Amount:     share.Value,
-			})
+		debts = append(debts, Debt{
+			FromUserID: debtors[i].userID,
+			ToUserID:   creditors[j].userID,
+			Amount:     amount,
+		})
+
+		debtors[i].amount -= amount
+		creditors[j].amount -= amount
+		if debtors[i].amount == 0 {
+			i++
+		}
+		if creditors[j].amount == 0 {
+			j++
 		}
 	}
 
-	return debts
+	return debts, nil
 }
@@ -0,0 +1,313 @@
+package debt_calculator
+
+import (
+	"testing"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sumShares(shares []Share) money.Money {
+	var total money.Money
+	for _, share := range shares {
+		total += share.Value
+	}
+	return total
+}
+
+func TestPercentStrategy_Calculate(t *testing.T) {
+	t.Run("остаток копеек достается плательщику", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(100),
+			FromUser: 2,
+			Portion:  map[string]float64{"3": 33.33, "1": 33.33, "2": 33.34},
+		}
+
+		shares, debts, err := (&PercentStrategy{}).Calculate(req, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []Share{
+			{UserID: 2, Value: money.FromMinor(3334)},
+			{UserID: 1, Value: money.FromMinor(3333)},
+			{UserID: 3, Value: money.FromMinor(3333)},
+		}, shares)
+		assert.Equal(t, req.Amount, sumShares(shares))
+		assert.Len(t, debts, 2)
+	})
+
+	t.Run("неверная сумма процентов", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(100),
+			FromUser: 1,
+			Portion:  map[string]float64{"1": 50, "2": 40},
+		}
+
+		_, _, err := (&PercentStrategy{}).Calculate(req, 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestUnitsStrategy_Calculate(t *testing.T) {
+	t.Run("равные доли на троих", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(100),
+			FromUser: 3,
+			Users:    []int64{1, 2, 3},
+		}
+
+		// Результат не должен зависеть от порядка обхода map
+		for i := 0; i < 20; i++ {
+			shares, debts, err := (&UnitsStrategy{}).Calculate(req, 1)
+			require.NoError(t, err)
+			assert.Equal(t, []Share{
+				{UserID: 3, Value: money.FromMinor(3334)},
+				{UserID: 1, Value: money.FromMinor(3333)},
+				{UserID: 2, Value: money.FromMinor(3333)},
+			}, shares)
+			assert.Equal(t, []Debt{
+				{FromUserID: 1, ToUserID: 3, Amount: money.FromMinor(3333)},
+				{FromUserID: 2, ToUserID: 3, Amount: money.FromMinor(3333)},
+			}, debts)
+		}
+	})
+
+	t.Run("сумма долей равна общей сумме", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(10.01),
+			FromUser: 1,
+			Users:    []int64{1, 2, 3, 4, 5, 6, 7},
+			Portion:  map[string]float64{"2": 3},
+		}
+
+		shares, _, err := (&UnitsStrategy{}).Calculate(req, 1)
+		require.NoError(t, err)
+		assert.Equal(t, req.Amount, sumShares(shares))
+	})
+}
+
+func TestAmountStrategy_Calculate(t *testing.T) {
+	req := &service.TransactionRequest{
+		Amount:   money.FromFloat(10),
+		FromUser: 2,
+		Amounts:  map[string]money.Money{"1": money.FromMinor(333), "2": money.FromMinor(334), "3": money.FromMinor(333)},
+	}
+
+	shares, debts, err := (&AmountStrategy{}).Calculate(req, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), shares[0].UserID)
+	assert.Equal(t, req.Amount, sumShares(shares))
+	assert.Equal(t, []Debt{
+		{FromUserID: 1, ToUserID: 2, Amount: money.FromMinor(333)},
+		{FromUserID: 3, ToUserID: 2, Amount: money.FromMinor(333)},
+	}, debts)
+
+	t.Run("суммы не указаны", func(t *testing.T) {
+		_, _, err := (&AmountStrategy{}).Calculate(&service.TransactionRequest{
+			Amount:   money.FromFloat(10),
+			FromUser: 2,
+			Portion:  map[string]float64{"1": 10},
+		}, 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestCalculate_MultiplePayers(t *testing.T) {
+	t.Run("долги учитывают оплату каждого плательщика", func(t *testing.T) {
+		// 1 оплатил отель картой 600, 2 доплатил наличными 300, делим на троих
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(900),
+			FromUser: 1,
+			Users:    []int64{1, 2, 3},
+			Payers: []service.PayerDTO{
+				{UserID: 2, Amount: money.FromFloat(300)},
+				{UserID: 1, Amount: money.FromFloat(600)},
+			},
+		}
+
+		shares, debts, err := (&UnitsStrategy{}).Calculate(req, 1)
+		require.NoError(t, err)
+		assert.Equal(t, req.Amount, sumShares(shares))
+		assert.Equal(t, []Debt{
+			{FromUserID: 3, ToUserID: 1, Amount: money.FromFloat(300)},
+		}, debts)
+	})
+
+	t.Run("должник расплачивается с несколькими кредиторами", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(100),
+			FromUser: 1,
+			Amounts:  map[string]money.Money{"1": money.FromFloat(10), "2": money.FromFloat(10), "3": money.FromFloat(80)},
+			Payers: []service.PayerDTO{
+				{UserID: 1, Amount: money.FromFloat(60)},
+				{UserID: 2, Amount: money.FromFloat(40)},
+			},
+		}
+
+		_, debts, err := (&AmountStrategy{}).Calculate(req, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []Debt{
+			{FromUserID: 3, ToUserID: 1, Amount: money.FromFloat(50)},
+			{FromUserID: 3, ToUserID: 2, Amount: money.FromFloat(30)},
+		}, debts)
+	})
+
+	t.Run("сумма оплат не совпадает с общей суммой", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(100),
+			FromUser: 1,
+			Users:    []int64{1, 2},
+			Payers: []service.PayerDTO{
+				{UserID: 1, Amount: money.FromFloat(60)},
+				{UserID: 2, Amount: money.FromFloat(30)},
+			},
+		}
+
+		_, _, err := (&UnitsStrategy{}).Calculate(req, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("повторяющийся плательщик", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(100),
+			FromUser: 1,
+			Payers: []service.PayerDTO{
+				{UserID: 1, Amount: money.FromFloat(50)},
+				{UserID: 1, Amount: money.FromFloat(50)},
+			},
+		}
+
+		_, err := GetPayers(req)
+		assert.Error(t, err)
+	})
+}
+
+func TestEqualStrategy_Calculate(t *testing.T) {
+	calculator, err := GetCalculator(EqualType)
+	require.NoError(t, err)
+
+	t.Run("поровну на всех участников", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(100),
+			FromUser: 2,
+			Users:    []int64{3, 1, 2},
+		}
+
+		shares, debts, err := calculator.Calculate(req, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []Share{
+			{UserID: 2, Value: money.FromMinor(3334)},
+			{UserID: 1, Value: money.FromMinor(3333)},
+			{UserID: 3, Value: money.FromMinor(3333)},
+		}, shares)
+		assert.Equal(t, []Debt{
+			{FromUserID: 1, ToUserID: 2, Amount: money.FromMinor(3333)},
+			{FromUserID: 3, ToUserID: 2, Amount: money.FromMinor(3333)},
+		}, debts)
+	})
+
+	t.Run("без плательщика", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:       money.FromFloat(100),
+			FromUser:     1,
+			Users:        []int64{1, 2, 3},
+			ExcludePayer: true,
+		}
+
+		shares, debts, err := calculator.Calculate(req, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []Share{
+			{UserID: 2, Value: money.FromFloat(50)},
+			{UserID: 3, Value: money.FromFloat(50)},
+		}, shares)
+		assert.Equal(t, []Debt{
+			{FromUserID: 2, ToUserID: 1, Amount: money.FromFloat(50)},
+			{FromUserID: 3, ToUserID: 1, Amount: money.FromFloat(50)},
+		}, debts)
+	})
+
+	t.Run("нет участников", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:       money.FromFloat(100),
+			FromUser:     1,
+			Users:        []int64{1},
+			ExcludePayer: true,
+		}
+
+		_, _, err := calculator.Calculate(req, 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestItemsStrategy_Calculate(t *testing.T) {
+	calculator, err := GetCalculator(ItemsType)
+	require.NoError(t, err)
+
+	t.Run("позиции и чаевые делятся по потреблению", func(t *testing.T) {
+		// 1 заплатил за ужин: пицца на двоих, вино только для 2, чаевые 10% общие
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(110),
+			FromUser: 1,
+			Items: []service.ItemDTO{
+				{Name: "Пицца", Price: money.FromFloat(30), Quantity: 2, Consumers: []int64{1, 2}},
+				{Name: "Вино", Price: money.FromFloat(40), Consumers: []int64{2}},
+			},
+			Charges: []service.ChargeDTO{
+				{Name: "Чаевые", Amount: money.FromFloat(10)},
+			},
+		}
+
+		shares, debts, err := calculator.Calculate(req, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []Share{
+			{UserID: 1, Value: money.FromFloat(33)},
+			{UserID: 2, Value: money.FromFloat(77)},
+		}, shares)
+		assert.Equal(t, []Debt{
+			{FromUserID: 2, ToUserID: 1, Amount: money.FromFloat(77)},
+		}, debts)
+	})
+
+	t.Run("копейки не теряются при делении позиций и надбавок", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(10.01),
+			FromUser: 3,
+			Items: []service.ItemDTO{
+				{Name: "Кофе", Price: money.FromFloat(10), Consumers: []int64{1, 2, 3}},
+			},
+			Charges: []service.ChargeDTO{
+				{Name: "Сервис", Amount: money.FromMinor(1)},
+			},
+		}
+
+		shares, _, err := calculator.Calculate(req, 1)
+		require.NoError(t, err)
+		assert.Equal(t, req.Amount, sumShares(shares))
+	})
+
+	t.Run("сумма позиций не совпадает с общей суммой", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(100),
+			FromUser: 1,
+			Items: []service.ItemDTO{
+				{Name: "Пицца", Price: money.FromFloat(30), Consumers: []int64{1, 2}},
+			},
+		}
+
+		_, _, err := calculator.Calculate(req, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("позиция без потребителей", func(t *testing.T) {
+		req := &service.TransactionRequest{
+			Amount:   money.FromFloat(30),
+			FromUser: 1,
+			Items: []service.ItemDTO{
+				{Name: "Пицца", Price: money.FromFloat(30)},
+			},
+		}
+
+		_, _, err := calculator.Calculate(req, 1)
+		assert.Error(t, err)
+	})
+}
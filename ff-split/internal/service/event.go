@@ -2,16 +2,22 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 )
 
 // EventRequest представляет DTO для запроса создания/обновления мероприятия
 type EventRequest struct {
-	Name        string          `json:"name" binding:"required"`
-	Description string          `json:"description"`
-	CategoryID  *int            `json:"category_id,omitempty"`
-	Members     EventMembersDTO `json:"members"`
+	Name                  string          `json:"name" binding:"required"`
+	Description           string          `json:"description"`
+	CategoryID            *int            `json:"category_id,omitempty"`
+	Currency              string          `json:"currency,omitempty"`
+	OptimizationAlgorithm string          `json:"optimization_algorithm,omitempty"`
+	Members               EventMembersDTO `json:"members"`
+	// CreatorID - внешний ID создателя мероприятия, он становится владельцем
+	CreatorID *int64 `json:"-"`
 }
 
 // EventMembersDTO представляет DTO для передачи данных о членах мероприятия
@@ -22,12 +28,17 @@ type EventMembersDTO struct {
 
 // EventResponse представляет DTO для ответа с данными мероприятия
 type EventResponse struct {
-	ID          int64  `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	CategoryID  *int   `json:"category_id,omitempty"`
-	PhotoID     string `json:"photo_id,omitempty"`
-	Balance     *int   `json:"balance,omitempty"`
+	ID                    int64        `json:"id"`
+	Name                  string       `json:"name"`
+	Description           string       `json:"description,omitempty"`
+	CategoryID            *int         `json:"category_id,omitempty"`
+	PhotoID               string       `json:"photo_id,omitempty"`
+	Currency              string       `json:"currency,omitempty"`
+	OptimizationAlgorithm string       `json:"optimization_algorithm,omitempty"`
+	Status                string       `json:"status,omitempty"`
+	Balance               *money.Money `json:"balance,omitempty"`
+	// DeletedAt - время переноса в корзину, заполняется только для удаленных мероприятий
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 // EventListResponse представляет DTO для ответа со списком мероприятий
@@ -38,10 +49,21 @@ type EventListResponse struct {
 // Event определяет методы для работы с мероприятиями
 type Event interface {
 	GetEvents(ctx context.Context) ([]models.Event, error)
-	GetEventsByUserID(ctx context.Context, userID int64) ([]EventResponse, error)
-	GetBalanceByEventID(ctx context.Context, userID int64, eventID int64) (float64, error)
+	// GetEventsByUserID возвращает мероприятия пользователя с балансами; архивные - только если includeArchived
+	GetEventsByUserID(ctx context.Context, userID int64, includeArchived bool) ([]EventResponse, error)
+	GetBalanceByEventID(ctx context.Context, userID int64, eventID int64) (money.Money, error)
 	GetEventByID(ctx context.Context, id int64) (*models.Event, error)
+	// CheckAccess проверяет, что пользователь (по внешнему ID) состоит в мероприятии, и возвращает его участие с ролью
+	CheckAccess(ctx context.Context, eventID int64, externalUserID int64) (*models.UserEvent, error)
 	CreateEvent(ctx context.Context, request *EventRequest) (*EventResponse, error)
 	UpdateEvent(ctx context.Context, id int64, request *EventRequest) (*EventResponse, error)
+	// DeleteEvent переносит мероприятие в корзину
 	DeleteEvent(ctx context.Context, id int64) error
+	// GetDeletedEventsByUserID возвращает мероприятия пользователя (по внутреннему ID) из корзины
+	GetDeletedEventsByUserID(ctx context.Context, userID int64) ([]EventResponse, error)
+	// RestoreEvent возвращает мероприятие из корзины; восстановить мероприятие может только его владелец
+	RestoreEvent(ctx context.Context, id int64, externalUserID int64) (*EventResponse, error)
+	// ChangeStatus переводит мероприятие в другой статус жизненного цикла.
+	// Закрытие с финальной оптимизацией долгов выполняет Transaction.CloseEvent.
+	ChangeStatus(ctx context.Context, id int64, status string) (*models.Event, error)
 }
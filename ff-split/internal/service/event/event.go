@@ -3,14 +3,23 @@ package event
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 
 	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 
 	"gorm.io/gorm"
 
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
 	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/activity"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/currency"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/lifecycle"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/optimizer"
 )
 
 // EventService реализует интерфейс service.Event
@@ -19,15 +28,23 @@ type EventService struct {
 	userService     service.User
 	categoryService service.Category
 	repo            repository.Event
+	activities      service.ActivityRecorder
 }
 
 // NewEventService создает новый экземпляр EventService
-func NewEventService(repo repository.Event, dbImpl *gorm.DB, userService service.User, categoryService service.Category) *EventService {
+func NewEventService(
+	repo repository.Event,
+	dbImpl *gorm.DB,
+	userService service.User,
+	categoryService service.Category,
+	activities service.ActivityRecorder,
+) *EventService {
 	return &EventService{
 		repo:            repo,
 		db:              dbImpl,
 		userService:     userService,
 		categoryService: categoryService,
+		activities:      activities,
 	}
 }
 
@@ -36,9 +53,10 @@ func (s *EventService) GetEvents(ctx context.Context) ([]models.Event, error) {
 	return s.repo.GetAll(ctx)
 }
 
-// GetEventsByUserID получает мероприятия пользователя с балансами
-func (s *EventService) GetEventsByUserID(ctx context.Context, userID int64) ([]service.EventResponse, error) {
-	events, err := s.repo.GetByUserID(ctx, userID)
+// GetEventsByUserID получает мероприятия пользователя с балансами.
+// Архивные мероприятия возвращаются, только если includeArchived.
+func (s *EventService) GetEventsByUserID(ctx context.Context, userID int64, includeArchived bool) ([]service.EventResponse, error) {
+	events, err := s.repo.GetByUserID(ctx, userID, includeArchived)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при получении мероприятий пользователя: %w", err)
 	}
@@ -62,15 +80,17 @@ func (s *EventService) GetEventsByUserID(ctx context.Context, userID int64) ([]s
 	// Преобразуем события в ответы с балансами
 	responses := make([]service.EventResponse, len(events))
 	for i, event := range events {
-		balanceFloat := balances[event.ID]
-		balanceInt := int(balanceFloat)
+		balance := balances[event.ID]
 		responses[i] = service.EventResponse{
-			ID:          event.ID,
-			Name:        event.Name,
-			Description: event.Description,
-			CategoryID:  event.CategoryID,
-			PhotoID:     event.ImageID,
-			Balance:     &balanceInt,
+			ID:                    event.ID,
+			Name:                  event.Name,
+			Description:           event.Description,
+			CategoryID:            event.CategoryID,
+			PhotoID:               event.ImageID,
+			Currency:              event.Currency,
+			Balance:               &balance,
+			OptimizationAlgorithm: event.OptimizationAlgorithm,
+			Status:                event.Status,
 		}
 	}
 
@@ -78,16 +98,16 @@ func (s *EventService) GetEventsByUserID(ctx context.Context, userID int64) ([]s
 }
 
 // GetBalanceByEventID рассчитывает баланс пользователя по конкретному событию
-func (s *EventService) GetBalanceByEventID(ctx context.Context, userID int64, eventID int64) (float64, error) {
+func (s *EventService) GetBalanceByEventID(ctx context.Context, userID int64, eventID int64) (money.Money, error) {
 	eventIDs := []int64{eventID}
 	balances, err := s.repo.CalculateUserBalances(ctx, userID, eventIDs)
 	if err != nil {
-		return 0, fmt.Errorf("ошибка при расчете баланса: %w", err)
+		return money.Zero, fmt.Errorf("ошибка при расчете баланса: %w", err)
 	}
 
 	balance, exists := balances[eventID]
 	if !exists {
-		return 0, nil
+		return money.Zero, nil
 	}
 
 	return balance, nil
@@ -98,8 +118,34 @@ func (s *EventService) GetEventByID(ctx context.Context, id int64) (*models.Even
 	return s.repo.GetByID(ctx, id)
 }
 
+// CheckAccess проверяет, что пользователь (по внешнему ID) состоит в мероприятии, и возвращает его участие с ролью.
+// Для несуществующего мероприятия возвращает EntityNotFoundError, для постороннего пользователя - ForbiddenError.
+func (s *EventService) CheckAccess(ctx context.Context, eventID int64, externalUserID int64) (*models.UserEvent, error) {
+	event, err := s.repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении мероприятия: %w", err)
+	}
+	if event == nil {
+		return nil, customErrors.NewEntityNotFoundError(strconv.FormatInt(eventID, 10), "event")
+	}
+
+	member, err := s.repo.GetMemberByExternalUserID(ctx, eventID, externalUserID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при проверке доступа к мероприятию: %w", err)
+	}
+	if member == nil {
+		return nil, customErrors.NewForbiddenError("пользователь не является участником мероприятия")
+	}
+
+	return member, nil
+}
+
 // CreateEvent создает новое мероприятие
 func (s *EventService) CreateEvent(ctx context.Context, request *service.EventRequest) (*service.EventResponse, error) {
+	// Создатель всегда становится участником, а затем и владельцем мероприятия
+	if request.CreatorID != nil && !slices.Contains(request.Members.UserIDs, *request.CreatorID) {
+		request.Members.UserIDs = append(request.Members.UserIDs, *request.CreatorID)
+	}
 
 	if request.Members.UserIDs != nil {
 		notExistsUsers, err := s.userService.GetNotExistsUsers(ctx, request.Members.UserIDs)
@@ -121,15 +167,27 @@ func (s *EventService) CreateEvent(ctx context.Context, request *service.EventRe
 		categoryID = request.CategoryID
 	}
 
+	eventCurrency, err := currency.Normalize(request.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	algorithm, err := optimizer.Normalize(request.OptimizationAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
 	// Преобразуем DTO в модель
 	event := &models.Event{
-		Name:        request.Name,
-		Description: request.Description,
-		CategoryID:  categoryID,
-		Status:      "active", // Статус по умолчанию
+		Name:                  request.Name,
+		Description:           request.Description,
+		CategoryID:            categoryID,
+		Status:                models.EventStatusActive,
+		Currency:              eventCurrency,
+		OptimizationAlgorithm: algorithm,
 	}
 
-	err := db.WithTx(ctx, s.db, func(ctx context.Context) error {
+	err = db.WithTx(ctx, s.db, func(ctx context.Context) error {
 		// Создаем мероприятие
 		var err error
 		err = s.repo.Create(ctx, event)
@@ -158,6 +216,16 @@ func (s *EventService) CreateEvent(ctx context.Context, request *service.EventRe
 		if err != nil {
 			return fmt.Errorf("ошибка при добавлении пользователей в мероприятие: %w", err)
 		}
+
+		if request.CreatorID != nil {
+			creator, err := s.userService.GetUserByExternalUserID(ctx, *request.CreatorID)
+			if err != nil {
+				return fmt.Errorf("ошибка при получении создателя мероприятия: %w", err)
+			}
+			if err := s.userService.TransferEventOwnership(ctx, event.ID, creator.ID); err != nil {
+				return fmt.Errorf("ошибка при назначении владельца мероприятия: %w", err)
+			}
+		}
 		return nil
 	})
 	if err != nil {
@@ -165,21 +233,30 @@ func (s *EventService) CreateEvent(ctx context.Context, request *service.EventRe
 	}
 
 	// Заглушка для баланса
-	var balance *int = nil
+	var balance *money.Money = nil
 	// Здесь будет расчет баланса в будущем
 
 	return &service.EventResponse{
-		ID:          event.ID,
-		Name:        event.Name,
-		Description: event.Description,
-		CategoryID:  event.CategoryID,
-		PhotoID:     event.ImageID,
-		Balance:     balance,
+		ID:                    event.ID,
+		Name:                  event.Name,
+		Description:           event.Description,
+		CategoryID:            event.CategoryID,
+		PhotoID:               event.ImageID,
+		Currency:              event.Currency,
+		Balance:               balance,
+		OptimizationAlgorithm: event.OptimizationAlgorithm,
+		Status:                event.Status,
 	}, nil
 }
 
-// UpdateEvent обновляет мероприятие
+// UpdateEvent обновляет мероприятие.
+// Базовая валюта не меняется: долги уже пересчитаны в нее по курсам на момент ввода.
+// Алгоритм оптимизации меняется, только если он указан в запросе.
 func (s *EventService) UpdateEvent(ctx context.Context, id int64, request *service.EventRequest) (*service.EventResponse, error) {
+	if err := access.Require(ctx, id, access.EditEvent); err != nil {
+		return nil, err
+	}
+
 	// Преобразуем DTO в модель
 	event := &models.Event{
 		Name:        request.Name,
@@ -187,6 +264,14 @@ func (s *EventService) UpdateEvent(ctx context.Context, id int64, request *servi
 		CategoryID:  request.CategoryID,
 	}
 
+	if request.OptimizationAlgorithm != "" {
+		algorithm, err := optimizer.Normalize(request.OptimizationAlgorithm)
+		if err != nil {
+			return nil, err
+		}
+		event.OptimizationAlgorithm = algorithm
+	}
+
 	err := db.WithTx(ctx, s.db, func(ctx context.Context) error {
 		err := s.repo.Update(ctx, id, event)
 		if err != nil {
@@ -199,21 +284,26 @@ func (s *EventService) UpdateEvent(ctx context.Context, id int64, request *servi
 	}
 
 	// Заглушка для баланса
-	var balance *int = nil
+	var balance *money.Money = nil
 	// Здесь будет расчет баланса в будущем
 
 	return &service.EventResponse{
-		ID:          event.ID,
-		Name:        event.Name,
-		Description: event.Description,
-		CategoryID:  event.CategoryID,
-		PhotoID:     event.ImageID,
-		Balance:     balance,
+		ID:                    event.ID,
+		Name:                  event.Name,
+		Description:           event.Description,
+		CategoryID:            event.CategoryID,
+		PhotoID:               event.ImageID,
+		Balance:               balance,
+		OptimizationAlgorithm: event.OptimizationAlgorithm,
 	}, nil
 }
 
-// DeleteEvent удаляет мероприятие
+// DeleteEvent переносит мероприятие в корзину. Окончательно его удаляет очистка корзины после срока хранения
 func (s *EventService) DeleteEvent(ctx context.Context, id int64) error {
+	if err := access.Require(ctx, id, access.DeleteEvent); err != nil {
+		return err
+	}
+
 	return db.WithTx(ctx, s.db, func(ctx context.Context) error {
 		err := s.repo.Delete(ctx, id)
 		if err != nil {
@@ -222,3 +312,40 @@ func (s *EventService) DeleteEvent(ctx context.Context, id int64) error {
 		return nil
 	})
 }
+
+// ChangeStatus переводит мероприятие в другой статус жизненного цикла.
+// Переходы вперед доступны при праве редактирования мероприятия, возобновление - только владельцу.
+func (s *EventService) ChangeStatus(ctx context.Context, id int64, status string) (*models.Event, error) {
+	if err := access.Require(ctx, id, lifecycle.RequiredPermission(status)); err != nil {
+		return nil, err
+	}
+
+	event, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении мероприятия: %w", err)
+	}
+	if event == nil {
+		return nil, customErrors.NewEntityNotFoundError(strconv.FormatInt(id, 10), "event")
+	}
+
+	if err := lifecycle.CheckTransition(event.Status, status); err != nil {
+		return nil, err
+	}
+
+	err = db.WithTx(ctx, s.db, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, id, &models.Event{Status: status}); err != nil {
+			return fmt.Errorf("ошибка при смене статуса мероприятия: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	activity.Record(ctx, s.activities, id, models.ActivityTypeEventStatusChanged, models.EventStatusActivityPayload{
+		From: event.Status,
+		To:   status,
+	})
+	event.Status = status
+	return event, nil
+}
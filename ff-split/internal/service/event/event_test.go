@@ -7,8 +7,11 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
 	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -23,7 +26,7 @@ func TestEventService_GetEvents(t *testing.T) {
 	mockCategoryService := serviceMock.NewMockCategory(ctrl)
 	var db *gorm.DB // В реальных тестах можно использовать тестовую БД
 
-	eventService := NewEventService(mockEventRepo, db, mockUserService, mockCategoryService)
+	eventService := NewEventService(mockEventRepo, db, mockUserService, mockCategoryService, nil)
 
 	ctx := context.Background()
 
@@ -69,7 +72,7 @@ func TestEventService_GetEventByID(t *testing.T) {
 	mockCategoryService := serviceMock.NewMockCategory(ctrl)
 	var db *gorm.DB
 
-	eventService := NewEventService(mockEventRepo, db, mockUserService, mockCategoryService)
+	eventService := NewEventService(mockEventRepo, db, mockUserService, mockCategoryService, nil)
 
 	ctx := context.Background()
 	eventID := int64(1)
@@ -118,15 +121,15 @@ func TestEventService_GetBalanceByEventID(t *testing.T) {
 	mockCategoryService := serviceMock.NewMockCategory(ctrl)
 	var db *gorm.DB
 
-	eventService := NewEventService(mockEventRepo, db, mockUserService, mockCategoryService)
+	eventService := NewEventService(mockEventRepo, db, mockUserService, mockCategoryService, nil)
 
 	ctx := context.Background()
 	userID := int64(100)
 	eventID := int64(1)
 
 	t.Run("успешное получение баланса", func(t *testing.T) {
-		balances := map[int64]float64{
-			eventID: 150.50,
+		balances := map[int64]money.Money{
+			eventID: money.FromMinor(15050),
 		}
 
 		mockEventRepo.EXPECT().
@@ -137,11 +140,11 @@ func TestEventService_GetBalanceByEventID(t *testing.T) {
 		result, err := eventService.GetBalanceByEventID(ctx, userID, eventID)
 
 		assert.NoError(t, err)
-		assert.Equal(t, 150.50, result)
+		assert.Equal(t, money.FromMinor(15050), result)
 	})
 
 	t.Run("баланс не найден", func(t *testing.T) {
-		balances := map[int64]float64{}
+		balances := map[int64]money.Money{}
 
 		mockEventRepo.EXPECT().
 			CalculateUserBalances(ctx, userID, []int64{eventID}).
@@ -151,7 +154,7 @@ func TestEventService_GetBalanceByEventID(t *testing.T) {
 		result, err := eventService.GetBalanceByEventID(ctx, userID, eventID)
 
 		assert.NoError(t, err)
-		assert.Equal(t, 0.0, result)
+		assert.Equal(t, money.Zero, result)
 	})
 
 	t.Run("ошибка расчета баланса", func(t *testing.T) {
@@ -164,11 +167,43 @@ func TestEventService_GetBalanceByEventID(t *testing.T) {
 		result, err := eventService.GetBalanceByEventID(ctx, userID, eventID)
 
 		assert.Error(t, err)
-		assert.Equal(t, 0.0, result)
+		assert.Equal(t, money.Zero, result)
 		assert.Contains(t, err.Error(), "ошибка при расчете баланса")
 	})
 }
 
+func TestEventService_GetEventsByUserID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventRepo := repositoryMock.NewMockEvent(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockCategoryService := serviceMock.NewMockCategory(ctrl)
+	var db *gorm.DB
+
+	eventService := NewEventService(mockEventRepo, db, mockUserService, mockCategoryService, nil)
+
+	ctx := context.Background()
+	userID := int64(100)
+
+	t.Run("баланс возвращается с копейками", func(t *testing.T) {
+		mockEventRepo.EXPECT().
+			GetByUserID(ctx, userID, false).
+			Return([]models.Event{{ID: 1, Name: "Поездка"}, {ID: 2, Name: "Ужин"}}, nil)
+		mockEventRepo.EXPECT().
+			CalculateUserBalances(ctx, userID, []int64{1, 2}).
+			Return(map[int64]money.Money{1: money.FromMinor(-15050), 2: money.FromMinor(0)}, nil)
+
+		result, err := eventService.GetEventsByUserID(ctx, userID, false)
+
+		assert.NoError(t, err)
+		if assert.Len(t, result, 2) {
+			assert.Equal(t, money.FromMinor(-15050), *result[0].Balance)
+			assert.Equal(t, money.FromMinor(0), *result[1].Balance)
+		}
+	})
+}
+
 func TestEventService_DeleteEvent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -183,7 +218,7 @@ func TestEventService_DeleteEvent(t *testing.T) {
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockCategoryService := serviceMock.NewMockCategory(ctrl)
 
-	eventService := NewEventService(mockEventRepo, testDB, mockUserService, mockCategoryService)
+	eventService := NewEventService(mockEventRepo, testDB, mockUserService, mockCategoryService, nil)
 
 	ctx := context.Background()
 	eventID := int64(1)
@@ -211,5 +246,163 @@ func TestEventService_DeleteEvent(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "Ошибка при удалении мероприятия")
 	})
+	t.Run("администратор не может удалить мероприятие", func(t *testing.T) {
+		adminCtx := access.WithMember(ctx, &models.UserEvent{UserID: 10, EventID: eventID, Role: models.EventRoleAdmin})
+
+		err := eventService.DeleteEvent(adminCtx, eventID)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("владелец удаляет мероприятие", func(t *testing.T) {
+		ownerCtx := access.WithMember(ctx, &models.UserEvent{UserID: 10, EventID: eventID, Role: models.EventRoleOwner})
+		mockEventRepo.EXPECT().
+			Delete(gomock.Any(), eventID).
+			Return(nil).
+			Times(1)
+
+		err := eventService.DeleteEvent(ownerCtx, eventID)
+
+		assert.NoError(t, err)
+	})
+}
+
+
+func TestEventService_CheckAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventRepo := repositoryMock.NewMockEvent(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockCategoryService := serviceMock.NewMockCategory(ctrl)
+	var db *gorm.DB
+
+	eventService := NewEventService(mockEventRepo, db, mockUserService, mockCategoryService, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	externalUserID := int64(100)
+
+	t.Run("участник мероприятия", func(t *testing.T) {
+		member := &models.UserEvent{UserID: 10, EventID: eventID, Role: models.EventRoleMember}
+		mockEventRepo.EXPECT().GetByID(ctx, eventID).Return(&models.Event{ID: eventID}, nil)
+		mockEventRepo.EXPECT().GetMemberByExternalUserID(ctx, eventID, externalUserID).Return(member, nil)
+
+		result, err := eventService.CheckAccess(ctx, eventID, externalUserID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, member, result)
+	})
+
+	t.Run("посторонний пользователь", func(t *testing.T) {
+		mockEventRepo.EXPECT().GetByID(ctx, eventID).Return(&models.Event{ID: eventID}, nil)
+		mockEventRepo.EXPECT().GetMemberByExternalUserID(ctx, eventID, externalUserID).Return(nil, nil)
+
+		_, err := eventService.CheckAccess(ctx, eventID, externalUserID)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("мероприятие не найдено", func(t *testing.T) {
+		mockEventRepo.EXPECT().GetByID(ctx, eventID).Return(nil, nil)
+
+		_, err := eventService.CheckAccess(ctx, eventID, externalUserID)
+
+		var notFoundErr *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("ошибка проверки участия", func(t *testing.T) {
+		expectedErr := errors.New("database error")
+		mockEventRepo.EXPECT().GetByID(ctx, eventID).Return(&models.Event{ID: eventID}, nil)
+		mockEventRepo.EXPECT().GetMemberByExternalUserID(ctx, eventID, externalUserID).Return(nil, expectedErr)
+
+		_, err := eventService.CheckAccess(ctx, eventID, externalUserID)
+
+		assert.ErrorIs(t, err, expectedErr)
+	})
 }
 
+func TestEventService_ChangeStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Создаем in-memory SQLite БД для тестов с транзакциями
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Ошибка создания тестовой БД: %v", err)
+	}
+
+	mockEventRepo := repositoryMock.NewMockEvent(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockCategoryService := serviceMock.NewMockCategory(ctrl)
+
+	eventService := NewEventService(mockEventRepo, testDB, mockUserService, mockCategoryService, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+
+	t.Run("перевод на этап расчетов", func(t *testing.T) {
+		mockEventRepo.EXPECT().GetByID(ctx, eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockEventRepo.EXPECT().
+			Update(gomock.Any(), eventID, &models.Event{Status: models.EventStatusSettling}).
+			Return(nil)
+
+		event, err := eventService.ChangeStatus(ctx, eventID, models.EventStatusSettling)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.EventStatusSettling, event.Status)
+	})
+
+	t.Run("недопустимый переход", func(t *testing.T) {
+		mockEventRepo.EXPECT().GetByID(ctx, eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+
+		_, err := eventService.ChangeStatus(ctx, eventID, models.EventStatusArchived)
+
+		var logicErr *customErrors.LogicError
+		assert.ErrorAs(t, err, &logicErr)
+	})
+
+	t.Run("администратор не может возобновить мероприятие", func(t *testing.T) {
+		adminCtx := access.WithMember(ctx, &models.UserEvent{UserID: 10, EventID: eventID, Role: models.EventRoleAdmin})
+
+		_, err := eventService.ChangeStatus(adminCtx, eventID, models.EventStatusActive)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("владелец возобновляет архивное мероприятие", func(t *testing.T) {
+		ownerCtx := access.WithMember(ctx, &models.UserEvent{UserID: 10, EventID: eventID, Role: models.EventRoleOwner})
+		mockEventRepo.EXPECT().GetByID(ownerCtx, eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusArchived}, nil)
+		mockEventRepo.EXPECT().
+			Update(gomock.Any(), eventID, &models.Event{Status: models.EventStatusActive}).
+			Return(nil)
+
+		event, err := eventService.ChangeStatus(ownerCtx, eventID, models.EventStatusActive)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.EventStatusActive, event.Status)
+	})
+	t.Run("смена статуса записывается в ленту активности", func(t *testing.T) {
+		mockActivities := serviceMock.NewMockActivity(ctrl)
+		recordingService := NewEventService(mockEventRepo, testDB, mockUserService, mockCategoryService, mockActivities)
+
+		mockEventRepo.EXPECT().GetByID(ctx, eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusSettling}, nil)
+		mockEventRepo.EXPECT().
+			Update(gomock.Any(), eventID, &models.Event{Status: models.EventStatusClosed}).
+			Return(nil)
+		mockActivities.EXPECT().
+			RecordActivity(ctx, eventID, models.ActivityTypeEventStatusChanged, models.EventStatusActivityPayload{
+				From: models.EventStatusSettling,
+				To:   models.EventStatusClosed,
+			}).
+			Return(nil)
+
+		_, err := recordingService.ChangeStatus(ctx, eventID, models.EventStatusClosed)
+
+		assert.NoError(t, err)
+	})
+}
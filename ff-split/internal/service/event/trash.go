@@ -0,0 +1,77 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/activity"
+)
+
+// GetDeletedEventsByUserID возвращает мероприятия пользователя из корзины
+func (s *EventService) GetDeletedEventsByUserID(ctx context.Context, userID int64) ([]service.EventResponse, error) {
+	events, err := s.repo.GetDeletedByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении удаленных мероприятий: %w", err)
+	}
+
+	responses := make([]service.EventResponse, len(events))
+	for i := range events {
+		responses[i] = mapEventToResponse(&events[i])
+	}
+	return responses, nil
+}
+
+// RestoreEvent возвращает мероприятие из корзины.
+// Мероприятие в корзине недоступно через проверку доступа к мероприятию,
+// поэтому участие и роль пользователя проверяются здесь.
+func (s *EventService) RestoreEvent(ctx context.Context, id int64, externalUserID int64) (*service.EventResponse, error) {
+	member, err := s.repo.GetMemberByExternalUserID(ctx, id, externalUserID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, customErrors.NewEntityNotFoundError(strconv.FormatInt(id, 10), "event")
+	}
+
+	ctx = access.WithMember(ctx, member)
+	if err := access.Require(ctx, id, access.DeleteEvent); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Restore(ctx, id); err != nil {
+		return nil, fmt.Errorf("ошибка при восстановлении мероприятия: %w", err)
+	}
+
+	event, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении мероприятия: %w", err)
+	}
+	if event == nil {
+		return nil, customErrors.NewEntityNotFoundError(strconv.FormatInt(id, 10), "event")
+	}
+
+	activity.Record(ctx, s.activities, id, models.ActivityTypeEventRestored, nil)
+
+	response := mapEventToResponse(event)
+	return &response, nil
+}
+
+// mapEventToResponse преобразует модель мероприятия в DTO без баланса
+func mapEventToResponse(event *models.Event) service.EventResponse {
+	return service.EventResponse{
+		ID:                    event.ID,
+		Name:                  event.Name,
+		Description:           event.Description,
+		CategoryID:            event.CategoryID,
+		PhotoID:               event.ImageID,
+		Currency:              event.Currency,
+		OptimizationAlgorithm: event.OptimizationAlgorithm,
+		Status:                event.Status,
+		DeletedAt:             event.DeletedAt,
+	}
+}
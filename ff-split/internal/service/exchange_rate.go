@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// ExchangeRateDTO представляет DTO курса валюты
+type ExchangeRateDTO struct {
+	CurrencyFrom string    `json:"currency_from" binding:"required"`
+	CurrencyTo   string    `json:"currency_to" binding:"required"`
+	Rate         float64   `json:"rate" binding:"required"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// RateProvider определяет источник курсов валют.
+// GetRate возвращает, сколько единиц валюты to стоит одна единица валюты from.
+type RateProvider interface {
+	GetRate(ctx context.Context, from, to string) (float64, error)
+}
+
+// ExchangeRate определяет методы для работы с курсами валют
+type ExchangeRate interface {
+	RateProvider
+	GetRates(ctx context.Context) ([]ExchangeRateDTO, error)
+	SetRate(ctx context.Context, rate *ExchangeRateDTO) (*ExchangeRateDTO, error)
+	DeleteRate(ctx context.Context, from, to string) error
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"io"
+)
+
+// Форматы выгрузки мероприятия
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+	ExportFormatPDF  = "pdf"
+)
+
+// ExportFile представляет подготовленную выгрузку мероприятия
+type ExportFile struct {
+	FileName    string
+	ContentType string
+	// Write записывает файл в w. Данные мероприятия к этому моменту уже загружены,
+	// поэтому ошибка возможна только при формировании или передаче файла.
+	Write func(w io.Writer) error
+}
+
+// Export определяет методы выгрузки мероприятия в файл
+type Export interface {
+	// ExportEvent загружает транзакции с долями, долги, план переводов и итоги по участникам
+	// и подготавливает выгрузку в формате format (csv | xlsx | pdf)
+	ExportEvent(ctx context.Context, eventID int64, format string) (*ExportFile, error)
+}
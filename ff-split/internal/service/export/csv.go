@@ -0,0 +1,66 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+)
+
+// utf8BOM позволяет Excel определить кодировку CSV с кириллицей
+const utf8BOM = "\ufeff"
+
+// dateTimeLayout - формат даты и времени в выгрузке
+const dateTimeLayout = "2006-01-02 15:04"
+
+// writeCSV записывает выгрузку в CSV: таблицы следуют одна за другой,
+// каждая начинается со строки с названием и отделяется пустой строкой
+func (l *ledger) writeCSV(w io.Writer) error {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	records := [][]string{
+		{"Мероприятие", l.event.Name},
+		{"Валюта", l.event.Currency},
+		{},
+	}
+	for _, t := range l.tables() {
+		records = append(records, []string{t.title}, t.header)
+		for _, row := range t.rows {
+			record := make([]string, len(row))
+			for i, value := range row {
+				record[i] = formatCell(value)
+			}
+			records = append(records, record)
+		}
+		records = append(records, []string{})
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return fmt.Errorf("ошибка при формировании CSV: %w", err)
+	}
+	return nil
+}
+
+// formatCell возвращает текстовое представление значения ячейки
+func formatCell(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case money.Money:
+		return v.String()
+	case time.Time:
+		return v.Format(dateTimeLayout)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,169 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// transactionCategoryType - тип категорий транзакций
+const transactionCategoryType = "transaction"
+
+// contentTypes - MIME-типы поддерживаемых форматов выгрузки
+var contentTypes = map[string]string{
+	service.ExportFormatCSV:  "text/csv; charset=utf-8",
+	service.ExportFormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	service.ExportFormatPDF:  "application/pdf",
+}
+
+// ExportService реализует интерфейс service.Export
+type ExportService struct {
+	eventService       service.Event
+	userService        service.User
+	transactionService service.Transaction
+	analyticsService   service.Analytics
+	categoryService    service.Category
+}
+
+// NewExportService создает новый сервис выгрузки мероприятий
+func NewExportService(
+	eventService service.Event,
+	userService service.User,
+	transactionService service.Transaction,
+	analyticsService service.Analytics,
+	categoryService service.Category,
+) *ExportService {
+	return &ExportService{
+		eventService:       eventService,
+		userService:        userService,
+		transactionService: transactionService,
+		analyticsService:   analyticsService,
+		categoryService:    categoryService,
+	}
+}
+
+// ExportEvent загружает данные мероприятия и подготавливает выгрузку в формате format
+func (s *ExportService) ExportEvent(ctx context.Context, eventID int64, format string) (*service.ExportFile, error) {
+	contentType, ok := contentTypes[format]
+	if !ok {
+		return nil, customErrors.NewValidationError("format", "допустимые значения: csv, xlsx, pdf")
+	}
+
+	l, err := s.loadLedger(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var write func(w io.Writer) error
+	switch format {
+	case service.ExportFormatCSV:
+		write = l.writeCSV
+	case service.ExportFormatXLSX:
+		write = l.writeXLSX
+	case service.ExportFormatPDF:
+		write = l.writePDF
+	}
+
+	return &service.ExportFile{
+		FileName:    fmt.Sprintf("event-%d.%s", eventID, format),
+		ContentType: contentType,
+		Write:       write,
+	}, nil
+}
+
+// loadLedger загружает все данные мероприятия, необходимые для выгрузки
+func (s *ExportService) loadLedger(ctx context.Context, eventID int64) (*ledger, error) {
+	event, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := s.transactionService.GetTransactionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	debts, err := s.transactionService.GetDebtsByEventID(ctx, eventID, nil)
+	if err != nil {
+		return nil, err
+	}
+	settlements, err := s.transactionService.GetSettlementsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	// Выгрузка только читает данные: устаревший план пересчитывается в памяти, но не сохраняется
+	plan, err := s.transactionService.GetCurrentOptimizedDebts(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := s.analyticsService.GetEventAnalytics(ctx, eventID, &service.AnalyticsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.categoryService.GetCategories(ctx, transactionCategoryType)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &ledger{
+		event:        event,
+		transactions: transactions,
+		debts:        debts,
+		settlements:  settlements,
+		plan:         plan,
+		totals:       totals,
+		categories:   make(map[int]string, len(categories)),
+	}
+	for _, category := range categories {
+		l.categories[category.ID] = category.Name
+	}
+	if err := s.loadNames(ctx, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// loadNames заполняет имена участников мероприятия и пользователей,
+// которые встречаются в выгрузке, но уже покинули мероприятие
+func (s *ExportService) loadNames(ctx context.Context, l *ledger) error {
+	users, err := s.userService.GetUsersByEventID(ctx, l.event.ID)
+	if err != nil {
+		return err
+	}
+	l.names = make(map[int64]string, len(users))
+	for _, user := range users {
+		l.names[user.ID] = displayName(user.ID, user.NameCashed, user.NicknameCashed)
+	}
+
+	var missing []int64
+	for _, userID := range l.userIDs() {
+		if _, ok := l.names[userID]; !ok {
+			missing = append(missing, userID)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	others, err := s.userService.GetUsersByInternalUserIDs(ctx, missing)
+	if err != nil {
+		return err
+	}
+	for _, user := range others {
+		l.names[user.ID] = displayName(user.ID, user.NameCashed, user.NicknameCashed)
+	}
+	return nil
+}
+
+// displayName возвращает имя пользователя для выгрузки
+func displayName(userID int64, name, nickname string) string {
+	switch {
+	case name != "":
+		return name
+	case nickname != "":
+		return nickname
+	default:
+		return fmt.Sprintf("Пользователь %d", userID)
+	}
+}
@@ -0,0 +1,157 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+// newTestExportService создает сервис выгрузки с моками, возвращающими мероприятие
+// с одной транзакцией: Анна заплатила 300 за ужин на двоих, Борис уже покинул мероприятие
+func newTestExportService(t *testing.T) *ExportService {
+	ctrl := gomock.NewController(t)
+
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockTransactionService := serviceMock.NewMockTransaction(ctrl)
+	mockAnalyticsService := serviceMock.NewMockAnalytics(ctrl)
+	mockCategoryService := serviceMock.NewMockCategory(ctrl)
+
+	eventID := int64(1)
+	categoryID := 3
+	mockCategoryService.EXPECT().GetCategories(gomock.Any(), transactionCategoryType).
+		Return([]service.CategoryDTO{{ID: categoryID, Name: "Еда"}}, nil).AnyTimes()
+	mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).
+		Return(&models.Event{ID: eventID, Name: "Поход", Currency: "RUB"}, nil).AnyTimes()
+	mockTransactionService.EXPECT().GetTransactionsByEventID(gomock.Any(), eventID).Return([]service.TransactionResponse{
+		{
+			ID:                    10,
+			EventID:               eventID,
+			Name:                  "Ужин",
+			FromUser:              1,
+			TransactionCategoryID: &categoryID,
+			Amount:                money.FromFloat(300),
+			Currency:              "RUB",
+			ExchangeRate:          1,
+			Datetime:              time.Date(2025, 3, 3, 19, 30, 0, 0, time.UTC),
+			Shares: []service.ShareDTO{
+				{UserID: 1, Value: money.FromFloat(150)},
+				{UserID: 2, Value: money.FromFloat(150)},
+			},
+		},
+	}, nil).AnyTimes()
+	mockTransactionService.EXPECT().GetDebtsByEventID(gomock.Any(), eventID, nil).Return([]service.DebtDTO{
+		{FromUserID: 2, ToUserID: 1, Amount: money.FromFloat(150), TransactionID: 10},
+	}, nil).AnyTimes()
+	mockTransactionService.EXPECT().GetSettlementsByEventID(gomock.Any(), eventID).Return([]service.SettlementDTO{
+		{
+			ID:         5,
+			EventID:    eventID,
+			FromUserID: 2,
+			ToUserID:   1,
+			Amount:     money.FromFloat(50),
+			Comment:    "наличными",
+			CreatedAt:  time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC),
+		},
+	}, nil).AnyTimes()
+	mockTransactionService.EXPECT().GetCurrentOptimizedDebts(gomock.Any(), eventID).Return([]service.OptimizedDebtDTO{
+		{FromUserID: 2, ToUserID: 1, Amount: money.FromFloat(150), Status: models.OptimizedDebtStatusPending},
+	}, nil).AnyTimes()
+	mockAnalyticsService.EXPECT().GetEventAnalytics(gomock.Any(), eventID, gomock.Any()).Return(&service.EventAnalyticsDTO{
+		EventID:           eventID,
+		Currency:          "RUB",
+		Total:             money.FromFloat(300),
+		TransactionsCount: 1,
+		ByUser: []service.UserSpendingDTO{
+			{UserID: 1, Paid: money.FromFloat(300), Consumed: money.FromFloat(150), Net: money.FromFloat(150)},
+			{UserID: 2, Consumed: money.FromFloat(150), Net: money.FromFloat(-150)},
+		},
+	}, nil).AnyTimes()
+	mockUserService.EXPECT().GetUsersByEventID(gomock.Any(), eventID).
+		Return([]models.User{{ID: 1, NameCashed: "Анна", NicknameCashed: "anna"}}, nil).AnyTimes()
+	mockUserService.EXPECT().GetUsersByInternalUserIDs(gomock.Any(), []int64{2}).
+		Return([]models.User{{ID: 2, NicknameCashed: "boris"}}, nil).AnyTimes()
+
+	return NewExportService(mockEventService, mockUserService, mockTransactionService, mockAnalyticsService, mockCategoryService)
+}
+
+func TestExportService_ExportEvent_CSV(t *testing.T) {
+	exportService := newTestExportService(t)
+
+	file, err := exportService.ExportEvent(context.Background(), 1, service.ExportFormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, "event-1.csv", file.FileName)
+
+	var buf bytes.Buffer
+	require.NoError(t, file.Write(&buf))
+	content := buf.String()
+
+	assert.True(t, strings.HasPrefix(content, utf8BOM))
+	assert.Contains(t, content, "Мероприятие,Поход\n")
+	assert.Contains(t, content, "10,2025-03-03 19:30,Ужин,Еда,Анна,300.00,RUB,1,300.00,Анна,150.00\n")
+	assert.Contains(t, content, "10,2025-03-03 19:30,Ужин,Еда,Анна,300.00,RUB,1,300.00,boris,150.00\n", "имя покинувшего мероприятие пользователя")
+	assert.Contains(t, content, "Ужин,boris,Анна,150.00\n")
+	assert.Contains(t, content, "2025-03-04 10:00,boris,Анна,50.00,наличными\n", "погашения выводятся отдельно от долгов")
+	assert.Contains(t, content, "boris,Анна,150.00,0.00,Не погашен\n")
+	assert.Contains(t, content, "Всего,300.00,300.00,0.00\n")
+}
+
+func TestExportService_ExportEvent_XLSX(t *testing.T) {
+	exportService := newTestExportService(t)
+
+	file, err := exportService.ExportEvent(context.Background(), 1, service.ExportFormatXLSX)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, file.Write(&buf))
+
+	workbook, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer workbook.Close()
+
+	assert.Equal(t, []string{"Итоги по участникам", "План переводов", "Транзакции", "Долги", "Погашения"}, workbook.GetSheetList())
+
+	rows, err := workbook.GetRows("Транзакции")
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, "Участник", rows[0][9])
+	assert.Equal(t, "boris", rows[2][9])
+
+	amount, err := workbook.GetCellValue("Итоги по участникам", "B2", excelize.Options{RawCellValue: true})
+	require.NoError(t, err)
+	assert.Equal(t, "300", amount)
+}
+
+func TestExportService_ExportEvent_PDF(t *testing.T) {
+	exportService := newTestExportService(t)
+
+	file, err := exportService.ExportEvent(context.Background(), 1, service.ExportFormatPDF)
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", file.ContentType)
+
+	var buf bytes.Buffer
+	require.NoError(t, file.Write(&buf))
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+}
+
+func TestExportService_ExportEvent_InvalidFormat(t *testing.T) {
+	exportService := newTestExportService(t)
+
+	file, err := exportService.ExportEvent(context.Background(), 1, "docx")
+
+	var validationErr *customErrors.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Nil(t, file)
+}
@@ -0,0 +1,208 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// ledger содержит данные мероприятия, из которых строятся все форматы выгрузки
+type ledger struct {
+	event        *models.Event
+	transactions []service.TransactionResponse
+	debts        []service.DebtDTO
+	settlements  []service.SettlementDTO
+	plan         []service.OptimizedDebtDTO
+	totals       *service.EventAnalyticsDTO
+	names        map[int64]string
+	categories   map[int]string
+}
+
+// table - таблица выгрузки. Значения ячеек - string, int, float64, money.Money или time.Time.
+type table struct {
+	title  string
+	header []string
+	rows   [][]interface{}
+}
+
+// statusNames - названия статусов погашения оптимизированного долга
+var statusNames = map[string]string{
+	models.OptimizedDebtStatusPending: "Не погашен",
+	models.OptimizedDebtStatusPartial: "Погашен частично",
+	models.OptimizedDebtStatusSettled: "Погашен",
+}
+
+// name возвращает имя пользователя по внутреннему ID
+func (l *ledger) name(userID int64) string {
+	if name, ok := l.names[userID]; ok {
+		return name
+	}
+	return displayName(userID, "", "")
+}
+
+// userIDs возвращает ID всех пользователей, упомянутых в выгрузке
+func (l *ledger) userIDs() []int64 {
+	seen := make(map[int64]bool)
+	add := func(ids ...int64) {
+		for _, id := range ids {
+			seen[id] = true
+		}
+	}
+	for _, t := range l.transactions {
+		add(t.FromUser)
+		for _, payer := range t.Payers {
+			add(payer.UserID)
+		}
+		for _, share := range t.Shares {
+			add(share.UserID)
+		}
+	}
+	for _, debt := range l.debts {
+		add(debt.FromUserID, debt.ToUserID)
+	}
+	for _, settlement := range l.settlements {
+		add(settlement.FromUserID, settlement.ToUserID)
+	}
+	for _, debt := range l.plan {
+		add(debt.FromUserID, debt.ToUserID)
+	}
+	for _, user := range l.totals.ByUser {
+		add(user.UserID)
+	}
+
+	ids := make([]int64, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// category возвращает название категории транзакции
+func (l *ledger) category(t *service.TransactionResponse) string {
+	if t.TransactionCategoryID == nil {
+		return ""
+	}
+	return l.categories[*t.TransactionCategoryID]
+}
+
+// payers возвращает плательщиков транзакции одной строкой
+func (l *ledger) payers(t *service.TransactionResponse) string {
+	if len(t.Payers) <= 1 {
+		return l.name(t.FromUser)
+	}
+	parts := make([]string, len(t.Payers))
+	for i, payer := range t.Payers {
+		parts[i] = fmt.Sprintf("%s: %s", l.name(payer.UserID), payer.Amount)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// tables возвращает все таблицы выгрузки
+func (l *ledger) tables() []table {
+	return []table{
+		l.totalsTable(),
+		l.planTable(),
+		l.transactionsTable(),
+		l.debtsTable(),
+		l.settlementsTable(),
+	}
+}
+
+// transactionsTable возвращает транзакции с долями участников, по строке на долю
+func (l *ledger) transactionsTable() table {
+	t := table{
+		title: "Транзакции",
+		header: []string{
+			"ID", "Дата", "Название", "Категория", "Плательщики", "Сумма", "Валюта", "Курс",
+			"Сумма в " + l.event.Currency, "Участник", "Доля",
+		},
+	}
+	for i := range l.transactions {
+		tx := &l.transactions[i]
+		base := []interface{}{
+			tx.ID, tx.Datetime, tx.Name, l.category(tx), l.payers(tx), tx.Amount, tx.Currency, tx.ExchangeRate,
+			tx.Amount.MulFloat(tx.ExchangeRate),
+		}
+		if len(tx.Shares) == 0 {
+			t.rows = append(t.rows, append(base, "", ""))
+			continue
+		}
+		for _, share := range tx.Shares {
+			row := append(append([]interface{}{}, base...), l.name(share.UserID), share.Value)
+			t.rows = append(t.rows, row)
+		}
+	}
+	return t
+}
+
+// debtsTable возвращает долги по транзакциям до оптимизации
+func (l *ledger) debtsTable() table {
+	transactionNames := make(map[int]string, len(l.transactions))
+	for _, tx := range l.transactions {
+		transactionNames[tx.ID] = tx.Name
+	}
+
+	t := table{
+		title:  "Долги",
+		header: []string{"Основание", "Должник", "Кому", "Сумма, " + l.event.Currency},
+	}
+	for _, debt := range l.debts {
+		t.rows = append(t.rows, []interface{}{
+			transactionNames[debt.TransactionID], l.name(debt.FromUserID), l.name(debt.ToUserID), debt.Amount,
+		})
+	}
+	return t
+}
+
+// settlementsTable возвращает погашения долгов между участниками
+func (l *ledger) settlementsTable() table {
+	t := table{
+		title:  "Погашения",
+		header: []string{"Дата", "Кто вернул", "Кому", "Сумма, " + l.event.Currency, "Комментарий"},
+	}
+	for _, settlement := range l.settlements {
+		t.rows = append(t.rows, []interface{}{
+			settlement.CreatedAt, l.name(settlement.FromUserID), l.name(settlement.ToUserID), settlement.Amount, settlement.Comment,
+		})
+	}
+	return t
+}
+
+// planTable возвращает план переводов после оптимизации долгов
+func (l *ledger) planTable() table {
+	t := table{
+		title:  "План переводов",
+		header: []string{"Кто", "Кому", "Сумма, " + l.event.Currency, "Погашено", "Статус"},
+	}
+	for _, debt := range l.plan {
+		status, ok := statusNames[debt.Status]
+		if !ok {
+			status = debt.Status
+		}
+		t.rows = append(t.rows, []interface{}{
+			l.name(debt.FromUserID), l.name(debt.ToUserID), debt.Amount, debt.SettledAmount, status,
+		})
+	}
+	return t
+}
+
+// totalsTable возвращает оплаченные и потребленные суммы по участникам
+func (l *ledger) totalsTable() table {
+	t := table{
+		title:  "Итоги по участникам",
+		header: []string{"Участник", "Оплачено, " + l.event.Currency, "Потреблено, " + l.event.Currency, "Разница"},
+	}
+	var paid, consumed money.Money
+	for _, user := range l.totals.ByUser {
+		t.rows = append(t.rows, []interface{}{l.name(user.UserID), user.Paid, user.Consumed, user.Net})
+		paid += user.Paid
+		consumed += user.Consumed
+	}
+	t.rows = append(t.rows, []interface{}{"Всего", paid, consumed, paid - consumed})
+	return t
+}
@@ -0,0 +1,154 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/jung-kurt/gofpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+const (
+	// pdfFont - встроенный шрифт с поддержкой кириллицы
+	pdfFont = "Go"
+	// pdfFontSize - размер шрифта таблиц
+	pdfFontSize = 9
+	// pdfRowHeight - высота строки таблицы, мм
+	pdfRowHeight = 6
+	// pdfCellPadding - суммарный горизонтальный отступ текста в ячейке, мм
+	pdfCellPadding = 2
+)
+
+// writePDF записывает печатную сводку мероприятия: итоги по участникам,
+// план переводов и список транзакций
+func (l *ledger) writePDF(w io.Writer) error {
+	pdf := gofpdf.New("L", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(pdfFont, "", goregular.TTF)
+	pdf.AddUTF8FontFromBytes(pdfFont, "B", gobold.TTF)
+	pdf.SetTitle(l.event.Name, true)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AddPage()
+
+	pdf.SetFont(pdfFont, "B", 16)
+	pdf.CellFormat(0, 10, l.event.Name, "", 1, "L", false, 0, "")
+	pdf.SetFont(pdfFont, "", 10)
+	pdf.CellFormat(0, 6, fmt.Sprintf("Валюта: %s. Всего расходов: %s. Транзакций: %d. Сформировано: %s",
+		l.event.Currency, l.totals.Total, l.totals.TransactionsCount, time.Now().Format(dateTimeLayout)),
+		"", 1, "L", false, 0, "")
+
+	for _, t := range []table{l.totalsTable(), l.planTable(), l.transactionsSummaryTable()} {
+		pdf.Ln(4)
+		writePDFTable(pdf, t)
+	}
+
+	if err := pdf.Output(w); err != nil {
+		return fmt.Errorf("ошибка при формировании PDF: %w", err)
+	}
+	return nil
+}
+
+// transactionsSummaryTable возвращает транзакции по строке на транзакцию с перечнем участников
+func (l *ledger) transactionsSummaryTable() table {
+	t := table{
+		title:  "Транзакции",
+		header: []string{"Дата", "Название", "Плательщики", "Сумма", "Сумма в " + l.event.Currency, "Участники"},
+	}
+	for i := range l.transactions {
+		tx := &l.transactions[i]
+		participants := make([]string, len(tx.Shares))
+		for j, share := range tx.Shares {
+			participants[j] = l.name(share.UserID)
+		}
+		t.rows = append(t.rows, []interface{}{
+			tx.Datetime, tx.Name, l.payers(tx), fmt.Sprintf("%s %s", tx.Amount, tx.Currency),
+			tx.Amount.MulFloat(tx.ExchangeRate), strings.Join(participants, ", "),
+		})
+	}
+	return t
+}
+
+// writePDFTable выводит таблицу с заголовком; ширина колонок пропорциональна содержимому
+func writePDFTable(pdf *gofpdf.Fpdf, t table) {
+	pdf.SetFont(pdfFont, "B", 12)
+	pdf.CellFormat(0, 8, t.title, "", 1, "L", false, 0, "")
+
+	cells := make([][]string, len(t.rows))
+	for i, row := range t.rows {
+		cells[i] = make([]string, len(row))
+		for j, value := range row {
+			cells[i][j] = formatCell(value)
+		}
+	}
+
+	pdf.SetFont(pdfFont, "B", pdfFontSize)
+	widths := make([]float64, len(t.header))
+	for i, title := range t.header {
+		widths[i] = pdf.GetStringWidth(title) + pdfCellPadding
+	}
+	pdf.SetFont(pdfFont, "", pdfFontSize)
+	for _, row := range cells {
+		for i, text := range row {
+			if width := pdf.GetStringWidth(text) + pdfCellPadding; width > widths[i] {
+				widths[i] = width
+			}
+		}
+	}
+	scalePDFWidths(pdf, widths)
+
+	pdf.SetFont(pdfFont, "B", pdfFontSize)
+	pdf.SetFillColor(230, 230, 230)
+	for i, title := range t.header {
+		pdf.CellFormat(widths[i], pdfRowHeight, fitPDFText(pdf, title, widths[i]), "1", 0, "C", true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont(pdfFont, "", pdfFontSize)
+	for r, row := range cells {
+		for i, text := range row {
+			align := "L"
+			if _, ok := t.rows[r][i].(money.Money); ok {
+				align = "R"
+			}
+			pdf.CellFormat(widths[i], pdfRowHeight, fitPDFText(pdf, text, widths[i]), "1", 0, align, false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+}
+
+// scalePDFWidths растягивает или сжимает колонки до ширины страницы
+func scalePDFWidths(pdf *gofpdf.Fpdf, widths []float64) {
+	pageWidth, _ := pdf.GetPageSize()
+	left, _, right, _ := pdf.GetMargins()
+	available := pageWidth - left - right
+
+	total := 0.0
+	for _, width := range widths {
+		total += width
+	}
+	if total == 0 {
+		return
+	}
+	for i := range widths {
+		widths[i] = widths[i] * available / total
+	}
+}
+
+// fitPDFText обрезает текст, не помещающийся в ячейку ширины width
+func fitPDFText(pdf *gofpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text)+pdfCellPadding <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		truncated := string(runes) + "…"
+		if pdf.GetStringWidth(truncated)+pdfCellPadding <= width {
+			return truncated
+		}
+	}
+	return ""
+}
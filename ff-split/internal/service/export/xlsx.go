@@ -0,0 +1,120 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/xuri/excelize/v2"
+)
+
+// Встроенные форматы чисел Excel
+const (
+	xlsxFormatAmount   = 4  // #,##0.00
+	xlsxFormatDateTime = 22 // m/d/yy h:mm
+)
+
+// writeXLSX записывает выгрузку в XLSX, каждая таблица - на отдельном листе
+func (l *ledger) writeXLSX(w io.Writer) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	styles, err := newXLSXStyles(f)
+	if err != nil {
+		return err
+	}
+
+	for i, t := range l.tables() {
+		if i == 0 {
+			err = f.SetSheetName(f.GetSheetName(0), t.title)
+		} else {
+			_, err = f.NewSheet(t.title)
+		}
+		if err != nil {
+			return fmt.Errorf("ошибка при создании листа %q: %w", t.title, err)
+		}
+		if err := writeXLSXTable(f, t, styles); err != nil {
+			return fmt.Errorf("ошибка при заполнении листа %q: %w", t.title, err)
+		}
+	}
+
+	if err := f.Write(w); err != nil {
+		return fmt.Errorf("ошибка при формировании XLSX: %w", err)
+	}
+	return nil
+}
+
+// xlsxStyles - стили ячеек выгрузки
+type xlsxStyles struct {
+	header   int
+	amount   int
+	dateTime int
+}
+
+// newXLSXStyles регистрирует стили ячеек в книге
+func newXLSXStyles(f *excelize.File) (*xlsxStyles, error) {
+	header, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return nil, err
+	}
+	amount, err := f.NewStyle(&excelize.Style{NumFmt: xlsxFormatAmount})
+	if err != nil {
+		return nil, err
+	}
+	dateTime, err := f.NewStyle(&excelize.Style{NumFmt: xlsxFormatDateTime})
+	if err != nil {
+		return nil, err
+	}
+	return &xlsxStyles{header: header, amount: amount, dateTime: dateTime}, nil
+}
+
+// writeXLSXTable записывает таблицу на лист с названием таблицы
+func writeXLSXTable(f *excelize.File, t table, styles *xlsxStyles) error {
+	sheet := t.title
+
+	header := make([]interface{}, len(t.header))
+	for i, title := range t.header {
+		header[i] = title
+	}
+	if err := f.SetSheetRow(sheet, "A1", &header); err != nil {
+		return err
+	}
+	lastColumn, err := excelize.ColumnNumberToName(len(t.header))
+	if err != nil {
+		return err
+	}
+	if err := f.SetCellStyle(sheet, "A1", lastColumn+"1", styles.header); err != nil {
+		return err
+	}
+	if err := f.SetColWidth(sheet, "A", lastColumn, 18); err != nil {
+		return err
+	}
+
+	for r, row := range t.rows {
+		for c, value := range row {
+			cell, err := excelize.CoordinatesToCellName(c+1, r+2)
+			if err != nil {
+				return err
+			}
+
+			style := 0
+			switch v := value.(type) {
+			case money.Money:
+				value = v.Float64()
+				style = styles.amount
+			case time.Time:
+				style = styles.dateTime
+			}
+			if err := f.SetCellValue(sheet, cell, value); err != nil {
+				return err
+			}
+			if style != 0 {
+				if err := f.SetCellStyle(sheet, cell, cell, style); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+)
+
+// Форматы импорта транзакций
+const (
+	ImportFormatFinFlow   = "finflow"   // CSV-выгрузка FinFlow
+	ImportFormatSplitwise = "splitwise" // CSV-выгрузка Splitwise
+)
+
+// Статусы строки импорта
+const (
+	ImportRowStatusOK      = "ok"      // Транзакция создана (или будет создана при пробном запуске)
+	ImportRowStatusError   = "error"   // Строку не удалось импортировать
+	ImportRowStatusSkipped = "skipped" // Строка не является расходом и пропущена
+)
+
+// ImportRequest представляет запрос на импорт транзакций
+type ImportRequest struct {
+	Format string    // finflow | splitwise; пусто - определить по заголовку файла
+	DryRun bool      // Только проверить файл, ничего не сохраняя
+	Data   io.Reader // Содержимое CSV-файла
+}
+
+// ImportRowResult представляет результат импорта одной транзакции
+type ImportRowResult struct {
+	Line          int // Номер первой строки транзакции в файле
+	Name          string
+	Datetime      *time.Time
+	Amount        money.Money
+	Currency      string
+	Status        string
+	Error         string
+	Warning       string
+	TransactionID *int // ID созданной транзакции; nil при пробном запуске и ошибке
+}
+
+// ImportReport представляет отчет об импорте транзакций.
+// При пробном запуске Imported - число транзакций, которые будут созданы.
+type ImportReport struct {
+	Format       string
+	DryRun       bool
+	Imported     int
+	Failed       int
+	Skipped      int
+	CreatedUsers []string // Имена dummy-пользователей, созданных (или которые будут созданы) вместе с импортированными строками
+	Rows         []ImportRowResult
+}
+
+// Import определяет методы импорта транзакций из других приложений
+type Import interface {
+	// ImportTransactions разбирает CSV-файл и создает транзакции мероприятия
+	ImportTransactions(ctx context.Context, eventID int64, req *ImportRequest) (*ImportReport, error)
+}
@@ -0,0 +1,154 @@
+package importer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+)
+
+// Разметка CSV-выгрузки FinFlow
+const (
+	finflowEventTitle        = "Мероприятие"
+	finflowTransactionsTitle = "Транзакции"
+	finflowDateLayout        = "2006-01-02 15:04"
+)
+
+// Колонки таблицы транзакций выгрузки FinFlow
+const (
+	finflowColumnID          = "ID"
+	finflowColumnDate        = "Дата"
+	finflowColumnName        = "Название"
+	finflowColumnCategory    = "Категория"
+	finflowColumnPayers      = "Плательщики"
+	finflowColumnAmount      = "Сумма"
+	finflowColumnCurrency    = "Валюта"
+	finflowColumnRate        = "Курс"
+	finflowColumnParticipant = "Участник"
+	finflowColumnShare       = "Доля"
+)
+
+// finflowRequiredColumns - колонки, без которых транзакции не восстановить
+var finflowRequiredColumns = []string{
+	finflowColumnID, finflowColumnDate, finflowColumnName, finflowColumnPayers,
+	finflowColumnAmount, finflowColumnParticipant, finflowColumnShare,
+}
+
+// parseFinFlow разбирает таблицу транзакций CSV-выгрузки FinFlow.
+// Транзакция занимает по строке на каждую долю, строки объединяются по ID.
+func parseFinFlow(rows []csvRow) ([]*record, error) {
+	start := -1
+	for i, row := range rows {
+		if len(row.fields) == 1 && row.fields[0] == finflowTransactionsTitle {
+			start = i + 1
+			break
+		}
+	}
+	if start < 0 || start >= len(rows) {
+		return nil, customErrors.NewValidationError("data", "в выгрузке FinFlow нет таблицы транзакций")
+	}
+
+	index := columnIndex(rows[start].fields)
+	for _, column := range finflowRequiredColumns {
+		if _, ok := index[column]; !ok {
+			return nil, customErrors.NewValidationError("data", fmt.Sprintf("в выгрузке FinFlow нет колонки %q", column))
+		}
+	}
+
+	var records []*record
+	byID := make(map[string]*record)
+	for _, row := range rows[start+1:] {
+		// Таблица заканчивается заголовком следующей таблицы
+		if len(row.fields) == 1 {
+			break
+		}
+
+		id := field(row.fields, index, finflowColumnID)
+		rec, ok := byID[id]
+		if !ok {
+			rec = newFinFlowRecord(row, index)
+			byID[id] = rec
+			records = append(records, rec)
+		}
+
+		participant := field(row.fields, index, finflowColumnParticipant)
+		if participant == "" {
+			continue
+		}
+		share, err := money.Parse(field(row.fields, index, finflowColumnShare))
+		if err != nil {
+			rec.fail("строка %d: доля участника %q: %v", row.line, participant, err)
+			continue
+		}
+		rec.shares = append(rec.shares, namedAmount{name: participant, amount: share})
+	}
+	return records, nil
+}
+
+// newFinFlowRecord разбирает общие поля транзакции из первой строки с ее ID
+func newFinFlowRecord(row csvRow, index map[string]int) *record {
+	rec := &record{
+		line:     row.line,
+		name:     field(row.fields, index, finflowColumnName),
+		category: field(row.fields, index, finflowColumnCategory),
+		currency: field(row.fields, index, finflowColumnCurrency),
+	}
+
+	datetime, err := time.Parse(finflowDateLayout, field(row.fields, index, finflowColumnDate))
+	if err != nil {
+		rec.fail("некорректная дата: %q", field(row.fields, index, finflowColumnDate))
+	} else {
+		rec.datetime = &datetime
+	}
+
+	amount, err := money.Parse(field(row.fields, index, finflowColumnAmount))
+	if err != nil {
+		rec.fail("сумма: %v", err)
+	}
+	rec.amount = amount
+
+	if value := field(row.fields, index, finflowColumnRate); value != "" {
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			rec.fail("некорректный курс: %q", value)
+		} else {
+			rec.rate = &rate
+		}
+	}
+
+	payers, err := parseFinFlowPayers(field(row.fields, index, finflowColumnPayers), amount)
+	if err != nil {
+		rec.fail("плательщики: %v", err)
+	}
+	rec.payers = payers
+	return rec
+}
+
+// parseFinFlowPayers разбирает колонку плательщиков: одно имя, если платил один
+// пользователь, или список "Имя: сумма" через запятую
+func parseFinFlowPayers(value string, amount money.Money) ([]namedAmount, error) {
+	if value == "" {
+		return nil, fmt.Errorf("плательщик не указан")
+	}
+	if !strings.Contains(value, ": ") {
+		return []namedAmount{{name: value, amount: amount}}, nil
+	}
+
+	parts := strings.Split(value, ", ")
+	payers := make([]namedAmount, 0, len(parts))
+	for _, part := range parts {
+		separator := strings.LastIndex(part, ": ")
+		if separator < 0 {
+			return nil, fmt.Errorf("ожидается \"Имя: сумма\", получено %q", part)
+		}
+		paid, err := money.Parse(part[separator+2:])
+		if err != nil {
+			return nil, err
+		}
+		payers = append(payers, namedAmount{name: part[:separator], amount: paid})
+	}
+	return payers, nil
+}
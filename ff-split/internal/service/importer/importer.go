@@ -0,0 +1,279 @@
+package importer
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/debt_calculator"
+)
+
+// transactionCategoryType - тип категорий транзакций
+const transactionCategoryType = "transaction"
+
+// ImportService реализует интерфейс service.Import
+type ImportService struct {
+	db                 *gorm.DB
+	transactionService service.Transaction
+	userService        service.User
+	categoryService    service.Category
+}
+
+// NewImportService создает новый сервис импорта транзакций
+func NewImportService(db *gorm.DB, transactionService service.Transaction, userService service.User, categoryService service.Category) *ImportService {
+	return &ImportService{
+		db:                 db,
+		transactionService: transactionService,
+		userService:        userService,
+		categoryService:    categoryService,
+	}
+}
+
+// ImportTransactions разбирает CSV-файл и создает транзакции мероприятия.
+// Каждая транзакция создается через TransactionService.CreateTransaction независимо от остальных,
+// поэтому ошибка в одной строке не отменяет импорт других. Для имен, не совпавших
+// ни с одним участником, создаются dummy-пользователи вместе с первой строкой, в которой
+// они встречаются: если строка не импортирована, пользователи откатываются вместе с ней.
+func (s *ImportService) ImportTransactions(ctx context.Context, eventID int64, req *service.ImportRequest) (*service.ImportReport, error) {
+	if err := access.Require(ctx, eventID, access.EditTransactions); err != nil {
+		return nil, err
+	}
+
+	records, format, err := parse(req.Format, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.userService.GetUsersByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.categoryService.GetCategories(ctx, transactionCategoryType)
+	if err != nil {
+		return nil, err
+	}
+
+	names := newNameResolver(users)
+	categoryIDs := make(map[string]int, len(categories))
+	for _, category := range categories {
+		categoryIDs[normalizeName(category.Name)] = category.ID
+	}
+
+	report := &service.ImportReport{
+		Format: format,
+		DryRun: req.DryRun,
+		Rows:   make([]service.ImportRowResult, 0, len(records)),
+	}
+	for _, rec := range records {
+		row, created := s.importRecord(ctx, eventID, rec, names, categoryIDs, req.DryRun)
+		switch row.Status {
+		case service.ImportRowStatusOK:
+			report.Imported++
+			report.CreatedUsers = append(report.CreatedUsers, created...)
+		case service.ImportRowStatusError:
+			report.Failed++
+		case service.ImportRowStatusSkipped:
+			report.Skipped++
+		}
+		report.Rows = append(report.Rows, row)
+	}
+	return report, nil
+}
+
+// importRecord проверяет транзакцию и, если это не пробный запуск, создает ее.
+// Возвращает имена dummy-пользователей, созданных для строки; при ошибке они не сохраняются.
+func (s *ImportService) importRecord(
+	ctx context.Context,
+	eventID int64,
+	rec *record,
+	names *nameResolver,
+	categoryIDs map[string]int,
+	dryRun bool,
+) (service.ImportRowResult, []string) {
+	row := service.ImportRowResult{
+		Line:     rec.line,
+		Name:     rec.name,
+		Datetime: rec.datetime,
+		Amount:   rec.amount,
+		Currency: rec.currency,
+		Status:   service.ImportRowStatusOK,
+	}
+	switch {
+	case rec.skip != "":
+		row.Status = service.ImportRowStatusSkipped
+		row.Warning = rec.skip
+		return row, nil
+	case rec.err != nil:
+		row.Status = service.ImportRowStatusError
+		row.Error = rec.err.Error()
+		return row, nil
+	}
+
+	var categoryID *int
+	if rec.category != "" {
+		if id, ok := categoryIDs[normalizeName(rec.category)]; ok {
+			categoryID = &id
+		} else {
+			row.Warning = "категория «" + rec.category + "» не найдена, транзакция импортирована без категории"
+		}
+	}
+
+	unmatched := names.unmatched(rec)
+	var err error
+	if dryRun {
+		// Временные ID только связывают доли одного пользователя при проверке
+		for _, name := range unmatched {
+			names.add(name, names.tempID())
+		}
+		txReq := buildRequest(rec, names)
+		txReq.TransactionCategoryID = categoryID
+		err = validateRequest(txReq, eventID)
+	} else {
+		err = db.WithTx(ctx, s.db, func(ctx context.Context) error {
+			for _, name := range unmatched {
+				user, err := s.userService.CreateDummyUser(ctx, name, eventID)
+				if err != nil {
+					return err
+				}
+				names.add(name, user.ID)
+			}
+
+			txReq := buildRequest(rec, names)
+			txReq.TransactionCategoryID = categoryID
+			transaction, err := s.transactionService.CreateTransaction(ctx, eventID, txReq)
+			if err != nil {
+				return err
+			}
+			row.TransactionID = &transaction.ID
+			return nil
+		})
+	}
+	if err != nil {
+		// Пользователи неудачной строки откатились, их создаст следующая строка с тем же именем
+		names.remove(unmatched)
+		row.Status = service.ImportRowStatusError
+		row.Error = err.Error()
+		return row, nil
+	}
+	return row, unmatched
+}
+
+// buildRequest преобразует транзакцию из файла в запрос на создание транзакции с долями в виде сумм
+func buildRequest(rec *record, names *nameResolver) *service.TransactionRequest {
+	req := &service.TransactionRequest{
+		Type:         debt_calculator.AmountType,
+		Name:         rec.name,
+		Amount:       rec.amount,
+		Currency:     rec.currency,
+		ExchangeRate: rec.rate,
+		Datetime:     rec.datetime,
+		Amounts:      make(map[string]money.Money, len(rec.shares)),
+	}
+
+	// Основной плательщик - заплативший больше всех
+	var maxPaid money.Money
+	for i, payer := range rec.payers {
+		if i == 0 || payer.amount > maxPaid {
+			req.FromUser = names.id(payer.name)
+			maxPaid = payer.amount
+		}
+	}
+	if len(rec.payers) > 1 {
+		for _, payer := range rec.payers {
+			req.Payers = append(req.Payers, service.PayerDTO{UserID: names.id(payer.name), Amount: payer.amount})
+		}
+	}
+
+	for _, share := range rec.shares {
+		userID := names.id(share.name)
+		key := strconv.FormatInt(userID, 10)
+		if _, ok := req.Amounts[key]; !ok {
+			req.Users = append(req.Users, userID)
+		}
+		req.Amounts[key] += share.amount
+	}
+	return req
+}
+
+// validateRequest проверяет доли и оплаты транзакции тем же калькулятором, что и при создании
+func validateRequest(req *service.TransactionRequest, eventID int64) error {
+	calculator, err := debt_calculator.GetCalculator(req.Type)
+	if err != nil {
+		return err
+	}
+	_, _, err = calculator.Calculate(req, eventID)
+	return err
+}
+
+// nameResolver сопоставляет имена из файла с пользователями мероприятия
+type nameResolver struct {
+	ids    map[string]int64
+	lastID int64 // Последний выданный временный ID для пробного запуска
+}
+
+// newNameResolver создает сопоставление по кешированным имени и никнейму участников
+func newNameResolver(users []models.User) *nameResolver {
+	r := &nameResolver{ids: make(map[string]int64, len(users)*2)}
+	for _, user := range users {
+		for _, name := range []string{user.NameCashed, user.NicknameCashed} {
+			key := normalizeName(name)
+			if _, ok := r.ids[key]; key != "" && !ok {
+				r.ids[key] = user.ID
+			}
+		}
+	}
+	return r
+}
+
+// add сопоставляет имя с пользователем
+func (r *nameResolver) add(name string, userID int64) {
+	r.ids[normalizeName(name)] = userID
+}
+
+// remove удаляет сопоставления имен
+func (r *nameResolver) remove(names []string) {
+	for _, name := range names {
+		delete(r.ids, normalizeName(name))
+	}
+}
+
+// tempID возвращает новый отрицательный ID, который не совпадает с ID пользователей
+func (r *nameResolver) tempID() int64 {
+	r.lastID--
+	return r.lastID
+}
+
+// id возвращает ID пользователя по имени
+func (r *nameResolver) id(name string) int64 {
+	return r.ids[normalizeName(name)]
+}
+
+// unmatched возвращает имена из строки, не совпавшие ни с одним участником,
+// в порядке первого упоминания
+func (r *nameResolver) unmatched(rec *record) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, list := range [][]namedAmount{rec.payers, rec.shares} {
+		for _, item := range list {
+			key := normalizeName(item.name)
+			if _, ok := r.ids[key]; ok || seen[key] {
+				continue
+			}
+			seen[key] = true
+			names = append(names, item.name)
+		}
+	}
+	return names
+}
+
+// normalizeName приводит имя к виду для сравнения без учета регистра и пробелов по краям
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,241 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const splitwiseCSV = `Date,Description,Category,Cost,Currency,Anna,Boris,Vera
+
+2025-03-01,Dinner,Dining out,90.00,RUB,60.00,-30.00,-30.00
+2025-03-02,Taxi,Taxi,100.00,RUB,25.00,25.00,-50.00
+2025-03-03,Payment,Payment,30.00,RUB,-30.00,30.00,0.00
+2025-03-04,Broken,General,abc,RUB,1.00,-1.00,0.00
+
+2025-03-05,Total balance,,,RUB,55.00,25.00,-80.00
+`
+
+const finflowCSV = "\ufeffМероприятие,Поход\n" +
+	"Валюта,RUB\n" +
+	"\n" +
+	"Итоги по участникам\n" +
+	"Участник,\"Оплачено, RUB\",\"Потреблено, RUB\",Разница\n" +
+	"Анна,300.00,150.00,150.00\n" +
+	"\n" +
+	"Транзакции\n" +
+	"ID,Дата,Название,Категория,Плательщики,Сумма,Валюта,Курс,Сумма в RUB,Участник,Доля\n" +
+	"10,2025-03-03 19:30,Ужин,Еда,Анна,300.00,RUB,1,300.00,Анна,150.00\n" +
+	"10,2025-03-03 19:30,Ужин,Еда,Анна,300.00,RUB,1,300.00,Борис,150.00\n" +
+	"11,2025-03-04 10:00,Билеты,,\"Анна: 60.00, Борис: 40.00\",100.00,USD,90.5,9050.00,Борис,100.00\n" +
+	"\n" +
+	"Долги\n" +
+	"Основание,Должник,Кому,\"Сумма, RUB\"\n" +
+	"Ужин,Борис,Анна,150.00\n"
+
+func TestParseSplitwise(t *testing.T) {
+	records, format, err := parse("", strings.NewReader(splitwiseCSV))
+	require.NoError(t, err)
+	assert.Equal(t, service.ImportFormatSplitwise, format)
+	require.Len(t, records, 4, "итоговая строка не является транзакцией")
+
+	dinner := records[0]
+	assert.Equal(t, 3, dinner.line)
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), *dinner.datetime)
+	assert.Equal(t, []namedAmount{{name: "Anna", amount: money.FromFloat(90)}}, dinner.payers)
+	assert.ElementsMatch(t, []namedAmount{
+		{name: "Anna", amount: money.FromFloat(30)},
+		{name: "Boris", amount: money.FromFloat(30)},
+		{name: "Vera", amount: money.FromFloat(30)},
+	}, dinner.shares)
+
+	taxi := records[1]
+	assert.Equal(t, []namedAmount{
+		{name: "Anna", amount: money.FromFloat(50)},
+		{name: "Boris", amount: money.FromFloat(50)},
+	}, taxi.payers, "оплата делится между плательщиками пропорционально итогам")
+	assert.ElementsMatch(t, []namedAmount{
+		{name: "Anna", amount: money.FromFloat(25)},
+		{name: "Boris", amount: money.FromFloat(25)},
+		{name: "Vera", amount: money.FromFloat(50)},
+	}, taxi.shares)
+
+	assert.NotEmpty(t, records[2].skip, "погашения пропускаются")
+	assert.Error(t, records[3].err)
+}
+
+func TestParseFinFlow(t *testing.T) {
+	records, format, err := parse("", strings.NewReader(finflowCSV))
+	require.NoError(t, err)
+	assert.Equal(t, service.ImportFormatFinFlow, format)
+	require.Len(t, records, 2)
+
+	dinner := records[0]
+	require.NoError(t, dinner.err)
+	assert.Equal(t, "Ужин", dinner.name)
+	assert.Equal(t, "Еда", dinner.category)
+	assert.Equal(t, money.FromFloat(300), dinner.amount)
+	assert.Equal(t, []namedAmount{{name: "Анна", amount: money.FromFloat(300)}}, dinner.payers)
+	assert.Equal(t, []namedAmount{
+		{name: "Анна", amount: money.FromFloat(150)},
+		{name: "Борис", amount: money.FromFloat(150)},
+	}, dinner.shares)
+
+	tickets := records[1]
+	require.NoError(t, tickets.err)
+	assert.Equal(t, "USD", tickets.currency)
+	require.NotNil(t, tickets.rate)
+	assert.Equal(t, 90.5, *tickets.rate)
+	assert.Equal(t, []namedAmount{
+		{name: "Анна", amount: money.FromFloat(60)},
+		{name: "Борис", amount: money.FromFloat(40)},
+	}, tickets.payers)
+}
+
+func TestParse_UnknownFormat(t *testing.T) {
+	_, _, err := parse("", strings.NewReader("a,b,c\n1,2,3\n"))
+
+	var validationErr *customErrors.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestImportService_ImportTransactions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionService := serviceMock.NewMockTransaction(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockCategoryService := serviceMock.NewMockCategory(ctrl)
+
+	// Создаем in-memory SQLite БД для тестов с транзакциями
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	importService := NewImportService(testDB, mockTransactionService, mockUserService, mockCategoryService)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	members := []models.User{
+		{ID: 1, NameCashed: "Anna Smith", NicknameCashed: "anna"},
+		{ID: 2, NameCashed: "Boris"},
+	}
+	categories := []service.CategoryDTO{{ID: 7, Name: "Taxi"}}
+
+	t.Run("пробный запуск", func(t *testing.T) {
+		mockUserService.EXPECT().GetUsersByEventID(ctx, eventID).Return(members, nil)
+		mockCategoryService.EXPECT().GetCategories(ctx, transactionCategoryType).Return(categories, nil)
+
+		report, err := importService.ImportTransactions(ctx, eventID, &service.ImportRequest{
+			DryRun: true,
+			Data:   strings.NewReader(splitwiseCSV),
+		})
+		require.NoError(t, err)
+
+		assert.True(t, report.DryRun)
+		assert.Equal(t, service.ImportFormatSplitwise, report.Format)
+		assert.Equal(t, []string{"Vera"}, report.CreatedUsers)
+		assert.Equal(t, 2, report.Imported)
+		assert.Equal(t, 1, report.Failed)
+		assert.Equal(t, 1, report.Skipped)
+		require.Len(t, report.Rows, 4)
+		assert.NotEmpty(t, report.Rows[0].Warning, "категория Dining out не найдена")
+		assert.Empty(t, report.Rows[1].Warning)
+		assert.Nil(t, report.Rows[1].TransactionID)
+	})
+
+	t.Run("импорт", func(t *testing.T) {
+		mockUserService.EXPECT().GetUsersByEventID(ctx, eventID).Return(members, nil)
+		mockCategoryService.EXPECT().GetCategories(ctx, transactionCategoryType).Return(categories, nil)
+		mockUserService.EXPECT().CreateDummyUser(gomock.Any(), "Vera", eventID).Return(&models.User{ID: 3, NameCashed: "Vera", IsDummy: true}, nil)
+
+		mockTransactionService.EXPECT().CreateTransaction(gomock.Any(), eventID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int64, req *service.TransactionRequest) (*service.TransactionResponse, error) {
+				assert.Equal(t, "Dinner", req.Name)
+				assert.Equal(t, int64(1), req.FromUser)
+				assert.Empty(t, req.Payers)
+				assert.Equal(t, map[string]money.Money{"1": money.FromFloat(30), "2": money.FromFloat(30), "3": money.FromFloat(30)}, req.Amounts)
+				assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), *req.Datetime)
+				assert.Nil(t, req.TransactionCategoryID)
+				return &service.TransactionResponse{ID: 100}, nil
+			})
+		mockTransactionService.EXPECT().CreateTransaction(gomock.Any(), eventID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int64, req *service.TransactionRequest) (*service.TransactionResponse, error) {
+				assert.Equal(t, "Taxi", req.Name)
+				assert.Len(t, req.Payers, 2)
+				require.NotNil(t, req.TransactionCategoryID)
+				assert.Equal(t, 7, *req.TransactionCategoryID)
+				return nil, errors.New("мероприятие закрыто")
+			})
+
+		report, err := importService.ImportTransactions(ctx, eventID, &service.ImportRequest{
+			Format: service.ImportFormatSplitwise,
+			Data:   strings.NewReader(splitwiseCSV),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Vera"}, report.CreatedUsers)
+		assert.Equal(t, 1, report.Imported)
+		assert.Equal(t, 2, report.Failed)
+		require.NotNil(t, report.Rows[0].TransactionID)
+		assert.Equal(t, 100, *report.Rows[0].TransactionID)
+		assert.Equal(t, service.ImportRowStatusError, report.Rows[1].Status)
+		assert.Equal(t, "мероприятие закрыто", report.Rows[1].Error)
+	})
+	t.Run("пользователь неудачной строки создается заново со следующей строкой", func(t *testing.T) {
+		mockUserService.EXPECT().GetUsersByEventID(ctx, eventID).Return(members, nil)
+		mockCategoryService.EXPECT().GetCategories(ctx, transactionCategoryType).Return(categories, nil)
+		gomock.InOrder(
+			mockUserService.EXPECT().CreateDummyUser(gomock.Any(), "Vera", eventID).Return(&models.User{ID: 3, NameCashed: "Vera", IsDummy: true}, nil),
+			mockTransactionService.EXPECT().CreateTransaction(gomock.Any(), eventID, gomock.Any()).Return(nil, errors.New("мероприятие закрыто")),
+			mockUserService.EXPECT().CreateDummyUser(gomock.Any(), "Vera", eventID).Return(&models.User{ID: 4, NameCashed: "Vera", IsDummy: true}, nil),
+			mockTransactionService.EXPECT().CreateTransaction(gomock.Any(), eventID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ int64, req *service.TransactionRequest) (*service.TransactionResponse, error) {
+					assert.Contains(t, req.Amounts, "4")
+					return &service.TransactionResponse{ID: 101}, nil
+				}),
+		)
+
+		report, err := importService.ImportTransactions(ctx, eventID, &service.ImportRequest{
+			Format: service.ImportFormatSplitwise,
+			Data: strings.NewReader("Date,Description,Category,Cost,Currency,Anna,Vera\n" +
+				"2025-03-01,Dinner,,90.00,RUB,45.00,-45.00\n" +
+				"2025-03-02,Taxi,,100.00,RUB,50.00,-50.00\n"),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Vera"}, report.CreatedUsers)
+		assert.Equal(t, 1, report.Imported)
+		assert.Equal(t, 1, report.Failed)
+	})
+
+	t.Run("без импортированных строк пользователи не создаются", func(t *testing.T) {
+		mockUserService.EXPECT().GetUsersByEventID(ctx, eventID).Return(members, nil)
+		mockCategoryService.EXPECT().GetCategories(ctx, transactionCategoryType).Return(categories, nil)
+		mockUserService.EXPECT().CreateDummyUser(gomock.Any(), "Vera", eventID).Return(&models.User{ID: 3, NameCashed: "Vera", IsDummy: true}, nil)
+		mockTransactionService.EXPECT().CreateTransaction(gomock.Any(), eventID, gomock.Any()).Return(nil, errors.New("мероприятие закрыто"))
+
+		report, err := importService.ImportTransactions(ctx, eventID, &service.ImportRequest{
+			Format: service.ImportFormatSplitwise,
+			Data: strings.NewReader("Date,Description,Category,Cost,Currency,Anna,Vera\n" +
+				"2025-03-01,Dinner,,90.00,RUB,45.00,-45.00\n"),
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, report.CreatedUsers)
+		assert.Equal(t, 0, report.Imported)
+		assert.Equal(t, 1, report.Failed)
+	})
+}
@@ -0,0 +1,171 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// utf8BOM - метка порядка байтов, которую добавляют Excel и выгрузка FinFlow
+var utf8BOM = []byte("\ufeff")
+
+// csvRow - строка CSV-файла с номером строки в файле
+type csvRow struct {
+	line   int
+	fields []string
+}
+
+// namedAmount - сумма, относящаяся к пользователю, указанному по имени
+type namedAmount struct {
+	name   string
+	amount money.Money
+}
+
+// record - транзакция, прочитанная из файла. Суммы указаны в валюте транзакции.
+type record struct {
+	line     int
+	name     string
+	datetime *time.Time
+	category string
+	currency string
+	rate     *float64
+	amount   money.Money
+	payers   []namedAmount
+	shares   []namedAmount
+	skip     string // Причина, по которой строка пропущена
+	err      error  // Ошибка разбора строки
+}
+
+// fail запоминает первую ошибку разбора строки
+func (r *record) fail(format string, args ...interface{}) {
+	if r.err == nil {
+		r.err = fmt.Errorf(format, args...)
+	}
+}
+
+// parse читает файл и возвращает транзакции и определенный формат файла
+func parse(format string, data io.Reader) ([]*record, string, error) {
+	rows, err := readCSV(data)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(rows) == 0 {
+		return nil, "", customErrors.NewValidationError("data", "файл пуст")
+	}
+
+	if format == "" {
+		format = detectFormat(rows[0].fields)
+	}
+
+	var records []*record
+	switch format {
+	case service.ImportFormatFinFlow:
+		records, err = parseFinFlow(rows)
+	case service.ImportFormatSplitwise:
+		records, err = parseSplitwise(rows)
+	case "":
+		return nil, "", customErrors.NewValidationError("format", "не удалось определить формат файла")
+	default:
+		return nil, "", customErrors.NewValidationError("format", "допустимые значения: finflow, splitwise")
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return records, format, nil
+}
+
+// readCSV читает все строки CSV-файла, пропуская BOM и пустые строки
+func readCSV(data io.Reader) ([]csvRow, error) {
+	reader := bufio.NewReader(data)
+	if prefix, _ := reader.Peek(len(utf8BOM)); bytes.Equal(prefix, utf8BOM) {
+		if _, err := reader.Discard(len(utf8BOM)); err != nil {
+			return nil, err
+		}
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	var rows []csvRow
+	for {
+		fields, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, customErrors.NewValidationError("data", fmt.Sprintf("некорректный CSV: %v", err))
+		}
+		line, _ := csvReader.FieldPos(0)
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if isBlank(fields) {
+			continue
+		}
+		rows = append(rows, csvRow{line: line, fields: fields})
+	}
+	return rows, nil
+}
+
+// detectFormat определяет формат файла по первой строке
+func detectFormat(first []string) string {
+	switch {
+	case len(first) > 0 && first[0] == finflowEventTitle:
+		return service.ImportFormatFinFlow
+	case hasPrefix(first, splitwiseColumns):
+		return service.ImportFormatSplitwise
+	default:
+		return ""
+	}
+}
+
+// columnIndex возвращает позиции колонок заголовка по названию
+func columnIndex(header []string) map[string]int {
+	index := make(map[string]int, len(header))
+	for i, title := range header {
+		index[title] = i
+	}
+	return index
+}
+
+// field возвращает значение колонки строки или пустую строку, если колонки нет
+func field(fields []string, index map[string]int, column string) string {
+	i, ok := index[column]
+	if !ok || i >= len(fields) {
+		return ""
+	}
+	return fields[i]
+}
+
+// hasPrefix проверяет, что строка начинается с указанных значений
+func hasPrefix(fields, prefix []string) bool {
+	if len(fields) < len(prefix) {
+		return false
+	}
+	for i, value := range prefix {
+		if !strings.EqualFold(fields[i], value) {
+			return false
+		}
+	}
+	return true
+}
+
+// isBlank проверяет, что все поля строки пусты
+func isBlank(fields []string) bool {
+	for _, value := range fields {
+		if value != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,127 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+)
+
+// Разметка CSV-выгрузки Splitwise
+const (
+	splitwiseDateLayout      = "2006-01-02"
+	splitwiseTotalRow        = "Total balance"
+	splitwisePaymentCategory = "Payment"
+)
+
+// splitwiseColumns - первые колонки выгрузки Splitwise; следующие колонки - участники
+var splitwiseColumns = []string{"Date", "Description", "Category", "Cost", "Currency"}
+
+// parseSplitwise разбирает CSV-выгрузку Splitwise. Для каждого участника в ней указан
+// итог по расходу: положительный - сколько ему должны, отрицательный - сколько должен он.
+func parseSplitwise(rows []csvRow) ([]*record, error) {
+	people := rows[0].fields[len(splitwiseColumns):]
+
+	var records []*record
+	for _, row := range rows[1:] {
+		fields := row.fields
+		if len(fields) > 1 && strings.EqualFold(fields[1], splitwiseTotalRow) {
+			continue
+		}
+
+		rec := &record{line: row.line}
+		records = append(records, rec)
+		if len(fields) < len(splitwiseColumns)+len(people) {
+			rec.fail("ожидается %d колонок, получено %d", len(splitwiseColumns)+len(people), len(fields))
+			continue
+		}
+
+		rec.name = fields[1]
+		rec.category = fields[2]
+		rec.currency = fields[4]
+		if strings.EqualFold(rec.category, splitwisePaymentCategory) {
+			rec.skip = "погашение долга не является расходом"
+			continue
+		}
+
+		datetime, err := time.Parse(splitwiseDateLayout, fields[0])
+		if err != nil {
+			rec.fail("некорректная дата: %q", fields[0])
+		} else {
+			rec.datetime = &datetime
+		}
+
+		cost, err := money.Parse(fields[3])
+		if err != nil {
+			rec.fail("сумма: %v", err)
+			continue
+		}
+		rec.amount = cost
+
+		balances := make([]namedAmount, 0, len(people))
+		for i, person := range people {
+			value := fields[len(splitwiseColumns)+i]
+			if value == "" {
+				continue
+			}
+			balance, err := money.Parse(value)
+			if err != nil {
+				rec.fail("итог участника %q: %v", person, err)
+				continue
+			}
+			if balance != 0 {
+				balances = append(balances, namedAmount{name: person, amount: balance})
+			}
+		}
+		if rec.err != nil {
+			continue
+		}
+
+		rec.payers, rec.shares, err = splitwiseSplit(cost, balances)
+		if err != nil {
+			rec.fail("%v", err)
+		}
+	}
+	return records, nil
+}
+
+// splitwiseSplit восстанавливает оплаты и доли по итогам участников.
+// Участники с отрицательным итогом ничего не платили и должны его модуль.
+// Стоимость делится между участниками с положительным итогом пропорционально итогу,
+// а их доля - оплата за вычетом итога. Для одного плательщика это точное восстановление,
+// для нескольких - распределение, дающее те же долги.
+func splitwiseSplit(cost money.Money, balances []namedAmount) ([]namedAmount, []namedAmount, error) {
+	var creditors []namedAmount
+	var weights []float64
+	var credit money.Money
+	shares := make([]namedAmount, 0, len(balances))
+	for _, balance := range balances {
+		if balance.amount > 0 {
+			creditors = append(creditors, balance)
+			weights = append(weights, balance.amount.Float64())
+			credit += balance.amount
+		} else {
+			shares = append(shares, namedAmount{name: balance.name, amount: -balance.amount})
+		}
+	}
+	if len(creditors) == 0 {
+		return nil, nil, fmt.Errorf("не удалось определить плательщика")
+	}
+	if credit > cost {
+		return nil, nil, fmt.Errorf("итоги участников превышают стоимость")
+	}
+
+	paid, err := cost.Allocate(weights)
+	if err != nil {
+		return nil, nil, err
+	}
+	payers := make([]namedAmount, len(creditors))
+	for i, creditor := range creditors {
+		payers[i] = namedAmount{name: creditor.name, amount: paid[i]}
+		if share := paid[i] - creditor.amount; share > 0 {
+			shares = append(shares, namedAmount{name: creditor.name, amount: share})
+		}
+	}
+	return payers, shares, nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// InviteRequest представляет запрос на создание приглашения в мероприятие
+type InviteRequest struct {
+	Role      models.EventRole // Роль присоединившегося; по умолчанию member
+	MaxUses   *int             // Лимит использований; nil - без ограничения
+	ExpiresAt *time.Time       // Срок действия; nil - бессрочно
+}
+
+// Invite определяет методы для работы с приглашениями в мероприятия
+type Invite interface {
+	// CreateInvite создает приглашение в мероприятие
+	CreateInvite(ctx context.Context, eventID int64, request *InviteRequest) (*models.EventInvite, error)
+
+	// GetInvitesByEventID возвращает действующие приглашения мероприятия
+	GetInvitesByEventID(ctx context.Context, eventID int64) ([]models.EventInvite, error)
+
+	// RevokeInvite отзывает приглашение мероприятия
+	RevokeInvite(ctx context.Context, eventID int64, id int) error
+
+	// JoinEvent добавляет пользователя (по внешнему ID) в мероприятие по коду приглашения
+	JoinEvent(ctx context.Context, token string, externalUserID int64) (*models.UserEvent, error)
+}
@@ -0,0 +1,181 @@
+package invite
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"gorm.io/gorm"
+)
+
+// tokenBytes - длина кода приглашения в байтах до кодирования
+const tokenBytes = 16
+
+// InviteService реализует интерфейс service.Invite
+type InviteService struct {
+	db           *gorm.DB
+	repo         repository.Invite
+	userService  service.User
+	eventService service.Event
+}
+
+// NewInviteService создает новый сервис приглашений
+func NewInviteService(db *gorm.DB, repo repository.Invite, userService service.User, eventService service.Event) *InviteService {
+	return &InviteService{
+		db:           db,
+		repo:         repo,
+		userService:  userService,
+		eventService: eventService,
+	}
+}
+
+// CreateInvite создает приглашение в мероприятие.
+// Приглашать можно только с ролью ниже собственной, владелец назначается передачей владения.
+func (s *InviteService) CreateInvite(ctx context.Context, eventID int64, request *service.InviteRequest) (*models.EventInvite, error) {
+	if err := access.Require(ctx, eventID, access.ManageMembers); err != nil {
+		return nil, err
+	}
+
+	role := request.Role
+	if role == "" {
+		role = models.EventRoleMember
+	}
+	if !access.IsValidRole(role) {
+		return nil, customErrors.NewValidationError("role", fmt.Sprintf("неизвестная роль %q", role))
+	}
+	if role == models.EventRoleOwner {
+		return nil, customErrors.NewValidationError("role", "владелец назначается передачей владения")
+	}
+	if request.MaxUses != nil && *request.MaxUses <= 0 {
+		return nil, customErrors.NewValidationError("max_uses", "лимит использований должен быть положительным")
+	}
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		return nil, customErrors.NewValidationError("expires_at", "срок действия приглашения уже истек")
+	}
+
+	invite := &models.EventInvite{
+		EventID:   eventID,
+		Role:      role,
+		MaxUses:   request.MaxUses,
+		ExpiresAt: request.ExpiresAt,
+	}
+	if actor, ok := access.MemberFromContext(ctx); ok {
+		if access.Rank(actor.Role) <= access.Rank(role) {
+			return nil, customErrors.NewForbiddenError("нельзя приглашать участников с такой же или более высокой ролью")
+		}
+		invite.CreatedBy = &actor.UserID
+	}
+
+	event, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, customErrors.NewEntityNotFoundError(strconv.FormatInt(eventID, 10), "event")
+	}
+
+	invite.Token, err = generateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, invite); err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+// GetInvitesByEventID возвращает действующие приглашения мероприятия
+func (s *InviteService) GetInvitesByEventID(ctx context.Context, eventID int64) ([]models.EventInvite, error) {
+	if err := access.Require(ctx, eventID, access.ManageMembers); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetActiveByEventID(ctx, eventID)
+}
+
+// RevokeInvite отзывает приглашение мероприятия. Повторный отзыв ничего не меняет.
+func (s *InviteService) RevokeInvite(ctx context.Context, eventID int64, id int) error {
+	if err := access.Require(ctx, eventID, access.ManageMembers); err != nil {
+		return err
+	}
+
+	invite, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if invite == nil || invite.EventID != eventID {
+		return customErrors.NewEntityNotFoundError(strconv.Itoa(id), "invite")
+	}
+	if invite.RevokedAt != nil {
+		return nil
+	}
+
+	return s.repo.Revoke(ctx, id)
+}
+
+// JoinEvent добавляет пользователя в мероприятие по коду приглашения с ролью из приглашения.
+// Профиль пользователя предварительно синхронизируется с ID-сервисом.
+func (s *InviteService) JoinEvent(ctx context.Context, token string, externalUserID int64) (*models.UserEvent, error) {
+	invite, err := s.repo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if invite == nil {
+		return nil, customErrors.NewEntityNotFoundError(token, "invite")
+	}
+	if invite.RevokedAt != nil {
+		return nil, customErrors.NewLogicError("приглашение отозвано")
+	}
+	if invite.IsExpired(time.Now()) {
+		return nil, customErrors.NewLogicError("срок действия приглашения истек")
+	}
+	if invite.IsExhausted() {
+		return nil, customErrors.NewLogicError("приглашение использовано максимальное число раз")
+	}
+
+	user, err := s.userService.SyncUserWithIDService(ctx, externalUserID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при синхронизации пользователя: %w", err)
+	}
+
+	err = db.WithTx(ctx, s.db, func(ctx context.Context) error {
+		// Лимит проверяется повторно при учете использования: приглашением могли воспользоваться параллельно
+		ok, err := s.repo.IncrementUses(ctx, invite.ID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return customErrors.NewLogicError("приглашение использовано максимальное число раз")
+		}
+
+		return s.userService.AddEventMember(ctx, invite.EventID, user.ID, invite.Role)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UserEvent{
+		UserID:  user.ID,
+		EventID: invite.EventID,
+		Role:    invite.Role,
+	}, nil
+}
+
+// generateToken генерирует случайный код приглашения, пригодный для ссылки
+func generateToken() (string, error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("ошибка при генерации кода приглашения: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,208 @@
+package invite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestInviteService_CreateInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInviteRepo := repositoryMock.NewMockInvite(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+	var db *gorm.DB
+
+	inviteService := NewInviteService(db, mockInviteRepo, mockUserService, mockEventService)
+
+	eventID := int64(1)
+	ownerCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 10, EventID: eventID, Role: models.EventRoleOwner})
+	adminCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 20, EventID: eventID, Role: models.EventRoleAdmin})
+
+	t.Run("приглашение с ролью по умолчанию", func(t *testing.T) {
+		maxUses := 5
+		mockEventService.EXPECT().GetEventByID(ownerCtx, eventID).Return(&models.Event{ID: eventID}, nil)
+		mockInviteRepo.EXPECT().Create(ownerCtx, gomock.Any()).DoAndReturn(func(_ context.Context, invite *models.EventInvite) error {
+			invite.ID = 1
+			return nil
+		})
+
+		invite, err := inviteService.CreateInvite(ownerCtx, eventID, &service.InviteRequest{MaxUses: &maxUses})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.EventRoleMember, invite.Role)
+		assert.Equal(t, &maxUses, invite.MaxUses)
+		assert.Equal(t, int64(10), *invite.CreatedBy)
+		assert.NotEmpty(t, invite.Token)
+	})
+
+	t.Run("администратор не может приглашать администраторов", func(t *testing.T) {
+		_, err := inviteService.CreateInvite(adminCtx, eventID, &service.InviteRequest{Role: models.EventRoleAdmin})
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("участник не может приглашать", func(t *testing.T) {
+		memberCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 30, EventID: eventID, Role: models.EventRoleMember})
+
+		_, err := inviteService.CreateInvite(memberCtx, eventID, &service.InviteRequest{Role: models.EventRoleViewer})
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("приглашение владельца", func(t *testing.T) {
+		_, err := inviteService.CreateInvite(ownerCtx, eventID, &service.InviteRequest{Role: models.EventRoleOwner})
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("срок действия в прошлом", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Hour)
+
+		_, err := inviteService.CreateInvite(ownerCtx, eventID, &service.InviteRequest{ExpiresAt: &expiresAt})
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestInviteService_RevokeInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInviteRepo := repositoryMock.NewMockInvite(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+	var db *gorm.DB
+
+	inviteService := NewInviteService(db, mockInviteRepo, mockUserService, mockEventService)
+
+	ctx := context.Background()
+	eventID := int64(1)
+
+	t.Run("успешный отзыв", func(t *testing.T) {
+		mockInviteRepo.EXPECT().GetByID(ctx, 1).Return(&models.EventInvite{ID: 1, EventID: eventID}, nil)
+		mockInviteRepo.EXPECT().Revoke(ctx, 1).Return(nil)
+
+		err := inviteService.RevokeInvite(ctx, eventID, 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("приглашение другого мероприятия", func(t *testing.T) {
+		mockInviteRepo.EXPECT().GetByID(ctx, 2).Return(&models.EventInvite{ID: 2, EventID: 42}, nil)
+
+		err := inviteService.RevokeInvite(ctx, eventID, 2)
+
+		var notFoundErr *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestInviteService_JoinEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Создаем in-memory SQLite БД для тестов с транзакциями
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	mockInviteRepo := repositoryMock.NewMockInvite(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+
+	inviteService := NewInviteService(testDB, mockInviteRepo, mockUserService, mockEventService)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	externalUserID := int64(500)
+	token := "invite-token"
+
+	t.Run("присоединение с ролью из приглашения", func(t *testing.T) {
+		mockInviteRepo.EXPECT().GetByToken(ctx, token).
+			Return(&models.EventInvite{ID: 1, EventID: eventID, Token: token, Role: models.EventRoleViewer}, nil)
+		mockUserService.EXPECT().SyncUserWithIDService(ctx, externalUserID).Return(&models.User{ID: 7}, nil)
+		mockInviteRepo.EXPECT().IncrementUses(gomock.Any(), 1).Return(true, nil)
+		mockUserService.EXPECT().AddEventMember(gomock.Any(), eventID, int64(7), models.EventRoleViewer).Return(nil)
+
+		member, err := inviteService.JoinEvent(ctx, token, externalUserID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &models.UserEvent{UserID: 7, EventID: eventID, Role: models.EventRoleViewer}, member)
+	})
+
+	t.Run("приглашение не найдено", func(t *testing.T) {
+		mockInviteRepo.EXPECT().GetByToken(ctx, "unknown").Return(nil, nil)
+
+		_, err := inviteService.JoinEvent(ctx, "unknown", externalUserID)
+
+		var notFoundErr *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("отозванное приглашение", func(t *testing.T) {
+		revokedAt := time.Now()
+		mockInviteRepo.EXPECT().GetByToken(ctx, token).
+			Return(&models.EventInvite{ID: 1, EventID: eventID, Token: token, RevokedAt: &revokedAt}, nil)
+
+		_, err := inviteService.JoinEvent(ctx, token, externalUserID)
+
+		var logicErr *customErrors.LogicError
+		assert.ErrorAs(t, err, &logicErr)
+	})
+
+	t.Run("просроченное приглашение", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Minute)
+		mockInviteRepo.EXPECT().GetByToken(ctx, token).
+			Return(&models.EventInvite{ID: 1, EventID: eventID, Token: token, ExpiresAt: &expiresAt}, nil)
+
+		_, err := inviteService.JoinEvent(ctx, token, externalUserID)
+
+		var logicErr *customErrors.LogicError
+		assert.ErrorAs(t, err, &logicErr)
+	})
+
+	t.Run("лимит исчерпан параллельным присоединением", func(t *testing.T) {
+		maxUses := 1
+		mockInviteRepo.EXPECT().GetByToken(ctx, token).
+			Return(&models.EventInvite{ID: 1, EventID: eventID, Token: token, MaxUses: &maxUses}, nil)
+		mockUserService.EXPECT().SyncUserWithIDService(ctx, externalUserID).Return(&models.User{ID: 7}, nil)
+		mockInviteRepo.EXPECT().IncrementUses(gomock.Any(), 1).Return(false, nil)
+
+		_, err := inviteService.JoinEvent(ctx, token, externalUserID)
+
+		var logicErr *customErrors.LogicError
+		assert.ErrorAs(t, err, &logicErr)
+	})
+
+	t.Run("пользователь уже участник", func(t *testing.T) {
+		mockInviteRepo.EXPECT().GetByToken(ctx, token).
+			Return(&models.EventInvite{ID: 1, EventID: eventID, Token: token, Role: models.EventRoleMember}, nil)
+		mockUserService.EXPECT().SyncUserWithIDService(ctx, externalUserID).Return(&models.User{ID: 7}, nil)
+		mockInviteRepo.EXPECT().IncrementUses(gomock.Any(), 1).Return(true, nil)
+		mockUserService.EXPECT().AddEventMember(gomock.Any(), eventID, int64(7), models.EventRoleMember).
+			Return(customErrors.NewAlreadyExistsError("event member", "пользователь уже участвует в мероприятии"))
+
+		_, err := inviteService.JoinEvent(ctx, token, externalUserID)
+
+		var alreadyExistsErr *customErrors.AlreadyExistsError
+		assert.ErrorAs(t, err, &alreadyExistsErr)
+	})
+}
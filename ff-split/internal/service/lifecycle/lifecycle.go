@@ -0,0 +1,77 @@
+package lifecycle
+
+import (
+	"fmt"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+)
+
+// transitions - допустимые переходы между статусами мероприятия.
+// Возврат в active из любого статуса - возобновление мероприятия.
+var transitions = map[string][]string{
+	models.EventStatusActive:   {models.EventStatusSettling, models.EventStatusClosed},
+	models.EventStatusSettling: {models.EventStatusClosed, models.EventStatusActive},
+	models.EventStatusClosed:   {models.EventStatusArchived, models.EventStatusActive},
+	models.EventStatusArchived: {models.EventStatusActive},
+}
+
+// IsValidStatus проверяет, что статус известен
+func IsValidStatus(status string) bool {
+	_, ok := transitions[status]
+	return ok
+}
+
+// CanTransition проверяет, допустим ли переход мероприятия из статуса from в статус to
+func CanTransition(from, to string) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckTransition возвращает LogicError, если переход между статусами недопустим
+func CheckTransition(from, to string) error {
+	if !IsValidStatus(to) {
+		return customErrors.NewValidationError("status", fmt.Sprintf("неизвестный статус мероприятия %q", to))
+	}
+	if !CanTransition(from, to) {
+		return customErrors.NewLogicError(fmt.Sprintf("нельзя перевести мероприятие из статуса %q в %q", from, to))
+	}
+	return nil
+}
+
+// RequiredPermission возвращает право, необходимое для перевода мероприятия в статус.
+// Возобновить мероприятие может только владелец.
+func RequiredPermission(to string) access.Permission {
+	if to == models.EventStatusActive {
+		return access.ReopenEvent
+	}
+	return access.EditEvent
+}
+
+// AcceptsTransactions проверяет, можно ли добавлять и менять транзакции мероприятия
+func AcceptsTransactions(status string) bool {
+	return status == models.EventStatusActive
+}
+
+// AcceptsSettlements проверяет, можно ли регистрировать погашения в мероприятии
+func AcceptsSettlements(status string) bool {
+	return status != models.EventStatusArchived
+}
+
+// IsPlanFrozen проверяет, зафиксирован ли план переводов мероприятия
+func IsPlanFrozen(status string) bool {
+	return status == models.EventStatusClosed || status == models.EventStatusArchived
+}
+
+// CheckAcceptsTransactions возвращает LogicError, если транзакции мероприятия менять нельзя
+func CheckAcceptsTransactions(status string) error {
+	if !AcceptsTransactions(status) {
+		return customErrors.NewLogicError(fmt.Sprintf("мероприятие в статусе %q не принимает изменения транзакций", status))
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package lifecycle
+
+import (
+	"errors"
+	"testing"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		allowed  bool
+	}{
+		{models.EventStatusActive, models.EventStatusSettling, true},
+		{models.EventStatusActive, models.EventStatusClosed, true},
+		{models.EventStatusActive, models.EventStatusArchived, false},
+		{models.EventStatusSettling, models.EventStatusClosed, true},
+		{models.EventStatusSettling, models.EventStatusArchived, false},
+		{models.EventStatusClosed, models.EventStatusArchived, true},
+		{models.EventStatusClosed, models.EventStatusSettling, false},
+		{models.EventStatusSettling, models.EventStatusActive, true},
+		{models.EventStatusClosed, models.EventStatusActive, true},
+		{models.EventStatusArchived, models.EventStatusActive, true},
+		{models.EventStatusActive, models.EventStatusActive, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			assert.Equal(t, tt.allowed, CanTransition(tt.from, tt.to))
+		})
+	}
+}
+
+func TestCheckTransition(t *testing.T) {
+	t.Run("неизвестный статус", func(t *testing.T) {
+		var validationErr *customErrors.ValidationError
+		assert.True(t, errors.As(CheckTransition(models.EventStatusActive, "archive"), &validationErr))
+	})
+
+	t.Run("недопустимый переход", func(t *testing.T) {
+		var logicErr *customErrors.LogicError
+		assert.True(t, errors.As(CheckTransition(models.EventStatusActive, models.EventStatusArchived), &logicErr))
+	})
+
+	t.Run("допустимый переход", func(t *testing.T) {
+		assert.NoError(t, CheckTransition(models.EventStatusClosed, models.EventStatusArchived))
+	})
+}
+
+func TestRequiredPermission(t *testing.T) {
+	assert.Equal(t, access.ReopenEvent, RequiredPermission(models.EventStatusActive))
+	assert.Equal(t, access.EditEvent, RequiredPermission(models.EventStatusClosed))
+}
+
+func TestStatusRules(t *testing.T) {
+	assert.True(t, AcceptsTransactions(models.EventStatusActive))
+	assert.False(t, AcceptsTransactions(models.EventStatusSettling))
+	assert.False(t, AcceptsTransactions(models.EventStatusClosed))
+
+	assert.True(t, AcceptsSettlements(models.EventStatusClosed))
+	assert.False(t, AcceptsSettlements(models.EventStatusArchived))
+
+	assert.False(t, IsPlanFrozen(models.EventStatusSettling))
+	assert.True(t, IsPlanFrozen(models.EventStatusClosed))
+	assert.True(t, IsPlanFrozen(models.EventStatusArchived))
+}
@@ -0,0 +1,177 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/activity.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/ivasnev/FinFlow/ff-split/internal/models"
+	service "github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// MockActivityRecorder is a mock of ActivityRecorder interface.
+type MockActivityRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityRecorderMockRecorder
+}
+
+// MockActivityRecorderMockRecorder is the mock recorder for MockActivityRecorder.
+type MockActivityRecorderMockRecorder struct {
+	mock *MockActivityRecorder
+}
+
+// NewMockActivityRecorder creates a new mock instance.
+func NewMockActivityRecorder(ctrl *gomock.Controller) *MockActivityRecorder {
+	mock := &MockActivityRecorder{ctrl: ctrl}
+	mock.recorder = &MockActivityRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityRecorder) EXPECT() *MockActivityRecorderMockRecorder {
+	return m.recorder
+}
+
+// RecordActivity mocks base method.
+func (m *MockActivityRecorder) RecordActivity(ctx context.Context, eventID int64, activityType string, payload interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordActivity", ctx, eventID, activityType, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordActivity indicates an expected call of RecordActivity.
+func (mr *MockActivityRecorderMockRecorder) RecordActivity(ctx, eventID, activityType, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordActivity", reflect.TypeOf((*MockActivityRecorder)(nil).RecordActivity), ctx, eventID, activityType, payload)
+}
+
+// MockActivity is a mock of Activity interface.
+type MockActivity struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityMockRecorder
+}
+
+// MockActivityMockRecorder is the mock recorder for MockActivity.
+type MockActivityMockRecorder struct {
+	mock *MockActivity
+}
+
+// NewMockActivity creates a new mock instance.
+func NewMockActivity(ctrl *gomock.Controller) *MockActivity {
+	mock := &MockActivity{ctrl: ctrl}
+	mock.recorder = &MockActivityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivity) EXPECT() *MockActivityMockRecorder {
+	return m.recorder
+}
+
+// CreateActivity mocks base method.
+func (m *MockActivity) CreateActivity(ctx context.Context, activity *models.Activity) (*models.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateActivity", ctx, activity)
+	ret0, _ := ret[0].(*models.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateActivity indicates an expected call of CreateActivity.
+func (mr *MockActivityMockRecorder) CreateActivity(ctx, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateActivity", reflect.TypeOf((*MockActivity)(nil).CreateActivity), ctx, activity)
+}
+
+// DeleteActivity mocks base method.
+func (m *MockActivity) DeleteActivity(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteActivity", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteActivity indicates an expected call of DeleteActivity.
+func (mr *MockActivityMockRecorder) DeleteActivity(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActivity", reflect.TypeOf((*MockActivity)(nil).DeleteActivity), ctx, id)
+}
+
+// GetActivitiesByEventID mocks base method.
+func (m *MockActivity) GetActivitiesByEventID(ctx context.Context, eventID int64) ([]models.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivitiesByEventID", ctx, eventID)
+	ret0, _ := ret[0].([]models.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivitiesByEventID indicates an expected call of GetActivitiesByEventID.
+func (mr *MockActivityMockRecorder) GetActivitiesByEventID(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivitiesByEventID", reflect.TypeOf((*MockActivity)(nil).GetActivitiesByEventID), ctx, eventID)
+}
+
+// GetActivityByID mocks base method.
+func (m *MockActivity) GetActivityByID(ctx context.Context, id int) (*models.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityByID", ctx, id)
+	ret0, _ := ret[0].(*models.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityByID indicates an expected call of GetActivityByID.
+func (mr *MockActivityMockRecorder) GetActivityByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityByID", reflect.TypeOf((*MockActivity)(nil).GetActivityByID), ctx, id)
+}
+
+// GetActivityFeed mocks base method.
+func (m *MockActivity) GetActivityFeed(ctx context.Context, eventID int64, request *service.ActivityFeedRequest) (*service.ActivityFeedDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityFeed", ctx, eventID, request)
+	ret0, _ := ret[0].(*service.ActivityFeedDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityFeed indicates an expected call of GetActivityFeed.
+func (mr *MockActivityMockRecorder) GetActivityFeed(ctx, eventID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityFeed", reflect.TypeOf((*MockActivity)(nil).GetActivityFeed), ctx, eventID, request)
+}
+
+// RecordActivity mocks base method.
+func (m *MockActivity) RecordActivity(ctx context.Context, eventID int64, activityType string, payload interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordActivity", ctx, eventID, activityType, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordActivity indicates an expected call of RecordActivity.
+func (mr *MockActivityMockRecorder) RecordActivity(ctx, eventID, activityType, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordActivity", reflect.TypeOf((*MockActivity)(nil).RecordActivity), ctx, eventID, activityType, payload)
+}
+
+// UpdateActivity mocks base method.
+func (m *MockActivity) UpdateActivity(ctx context.Context, id int, activity *models.Activity) (*models.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivity", ctx, id, activity)
+	ret0, _ := ret[0].(*models.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivity indicates an expected call of UpdateActivity.
+func (mr *MockActivityMockRecorder) UpdateActivity(ctx, id, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivity", reflect.TypeOf((*MockActivity)(nil).UpdateActivity), ctx, id, activity)
+}
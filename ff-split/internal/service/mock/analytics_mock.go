@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/analytics.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	service "github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// MockAnalytics is a mock of Analytics interface.
+type MockAnalytics struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyticsMockRecorder
+}
+
+// MockAnalyticsMockRecorder is the mock recorder for MockAnalytics.
+type MockAnalyticsMockRecorder struct {
+	mock *MockAnalytics
+}
+
+// NewMockAnalytics creates a new mock instance.
+func NewMockAnalytics(ctrl *gomock.Controller) *MockAnalytics {
+	mock := &MockAnalytics{ctrl: ctrl}
+	mock.recorder = &MockAnalyticsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalytics) EXPECT() *MockAnalyticsMockRecorder {
+	return m.recorder
+}
+
+// GetEventAnalytics mocks base method.
+func (m *MockAnalytics) GetEventAnalytics(ctx context.Context, eventID int64, req *service.AnalyticsRequest) (*service.EventAnalyticsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventAnalytics", ctx, eventID, req)
+	ret0, _ := ret[0].(*service.EventAnalyticsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventAnalytics indicates an expected call of GetEventAnalytics.
+func (mr *MockAnalyticsMockRecorder) GetEventAnalytics(ctx, eventID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventAnalytics", reflect.TypeOf((*MockAnalytics)(nil).GetEventAnalytics), ctx, eventID, req)
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	money "github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	models "github.com/ivasnev/FinFlow/ff-split/internal/models"
 	service "github.com/ivasnev/FinFlow/ff-split/internal/service"
 )
@@ -36,6 +37,36 @@ func (m *MockEvent) EXPECT() *MockEventMockRecorder {
 	return m.recorder
 }
 
+// ChangeStatus mocks base method.
+func (m *MockEvent) ChangeStatus(ctx context.Context, id int64, status string) (*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatus", ctx, id, status)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeStatus indicates an expected call of ChangeStatus.
+func (mr *MockEventMockRecorder) ChangeStatus(ctx, id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockEvent)(nil).ChangeStatus), ctx, id, status)
+}
+
+// CheckAccess mocks base method.
+func (m *MockEvent) CheckAccess(ctx context.Context, eventID, externalUserID int64) (*models.UserEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", ctx, eventID, externalUserID)
+	ret0, _ := ret[0].(*models.UserEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockEventMockRecorder) CheckAccess(ctx, eventID, externalUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockEvent)(nil).CheckAccess), ctx, eventID, externalUserID)
+}
+
 // CreateEvent mocks base method.
 func (m *MockEvent) CreateEvent(ctx context.Context, request *service.EventRequest) (*service.EventResponse, error) {
 	m.ctrl.T.Helper()
@@ -66,10 +97,10 @@ func (mr *MockEventMockRecorder) DeleteEvent(ctx, id interface{}) *gomock.Call {
 }
 
 // GetBalanceByEventID mocks base method.
-func (m *MockEvent) GetBalanceByEventID(ctx context.Context, userID, eventID int64) (float64, error) {
+func (m *MockEvent) GetBalanceByEventID(ctx context.Context, userID, eventID int64) (money.Money, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBalanceByEventID", ctx, userID, eventID)
-	ret0, _ := ret[0].(float64)
+	ret0, _ := ret[0].(money.Money)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -80,6 +111,21 @@ func (mr *MockEventMockRecorder) GetBalanceByEventID(ctx, userID, eventID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceByEventID", reflect.TypeOf((*MockEvent)(nil).GetBalanceByEventID), ctx, userID, eventID)
 }
 
+// GetDeletedEventsByUserID mocks base method.
+func (m *MockEvent) GetDeletedEventsByUserID(ctx context.Context, userID int64) ([]service.EventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedEventsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]service.EventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedEventsByUserID indicates an expected call of GetDeletedEventsByUserID.
+func (mr *MockEventMockRecorder) GetDeletedEventsByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedEventsByUserID", reflect.TypeOf((*MockEvent)(nil).GetDeletedEventsByUserID), ctx, userID)
+}
+
 // GetEventByID mocks base method.
 func (m *MockEvent) GetEventByID(ctx context.Context, id int64) (*models.Event, error) {
 	m.ctrl.T.Helper()
@@ -111,18 +157,33 @@ func (mr *MockEventMockRecorder) GetEvents(ctx interface{}) *gomock.Call {
 }
 
 // GetEventsByUserID mocks base method.
-func (m *MockEvent) GetEventsByUserID(ctx context.Context, userID int64) ([]service.EventResponse, error) {
+func (m *MockEvent) GetEventsByUserID(ctx context.Context, userID int64, includeArchived bool) ([]service.EventResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventsByUserID", ctx, userID)
+	ret := m.ctrl.Call(m, "GetEventsByUserID", ctx, userID, includeArchived)
 	ret0, _ := ret[0].([]service.EventResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEventsByUserID indicates an expected call of GetEventsByUserID.
-func (mr *MockEventMockRecorder) GetEventsByUserID(ctx, userID interface{}) *gomock.Call {
+func (mr *MockEventMockRecorder) GetEventsByUserID(ctx, userID, includeArchived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsByUserID", reflect.TypeOf((*MockEvent)(nil).GetEventsByUserID), ctx, userID, includeArchived)
+}
+
+// RestoreEvent mocks base method.
+func (m *MockEvent) RestoreEvent(ctx context.Context, id, externalUserID int64) (*service.EventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEvent", ctx, id, externalUserID)
+	ret0, _ := ret[0].(*service.EventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreEvent indicates an expected call of RestoreEvent.
+func (mr *MockEventMockRecorder) RestoreEvent(ctx, id, externalUserID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsByUserID", reflect.TypeOf((*MockEvent)(nil).GetEventsByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEvent", reflect.TypeOf((*MockEvent)(nil).RestoreEvent), ctx, id, externalUserID)
 }
 
 // UpdateEvent mocks base method.
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/recurring.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	service "github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// MockRecurring is a mock of Recurring interface.
+type MockRecurring struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecurringMockRecorder
+}
+
+// MockRecurringMockRecorder is the mock recorder for MockRecurring.
+type MockRecurringMockRecorder struct {
+	mock *MockRecurring
+}
+
+// NewMockRecurring creates a new mock instance.
+func NewMockRecurring(ctrl *gomock.Controller) *MockRecurring {
+	mock := &MockRecurring{ctrl: ctrl}
+	mock.recorder = &MockRecurringMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecurring) EXPECT() *MockRecurringMockRecorder {
+	return m.recorder
+}
+
+// CreateRecurring mocks base method.
+func (m *MockRecurring) CreateRecurring(ctx context.Context, eventID int64, req *service.RecurringRequest) (*service.RecurringDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecurring", ctx, eventID, req)
+	ret0, _ := ret[0].(*service.RecurringDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecurring indicates an expected call of CreateRecurring.
+func (mr *MockRecurringMockRecorder) CreateRecurring(ctx, eventID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurring", reflect.TypeOf((*MockRecurring)(nil).CreateRecurring), ctx, eventID, req)
+}
+
+// DeleteRecurring mocks base method.
+func (m *MockRecurring) DeleteRecurring(ctx context.Context, eventID int64, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecurring", ctx, eventID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecurring indicates an expected call of DeleteRecurring.
+func (mr *MockRecurringMockRecorder) DeleteRecurring(ctx, eventID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecurring", reflect.TypeOf((*MockRecurring)(nil).DeleteRecurring), ctx, eventID, id)
+}
+
+// GetRecurringByEventID mocks base method.
+func (m *MockRecurring) GetRecurringByEventID(ctx context.Context, eventID int64) ([]service.RecurringDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurringByEventID", ctx, eventID)
+	ret0, _ := ret[0].([]service.RecurringDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecurringByEventID indicates an expected call of GetRecurringByEventID.
+func (mr *MockRecurringMockRecorder) GetRecurringByEventID(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurringByEventID", reflect.TypeOf((*MockRecurring)(nil).GetRecurringByEventID), ctx, eventID)
+}
+
+// ProcessDue mocks base method.
+func (m *MockRecurring) ProcessDue(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessDue", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessDue indicates an expected call of ProcessDue.
+func (mr *MockRecurringMockRecorder) ProcessDue(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessDue", reflect.TypeOf((*MockRecurring)(nil).ProcessDue), ctx, now)
+}
+
+// ReassignUser mocks base method.
+func (m *MockRecurring) ReassignUser(ctx context.Context, fromUserID, toUserID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignUser", ctx, fromUserID, toUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReassignUser indicates an expected call of ReassignUser.
+func (mr *MockRecurringMockRecorder) ReassignUser(ctx, fromUserID, toUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignUser", reflect.TypeOf((*MockRecurring)(nil).ReassignUser), ctx, fromUserID, toUserID)
+}
+
+// SetRecurringPaused mocks base method.
+func (m *MockRecurring) SetRecurringPaused(ctx context.Context, eventID int64, id int, paused bool) (*service.RecurringDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRecurringPaused", ctx, eventID, id, paused)
+	ret0, _ := ret[0].(*service.RecurringDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRecurringPaused indicates an expected call of SetRecurringPaused.
+func (mr *MockRecurringMockRecorder) SetRecurringPaused(ctx, eventID, id, paused interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecurringPaused", reflect.TypeOf((*MockRecurring)(nil).SetRecurringPaused), ctx, eventID, id, paused)
+}
+
+// UpdateRecurring mocks base method.
+func (m *MockRecurring) UpdateRecurring(ctx context.Context, eventID int64, id int, req *service.RecurringRequest) (*service.RecurringDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecurring", ctx, eventID, id, req)
+	ret0, _ := ret[0].(*service.RecurringDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRecurring indicates an expected call of UpdateRecurring.
+func (mr *MockRecurringMockRecorder) UpdateRecurring(ctx, eventID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecurring", reflect.TypeOf((*MockRecurring)(nil).UpdateRecurring), ctx, eventID, id, req)
+}
@@ -0,0 +1,498 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/transaction.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	service "github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// MockTransaction is a mock of Transaction interface.
+type MockTransaction struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionMockRecorder
+}
+
+// MockTransactionMockRecorder is the mock recorder for MockTransaction.
+type MockTransactionMockRecorder struct {
+	mock *MockTransaction
+}
+
+// NewMockTransaction creates a new mock instance.
+func NewMockTransaction(ctrl *gomock.Controller) *MockTransaction {
+	mock := &MockTransaction{ctrl: ctrl}
+	mock.recorder = &MockTransactionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransaction) EXPECT() *MockTransactionMockRecorder {
+	return m.recorder
+}
+
+// AttachFile mocks base method.
+func (m *MockTransaction) AttachFile(ctx context.Context, eventID int64, id int, objectID string) (*service.AttachmentDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachFile", ctx, eventID, id, objectID)
+	ret0, _ := ret[0].(*service.AttachmentDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachFile indicates an expected call of AttachFile.
+func (mr *MockTransactionMockRecorder) AttachFile(ctx, eventID, id, objectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachFile", reflect.TypeOf((*MockTransaction)(nil).AttachFile), ctx, eventID, id, objectID)
+}
+
+// CloseEvent mocks base method.
+func (m *MockTransaction) CloseEvent(ctx context.Context, eventID int64) (*service.OptimizationResultDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseEvent", ctx, eventID)
+	ret0, _ := ret[0].(*service.OptimizationResultDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseEvent indicates an expected call of CloseEvent.
+func (mr *MockTransactionMockRecorder) CloseEvent(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseEvent", reflect.TypeOf((*MockTransaction)(nil).CloseEvent), ctx, eventID)
+}
+
+// CreateSettlement mocks base method.
+func (m *MockTransaction) CreateSettlement(ctx context.Context, eventID int64, req *service.SettlementRequest) (*service.SettlementDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSettlement", ctx, eventID, req)
+	ret0, _ := ret[0].(*service.SettlementDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSettlement indicates an expected call of CreateSettlement.
+func (mr *MockTransactionMockRecorder) CreateSettlement(ctx, eventID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSettlement", reflect.TypeOf((*MockTransaction)(nil).CreateSettlement), ctx, eventID, req)
+}
+
+// CreateTransaction mocks base method.
+func (m *MockTransaction) CreateTransaction(ctx context.Context, eventID int64, req *service.TransactionRequest) (*service.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransaction", ctx, eventID, req)
+	ret0, _ := ret[0].(*service.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MockTransactionMockRecorder) CreateTransaction(ctx, eventID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockTransaction)(nil).CreateTransaction), ctx, eventID, req)
+}
+
+// CreateTransactionItem mocks base method.
+func (m *MockTransaction) CreateTransactionItem(ctx context.Context, transactionID int, req *service.ItemDTO) (*service.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionItem", ctx, transactionID, req)
+	ret0, _ := ret[0].(*service.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransactionItem indicates an expected call of CreateTransactionItem.
+func (mr *MockTransactionMockRecorder) CreateTransactionItem(ctx, transactionID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionItem", reflect.TypeOf((*MockTransaction)(nil).CreateTransactionItem), ctx, transactionID, req)
+}
+
+// DeleteSettlement mocks base method.
+func (m *MockTransaction) DeleteSettlement(ctx context.Context, eventID int64, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSettlement", ctx, eventID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSettlement indicates an expected call of DeleteSettlement.
+func (mr *MockTransactionMockRecorder) DeleteSettlement(ctx, eventID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSettlement", reflect.TypeOf((*MockTransaction)(nil).DeleteSettlement), ctx, eventID, id)
+}
+
+// DeleteTransaction mocks base method.
+func (m *MockTransaction) DeleteTransaction(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransaction", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransaction indicates an expected call of DeleteTransaction.
+func (mr *MockTransactionMockRecorder) DeleteTransaction(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockTransaction)(nil).DeleteTransaction), ctx, id)
+}
+
+// DeleteTransactionItem mocks base method.
+func (m *MockTransaction) DeleteTransactionItem(ctx context.Context, transactionID, itemID int) (*service.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransactionItem", ctx, transactionID, itemID)
+	ret0, _ := ret[0].(*service.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTransactionItem indicates an expected call of DeleteTransactionItem.
+func (mr *MockTransactionMockRecorder) DeleteTransactionItem(ctx, transactionID, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionItem", reflect.TypeOf((*MockTransaction)(nil).DeleteTransactionItem), ctx, transactionID, itemID)
+}
+
+// DetachFile mocks base method.
+func (m *MockTransaction) DetachFile(ctx context.Context, eventID int64, id, attachmentID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFile", ctx, eventID, id, attachmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachFile indicates an expected call of DetachFile.
+func (mr *MockTransactionMockRecorder) DetachFile(ctx, eventID, id, attachmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFile", reflect.TypeOf((*MockTransaction)(nil).DetachFile), ctx, eventID, id, attachmentID)
+}
+
+// GetAttachmentURL mocks base method.
+func (m *MockTransaction) GetAttachmentURL(ctx context.Context, eventID int64, id, attachmentID int) (*service.AttachmentDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentURL", ctx, eventID, id, attachmentID)
+	ret0, _ := ret[0].(*service.AttachmentDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentURL indicates an expected call of GetAttachmentURL.
+func (mr *MockTransactionMockRecorder) GetAttachmentURL(ctx, eventID, id, attachmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentURL", reflect.TypeOf((*MockTransaction)(nil).GetAttachmentURL), ctx, eventID, id, attachmentID)
+}
+
+// GetCurrentOptimizedDebts mocks base method.
+func (m *MockTransaction) GetCurrentOptimizedDebts(ctx context.Context, eventID int64) ([]service.OptimizedDebtDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentOptimizedDebts", ctx, eventID)
+	ret0, _ := ret[0].([]service.OptimizedDebtDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentOptimizedDebts indicates an expected call of GetCurrentOptimizedDebts.
+func (mr *MockTransactionMockRecorder) GetCurrentOptimizedDebts(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentOptimizedDebts", reflect.TypeOf((*MockTransaction)(nil).GetCurrentOptimizedDebts), ctx, eventID)
+}
+
+// GetDebtsByEventID mocks base method.
+func (m *MockTransaction) GetDebtsByEventID(ctx context.Context, eventID int64, userID *int64) ([]service.DebtDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebtsByEventID", ctx, eventID, userID)
+	ret0, _ := ret[0].([]service.DebtDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebtsByEventID indicates an expected call of GetDebtsByEventID.
+func (mr *MockTransactionMockRecorder) GetDebtsByEventID(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByEventID", reflect.TypeOf((*MockTransaction)(nil).GetDebtsByEventID), ctx, eventID, userID)
+}
+
+// GetDebtsByEventIDFromUser mocks base method.
+func (m *MockTransaction) GetDebtsByEventIDFromUser(ctx context.Context, eventID, userID int64) ([]service.DebtDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebtsByEventIDFromUser", ctx, eventID, userID)
+	ret0, _ := ret[0].([]service.DebtDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebtsByEventIDFromUser indicates an expected call of GetDebtsByEventIDFromUser.
+func (mr *MockTransactionMockRecorder) GetDebtsByEventIDFromUser(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByEventIDFromUser", reflect.TypeOf((*MockTransaction)(nil).GetDebtsByEventIDFromUser), ctx, eventID, userID)
+}
+
+// GetDebtsByEventIDToUser mocks base method.
+func (m *MockTransaction) GetDebtsByEventIDToUser(ctx context.Context, eventID, userID int64) ([]service.DebtDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebtsByEventIDToUser", ctx, eventID, userID)
+	ret0, _ := ret[0].([]service.DebtDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDebtsByEventIDToUser indicates an expected call of GetDebtsByEventIDToUser.
+func (mr *MockTransactionMockRecorder) GetDebtsByEventIDToUser(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebtsByEventIDToUser", reflect.TypeOf((*MockTransaction)(nil).GetDebtsByEventIDToUser), ctx, eventID, userID)
+}
+
+// GetDeletedTransactions mocks base method.
+func (m *MockTransaction) GetDeletedTransactions(ctx context.Context, eventID int64) ([]service.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedTransactions", ctx, eventID)
+	ret0, _ := ret[0].([]service.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedTransactions indicates an expected call of GetDeletedTransactions.
+func (mr *MockTransactionMockRecorder) GetDeletedTransactions(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedTransactions", reflect.TypeOf((*MockTransaction)(nil).GetDeletedTransactions), ctx, eventID)
+}
+
+// GetOptimizedDebtsByEventID mocks base method.
+func (m *MockTransaction) GetOptimizedDebtsByEventID(ctx context.Context, eventID int64, userID *int64) ([]service.OptimizedDebtDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptimizedDebtsByEventID", ctx, eventID, userID)
+	ret0, _ := ret[0].([]service.OptimizedDebtDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOptimizedDebtsByEventID indicates an expected call of GetOptimizedDebtsByEventID.
+func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByEventID(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByEventID", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByEventID), ctx, eventID, userID)
+}
+
+// GetOptimizedDebtsByEventIDFromUser mocks base method.
+func (m *MockTransaction) GetOptimizedDebtsByEventIDFromUser(ctx context.Context, eventID, userID int64) ([]service.OptimizedDebtDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptimizedDebtsByEventIDFromUser", ctx, eventID, userID)
+	ret0, _ := ret[0].([]service.OptimizedDebtDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOptimizedDebtsByEventIDFromUser indicates an expected call of GetOptimizedDebtsByEventIDFromUser.
+func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByEventIDFromUser(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByEventIDFromUser", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByEventIDFromUser), ctx, eventID, userID)
+}
+
+// GetOptimizedDebtsByEventIDToUser mocks base method.
+func (m *MockTransaction) GetOptimizedDebtsByEventIDToUser(ctx context.Context, eventID, userID int64) ([]service.OptimizedDebtDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptimizedDebtsByEventIDToUser", ctx, eventID, userID)
+	ret0, _ := ret[0].([]service.OptimizedDebtDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOptimizedDebtsByEventIDToUser indicates an expected call of GetOptimizedDebtsByEventIDToUser.
+func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByEventIDToUser(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByEventIDToUser", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByEventIDToUser), ctx, eventID, userID)
+}
+
+// GetOptimizedDebtsByUserID mocks base method.
+func (m *MockTransaction) GetOptimizedDebtsByUserID(ctx context.Context, eventID, userID int64) ([]service.OptimizedDebtDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptimizedDebtsByUserID", ctx, eventID, userID)
+	ret0, _ := ret[0].([]service.OptimizedDebtDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOptimizedDebtsByUserID indicates an expected call of GetOptimizedDebtsByUserID.
+func (mr *MockTransactionMockRecorder) GetOptimizedDebtsByUserID(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptimizedDebtsByUserID", reflect.TypeOf((*MockTransaction)(nil).GetOptimizedDebtsByUserID), ctx, eventID, userID)
+}
+
+// GetSettlementsByEventID mocks base method.
+func (m *MockTransaction) GetSettlementsByEventID(ctx context.Context, eventID int64) ([]service.SettlementDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettlementsByEventID", ctx, eventID)
+	ret0, _ := ret[0].([]service.SettlementDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettlementsByEventID indicates an expected call of GetSettlementsByEventID.
+func (mr *MockTransactionMockRecorder) GetSettlementsByEventID(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlementsByEventID", reflect.TypeOf((*MockTransaction)(nil).GetSettlementsByEventID), ctx, eventID)
+}
+
+// GetTransactionByID mocks base method.
+func (m *MockTransaction) GetTransactionByID(ctx context.Context, id int) (*service.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByID", ctx, id)
+	ret0, _ := ret[0].(*service.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByID indicates an expected call of GetTransactionByID.
+func (mr *MockTransactionMockRecorder) GetTransactionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByID", reflect.TypeOf((*MockTransaction)(nil).GetTransactionByID), ctx, id)
+}
+
+// GetTransactionHistory mocks base method.
+func (m *MockTransaction) GetTransactionHistory(ctx context.Context, eventID int64, id int) ([]service.TransactionRevisionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionHistory", ctx, eventID, id)
+	ret0, _ := ret[0].([]service.TransactionRevisionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionHistory indicates an expected call of GetTransactionHistory.
+func (mr *MockTransactionMockRecorder) GetTransactionHistory(ctx, eventID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionHistory", reflect.TypeOf((*MockTransaction)(nil).GetTransactionHistory), ctx, eventID, id)
+}
+
+// GetTransactionItems mocks base method.
+func (m *MockTransaction) GetTransactionItems(ctx context.Context, transactionID int) ([]service.ItemDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionItems", ctx, transactionID)
+	ret0, _ := ret[0].([]service.ItemDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionItems indicates an expected call of GetTransactionItems.
+func (mr *MockTransactionMockRecorder) GetTransactionItems(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionItems", reflect.TypeOf((*MockTransaction)(nil).GetTransactionItems), ctx, transactionID)
+}
+
+// GetTransactionsByEventID mocks base method.
+func (m *MockTransaction) GetTransactionsByEventID(ctx context.Context, eventID int64) ([]service.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionsByEventID", ctx, eventID)
+	ret0, _ := ret[0].([]service.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionsByEventID indicates an expected call of GetTransactionsByEventID.
+func (mr *MockTransactionMockRecorder) GetTransactionsByEventID(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsByEventID", reflect.TypeOf((*MockTransaction)(nil).GetTransactionsByEventID), ctx, eventID)
+}
+
+// ListTransactions mocks base method.
+func (m *MockTransaction) ListTransactions(ctx context.Context, eventID int64, req *service.TransactionListRequest) (*service.TransactionPageDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", ctx, eventID, req)
+	ret0, _ := ret[0].(*service.TransactionPageDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MockTransactionMockRecorder) ListTransactions(ctx, eventID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockTransaction)(nil).ListTransactions), ctx, eventID, req)
+}
+
+// OptimizeDebts mocks base method.
+func (m *MockTransaction) OptimizeDebts(ctx context.Context, eventID int64) ([]service.OptimizedDebtDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OptimizeDebts", ctx, eventID)
+	ret0, _ := ret[0].([]service.OptimizedDebtDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OptimizeDebts indicates an expected call of OptimizeDebts.
+func (mr *MockTransactionMockRecorder) OptimizeDebts(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OptimizeDebts", reflect.TypeOf((*MockTransaction)(nil).OptimizeDebts), ctx, eventID)
+}
+
+// OptimizeDebtsWithAlgorithm mocks base method.
+func (m *MockTransaction) OptimizeDebtsWithAlgorithm(ctx context.Context, eventID int64, algorithm string) (*service.OptimizationResultDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OptimizeDebtsWithAlgorithm", ctx, eventID, algorithm)
+	ret0, _ := ret[0].(*service.OptimizationResultDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OptimizeDebtsWithAlgorithm indicates an expected call of OptimizeDebtsWithAlgorithm.
+func (mr *MockTransactionMockRecorder) OptimizeDebtsWithAlgorithm(ctx, eventID, algorithm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OptimizeDebtsWithAlgorithm", reflect.TypeOf((*MockTransaction)(nil).OptimizeDebtsWithAlgorithm), ctx, eventID, algorithm)
+}
+
+// RestoreTransaction mocks base method.
+func (m *MockTransaction) RestoreTransaction(ctx context.Context, eventID int64, id int) (*service.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTransaction", ctx, eventID, id)
+	ret0, _ := ret[0].(*service.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTransaction indicates an expected call of RestoreTransaction.
+func (mr *MockTransactionMockRecorder) RestoreTransaction(ctx, eventID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTransaction", reflect.TypeOf((*MockTransaction)(nil).RestoreTransaction), ctx, eventID, id)
+}
+
+// RevertTransaction mocks base method.
+func (m *MockTransaction) RevertTransaction(ctx context.Context, eventID int64, id, revisionID int) (*service.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertTransaction", ctx, eventID, id, revisionID)
+	ret0, _ := ret[0].(*service.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertTransaction indicates an expected call of RevertTransaction.
+func (mr *MockTransactionMockRecorder) RevertTransaction(ctx, eventID, id, revisionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertTransaction", reflect.TypeOf((*MockTransaction)(nil).RevertTransaction), ctx, eventID, id, revisionID)
+}
+
+// UpdateTransaction mocks base method.
+func (m *MockTransaction) UpdateTransaction(ctx context.Context, id int, req *service.TransactionRequest) (*service.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, id, req)
+	ret0, _ := ret[0].(*service.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransaction indicates an expected call of UpdateTransaction.
+func (mr *MockTransactionMockRecorder) UpdateTransaction(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockTransaction)(nil).UpdateTransaction), ctx, id, req)
+}
+
+// UpdateTransactionItem mocks base method.
+func (m *MockTransaction) UpdateTransactionItem(ctx context.Context, transactionID, itemID int, req *service.ItemDTO) (*service.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransactionItem", ctx, transactionID, itemID, req)
+	ret0, _ := ret[0].(*service.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransactionItem indicates an expected call of UpdateTransactionItem.
+func (mr *MockTransactionMockRecorder) UpdateTransactionItem(ctx, transactionID, itemID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionItem", reflect.TypeOf((*MockTransaction)(nil).UpdateTransactionItem), ctx, transactionID, itemID, req)
+}
@@ -36,6 +36,20 @@ func (m *MockUser) EXPECT() *MockUserMockRecorder {
 	return m.recorder
 }
 
+// AddEventMember mocks base method.
+func (m *MockUser) AddEventMember(ctx context.Context, eventID, userID int64, role models.EventRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEventMember", ctx, eventID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEventMember indicates an expected call of AddEventMember.
+func (mr *MockUserMockRecorder) AddEventMember(ctx, eventID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventMember", reflect.TypeOf((*MockUser)(nil).AddEventMember), ctx, eventID, userID, role)
+}
+
 // AddUsersToEvent mocks base method.
 func (m *MockUser) AddUsersToEvent(ctx context.Context, ids []int64, eventID int64) error {
 	m.ctrl.T.Helper()
@@ -79,6 +93,20 @@ func (mr *MockUserMockRecorder) BatchSyncUsersWithIDService(ctx, userIDs interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSyncUsersWithIDService", reflect.TypeOf((*MockUser)(nil).BatchSyncUsersWithIDService), ctx, userIDs)
 }
 
+// ChangeEventRole mocks base method.
+func (m *MockUser) ChangeEventRole(ctx context.Context, eventID, userID int64, role models.EventRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeEventRole", ctx, eventID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeEventRole indicates an expected call of ChangeEventRole.
+func (mr *MockUserMockRecorder) ChangeEventRole(ctx, eventID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeEventRole", reflect.TypeOf((*MockUser)(nil).ChangeEventRole), ctx, eventID, userID, role)
+}
+
 // CreateDummyUser mocks base method.
 func (m *MockUser) CreateDummyUser(ctx context.Context, name string, eventID int64) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +166,21 @@ func (mr *MockUserMockRecorder) GetDummiesByEventID(ctx, eventID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDummiesByEventID", reflect.TypeOf((*MockUser)(nil).GetDummiesByEventID), ctx, eventID)
 }
 
+// GetEventMembers mocks base method.
+func (m *MockUser) GetEventMembers(ctx context.Context, eventID int64) ([]models.UserEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventMembers", ctx, eventID)
+	ret0, _ := ret[0].([]models.UserEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventMembers indicates an expected call of GetEventMembers.
+func (mr *MockUserMockRecorder) GetEventMembers(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventMembers", reflect.TypeOf((*MockUser)(nil).GetEventMembers), ctx, eventID)
+}
+
 // GetInternalUserIdsByExternalUserIds mocks base method.
 func (m *MockUser) GetInternalUserIdsByExternalUserIds(ctx context.Context, externalUserIds []int64) ([]int64, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +301,20 @@ func (mr *MockUserMockRecorder) GetUsersByInternalUserIDs(ctx, userIDs interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByInternalUserIDs", reflect.TypeOf((*MockUser)(nil).GetUsersByInternalUserIDs), ctx, userIDs)
 }
 
+// MergeDummyUser mocks base method.
+func (m *MockUser) MergeDummyUser(ctx context.Context, dummyID, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeDummyUser", ctx, dummyID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeDummyUser indicates an expected call of MergeDummyUser.
+func (mr *MockUserMockRecorder) MergeDummyUser(ctx, dummyID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDummyUser", reflect.TypeOf((*MockUser)(nil).MergeDummyUser), ctx, dummyID, userID)
+}
+
 // RemoveUserFromEvent mocks base method.
 func (m *MockUser) RemoveUserFromEvent(ctx context.Context, userID, eventID int64) error {
 	m.ctrl.T.Helper()
@@ -287,6 +344,20 @@ func (mr *MockUserMockRecorder) SyncUserWithIDService(ctx, userID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncUserWithIDService", reflect.TypeOf((*MockUser)(nil).SyncUserWithIDService), ctx, userID)
 }
 
+// TransferEventOwnership mocks base method.
+func (m *MockUser) TransferEventOwnership(ctx context.Context, eventID, newOwnerID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferEventOwnership", ctx, eventID, newOwnerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferEventOwnership indicates an expected call of TransferEventOwnership.
+func (mr *MockUserMockRecorder) TransferEventOwnership(ctx, eventID, newOwnerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferEventOwnership", reflect.TypeOf((*MockUser)(nil).TransferEventOwnership), ctx, eventID, newOwnerID)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUser) UpdateUser(ctx context.Context, user *models.User) (*models.User, error) {
 	m.ctrl.T.Helper()
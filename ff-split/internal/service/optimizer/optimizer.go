@@ -0,0 +1,45 @@
+package optimizer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ivasnev/FinFlow/ff-common/optimizers"
+	"github.com/ivasnev/FinFlow/ff-common/optimizers/registry"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+)
+
+// DefaultAlgorithm - алгоритм оптимизации долгов, используемый, если алгоритм не выбран
+const DefaultAlgorithm = registry.DefaultAlgorithm
+
+// Normalize приводит имя алгоритма к нижнему регистру и проверяет, что он зарегистрирован.
+// Пустое имя заменяется на DefaultAlgorithm.
+func Normalize(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return DefaultAlgorithm, nil
+	}
+	if !registry.Has(name) {
+		return "", customErrors.NewValidationError("optimization_algorithm",
+			fmt.Sprintf("неизвестный алгоритм оптимизации %q, доступны: %s", name, strings.Join(registry.Names(), ", ")))
+	}
+	return name, nil
+}
+
+// Get возвращает оптимизатор долгов по имени алгоритма вместе с нормализованным именем
+func Get(name string) (optimizers.Optimizer, string, error) {
+	name, err := Normalize(name)
+	if err != nil {
+		return nil, "", err
+	}
+	opt, err := registry.Get(name)
+	if err != nil {
+		return nil, "", err
+	}
+	return opt, name, nil
+}
+
+// Algorithms возвращает список доступных алгоритмов оптимизации
+func Algorithms() []string {
+	return registry.Names()
+}
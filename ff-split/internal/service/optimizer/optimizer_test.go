@@ -0,0 +1,38 @@
+package optimizer
+
+import (
+	"testing"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Run("пустое имя заменяется алгоритмом по умолчанию", func(t *testing.T) {
+		name, err := Normalize("")
+		require.NoError(t, err)
+		assert.Equal(t, DefaultAlgorithm, name)
+	})
+
+	t.Run("имя приводится к нижнему регистру", func(t *testing.T) {
+		name, err := Normalize(" Greedy ")
+		require.NoError(t, err)
+		assert.Equal(t, "greedy", name)
+	})
+
+	t.Run("неизвестный алгоритм", func(t *testing.T) {
+		_, err := Normalize("simplex")
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestGet(t *testing.T) {
+	for _, algorithm := range Algorithms() {
+		opt, name, err := Get(algorithm)
+		require.NoError(t, err)
+		assert.NotNil(t, opt)
+		assert.Equal(t, algorithm, name)
+	}
+}
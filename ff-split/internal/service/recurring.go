@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// RecurringRequest представляет запрос на создание или изменение регулярной транзакции
+type RecurringRequest struct {
+	Frequency   string             // Частота: daily | weekly | monthly | yearly
+	Interval    int                // Каждые Interval периодов; по умолчанию 1
+	StartDate   time.Time          // Первый повтор
+	EndDate     *time.Time         // Последний возможный повтор; nil - бессрочно
+	Transaction TransactionRequest // Транзакция, создаваемая при каждом повторе
+}
+
+// RecurringDTO представляет шаблон регулярной транзакции
+type RecurringDTO struct {
+	ID          int
+	EventID     int64
+	Frequency   string
+	Interval    int
+	StartDate   time.Time
+	EndDate     *time.Time
+	Paused      bool
+	NextRunAt   *time.Time // Время следующего повтора; nil - повторы закончились
+	CreatedBy   *int64
+	CreatedAt   time.Time
+	Transaction TransactionRequest
+}
+
+// Recurring определяет методы для работы с регулярными транзакциями
+type Recurring interface {
+	// CreateRecurring создает шаблон регулярной транзакции
+	CreateRecurring(ctx context.Context, eventID int64, req *RecurringRequest) (*RecurringDTO, error)
+
+	// GetRecurringByEventID возвращает шаблоны регулярных транзакций мероприятия
+	GetRecurringByEventID(ctx context.Context, eventID int64) ([]RecurringDTO, error)
+
+	// UpdateRecurring изменяет расписание и транзакцию шаблона
+	UpdateRecurring(ctx context.Context, eventID int64, id int, req *RecurringRequest) (*RecurringDTO, error)
+
+	// SetRecurringPaused приостанавливает или возобновляет шаблон
+	SetRecurringPaused(ctx context.Context, eventID int64, id int, paused bool) (*RecurringDTO, error)
+
+	// DeleteRecurring удаляет шаблон
+	DeleteRecurring(ctx context.Context, eventID int64, id int) error
+
+	// ReassignUser заменяет пользователя fromUserID на toUserID в транзакциях шаблонов,
+	// например при объединении dummy-пользователя с реальным
+	ReassignUser(ctx context.Context, fromUserID, toUserID int64) error
+
+	// ProcessDue создает транзакции для всех повторов, наступивших к моменту now.
+	// Возвращает число созданных транзакций.
+	ProcessDue(ctx context.Context, now time.Time) (int, error)
+}
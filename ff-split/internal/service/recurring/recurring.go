@@ -0,0 +1,406 @@
+package recurring
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/debt_calculator"
+	"gorm.io/gorm"
+)
+
+// dueBatchSize - сколько шаблонов обрабатывается за один вызов ProcessDue
+const dueBatchSize = 100
+
+// RecurringService реализует интерфейс service.Recurring
+type RecurringService struct {
+	db                 *gorm.DB
+	repo               repository.Recurring
+	transactionService service.Transaction
+}
+
+// NewRecurringService создает новый сервис регулярных транзакций
+func NewRecurringService(db *gorm.DB, repo repository.Recurring, transactionService service.Transaction) *RecurringService {
+	return &RecurringService{
+		db:                 db,
+		repo:               repo,
+		transactionService: transactionService,
+	}
+}
+
+// CreateRecurring создает шаблон регулярной транзакции.
+// Повторы, время которых на момент создания уже прошло, не создаются.
+func (s *RecurringService) CreateRecurring(ctx context.Context, eventID int64, req *service.RecurringRequest) (*service.RecurringDTO, error) {
+	if err := access.Require(ctx, eventID, access.EditTransactions); err != nil {
+		return nil, err
+	}
+
+	recurring := &models.RecurringTransaction{EventID: eventID}
+	if err := applyRequest(recurring, eventID, req); err != nil {
+		return nil, err
+	}
+	if actor, ok := access.MemberFromContext(ctx); ok {
+		recurring.CreatedBy = &actor.UserID
+	}
+	recurring.NextIndex, recurring.NextRunAt = nextOccurrence(recurring, 0, time.Now())
+
+	if err := s.repo.Create(ctx, recurring); err != nil {
+		return nil, err
+	}
+	return convertToDTO(recurring)
+}
+
+// GetRecurringByEventID возвращает шаблоны регулярных транзакций мероприятия
+func (s *RecurringService) GetRecurringByEventID(ctx context.Context, eventID int64) ([]service.RecurringDTO, error) {
+	recurrings, err := s.repo.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]service.RecurringDTO, 0, len(recurrings))
+	for i := range recurrings {
+		dto, err := convertToDTO(&recurrings[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *dto)
+	}
+	return result, nil
+}
+
+// UpdateRecurring изменяет расписание и транзакцию шаблона.
+// Следующий повтор планируется по новому расписанию начиная с текущего момента.
+func (s *RecurringService) UpdateRecurring(ctx context.Context, eventID int64, id int, req *service.RecurringRequest) (*service.RecurringDTO, error) {
+	recurring, err := s.getRecurring(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyRequest(recurring, eventID, req); err != nil {
+		return nil, err
+	}
+	recurring.NextIndex, recurring.NextRunAt = nextOccurrence(recurring, 0, time.Now())
+
+	if err := s.repo.Update(ctx, recurring); err != nil {
+		return nil, err
+	}
+	return convertToDTO(recurring)
+}
+
+// SetRecurringPaused приостанавливает или возобновляет шаблон.
+// Повторы, пропущенные за время паузы, не создаются.
+func (s *RecurringService) SetRecurringPaused(ctx context.Context, eventID int64, id int, paused bool) (*service.RecurringDTO, error) {
+	recurring, err := s.getRecurring(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	if recurring.Paused == paused {
+		return convertToDTO(recurring)
+	}
+
+	recurring.Paused = paused
+	if !paused {
+		recurring.NextIndex, recurring.NextRunAt = nextOccurrence(recurring, recurring.NextIndex, time.Now())
+	}
+
+	if err := s.repo.Update(ctx, recurring); err != nil {
+		return nil, err
+	}
+	return convertToDTO(recurring)
+}
+
+// DeleteRecurring удаляет шаблон. Уже созданные транзакции остаются.
+func (s *RecurringService) DeleteRecurring(ctx context.Context, eventID int64, id int) error {
+	if _, err := s.getRecurring(ctx, eventID, id); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, id)
+}
+
+// ReassignUser заменяет пользователя fromUserID на toUserID в транзакциях шаблонов.
+// Вызывается в транзакции объединения пользователей, поэтому права не проверяет.
+func (s *RecurringService) ReassignUser(ctx context.Context, fromUserID, toUserID int64) error {
+	recurrings, err := s.repo.GetByParticipant(ctx, fromUserID)
+	if err != nil {
+		return err
+	}
+
+	for i := range recurrings {
+		recurring := &recurrings[i]
+		var req service.TransactionRequest
+		if err := json.Unmarshal(recurring.Body, &req); err != nil {
+			return fmt.Errorf("ошибка при разборе транзакции шаблона %d: %w", recurring.ID, err)
+		}
+		if !reassignUser(&req, fromUserID, toUserID) {
+			continue
+		}
+
+		body, err := json.Marshal(req)
+		if err != nil {
+			return fmt.Errorf("ошибка при сохранении транзакции шаблона %d: %w", recurring.ID, err)
+		}
+		recurring.Body = body
+		if err := s.repo.Update(ctx, recurring); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProcessDue создает транзакции для всех повторов, наступивших к моменту now.
+// Ошибка одного шаблона не останавливает обработку остальных.
+func (s *RecurringService) ProcessDue(ctx context.Context, now time.Time) (int, error) {
+	recurrings, err := s.repo.GetDue(ctx, now, dueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	var errs []error
+	for i := range recurrings {
+		n, err := s.materialize(ctx, &recurrings[i], now)
+		created += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("регулярная транзакция %d: %w", recurrings[i].ID, err))
+		}
+	}
+	return created, errors.Join(errs...)
+}
+
+// materialize создает транзакции для наступивших повторов шаблона.
+//
+// Каждый повтор обрабатывается в одной транзакции БД: шаблон переходит к следующему повтору,
+// записывается повтор с уникальным временем и создается сама транзакция. Ни перезапуск, ни
+// параллельный обработчик не создадут повтор дважды, а сбой процесса не оставит повтор без
+// транзакции. Если транзакцию создать нельзя, повтор все равно закрепляется вместе с ошибкой.
+func (s *RecurringService) materialize(ctx context.Context, recurring *models.RecurringTransaction, now time.Time) (int, error) {
+	created := 0
+	for recurring.NextRunAt != nil && !recurring.NextRunAt.After(now) {
+		occurrence := &models.RecurringOccurrence{
+			RecurringID: recurring.ID,
+			ScheduledAt: *recurring.NextRunAt,
+		}
+		nextIndex, nextRunAt := nextOccurrence(recurring, recurring.NextIndex+1, occurrence.ScheduledAt)
+
+		var advanced, ok bool
+		err := db.WithTx(ctx, s.db, func(ctx context.Context) error {
+			var err error
+			advanced, err = s.repo.Advance(ctx, recurring.ID, recurring.NextIndex, nextIndex, nextRunAt)
+			if err != nil || !advanced {
+				return err
+			}
+			claimed, err := s.repo.CreateOccurrence(ctx, occurrence)
+			if err != nil || !claimed {
+				return err
+			}
+			ok, err = s.createOccurrenceTransaction(ctx, recurring, occurrence)
+			return err
+		})
+		if err != nil {
+			return created, err
+		}
+		if !advanced {
+			// Шаблон изменен, приостановлен или обработан параллельно
+			return created, nil
+		}
+		recurring.NextIndex, recurring.NextRunAt = nextIndex, nextRunAt
+		if ok {
+			created++
+		}
+	}
+	return created, nil
+}
+
+// createOccurrenceTransaction создает транзакцию повтора и сохраняет результат в повторе.
+// Возвращает false, если транзакцию создать не удалось: причина записывается в повтор.
+func (s *RecurringService) createOccurrenceTransaction(ctx context.Context, recurring *models.RecurringTransaction, occurrence *models.RecurringOccurrence) (bool, error) {
+	var req service.TransactionRequest
+	if err := json.Unmarshal(recurring.Body, &req); err != nil {
+		return false, fmt.Errorf("ошибка при разборе транзакции шаблона: %w", err)
+	}
+	// Повтор датируется временем по расписанию, даже если обработан с опозданием
+	scheduledAt := occurrence.ScheduledAt
+	req.Datetime = &scheduledAt
+
+	resp, err := s.transactionService.CreateTransaction(ctx, recurring.EventID, &req)
+	if err != nil {
+		msg := err.Error()
+		return false, s.repo.SetOccurrenceResult(ctx, occurrence.ID, nil, &msg)
+	}
+	return true, s.repo.SetOccurrenceResult(ctx, occurrence.ID, &resp.ID, nil)
+}
+
+// getRecurring проверяет права на изменение шаблонов и возвращает шаблон мероприятия
+func (s *RecurringService) getRecurring(ctx context.Context, eventID int64, id int) (*models.RecurringTransaction, error) {
+	if err := access.Require(ctx, eventID, access.EditTransactions); err != nil {
+		return nil, err
+	}
+
+	recurring, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if recurring == nil || recurring.EventID != eventID {
+		return nil, customErrors.NewEntityNotFoundError(strconv.Itoa(id), "recurring transaction")
+	}
+	return recurring, nil
+}
+
+// reassignUser заменяет пользователя from на to в запросе на создание транзакции.
+// Как и при объединении сохраненных транзакций, доли и оплаты обоих пользователей
+// складываются, а повторы в списках участников удаляются. Возвращает false,
+// если пользователь from в запросе не упоминается.
+func reassignUser(req *service.TransactionRequest, from, to int64) bool {
+	changed := false
+	if req.FromUser == from {
+		req.FromUser = to
+		changed = true
+	}
+
+	if users, ok := replaceUser(req.Users, from, to); ok {
+		req.Users = users
+		changed = true
+	}
+
+	fromKey, toKey := strconv.FormatInt(from, 10), strconv.FormatInt(to, 10)
+	if value, ok := req.Portion[fromKey]; ok {
+		delete(req.Portion, fromKey)
+		req.Portion[toKey] += value
+		changed = true
+	}
+	if value, ok := req.Amounts[fromKey]; ok {
+		delete(req.Amounts, fromKey)
+		req.Amounts[toKey] += value
+		changed = true
+	}
+
+	payers := req.Payers[:0]
+	toPayer := -1
+	for _, payer := range req.Payers {
+		if payer.UserID == from {
+			payer.UserID = to
+			changed = true
+		}
+		if payer.UserID == to {
+			if toPayer >= 0 {
+				payers[toPayer].Amount += payer.Amount
+				continue
+			}
+			toPayer = len(payers)
+		}
+		payers = append(payers, payer)
+	}
+	req.Payers = payers
+
+	for i := range req.Items {
+		if consumers, ok := replaceUser(req.Items[i].Consumers, from, to); ok {
+			req.Items[i].Consumers = consumers
+			changed = true
+		}
+	}
+	return changed
+}
+
+// replaceUser заменяет from на to в списке пользователей без повторов, сохраняя порядок
+func replaceUser(users []int64, from, to int64) ([]int64, bool) {
+	found := false
+	for _, user := range users {
+		if user == from {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return users, false
+	}
+
+	result := make([]int64, 0, len(users))
+	seen := false
+	for _, user := range users {
+		if user == from {
+			user = to
+		}
+		if user == to {
+			if seen {
+				continue
+			}
+			seen = true
+		}
+		result = append(result, user)
+	}
+	return result, true
+}
+
+// applyRequest проверяет запрос и переносит расписание и транзакцию в шаблон
+func applyRequest(recurring *models.RecurringTransaction, eventID int64, req *service.RecurringRequest) error {
+	if !isValidFrequency(req.Frequency) {
+		return customErrors.NewValidationError("frequency", fmt.Sprintf("неизвестная частота %q", req.Frequency))
+	}
+	interval := req.Interval
+	if interval == 0 {
+		interval = 1
+	}
+	if interval < 0 {
+		return customErrors.NewValidationError("interval", "интервал должен быть положительным")
+	}
+	if req.StartDate.IsZero() {
+		return customErrors.NewValidationError("start_date", "не указана дата первого повтора")
+	}
+	if req.EndDate != nil && req.EndDate.Before(req.StartDate) {
+		return customErrors.NewValidationError("end_date", "дата окончания раньше даты первого повтора")
+	}
+	if req.Transaction.Name == "" {
+		return customErrors.NewValidationError("transaction.name", "не указано название транзакции")
+	}
+
+	// Проверяем распределение заранее, чтобы не узнать об ошибке только при первом повторе
+	calculator, err := debt_calculator.GetCalculator(req.Transaction.Type)
+	if err != nil {
+		return customErrors.NewValidationError("transaction.type", err.Error())
+	}
+	if _, _, err := calculator.Calculate(&req.Transaction, eventID); err != nil {
+		return customErrors.NewValidationError("transaction", err.Error())
+	}
+
+	body, err := json.Marshal(req.Transaction)
+	if err != nil {
+		return fmt.Errorf("ошибка при сохранении транзакции шаблона: %w", err)
+	}
+
+	recurring.Frequency = req.Frequency
+	recurring.Interval = interval
+	recurring.StartDate = req.StartDate
+	recurring.EndDate = req.EndDate
+	recurring.Body = body
+	return nil
+}
+
+// convertToDTO преобразует шаблон в DTO
+func convertToDTO(recurring *models.RecurringTransaction) (*service.RecurringDTO, error) {
+	dto := &service.RecurringDTO{
+		ID:        recurring.ID,
+		EventID:   recurring.EventID,
+		Frequency: recurring.Frequency,
+		Interval:  recurring.Interval,
+		StartDate: recurring.StartDate,
+		EndDate:   recurring.EndDate,
+		Paused:    recurring.Paused,
+		NextRunAt: recurring.NextRunAt,
+		CreatedBy: recurring.CreatedBy,
+		CreatedAt: recurring.CreatedAt,
+	}
+	if err := json.Unmarshal(recurring.Body, &dto.Transaction); err != nil {
+		return nil, fmt.Errorf("ошибка при разборе транзакции шаблона: %w", err)
+	}
+	return dto, nil
+}
@@ -0,0 +1,423 @@
+package recurring
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestOccurrenceAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		frequency string
+		interval  int
+		start     time.Time
+		index     int
+		want      time.Time
+	}{
+		{
+			name:      "ежедневно",
+			frequency: models.RecurrenceDaily,
+			interval:  1,
+			start:     time.Date(2025, 1, 30, 9, 0, 0, 0, time.UTC),
+			index:     3,
+			want:      time.Date(2025, 2, 2, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "раз в две недели",
+			frequency: models.RecurrenceWeekly,
+			interval:  2,
+			start:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			index:     2,
+			want:      time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "ежемесячно в короткий месяц",
+			frequency: models.RecurrenceMonthly,
+			interval:  1,
+			start:     time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC),
+			index:     1,
+			want:      time.Date(2025, 2, 28, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "ежемесячно после короткого месяца",
+			frequency: models.RecurrenceMonthly,
+			interval:  1,
+			start:     time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC),
+			index:     2,
+			want:      time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "ежегодно с 29 февраля",
+			frequency: models.RecurrenceYearly,
+			interval:  1,
+			start:     time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			index:     1,
+			want:      time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurring := &models.RecurringTransaction{Frequency: tt.frequency, Interval: tt.interval, StartDate: tt.start}
+
+			assert.Equal(t, tt.want, occurrenceAt(recurring, tt.index))
+		})
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+	recurring := &models.RecurringTransaction{
+		Frequency: models.RecurrenceMonthly,
+		Interval:  1,
+		StartDate: start,
+		EndDate:   &endDate,
+	}
+
+	t.Run("первый повтор не раньше момента", func(t *testing.T) {
+		index, at := nextOccurrence(recurring, 0, time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, 1, index)
+		require.NotNil(t, at)
+		assert.Equal(t, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), *at)
+	})
+
+	t.Run("повторы закончились", func(t *testing.T) {
+		_, at := nextOccurrence(recurring, 0, time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC))
+
+		assert.Nil(t, at)
+	})
+}
+
+// newTransactionRequest возвращает корректный запрос на транзакцию поровну
+func newTransactionRequest() service.TransactionRequest {
+	return service.TransactionRequest{
+		Name:     "Аренда",
+		Type:     "equal",
+		FromUser: 1,
+		Amount:   money.FromFloat(1000),
+		Users:    []int64{1, 2},
+	}
+}
+
+func TestRecurringService_CreateRecurring(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRecurringRepo := repositoryMock.NewMockRecurring(ctrl)
+	mockTransactionService := serviceMock.NewMockTransaction(ctrl)
+	var db *gorm.DB
+
+	recurringService := NewRecurringService(db, mockRecurringRepo, mockTransactionService)
+
+	eventID := int64(1)
+	ctx := access.WithMember(context.Background(), &models.UserEvent{UserID: 1, EventID: eventID, Role: models.EventRoleMember})
+
+	t.Run("успешное создание", func(t *testing.T) {
+		start := time.Now().Add(time.Hour).Truncate(time.Second)
+		mockRecurringRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, recurring *models.RecurringTransaction) error {
+			recurring.ID = 1
+			return nil
+		})
+
+		recurring, err := recurringService.CreateRecurring(ctx, eventID, &service.RecurringRequest{
+			Frequency:   models.RecurrenceMonthly,
+			StartDate:   start,
+			Transaction: newTransactionRequest(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, recurring.ID)
+		assert.Equal(t, 1, recurring.Interval, "интервал по умолчанию")
+		require.NotNil(t, recurring.NextRunAt)
+		assert.Equal(t, start, *recurring.NextRunAt, "первый повтор - дата начала")
+		assert.Equal(t, int64(1), *recurring.CreatedBy)
+		assert.Equal(t, "Аренда", recurring.Transaction.Name)
+	})
+
+	t.Run("прошедшие повторы пропускаются", func(t *testing.T) {
+		start := time.Now().AddDate(0, 0, -3)
+		mockRecurringRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		recurring, err := recurringService.CreateRecurring(ctx, eventID, &service.RecurringRequest{
+			Frequency:   models.RecurrenceWeekly,
+			StartDate:   start,
+			Transaction: newTransactionRequest(),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, recurring.NextRunAt)
+		assert.Equal(t, start.AddDate(0, 0, 7), *recurring.NextRunAt)
+	})
+
+	t.Run("неизвестная частота", func(t *testing.T) {
+		_, err := recurringService.CreateRecurring(ctx, eventID, &service.RecurringRequest{
+			Frequency:   "hourly",
+			StartDate:   time.Now(),
+			Transaction: newTransactionRequest(),
+		})
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("некорректное распределение", func(t *testing.T) {
+		req := newTransactionRequest()
+		req.Type = "percent"
+		req.Portion = map[string]float64{"1": 30, "2": 30}
+
+		_, err := recurringService.CreateRecurring(ctx, eventID, &service.RecurringRequest{
+			Frequency:   models.RecurrenceDaily,
+			StartDate:   time.Now(),
+			Transaction: req,
+		})
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("наблюдатель не может создавать", func(t *testing.T) {
+		viewerCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 2, EventID: eventID, Role: models.EventRoleViewer})
+
+		_, err := recurringService.CreateRecurring(viewerCtx, eventID, &service.RecurringRequest{
+			Frequency:   models.RecurrenceDaily,
+			StartDate:   time.Now(),
+			Transaction: newTransactionRequest(),
+		})
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+}
+
+func TestRecurringService_SetRecurringPaused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRecurringRepo := repositoryMock.NewMockRecurring(ctrl)
+	mockTransactionService := serviceMock.NewMockTransaction(ctrl)
+	var db *gorm.DB
+
+	recurringService := NewRecurringService(db, mockRecurringRepo, mockTransactionService)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	body, err := json.Marshal(newTransactionRequest())
+	require.NoError(t, err)
+
+	t.Run("возобновление пропускает повторы за время паузы", func(t *testing.T) {
+		start := time.Now().AddDate(0, 0, -10)
+		missed := start.AddDate(0, 0, 1)
+		mockRecurringRepo.EXPECT().GetByID(ctx, 1).Return(&models.RecurringTransaction{
+			ID: 1, EventID: eventID, Frequency: models.RecurrenceDaily, Interval: 1, StartDate: start,
+			Body: body, Paused: true, NextIndex: 1, NextRunAt: &missed,
+		}, nil)
+		mockRecurringRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+		recurring, err := recurringService.SetRecurringPaused(ctx, eventID, 1, false)
+
+		require.NoError(t, err)
+		assert.False(t, recurring.Paused)
+		require.NotNil(t, recurring.NextRunAt)
+		assert.False(t, recurring.NextRunAt.Before(time.Now().Add(-time.Minute)), "следующий повтор не в прошлом")
+	})
+
+	t.Run("шаблон другого мероприятия", func(t *testing.T) {
+		mockRecurringRepo.EXPECT().GetByID(ctx, 2).Return(&models.RecurringTransaction{ID: 2, EventID: 42}, nil)
+
+		_, err := recurringService.SetRecurringPaused(ctx, eventID, 2, true)
+
+		var notFoundErr *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestReassignUser(t *testing.T) {
+	t.Run("доли и оплаты складываются, повторы удаляются", func(t *testing.T) {
+		req := service.TransactionRequest{
+			Type:     "units",
+			FromUser: 3,
+			Users:    []int64{1, 3, 2},
+			Portion:  map[string]float64{"1": 1, "2": 2, "3": 3},
+			Payers: []service.PayerDTO{
+				{UserID: 2, Amount: money.FromFloat(300)},
+				{UserID: 3, Amount: money.FromFloat(700)},
+			},
+			Items: []service.ItemDTO{{Name: "Пицца", Consumers: []int64{3, 2}}},
+		}
+
+		changed := reassignUser(&req, 3, 2)
+
+		assert.True(t, changed)
+		assert.Equal(t, int64(2), req.FromUser)
+		assert.Equal(t, []int64{1, 2}, req.Users)
+		assert.Equal(t, map[string]float64{"1": 1, "2": 5}, req.Portion)
+		assert.Equal(t, []service.PayerDTO{{UserID: 2, Amount: money.FromFloat(1000)}}, req.Payers)
+		assert.Equal(t, []int64{2}, req.Items[0].Consumers)
+	})
+
+	t.Run("суммы участников складываются", func(t *testing.T) {
+		req := service.TransactionRequest{
+			Type:     "amount",
+			FromUser: 1,
+			Users:    []int64{1, 2, 3},
+			Amounts:  map[string]money.Money{"1": money.FromMinor(3333), "2": money.FromMinor(3333), "3": money.FromMinor(3334)},
+		}
+
+		changed := reassignUser(&req, 3, 2)
+
+		assert.True(t, changed)
+		assert.Equal(t, map[string]money.Money{"1": money.FromMinor(3333), "2": money.FromMinor(6667)}, req.Amounts)
+	})
+
+	t.Run("пользователь не упоминается", func(t *testing.T) {
+		req := newTransactionRequest()
+
+		changed := reassignUser(&req, 3, 2)
+
+		assert.False(t, changed)
+		assert.Equal(t, newTransactionRequest(), req)
+	})
+}
+
+func TestRecurringService_ReassignUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRecurringRepo := repositoryMock.NewMockRecurring(ctrl)
+	mockTransactionService := serviceMock.NewMockTransaction(ctrl)
+	var db *gorm.DB
+
+	recurringService := NewRecurringService(db, mockRecurringRepo, mockTransactionService)
+
+	ctx := context.Background()
+	body, err := json.Marshal(newTransactionRequest())
+	require.NoError(t, err)
+
+	t.Run("сохраняются только шаблоны с пользователем", func(t *testing.T) {
+		other, err := json.Marshal(service.TransactionRequest{Name: "Такси", Type: "equal", FromUser: 4, Users: []int64{4, 5}})
+		require.NoError(t, err)
+		mockRecurringRepo.EXPECT().GetByParticipant(ctx, int64(2)).Return([]models.RecurringTransaction{
+			{ID: 1, Body: body},
+			{ID: 2, Body: other},
+		}, nil)
+		mockRecurringRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, recurring *models.RecurringTransaction) error {
+			assert.Equal(t, 1, recurring.ID)
+			var req service.TransactionRequest
+			require.NoError(t, json.Unmarshal(recurring.Body, &req))
+			assert.Equal(t, []int64{1, 7}, req.Users)
+			return nil
+		})
+
+		err = recurringService.ReassignUser(ctx, 2, 7)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestRecurringService_ProcessDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Создаем in-memory SQLite БД для тестов с транзакциями
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	mockRecurringRepo := repositoryMock.NewMockRecurring(ctrl)
+	mockTransactionService := serviceMock.NewMockTransaction(ctrl)
+
+	recurringService := NewRecurringService(testDB, mockRecurringRepo, mockTransactionService)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	body, err := json.Marshal(newTransactionRequest())
+	require.NoError(t, err)
+	newRecurring := func() models.RecurringTransaction {
+		nextRunAt := start
+		return models.RecurringTransaction{
+			ID: 1, EventID: eventID, Frequency: models.RecurrenceDaily, Interval: 1, StartDate: start,
+			Body: body, NextIndex: 0, NextRunAt: &nextRunAt,
+		}
+	}
+
+	t.Run("наступившие повторы создаются один раз", func(t *testing.T) {
+		now := start.AddDate(0, 0, 1)
+		mockRecurringRepo.EXPECT().GetDue(ctx, now, dueBatchSize).Return([]models.RecurringTransaction{newRecurring()}, nil)
+
+		// Первый повтор создается
+		second := start.AddDate(0, 0, 1)
+		mockRecurringRepo.EXPECT().Advance(gomock.Any(), 1, 0, 1, &second).Return(true, nil)
+		mockRecurringRepo.EXPECT().CreateOccurrence(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, occurrence *models.RecurringOccurrence) (bool, error) {
+				assert.Equal(t, start, occurrence.ScheduledAt)
+				occurrence.ID = 10
+				return true, nil
+			})
+		mockTransactionService.EXPECT().CreateTransaction(gomock.Any(), eventID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ int64, req *service.TransactionRequest) (*service.TransactionResponse, error) {
+				// Транзакция датируется временем повтора, а не временем обработки
+				require.NotNil(t, req.Datetime)
+				assert.Equal(t, start, *req.Datetime)
+				return &service.TransactionResponse{ID: 100}, nil
+			})
+		transactionID := 100
+		mockRecurringRepo.EXPECT().SetOccurrenceResult(gomock.Any(), 10, &transactionID, nil).Return(nil)
+
+		// Второй повтор уже был записан до перезапуска - транзакция не создается повторно
+		third := start.AddDate(0, 0, 2)
+		mockRecurringRepo.EXPECT().Advance(gomock.Any(), 1, 1, 2, &third).Return(true, nil)
+		mockRecurringRepo.EXPECT().CreateOccurrence(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		created, err := recurringService.ProcessDue(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, created)
+	})
+
+	t.Run("шаблон обработан параллельно", func(t *testing.T) {
+		now := start
+		mockRecurringRepo.EXPECT().GetDue(ctx, now, dueBatchSize).Return([]models.RecurringTransaction{newRecurring()}, nil)
+		mockRecurringRepo.EXPECT().Advance(gomock.Any(), 1, 0, 1, gomock.Any()).Return(false, nil)
+
+		created, err := recurringService.ProcessDue(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+	})
+
+	t.Run("ошибка создания транзакции сохраняется в повторе", func(t *testing.T) {
+		now := start
+		mockRecurringRepo.EXPECT().GetDue(ctx, now, dueBatchSize).Return([]models.RecurringTransaction{newRecurring()}, nil)
+		mockRecurringRepo.EXPECT().Advance(gomock.Any(), 1, 0, 1, gomock.Any()).Return(true, nil)
+		mockRecurringRepo.EXPECT().CreateOccurrence(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, occurrence *models.RecurringOccurrence) (bool, error) {
+				occurrence.ID = 11
+				return true, nil
+			})
+		mockTransactionService.EXPECT().CreateTransaction(gomock.Any(), eventID, gomock.Any()).
+			Return(nil, customErrors.NewLogicError("мероприятие закрыто"))
+		mockRecurringRepo.EXPECT().SetOccurrenceResult(gomock.Any(), 11, nil, gomock.Not(gomock.Nil())).Return(nil)
+
+		created, err := recurringService.ProcessDue(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+	})
+}
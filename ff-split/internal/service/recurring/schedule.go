@@ -0,0 +1,64 @@
+package recurring
+
+import (
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+)
+
+// maxOccurrenceIndex ограничивает поиск повтора, чтобы ошибка в расписании не зациклила обработчик
+const maxOccurrenceIndex = 100000
+
+// isValidFrequency проверяет, что частота повторения известна
+func isValidFrequency(frequency string) bool {
+	switch frequency {
+	case models.RecurrenceDaily, models.RecurrenceWeekly, models.RecurrenceMonthly, models.RecurrenceYearly:
+		return true
+	}
+	return false
+}
+
+// occurrenceAt возвращает время повтора с номером index.
+// Повторы отсчитываются от StartDate, поэтому день месяца не "съезжает" после коротких месяцев.
+func occurrenceAt(recurring *models.RecurringTransaction, index int) time.Time {
+	periods := index * recurring.Interval
+	start := recurring.StartDate
+
+	switch recurring.Frequency {
+	case models.RecurrenceWeekly:
+		return start.AddDate(0, 0, 7*periods)
+	case models.RecurrenceMonthly:
+		return addMonths(start, periods)
+	case models.RecurrenceYearly:
+		return addMonths(start, 12*periods)
+	default:
+		return start.AddDate(0, 0, periods)
+	}
+}
+
+// addMonths прибавляет месяцы к дате. Если в итоговом месяце нет такого дня, берется последний день месяца.
+func addMonths(t time.Time, months int) time.Time {
+	firstDay := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstDay.AddDate(0, 1, -1).Day()
+
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstDay.AddDate(0, 0, day-1)
+}
+
+// nextOccurrence возвращает номер и время первого повтора не раньше notBefore, начиная с номера fromIndex.
+// Если повторов больше нет, время равно nil.
+func nextOccurrence(recurring *models.RecurringTransaction, fromIndex int, notBefore time.Time) (int, *time.Time) {
+	for index := fromIndex; index < maxOccurrenceIndex; index++ {
+		at := occurrenceAt(recurring, index)
+		if recurring.EndDate != nil && at.After(*recurring.EndDate) {
+			return index, nil
+		}
+		if !at.Before(notBefore) {
+			return index, &at
+		}
+	}
+	return maxOccurrenceIndex, nil
+}
@@ -0,0 +1,57 @@
+package recurring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// defaultWorkerInterval - период проверки, если в конфигурации он не задан
+const defaultWorkerInterval = time.Minute
+
+// Worker периодически создает транзакции для наступивших повторов регулярных транзакций.
+// Состояние расписания хранится в БД, поэтому обработчик можно перезапускать.
+type Worker struct {
+	service  service.Recurring
+	interval time.Duration
+}
+
+// NewWorker создает обработчик, проверяющий наступившие повторы раз в interval
+func NewWorker(service service.Recurring, interval time.Duration) *Worker {
+	if interval <= 0 {
+		interval = defaultWorkerInterval
+	}
+	return &Worker{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run запускает обработку и блокируется до отмены ctx
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.process(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// process обрабатывает наступившие повторы
+func (w *Worker) process(ctx context.Context) {
+	created, err := w.service.ProcessDue(ctx, time.Now())
+	if err != nil {
+		fmt.Printf("⛔️ Error processing recurring transactions: %v\n", err)
+	}
+	if created > 0 {
+		fmt.Printf("🔁 Created %d recurring transactions\n", created)
+	}
+}
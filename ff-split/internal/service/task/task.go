@@ -6,17 +6,19 @@ import (
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
 	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/activity"
 )
 
 // TaskService реализует сервис для работы с задачами
 type TaskService struct {
 	repo        repository.Task
 	userService service.User
+	activities  service.ActivityRecorder
 }
 
 // NewTaskService создает новый сервис для работы с задачами
-func NewTaskService(repo repository.Task, userService service.User) *TaskService {
-	return &TaskService{repo: repo, userService: userService}
+func NewTaskService(repo repository.Task, userService service.User, activities service.ActivityRecorder) *TaskService {
+	return &TaskService{repo: repo, userService: userService, activities: activities}
 }
 
 // GetTasksByEventID возвращает список задач мероприятия
@@ -64,6 +66,7 @@ func (s *TaskService) CreateTask(ctx context.Context, eventID int64, taskRequest
 	if err != nil {
 		return nil, err
 	}
+	s.recordTaskActivity(ctx, models.ActivityTypeTaskCreated, &task)
 
 	taskDTO := mapTaskToDTO(task)
 	return &taskDTO, nil
@@ -92,6 +95,7 @@ func (s *TaskService) UpdateTask(ctx context.Context, id uint, taskRequest *serv
 	if err != nil {
 		return nil, err
 	}
+	s.recordTaskActivity(ctx, models.ActivityTypeTaskUpdated, existingTask)
 
 	taskDTO := mapTaskToDTO(*existingTask)
 	return &taskDTO, nil
@@ -99,7 +103,27 @@ func (s *TaskService) UpdateTask(ctx context.Context, id uint, taskRequest *serv
 
 // DeleteTask удаляет задачу по ID
 func (s *TaskService) DeleteTask(ctx context.Context, id uint) error {
-	return s.repo.DeleteTask(id)
+	task, err := s.repo.GetTaskByID(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteTask(id); err != nil {
+		return err
+	}
+	s.recordTaskActivity(ctx, models.ActivityTypeTaskDeleted, task)
+	return nil
+}
+
+// recordTaskActivity записывает изменение задачи в ленту активности ее мероприятия
+func (s *TaskService) recordTaskActivity(ctx context.Context, activityType string, task *models.Task) {
+	if task.EventID == nil {
+		return
+	}
+	activity.Record(ctx, s.activities, *task.EventID, activityType, models.TaskActivityPayload{
+		TaskID: task.ID,
+		Title:  task.Title,
+	})
 }
 
 // Вспомогательные функции для маппинга между моделью и DTO
@@ -8,6 +8,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
 	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
@@ -20,7 +21,7 @@ func TestTaskService_GetTasksByEventID(t *testing.T) {
 
 	mockTaskRepo := repositoryMock.NewMockTask(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
-	taskService := NewTaskService(mockTaskRepo, mockUserService)
+	taskService := NewTaskService(mockTaskRepo, mockUserService, nil)
 
 	eventID := int64(1)
 
@@ -81,7 +82,7 @@ func TestTaskService_GetTaskByID(t *testing.T) {
 
 	mockTaskRepo := repositoryMock.NewMockTask(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
-	taskService := NewTaskService(mockTaskRepo, mockUserService)
+	taskService := NewTaskService(mockTaskRepo, mockUserService, nil)
 
 	taskID := uint(1)
 
@@ -132,7 +133,7 @@ func TestTaskService_CreateTask(t *testing.T) {
 
 	mockTaskRepo := repositoryMock.NewMockTask(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
-	taskService := NewTaskService(mockTaskRepo, mockUserService)
+	taskService := NewTaskService(mockTaskRepo, mockUserService, nil)
 
 	ctx := context.Background()
 	eventID := int64(1)
@@ -204,7 +205,7 @@ func TestTaskService_UpdateTask(t *testing.T) {
 
 	mockTaskRepo := repositoryMock.NewMockTask(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
-	taskService := NewTaskService(mockTaskRepo, mockUserService)
+	taskService := NewTaskService(mockTaskRepo, mockUserService, nil)
 
 	ctx := context.Background()
 	taskID := uint(1)
@@ -298,15 +299,26 @@ func TestTaskService_DeleteTask(t *testing.T) {
 
 	mockTaskRepo := repositoryMock.NewMockTask(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
-	taskService := NewTaskService(mockTaskRepo, mockUserService)
+	mockActivities := serviceMock.NewMockActivity(ctrl)
+	taskService := NewTaskService(mockTaskRepo, mockUserService, mockActivities)
 
 	taskID := uint(1)
+	eventID := int64(100)
+	task := &models.Task{ID: 1, EventID: &eventID, Title: "Купить продукты"}
 
 	t.Run("успешное удаление задачи", func(t *testing.T) {
+		mockTaskRepo.EXPECT().
+			GetTaskByID(taskID).
+			Return(task, nil).
+			Times(1)
 		mockTaskRepo.EXPECT().
 			DeleteTask(taskID).
 			Return(nil).
 			Times(1)
+		mockActivities.EXPECT().
+			RecordActivity(gomock.Any(), eventID, models.ActivityTypeTaskDeleted, models.TaskActivityPayload{TaskID: 1, Title: "Купить продукты"}).
+			Return(nil).
+			Times(1)
 
 		err := taskService.DeleteTask(context.Background(), taskID)
 
@@ -315,6 +327,10 @@ func TestTaskService_DeleteTask(t *testing.T) {
 
 	t.Run("ошибка удаления задачи", func(t *testing.T) {
 		expectedErr := errors.New("delete error")
+		mockTaskRepo.EXPECT().
+			GetTaskByID(taskID).
+			Return(task, nil).
+			Times(1)
 		mockTaskRepo.EXPECT().
 			DeleteTask(taskID).
 			Return(expectedErr).
@@ -325,5 +341,16 @@ func TestTaskService_DeleteTask(t *testing.T) {
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, expectedErr)
 	})
-}
 
+	t.Run("задача не найдена", func(t *testing.T) {
+		notFoundErr := customErrors.NewEntityNotFoundError("1", "task")
+		mockTaskRepo.EXPECT().
+			GetTaskByID(taskID).
+			Return(nil, notFoundErr).
+			Times(1)
+
+		err := taskService.DeleteTask(context.Background(), taskID)
+
+		assert.ErrorIs(t, err, notFoundErr)
+	})
+}
@@ -3,33 +3,76 @@ package service
 import (
 	"context"
 	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 )
 
 // TransactionRequest представляет запрос на создание транзакции
 type TransactionRequest struct {
-	Type     string             `json:"type" binding:"required"`      // "percent" | "amount" | "units"
-	FromUser int64              `json:"from_user" binding:"required"` // ID пользователя, который заплатил
-	Amount   float64            `json:"amount" binding:"required"`    // Общая сумма
-	Portion  map[string]float64 `json:"portion"`                      // Распределение (зависит от типа)
-	Users    []int64            `json:"users" binding:"required"`     // Список пользователей-участников
+	Type         string                 `json:"type" binding:"required"`      // "equal" | "percent" | "amount" | "units" | "items"
+	FromUser     int64                  `json:"from_user" binding:"required"` // ID пользователя, который заплатил
+	Amount       money.Money            `json:"amount" binding:"required"`    // Общая сумма
+	Portion      map[string]float64     `json:"portion"`                      // Проценты или единицы долей (для типов "percent" и "units")
+	Amounts      map[string]money.Money `json:"amounts"`                      // Суммы участников (для типа "amount")
+	Users        []int64                `json:"users" binding:"required"`     // Список пользователей-участников
+	Payers       []PayerDTO             `json:"payers"`                       // Плательщики (если не указаны, платит FromUser)
+	ExcludePayer bool                   `json:"exclude_payer"`                // Не включать плательщика в раздел поровну
+	Items        []ItemDTO              `json:"items"`                        // Позиции чека (для типа "items")
+	Charges      []ChargeDTO            `json:"charges"`                      // Общие надбавки чека (для типа "items")
+	Currency     string                 `json:"currency"`                     // Валюта транзакции (по умолчанию валюта мероприятия)
+	ExchangeRate *float64               `json:"exchange_rate"`                // Курс в валюту мероприятия (если не указан, берется из RateProvider)
+	Datetime     *time.Time             `json:"datetime,omitempty"`           // Дата и время транзакции (по умолчанию текущее время)
 
 	// Дополнительные поля для связи с сущностями
 	Name                  string `json:"name" binding:"required"` // Название/описание транзакции
 	TransactionCategoryID *int   `json:"transaction_category_id"` // ID категории транзакции
 }
 
-// TransactionResponse представляет ответ с информацией о транзакции
+// TransactionResponse представляет ответ с информацией о транзакции.
+// Сумма, оплаты, позиции и доли указаны в валюте транзакции, долги — в базовой валюте мероприятия.
 type TransactionResponse struct {
-	ID                    int        `json:"id"`
-	EventID               int64      `json:"event_id"`
-	Name                  string     `json:"name"`
-	TransactionCategoryID *int       `json:"transaction_category_id,omitempty"`
-	Type                  string     `json:"type"`
-	FromUser              int64      `json:"from_user"`
-	Amount                float64    `json:"amount"`
-	Datetime              time.Time  `json:"datetime"`
-	Debts                 []DebtDTO  `json:"debts,omitempty"`
-	Shares                []ShareDTO `json:"shares,omitempty"`
+	ID                    int         `json:"id"`
+	EventID               int64       `json:"event_id"`
+	Name                  string      `json:"name"`
+	TransactionCategoryID *int        `json:"transaction_category_id,omitempty"`
+	Type                  string      `json:"type"`
+	FromUser              int64       `json:"from_user"`
+	Amount                money.Money `json:"amount"`
+	Currency              string      `json:"currency"`
+	ExchangeRate          float64     `json:"exchange_rate"`
+	Datetime              time.Time   `json:"datetime"`
+	Payers                []PayerDTO  `json:"payers,omitempty"`
+	Items                 []ItemDTO   `json:"items,omitempty"`
+	Charges               []ChargeDTO `json:"charges,omitempty"`
+	Debts                 []DebtDTO   `json:"debts,omitempty"`
+	Shares                []ShareDTO  `json:"shares,omitempty"`
+	// Attachments - прикрепленные файлы со временными ссылками; в снимки ревизий не попадают
+	Attachments []AttachmentDTO `json:"attachments,omitempty"`
+	// DeletedAt - время переноса в корзину, заполняется только для удаленных транзакций
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// PayerDTO представляет информацию о плательщике транзакции
+type PayerDTO struct {
+	UserID int64       `json:"user_id"`
+	Amount money.Money `json:"amount"`
+}
+
+// ItemDTO представляет позицию чека
+type ItemDTO struct {
+	ID        int         `json:"id,omitempty"`
+	Name      string      `json:"name" binding:"required"`
+	Price     money.Money `json:"price" binding:"required"` // Цена за единицу
+	Quantity  float64     `json:"quantity"`                 // Количество (по умолчанию 1)
+	Consumers []int64     `json:"consumers"`                // Пользователи, делящие позицию поровну
+	Total     money.Money `json:"total"`                    // Стоимость позиции (цена × количество)
+}
+
+// ChargeDTO представляет общую надбавку чека (налог, чаевые, сервисный сбор)
+type ChargeDTO struct {
+	ID     int         `json:"id,omitempty"`
+	Name   string      `json:"name" binding:"required"`
+	Amount money.Money `json:"amount" binding:"required"`
 }
 
 // TransactionListResponse представляет ответ со списком транзакций
@@ -44,13 +87,14 @@ type DebtsUserResponse struct {
 	Photo string `json:"photo"`
 }
 
-// DebtDTO представляет информацию о долге
+// DebtDTO представляет информацию о долге по транзакции.
+// Погашения в список долгов не входят и возвращаются отдельно (SettlementDTO).
 type DebtDTO struct {
-	ID            int     `json:"id,omitempty"`
-	FromUserID    int64   `json:"from_user_id"`
-	ToUserID      int64   `json:"to_user_id"`
-	Amount        float64 `json:"amount"`
-	TransactionID int     `json:"transaction_id,omitempty"`
+	ID            int         `json:"id,omitempty"`
+	FromUserID    int64       `json:"from_user_id"`
+	ToUserID      int64       `json:"to_user_id"`
+	Amount        money.Money `json:"amount"`
+	TransactionID int         `json:"transaction_id,omitempty"`
 
 	FromUser  *DebtsUserResponse `json:"from_user,omitempty"`
 	ToUser    *DebtsUserResponse `json:"to_user,omitempty"`
@@ -62,19 +106,21 @@ type DebtListResponse []DebtDTO
 
 // ShareDTO представляет информацию о доле в транзакции
 type ShareDTO struct {
-	ID            int     `json:"id,omitempty"`
-	UserID        int64   `json:"user_id"`
-	Value         float64 `json:"value"`
-	TransactionID int     `json:"transaction_id,omitempty"`
+	ID            int         `json:"id,omitempty"`
+	UserID        int64       `json:"user_id"`
+	Value         money.Money `json:"value"`
+	TransactionID int         `json:"transaction_id,omitempty"`
 }
 
 // OptimizedDebtDTO представляет информацию об оптимизированных долгах
 type OptimizedDebtDTO struct {
-	ID         int     `json:"id,omitempty"`
-	FromUserID int64   `json:"from_user_id"`
-	ToUserID   int64   `json:"to_user_id"`
-	Amount     float64 `json:"amount"`
-	EventID    int64   `json:"event_id"`
+	ID            int         `json:"id,omitempty"`
+	FromUserID    int64       `json:"from_user_id"`
+	ToUserID      int64       `json:"to_user_id"`
+	Amount        money.Money `json:"amount"`
+	SettledAmount money.Money `json:"settled_amount"`
+	Status        string      `json:"status"` // "pending" | "partial" | "settled"
+	EventID       int64       `json:"event_id"`
 
 	FromUser  *DebtsUserResponse `json:"from_user,omitempty"`
 	ToUser    *DebtsUserResponse `json:"to_user,omitempty"`
@@ -84,21 +130,147 @@ type OptimizedDebtDTO struct {
 // OptimizedDebtListResponse представляет ответ со списком оптимизированных долгов
 type OptimizedDebtListResponse []OptimizedDebtDTO
 
+// OptimizationResultDTO представляет результат оптимизации долгов мероприятия
+type OptimizationResultDTO struct {
+	Algorithm         string             `json:"algorithm"`          // Алгоритм, которым получен результат
+	OriginalTransfers int                `json:"original_transfers"` // Переводов до оптимизации
+	RemovedTransfers  int                `json:"removed_transfers"`  // Сколько переводов удалось убрать
+	OptimizedDebts    []OptimizedDebtDTO `json:"optimized_debts"`
+}
+
+// SettlementRequest представляет запрос на создание погашения долга
+type SettlementRequest struct {
+	FromUserID      int64       `json:"from_user_id" binding:"required"` // Кто вернул деньги
+	ToUserID        int64       `json:"to_user_id" binding:"required"`   // Кому вернули деньги
+	Amount          money.Money `json:"amount" binding:"required"`       // Сумма в базовой валюте мероприятия
+	OptimizedDebtID *int        `json:"optimized_debt_id"`               // Погашаемый оптимизированный долг
+	Comment         string      `json:"comment"`
+}
+
+// SettlementDTO представляет информацию о погашении долга
+type SettlementDTO struct {
+	ID              int         `json:"id"`
+	EventID         int64       `json:"event_id"`
+	FromUserID      int64       `json:"from_user_id"`
+	ToUserID        int64       `json:"to_user_id"`
+	Amount          money.Money `json:"amount"`
+	OptimizedDebtID *int        `json:"optimized_debt_id,omitempty"`
+	Comment         string      `json:"comment,omitempty"`
+	CreatedAt       time.Time   `json:"created_at"`
+
+	FromUser *DebtsUserResponse `json:"from_user,omitempty"`
+	ToUser   *DebtsUserResponse `json:"to_user,omitempty"`
+}
+
+// Направления сортировки списка транзакций
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// TransactionListRequest представляет фильтры, сортировку и курсор списка транзакций.
+// По умолчанию транзакции отдаются от новых к старым.
+type TransactionListRequest struct {
+	From          *time.Time
+	To            *time.Time
+	CategoryID    *int
+	PayerID       *int64
+	ParticipantID *int64
+	AmountMin     *money.Money
+	AmountMax     *money.Money
+	Search        string
+	SortBy        string
+	SortOrder     string
+	Cursor        string
+	Limit         int
+}
+
+// TransactionPageDTO представляет страницу списка транзакций.
+// NextCursor пуст, если страница последняя.
+type TransactionPageDTO struct {
+	Transactions []TransactionResponse
+	NextCursor   *string
+}
+
+// TransactionRevisionDTO представляет ревизию транзакции.
+// Snapshot - состояние транзакции после изменения, Diff - поля, измененные относительно предыдущей ревизии.
+type TransactionRevisionDTO struct {
+	ID            int                 `json:"id"`
+	TransactionID int                 `json:"transaction_id"`
+	Action        string              `json:"action"` // "created" | "updated" | "deleted" | "restored" | "reverted"
+	ActorID       *int64              `json:"actor_id,omitempty"`
+	RevertedFrom  *int                `json:"reverted_from,omitempty"`
+	CreatedAt     time.Time           `json:"created_at"`
+	Snapshot      TransactionResponse `json:"snapshot"`
+	Diff          []FieldChangeDTO    `json:"diff"`
+}
+
+// FieldChangeDTO представляет изменение поля транзакции.
+// Значения полей даны в том же виде, что и в снимке транзакции.
+type FieldChangeDTO struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// AttachmentDTO представляет файл, прикрепленный к транзакции.
+// URL - временная ссылка из ff-files; пуста, если получить ссылку не удалось.
+type AttachmentDTO struct {
+	ID        int        `json:"id"`
+	ObjectID  string     `json:"object_id"`
+	URL       string     `json:"url,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedBy *int64     `json:"created_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
 // Transaction определяет методы для работы с транзакциями
 type Transaction interface {
 	GetTransactionsByEventID(ctx context.Context, eventID int64) ([]TransactionResponse, error)
+	ListTransactions(ctx context.Context, eventID int64, req *TransactionListRequest) (*TransactionPageDTO, error)
 	GetTransactionByID(ctx context.Context, id int) (*TransactionResponse, error)
 	CreateTransaction(ctx context.Context, eventID int64, req *TransactionRequest) (*TransactionResponse, error)
 	UpdateTransaction(ctx context.Context, id int, req *TransactionRequest) (*TransactionResponse, error)
+	// DeleteTransaction переносит транзакцию в корзину
 	DeleteTransaction(ctx context.Context, id int) error
 	GetDebtsByEventID(ctx context.Context, eventID int64, userID *int64) ([]DebtDTO, error)
-	GetDebtsByEventIDFromUser(eventID int64, userID int64) ([]DebtDTO, error)
-	GetDebtsByEventIDToUser(eventID int64, userID int64) ([]DebtDTO, error)
+	GetDebtsByEventIDFromUser(ctx context.Context, eventID int64, userID int64) ([]DebtDTO, error)
+	GetDebtsByEventIDToUser(ctx context.Context, eventID int64, userID int64) ([]DebtDTO, error)
 
 	// Методы для работы с оптимизированными долгами
 	OptimizeDebts(ctx context.Context, eventID int64) ([]OptimizedDebtDTO, error)
+	OptimizeDebtsWithAlgorithm(ctx context.Context, eventID int64, algorithm string) (*OptimizationResultDTO, error)
 	GetOptimizedDebtsByEventID(ctx context.Context, eventID int64, userID *int64) ([]OptimizedDebtDTO, error)
+	// GetCurrentOptimizedDebts возвращает актуальный план переводов, не сохраняя пересчитанный план
+	GetCurrentOptimizedDebts(ctx context.Context, eventID int64) ([]OptimizedDebtDTO, error)
 	GetOptimizedDebtsByUserID(ctx context.Context, eventID, userID int64) ([]OptimizedDebtDTO, error)
-	GetOptimizedDebtsByEventIDFromUser(eventID int64, userID int64) ([]OptimizedDebtDTO, error)
-	GetOptimizedDebtsByEventIDToUser(eventID int64, userID int64) ([]OptimizedDebtDTO, error)
+	GetOptimizedDebtsByEventIDFromUser(ctx context.Context, eventID int64, userID int64) ([]OptimizedDebtDTO, error)
+	GetOptimizedDebtsByEventIDToUser(ctx context.Context, eventID int64, userID int64) ([]OptimizedDebtDTO, error)
+
+	// Методы для работы с корзиной транзакций мероприятия
+	GetDeletedTransactions(ctx context.Context, eventID int64) ([]TransactionResponse, error)
+	RestoreTransaction(ctx context.Context, eventID int64, id int) (*TransactionResponse, error)
+
+	// Методы для работы с историей изменений транзакции
+	GetTransactionHistory(ctx context.Context, eventID int64, id int) ([]TransactionRevisionDTO, error)
+	RevertTransaction(ctx context.Context, eventID int64, id int, revisionID int) (*TransactionResponse, error)
+
+	// Методы для работы с вложениями транзакции, файлы хранятся в ff-files
+	AttachFile(ctx context.Context, eventID int64, id int, objectID string) (*AttachmentDTO, error)
+	DetachFile(ctx context.Context, eventID int64, id int, attachmentID int) error
+	GetAttachmentURL(ctx context.Context, eventID int64, id int, attachmentID int) (*AttachmentDTO, error)
+
+	// Методы для работы с позициями чека
+	GetTransactionItems(ctx context.Context, transactionID int) ([]ItemDTO, error)
+	CreateTransactionItem(ctx context.Context, transactionID int, req *ItemDTO) (*TransactionResponse, error)
+	UpdateTransactionItem(ctx context.Context, transactionID, itemID int, req *ItemDTO) (*TransactionResponse, error)
+	DeleteTransactionItem(ctx context.Context, transactionID, itemID int) (*TransactionResponse, error)
+
+	// Методы для работы с погашениями долгов
+	GetSettlementsByEventID(ctx context.Context, eventID int64) ([]SettlementDTO, error)
+	CreateSettlement(ctx context.Context, eventID int64, req *SettlementRequest) (*SettlementDTO, error)
+	DeleteSettlement(ctx context.Context, eventID int64, id int) error
+
+	// CloseEvent закрывает мероприятие: последний раз оптимизирует долги и фиксирует план переводов
+	CloseEvent(ctx context.Context, eventID int64) (*OptimizationResultDTO, error)
 }
@@ -0,0 +1,205 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ivasnev/FinFlow/ff-split/internal/adapters"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+)
+
+// attachmentURLTTL - срок действия временных ссылок на вложения в ответах
+const attachmentURLTTL = 15 * time.Minute
+
+// AttachFile прикрепляет к транзакции мероприятия файл, заранее загруженный в ff-files
+func (s *TransactionService) AttachFile(ctx context.Context, eventID int64, id int, objectID string) (*service.AttachmentDTO, error) {
+	fileID, err := uuid.Parse(objectID)
+	if err != nil {
+		return nil, customErrors.NewValidationError("object_id", "некорректный ID файла")
+	}
+	objectID = fileID.String()
+
+	if _, err := s.getEditableTransaction(ctx, eventID, id); err != nil {
+		return nil, err
+	}
+
+	// Проверяем, что файл действительно загружен в ff-files и загрузил его сам участник
+	metadata, err := s.files.GetFileMetadata(ctx, objectID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrFileNotFound) {
+			return nil, customErrors.NewEntityNotFoundError(objectID, "file")
+		}
+		return nil, fmt.Errorf("ошибка при получении файла %s: %w", objectID, err)
+	}
+	owner, err := s.isFileOwner(ctx, metadata)
+	if err != nil {
+		return nil, err
+	}
+	if !owner {
+		return nil, customErrors.NewForbiddenError("прикрепить можно только собственный файл")
+	}
+
+	attachments, err := s.repo.GetAttachmentsByTransactionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, attachment := range attachments {
+		if attachment.ObjectID == objectID {
+			return nil, customErrors.NewAlreadyExistsError("transaction attachment", "файл уже прикреплен к транзакции")
+		}
+	}
+
+	attachment := &models.TransactionAttachment{
+		TransactionID: id,
+		ObjectID:      objectID,
+	}
+	if member, ok := access.MemberFromContext(ctx); ok {
+		userID := member.UserID
+		attachment.CreatedBy = &userID
+	}
+	if err := s.repo.CreateAttachment(ctx, attachment); err != nil {
+		return nil, err
+	}
+
+	// Недоступность ff-files не мешает прикрепить файл: ссылка остается пустой
+	result := mapAttachmentToDTO(attachment)
+	_ = s.resolveAttachmentURL(ctx, &result)
+	return &result, nil
+}
+
+// GetAttachmentURL возвращает вложение транзакции мероприятия со временной ссылкой на файл
+func (s *TransactionService) GetAttachmentURL(ctx context.Context, eventID int64, id int, attachmentID int) (*service.AttachmentDTO, error) {
+	attachment, err := s.repo.GetAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.TransactionID != id {
+		return nil, customErrors.NewEntityNotFoundError(strconv.Itoa(attachmentID), "transaction_attachment")
+	}
+
+	transaction, err := s.repo.GetTransactionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if transaction.EventID == nil || *transaction.EventID != eventID {
+		return nil, customErrors.NewEntityNotFoundError(strconv.Itoa(id), "transaction")
+	}
+
+	result := mapAttachmentToDTO(attachment)
+	if err := s.resolveAttachmentURL(ctx, &result); err != nil {
+		if errors.Is(err, adapters.ErrFileNotFound) {
+			return nil, customErrors.NewEntityNotFoundError(attachment.ObjectID, "file")
+		}
+		return nil, fmt.Errorf("ошибка при получении ссылки на файл %s: %w", attachment.ObjectID, err)
+	}
+	return &result, nil
+}
+
+// DetachFile открепляет файл от транзакции мероприятия.
+// Из ff-files файл удаляется, только если его загрузил сам участник: чужой файл остается у владельца
+func (s *TransactionService) DetachFile(ctx context.Context, eventID int64, id int, attachmentID int) error {
+	attachment, err := s.repo.GetAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		return err
+	}
+	if attachment.TransactionID != id {
+		return customErrors.NewEntityNotFoundError(strconv.Itoa(attachmentID), "transaction_attachment")
+	}
+
+	if _, err := s.getEditableTransaction(ctx, eventID, id); err != nil {
+		return err
+	}
+
+	metadata, err := s.files.GetFileMetadata(ctx, attachment.ObjectID)
+	if err != nil && !errors.Is(err, adapters.ErrFileNotFound) {
+		return fmt.Errorf("ошибка при получении файла %s: %w", attachment.ObjectID, err)
+	}
+	if metadata != nil {
+		owner, err := s.isFileOwner(ctx, metadata)
+		if err != nil {
+			return err
+		}
+		// Файл удаляется до записи о вложении: если удалить запись не удастся,
+		// повторное открепление пропустит уже удаленный файл
+		if owner {
+			if err := s.files.DeleteFile(ctx, attachment.ObjectID); err != nil && !errors.Is(err, adapters.ErrFileNotFound) {
+				return fmt.Errorf("ошибка при удалении файла %s: %w", attachment.ObjectID, err)
+			}
+		}
+	}
+
+	return s.repo.DeleteAttachment(ctx, attachmentID)
+}
+
+// isFileOwner проверяет, что файл в ff-files загрузил участник, выполняющий запрос.
+// Владелец файла в ff-files - внешний ID пользователя
+func (s *TransactionService) isFileOwner(ctx context.Context, metadata *adapters.FileMetadataDTO) (bool, error) {
+	member, ok := access.MemberFromContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	user, err := s.userService.GetUserByInternalUserID(ctx, member.UserID)
+	if err != nil {
+		return false, err
+	}
+	return user.UserID != nil && strconv.FormatInt(*user.UserID, 10) == metadata.OwnerID, nil
+}
+
+// getEditableTransaction возвращает транзакцию мероприятия, которую участник может изменять
+func (s *TransactionService) getEditableTransaction(ctx context.Context, eventID int64, id int) (*models.Transaction, error) {
+	transaction, err := s.repo.GetTransactionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if transaction.EventID == nil || *transaction.EventID != eventID {
+		return nil, customErrors.NewEntityNotFoundError(strconv.Itoa(id), "transaction")
+	}
+	if err := s.requireEditableTransaction(ctx, transaction); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// loadAttachments добавляет в ответ вложения транзакции.
+// Временные ссылки не запрашиваются: клиент получает их по ID вложения
+func (s *TransactionService) loadAttachments(ctx context.Context, transaction *service.TransactionResponse) error {
+	attachments, err := s.repo.GetAttachmentsByTransactionID(ctx, transaction.ID)
+	if err != nil {
+		return err
+	}
+
+	transaction.Attachments = nil
+	for i := range attachments {
+		transaction.Attachments = append(transaction.Attachments, mapAttachmentToDTO(&attachments[i]))
+	}
+	return nil
+}
+
+// resolveAttachmentURL запрашивает в ff-files временную ссылку на файл вложения
+func (s *TransactionService) resolveAttachmentURL(ctx context.Context, attachment *service.AttachmentDTO) error {
+	url, err := s.files.GetTemporaryURL(ctx, attachment.ObjectID, attachmentURLTTL)
+	if err != nil {
+		return err
+	}
+	expiresAt := url.ExpiresAt
+	attachment.URL = url.URL
+	attachment.ExpiresAt = &expiresAt
+	return nil
+}
+
+// mapAttachmentToDTO преобразует вложение транзакции в DTO без временной ссылки
+func mapAttachmentToDTO(attachment *models.TransactionAttachment) service.AttachmentDTO {
+	return service.AttachmentDTO{
+		ID:        attachment.ID,
+		ObjectID:  attachment.ObjectID,
+		CreatedBy: attachment.CreatedBy,
+		CreatedAt: attachment.CreatedAt,
+	}
+}
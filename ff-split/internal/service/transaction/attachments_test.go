@@ -0,0 +1,279 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/adapters"
+	adaptersMock "github.com/ivasnev/FinFlow/ff-split/internal/adapters/mock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testObjectID      = "3f2b8c1e-0d4a-4c5e-9a7b-1c2d3e4f5a6b"
+	testOtherObjectID = "7a6b5c4d-3e2f-4a1b-8c9d-0e1f2a3b4c5d"
+)
+
+func TestTransactionService_AttachFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+	mockFiles := adaptersMock.NewMockFilesAdapter(ctrl)
+	transactionService := NewTransactionService(nil, mockTransactionRepo, nil, mockUserService, mockEventService, nil, nil, mockFiles)
+
+	eventID := int64(1)
+	transactionID := 1
+	memberID := int64(3)
+	externalID := int64(42)
+	ctx := access.WithMember(context.Background(), &models.UserEvent{UserID: memberID, EventID: eventID, Role: models.EventRoleMember})
+	member := &models.User{ID: memberID, UserID: &externalID}
+	ownFile := &adapters.FileMetadataDTO{ID: testObjectID, OwnerID: "42"}
+	transaction := &models.Transaction{ID: transactionID, EventID: &eventID, Name: "Ужин"}
+	activeEvent := &models.Event{ID: eventID, Status: models.EventStatusActive}
+	expiresAt := time.Date(2025, 6, 1, 19, 15, 0, 0, time.UTC)
+
+	t.Run("файл прикрепляется с временной ссылкой", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+		mockEventService.EXPECT().GetEventByID(ctx, eventID).Return(activeEvent, nil)
+		mockFiles.EXPECT().GetFileMetadata(ctx, testObjectID).Return(ownFile, nil)
+		mockUserService.EXPECT().GetUserByInternalUserID(ctx, memberID).Return(member, nil)
+		mockTransactionRepo.EXPECT().GetAttachmentsByTransactionID(gomock.Any(), transactionID).Return([]models.TransactionAttachment{
+			{ID: 1, TransactionID: transactionID, ObjectID: testOtherObjectID},
+		}, nil)
+		mockTransactionRepo.EXPECT().CreateAttachment(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, attachment *models.TransactionAttachment) error {
+			assert.Equal(t, transactionID, attachment.TransactionID)
+			assert.Equal(t, testObjectID, attachment.ObjectID)
+			assert.Equal(t, &memberID, attachment.CreatedBy)
+			attachment.ID = 2
+			return nil
+		})
+		mockFiles.EXPECT().GetTemporaryURL(ctx, testObjectID, attachmentURLTTL).Return(&adapters.TemporaryURLDTO{
+			URL:       "https://files.example/receipt.jpg?token=abc",
+			ExpiresAt: expiresAt,
+		}, nil)
+
+		// ID файла принимается в любом регистре и сохраняется в каноническом виде
+		result, err := transactionService.AttachFile(ctx, eventID, transactionID, "3F2B8C1E-0D4A-4C5E-9A7B-1C2D3E4F5A6B")
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.ID)
+		assert.Equal(t, testObjectID, result.ObjectID)
+		assert.Equal(t, "https://files.example/receipt.jpg?token=abc", result.URL)
+		assert.Equal(t, &expiresAt, result.ExpiresAt)
+	})
+
+	t.Run("некорректный ID файла", func(t *testing.T) {
+		_, err := transactionService.AttachFile(ctx, eventID, transactionID, "receipt.jpg")
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("файла нет в ff-files", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+		mockEventService.EXPECT().GetEventByID(ctx, eventID).Return(activeEvent, nil)
+		mockFiles.EXPECT().GetFileMetadata(ctx, testObjectID).Return(nil, adapters.ErrFileNotFound)
+
+		_, err := transactionService.AttachFile(ctx, eventID, transactionID, testObjectID)
+
+		var notFound *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("чужой файл не прикрепляется", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+		mockEventService.EXPECT().GetEventByID(ctx, eventID).Return(activeEvent, nil)
+		mockFiles.EXPECT().GetFileMetadata(ctx, testObjectID).Return(&adapters.FileMetadataDTO{ID: testObjectID, OwnerID: "7"}, nil)
+		mockUserService.EXPECT().GetUserByInternalUserID(ctx, memberID).Return(member, nil)
+
+		_, err := transactionService.AttachFile(ctx, eventID, transactionID, testObjectID)
+
+		var forbidden *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbidden)
+	})
+
+	t.Run("файл уже прикреплен", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+		mockEventService.EXPECT().GetEventByID(ctx, eventID).Return(activeEvent, nil)
+		mockFiles.EXPECT().GetFileMetadata(ctx, testObjectID).Return(ownFile, nil)
+		mockUserService.EXPECT().GetUserByInternalUserID(ctx, memberID).Return(member, nil)
+		mockTransactionRepo.EXPECT().GetAttachmentsByTransactionID(gomock.Any(), transactionID).Return([]models.TransactionAttachment{
+			{ID: 1, TransactionID: transactionID, ObjectID: testObjectID},
+		}, nil)
+
+		_, err := transactionService.AttachFile(ctx, eventID, transactionID, testObjectID)
+
+		var alreadyExists *customErrors.AlreadyExistsError
+		assert.ErrorAs(t, err, &alreadyExists)
+	})
+
+	t.Run("транзакция другого мероприятия не найдена", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+
+		_, err := transactionService.AttachFile(ctx, eventID+1, transactionID, testObjectID)
+
+		var notFound *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("наблюдатель не может прикреплять файлы", func(t *testing.T) {
+		viewerCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 100, EventID: eventID, Role: models.EventRoleViewer})
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+
+		_, err := transactionService.AttachFile(viewerCtx, eventID, transactionID, testObjectID)
+
+		var forbidden *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbidden)
+	})
+}
+
+func TestTransactionService_DetachFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+	mockFiles := adaptersMock.NewMockFilesAdapter(ctrl)
+	transactionService := NewTransactionService(nil, mockTransactionRepo, nil, mockUserService, mockEventService, nil, nil, mockFiles)
+
+	eventID := int64(1)
+	transactionID := 1
+	memberID := int64(3)
+	externalID := int64(42)
+	ctx := access.WithMember(context.Background(), &models.UserEvent{UserID: memberID, EventID: eventID, Role: models.EventRoleMember})
+	member := &models.User{ID: memberID, UserID: &externalID}
+	ownFile := &adapters.FileMetadataDTO{ID: testObjectID, OwnerID: "42"}
+	attachment := &models.TransactionAttachment{ID: 5, TransactionID: transactionID, ObjectID: testObjectID}
+	transaction := &models.Transaction{ID: transactionID, EventID: &eventID}
+	activeEvent := &models.Event{ID: eventID, Status: models.EventStatusActive}
+
+	expectEditable := func() {
+		mockTransactionRepo.EXPECT().GetAttachmentByID(gomock.Any(), attachment.ID).Return(attachment, nil)
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+		mockEventService.EXPECT().GetEventByID(ctx, eventID).Return(activeEvent, nil)
+	}
+
+	t.Run("собственный файл удаляется из ff-files вместе с вложением", func(t *testing.T) {
+		gomock.InOrder(
+			mockTransactionRepo.EXPECT().GetAttachmentByID(gomock.Any(), attachment.ID).Return(attachment, nil),
+			mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil),
+			mockEventService.EXPECT().GetEventByID(ctx, eventID).Return(activeEvent, nil),
+			mockFiles.EXPECT().GetFileMetadata(ctx, testObjectID).Return(ownFile, nil),
+			mockUserService.EXPECT().GetUserByInternalUserID(ctx, memberID).Return(member, nil),
+			mockFiles.EXPECT().DeleteFile(ctx, testObjectID).Return(nil),
+			mockTransactionRepo.EXPECT().DeleteAttachment(gomock.Any(), attachment.ID).Return(nil),
+		)
+
+		err := transactionService.DetachFile(ctx, eventID, transactionID, attachment.ID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("чужой файл только открепляется", func(t *testing.T) {
+		expectEditable()
+		mockFiles.EXPECT().GetFileMetadata(ctx, testObjectID).Return(&adapters.FileMetadataDTO{ID: testObjectID, OwnerID: "7"}, nil)
+		mockUserService.EXPECT().GetUserByInternalUserID(ctx, memberID).Return(member, nil)
+		mockTransactionRepo.EXPECT().DeleteAttachment(gomock.Any(), attachment.ID).Return(nil)
+
+		err := transactionService.DetachFile(ctx, eventID, transactionID, attachment.ID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("уже удаленный из ff-files файл не мешает открепить вложение", func(t *testing.T) {
+		expectEditable()
+		mockFiles.EXPECT().GetFileMetadata(ctx, testObjectID).Return(nil, adapters.ErrFileNotFound)
+		mockTransactionRepo.EXPECT().DeleteAttachment(gomock.Any(), attachment.ID).Return(nil)
+
+		err := transactionService.DetachFile(ctx, eventID, transactionID, attachment.ID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("при ошибке ff-files вложение сохраняется", func(t *testing.T) {
+		filesErr := errors.New("ff-files unavailable")
+		expectEditable()
+		mockFiles.EXPECT().GetFileMetadata(ctx, testObjectID).Return(ownFile, nil)
+		mockUserService.EXPECT().GetUserByInternalUserID(ctx, memberID).Return(member, nil)
+		mockFiles.EXPECT().DeleteFile(ctx, testObjectID).Return(filesErr)
+
+		err := transactionService.DetachFile(ctx, eventID, transactionID, attachment.ID)
+
+		assert.ErrorIs(t, err, filesErr)
+	})
+
+	t.Run("вложение другой транзакции не найдено", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetAttachmentByID(gomock.Any(), attachment.ID).Return(attachment, nil)
+
+		err := transactionService.DetachFile(ctx, eventID, transactionID+1, attachment.ID)
+
+		var notFound *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFound)
+	})
+}
+
+func TestTransactionService_GetAttachmentURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockFiles := adaptersMock.NewMockFilesAdapter(ctrl)
+	transactionService := NewTransactionService(nil, mockTransactionRepo, nil, nil, nil, nil, nil, mockFiles)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	transactionID := 1
+	attachment := &models.TransactionAttachment{ID: 5, TransactionID: transactionID, ObjectID: testObjectID}
+	transaction := &models.Transaction{ID: transactionID, EventID: &eventID}
+	expiresAt := time.Date(2025, 6, 1, 19, 15, 0, 0, time.UTC)
+
+	t.Run("ссылка запрашивается для одного вложения", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetAttachmentByID(gomock.Any(), attachment.ID).Return(attachment, nil)
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+		mockFiles.EXPECT().GetTemporaryURL(ctx, testObjectID, attachmentURLTTL).Return(&adapters.TemporaryURLDTO{
+			URL:       "https://files.example/receipt.jpg?token=abc",
+			ExpiresAt: expiresAt,
+		}, nil)
+
+		result, err := transactionService.GetAttachmentURL(ctx, eventID, transactionID, attachment.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, attachment.ID, result.ID)
+		assert.Equal(t, "https://files.example/receipt.jpg?token=abc", result.URL)
+		assert.Equal(t, &expiresAt, result.ExpiresAt)
+	})
+
+	t.Run("файла нет в ff-files", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetAttachmentByID(gomock.Any(), attachment.ID).Return(attachment, nil)
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+		mockFiles.EXPECT().GetTemporaryURL(ctx, testObjectID, attachmentURLTTL).Return(nil, adapters.ErrFileNotFound)
+
+		_, err := transactionService.GetAttachmentURL(ctx, eventID, transactionID, attachment.ID)
+
+		var notFound *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("транзакция другого мероприятия не найдена", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetAttachmentByID(gomock.Any(), attachment.ID).Return(attachment, nil)
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+
+		_, err := transactionService.GetAttachmentURL(ctx, eventID+1, transactionID, attachment.ID)
+
+		var notFound *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFound)
+	})
+}
@@ -0,0 +1,121 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestTransactionService_CreateTransaction_Currency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Создаем in-memory SQLite БД для тестов с транзакциями
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+	mockRateProvider := serviceMock.NewMockExchangeRate(ctrl)
+
+	transactionService := NewTransactionService(testDB, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, mockRateProvider, nil, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	payerID := int64(1)
+
+	newRequest := func() *service.TransactionRequest {
+		return &service.TransactionRequest{
+			Type:     "equal",
+			Name:     "Ужин в Париже",
+			FromUser: payerID,
+			Amount:   money.FromFloat(30),
+			Users:    []int64{1, 2, 3},
+			Currency: "eur",
+		}
+	}
+
+	expectCreate := func(expectedDebts []models.Debt) {
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Currency: "RUB", Status: models.EventStatusActive}, nil)
+		mockUserService.EXPECT().GetUserByInternalUserID(gomock.Any(), payerID).Return(&models.User{ID: payerID}, nil)
+		mockTransactionRepo.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *models.Transaction) error {
+			tx.ID = 1
+			return nil
+		})
+		mockTransactionRepo.EXPECT().CreateTransactionShares(gomock.Any(), gomock.Any()).Return(nil)
+		mockTransactionRepo.EXPECT().CreateDebts(gomock.Any(), expectedDebts).Return(nil)
+		mockTransactionRepo.EXPECT().CreateTransactionPayers(gomock.Any(), gomock.Any()).Return(nil)
+		mockTransactionRepo.EXPECT().GetLatestRevision(gomock.Any(), 1).Return(nil, nil)
+		mockTransactionRepo.EXPECT().CreateRevision(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	t.Run("долги пересчитываются по курсу из провайдера", func(t *testing.T) {
+		mockRateProvider.EXPECT().GetRate(gomock.Any(), "EUR", "RUB").Return(100.5, nil)
+		expectCreate([]models.Debt{
+			{TransactionID: 1, FromUserID: 2, ToUserID: payerID, Amount: money.FromFloat(1005)},
+			{TransactionID: 1, FromUserID: 3, ToUserID: payerID, Amount: money.FromFloat(1005)},
+		})
+
+		result, err := transactionService.CreateTransaction(ctx, eventID, newRequest())
+
+		require.NoError(t, err)
+		assert.Equal(t, "EUR", result.Currency)
+		assert.Equal(t, 100.5, result.ExchangeRate)
+		assert.Equal(t, money.FromFloat(30), result.Amount)
+		assert.Equal(t, money.FromFloat(10), result.Shares[0].Value)
+	})
+
+	t.Run("явно указанный курс имеет приоритет", func(t *testing.T) {
+		req := newRequest()
+		rate := 90.0
+		req.ExchangeRate = &rate
+		expectCreate([]models.Debt{
+			{TransactionID: 1, FromUserID: 2, ToUserID: payerID, Amount: money.FromFloat(900)},
+			{TransactionID: 1, FromUserID: 3, ToUserID: payerID, Amount: money.FromFloat(900)},
+		})
+
+		result, err := transactionService.CreateTransaction(ctx, eventID, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, 90.0, result.ExchangeRate)
+	})
+
+	t.Run("транзакция в валюте мероприятия", func(t *testing.T) {
+		req := newRequest()
+		req.Currency = ""
+		expectCreate([]models.Debt{
+			{TransactionID: 1, FromUserID: 2, ToUserID: payerID, Amount: money.FromFloat(10)},
+			{TransactionID: 1, FromUserID: 3, ToUserID: payerID, Amount: money.FromFloat(10)},
+		})
+
+		result, err := transactionService.CreateTransaction(ctx, eventID, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "RUB", result.Currency)
+		assert.Equal(t, 1.0, result.ExchangeRate)
+	})
+
+	t.Run("курс не найден", func(t *testing.T) {
+		expectedErr := errors.New("rate not found")
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Currency: "RUB", Status: models.EventStatusActive}, nil)
+		mockRateProvider.EXPECT().GetRate(gomock.Any(), "EUR", "RUB").Return(0.0, expectedErr)
+
+		result, err := transactionService.CreateTransaction(ctx, eventID, newRequest())
+
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, result)
+	})
+}
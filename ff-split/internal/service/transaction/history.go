@@ -0,0 +1,385 @@
+package transaction
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/activity"
+)
+
+// GetTransactionHistory возвращает ревизии транзакции мероприятия, начиная с последней.
+// История доступна и для транзакций из корзины.
+func (s *TransactionService) GetTransactionHistory(ctx context.Context, eventID int64, id int) ([]service.TransactionRevisionDTO, error) {
+	revisions, err := s.repo.GetRevisionsByTransactionID(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, customErrors.NewEntityNotFoundError(strconv.Itoa(id), "transaction")
+	}
+
+	result := make([]service.TransactionRevisionDTO, len(revisions))
+	for i, revision := range revisions {
+		dto, err := mapRevisionToDTO(&revision)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *dto
+	}
+	return result, nil
+}
+
+// RevertTransaction возвращает транзакцию к состоянию из ревизии.
+// Доли, оплаты, позиции и долги восстанавливаются из снимка без пересчета,
+// а сам откат записывается в историю новой ревизией.
+func (s *TransactionService) RevertTransaction(ctx context.Context, eventID int64, id int, revisionID int) (*service.TransactionResponse, error) {
+	revision, err := s.repo.GetRevisionByID(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	if revision.TransactionID != id || revision.EventID != eventID {
+		return nil, customErrors.NewEntityNotFoundError(strconv.Itoa(revisionID), "transaction_revision")
+	}
+
+	var snapshot service.TransactionResponse
+	if err := json.Unmarshal(revision.Snapshot, &snapshot); err != nil {
+		return nil, fmt.Errorf("ошибка при чтении снимка ревизии %d: %w", revisionID, err)
+	}
+	if err := s.requireSnapshotUsers(ctx, &snapshot); err != nil {
+		return nil, err
+	}
+
+	var result *service.TransactionResponse
+	var reverted *models.Transaction
+	err = db.WithTx(ctx, s.db, func(ctx context.Context) error {
+		transaction, err := s.repo.GetTransactionByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if transaction.EventID == nil || *transaction.EventID != eventID {
+			return customErrors.NewEntityNotFoundError(strconv.Itoa(id), "transaction")
+		}
+		if err := s.requireEditableTransaction(ctx, transaction); err != nil {
+			return err
+		}
+
+		resp, err := s.applySnapshot(ctx, transaction, &snapshot)
+		if err != nil {
+			return err
+		}
+		reverted = transaction
+
+		if err := s.recordRevision(ctx, models.RevisionActionReverted, resp, &revision.ID); err != nil {
+			return err
+		}
+
+		result = resp
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	activity.Record(ctx, s.activities, eventID, models.ActivityTypeTransactionUpdated, transactionPayload(reverted))
+
+	// Вложения не входят в снимок и при откате не меняются
+	if err := s.loadAttachments(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// applySnapshot перезаписывает транзакцию, ее доли, оплаты, позиции, надбавки и долги значениями из снимка
+func (s *TransactionService) applySnapshot(ctx context.Context, transaction *models.Transaction, snapshot *service.TransactionResponse) (*service.TransactionResponse, error) {
+	transaction.Name = snapshot.Name
+	transaction.TransactionCategoryID = snapshot.TransactionCategoryID
+	transaction.TotalPaid = snapshot.Amount
+	transaction.SplitType = s.getSplitTypeID(snapshot.Type)
+	transaction.Currency = snapshot.Currency
+	transaction.ExchangeRate = snapshot.ExchangeRate
+	transaction.Datetime = snapshot.Datetime
+	transaction.PayerID = nil
+	if snapshot.FromUser != 0 {
+		payerID := snapshot.FromUser
+		transaction.PayerID = &payerID
+	}
+
+	if err := s.repo.UpdateTransaction(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	// Удаляем текущие доли, долги, оплаты, позиции и надбавки
+	if err := s.repo.DeleteSharesByTransactionID(ctx, transaction.ID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteDebtsByTransactionID(ctx, transaction.ID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeletePayersByTransactionID(ctx, transaction.ID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteItemsByTransactionID(ctx, transaction.ID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteChargesByTransactionID(ctx, transaction.ID); err != nil {
+		return nil, err
+	}
+
+	dbShares := make([]models.TransactionShare, len(snapshot.Shares))
+	for i, share := range snapshot.Shares {
+		dbShares[i] = models.TransactionShare{
+			TransactionID: transaction.ID,
+			UserID:        share.UserID,
+			Value:         share.Value,
+		}
+	}
+	if err := s.repo.CreateTransactionShares(ctx, dbShares); err != nil {
+		return nil, err
+	}
+
+	dbDebts := make([]models.Debt, len(snapshot.Debts))
+	for i, debt := range snapshot.Debts {
+		dbDebts[i] = models.Debt{
+			TransactionID: transaction.ID,
+			FromUserID:    debt.FromUserID,
+			ToUserID:      debt.ToUserID,
+			Amount:        debt.Amount,
+		}
+	}
+	if err := s.repo.CreateDebts(ctx, dbDebts); err != nil {
+		return nil, err
+	}
+
+	dbPayers := make([]models.TransactionPayer, len(snapshot.Payers))
+	for i, payer := range snapshot.Payers {
+		dbPayers[i] = models.TransactionPayer{
+			TransactionID: transaction.ID,
+			UserID:        payer.UserID,
+			Amount:        payer.Amount,
+		}
+	}
+	if err := s.repo.CreateTransactionPayers(ctx, dbPayers); err != nil {
+		return nil, err
+	}
+
+	transaction.Items = make([]models.TransactionItem, 0, len(snapshot.Items))
+	for _, dto := range snapshot.Items {
+		item := mapItemFromDTO(transaction.ID, &dto)
+		if err := s.repo.CreateTransactionItem(ctx, &item); err != nil {
+			return nil, err
+		}
+		transaction.Items = append(transaction.Items, item)
+	}
+
+	transaction.Charges = make([]models.TransactionCharge, 0, len(snapshot.Charges))
+	for _, dto := range snapshot.Charges {
+		transaction.Charges = append(transaction.Charges, models.TransactionCharge{
+			TransactionID: transaction.ID,
+			Name:          dto.Name,
+			Amount:        dto.Amount,
+		})
+	}
+	if err := s.repo.CreateTransactionCharges(ctx, transaction.Charges); err != nil {
+		return nil, err
+	}
+
+	return s.mapTransactionToDTO(transaction, dbPayers, dbShares, dbDebts)
+}
+
+// requireSnapshotUsers проверяет, что все участники снимка еще существуют.
+// Снимок, сделанный до объединения dummy-пользователя, ссылается на удаленного пользователя:
+// такой откат отклоняется, а не падает на внешнем ключе
+func (s *TransactionService) requireSnapshotUsers(ctx context.Context, snapshot *service.TransactionResponse) error {
+	seen := make(map[int64]bool)
+	var ids []int64
+	add := func(userIDs ...int64) {
+		for _, id := range userIDs {
+			if id != 0 && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	add(snapshot.FromUser)
+	for _, share := range snapshot.Shares {
+		add(share.UserID)
+	}
+	for _, payer := range snapshot.Payers {
+		add(payer.UserID)
+	}
+	for _, debt := range snapshot.Debts {
+		add(debt.FromUserID, debt.ToUserID)
+	}
+	for _, item := range snapshot.Items {
+		add(item.Consumers...)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	users, err := s.userService.GetUsersByInternalUserIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	existing := make(map[int64]bool, len(users))
+	for _, user := range users {
+		existing[user.ID] = true
+	}
+	for _, id := range ids {
+		if !existing[id] {
+			return customErrors.NewValidationError("revision",
+				fmt.Sprintf("участник %d из ревизии удален или объединен с другим пользователем", id))
+		}
+	}
+	return nil
+}
+
+// recordRevision сохраняет ревизию транзакции со снимком ее состояния
+// и отличиями от предыдущей ревизии. Автор ревизии - участник из контекста;
+// для внутренних вызовов, например регулярных транзакций, автор не указывается.
+func (s *TransactionService) recordRevision(ctx context.Context, action string, transaction *service.TransactionResponse, revertedFrom *int) error {
+	snapshot, err := json.Marshal(snapshotOf(transaction))
+	if err != nil {
+		return fmt.Errorf("ошибка при сериализации снимка транзакции: %w", err)
+	}
+
+	previous, err := s.repo.GetLatestRevision(ctx, transaction.ID)
+	if err != nil {
+		return err
+	}
+	var previousSnapshot json.RawMessage
+	if previous != nil {
+		previousSnapshot = previous.Snapshot
+	}
+
+	changes, err := diffSnapshots(previousSnapshot, snapshot)
+	if err != nil {
+		return err
+	}
+	diff, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("ошибка при сериализации изменений транзакции: %w", err)
+	}
+
+	revision := &models.TransactionRevision{
+		TransactionID: transaction.ID,
+		EventID:       transaction.EventID,
+		Action:        action,
+		RevertedFrom:  revertedFrom,
+		Snapshot:      snapshot,
+		Diff:          diff,
+	}
+	if member, ok := access.MemberFromContext(ctx); ok {
+		userID := member.UserID
+		revision.ActorID = &userID
+	}
+
+	if err := s.repo.CreateRevision(ctx, revision); err != nil {
+		return fmt.Errorf("ошибка при записи ревизии транзакции %d: %w", transaction.ID, err)
+	}
+	return nil
+}
+
+// snapshotOf возвращает копию транзакции для снимка ревизии.
+// ID долей, долгов, позиций и надбавок меняются при каждом сохранении транзакции,
+// поэтому в снимок они не попадают и не создают ложных изменений.
+// Вложения живут отдельно от ревизий и в снимок тоже не попадают.
+func snapshotOf(transaction *service.TransactionResponse) service.TransactionResponse {
+	snapshot := *transaction
+	snapshot.DeletedAt = nil
+	snapshot.Attachments = nil
+
+	snapshot.Shares = make([]service.ShareDTO, len(transaction.Shares))
+	for i, share := range transaction.Shares {
+		snapshot.Shares[i] = service.ShareDTO{UserID: share.UserID, Value: share.Value}
+	}
+	snapshot.Debts = make([]service.DebtDTO, len(transaction.Debts))
+	for i, debt := range transaction.Debts {
+		snapshot.Debts[i] = service.DebtDTO{FromUserID: debt.FromUserID, ToUserID: debt.ToUserID, Amount: debt.Amount}
+	}
+	snapshot.Items = make([]service.ItemDTO, len(transaction.Items))
+	for i, item := range transaction.Items {
+		snapshot.Items[i] = item
+		snapshot.Items[i].ID = 0
+	}
+	snapshot.Charges = make([]service.ChargeDTO, len(transaction.Charges))
+	for i, charge := range transaction.Charges {
+		snapshot.Charges[i] = service.ChargeDTO{Name: charge.Name, Amount: charge.Amount}
+	}
+	return snapshot
+}
+
+// diffSnapshots сравнивает снимки транзакции по полям верхнего уровня.
+// Без предыдущего снимка все заполненные поля считаются новыми.
+func diffSnapshots(previous, current json.RawMessage) ([]service.FieldChangeDTO, error) {
+	oldFields := map[string]interface{}{}
+	if len(previous) > 0 {
+		if err := json.Unmarshal(previous, &oldFields); err != nil {
+			return nil, fmt.Errorf("ошибка при чтении предыдущего снимка транзакции: %w", err)
+		}
+	}
+	newFields := map[string]interface{}{}
+	if err := json.Unmarshal(current, &newFields); err != nil {
+		return nil, fmt.Errorf("ошибка при чтении снимка транзакции: %w", err)
+	}
+
+	fields := make([]string, 0, len(newFields))
+	for field := range newFields {
+		fields = append(fields, field)
+	}
+	for field := range oldFields {
+		if _, ok := newFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	changes := make([]service.FieldChangeDTO, 0)
+	for _, field := range fields {
+		// ID транзакции и мероприятия не меняются
+		if field == "id" || field == "event_id" {
+			continue
+		}
+		if reflect.DeepEqual(oldFields[field], newFields[field]) {
+			continue
+		}
+		changes = append(changes, service.FieldChangeDTO{
+			Field: field,
+			Old:   oldFields[field],
+			New:   newFields[field],
+		})
+	}
+	return changes, nil
+}
+
+// mapRevisionToDTO преобразует ревизию транзакции в DTO
+func mapRevisionToDTO(revision *models.TransactionRevision) (*service.TransactionRevisionDTO, error) {
+	dto := &service.TransactionRevisionDTO{
+		ID:            revision.ID,
+		TransactionID: revision.TransactionID,
+		Action:        revision.Action,
+		ActorID:       revision.ActorID,
+		RevertedFrom:  revision.RevertedFrom,
+		CreatedAt:     revision.CreatedAt,
+		Diff:          []service.FieldChangeDTO{},
+	}
+	if err := json.Unmarshal(revision.Snapshot, &dto.Snapshot); err != nil {
+		return nil, fmt.Errorf("ошибка при чтении снимка ревизии %d: %w", revision.ID, err)
+	}
+	if len(revision.Diff) > 0 {
+		if err := json.Unmarshal(revision.Diff, &dto.Diff); err != nil {
+			return nil, fmt.Errorf("ошибка при чтении изменений ревизии %d: %w", revision.ID, err)
+		}
+	}
+	return dto, nil
+}
@@ -0,0 +1,276 @@
+package transaction
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	datetime := time.Date(2025, 6, 1, 19, 0, 0, 0, time.UTC)
+	before := &service.TransactionResponse{
+		ID:       1,
+		EventID:  1,
+		Name:     "Ужин",
+		Type:     "equal",
+		FromUser: 1,
+		Amount:   money.FromFloat(1500),
+		Currency: "RUB",
+		Datetime: datetime,
+		Shares: []service.ShareDTO{
+			{ID: 10, UserID: 1, Value: money.FromFloat(750), TransactionID: 1},
+			{ID: 11, UserID: 2, Value: money.FromFloat(750), TransactionID: 1},
+		},
+	}
+	after := *before
+	after.Amount = money.FromFloat(15000)
+	after.Shares = []service.ShareDTO{
+		{ID: 12, UserID: 1, Value: money.FromFloat(7500), TransactionID: 1},
+		{ID: 13, UserID: 2, Value: money.FromFloat(7500), TransactionID: 1},
+	}
+
+	marshal := func(transaction *service.TransactionResponse) json.RawMessage {
+		data, err := json.Marshal(snapshotOf(transaction))
+		require.NoError(t, err)
+		return data
+	}
+
+	t.Run("изменения суммы и долей", func(t *testing.T) {
+		changes, err := diffSnapshots(marshal(before), marshal(&after))
+
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, "amount", changes[0].Field)
+		assert.Equal(t, 1500.0, changes[0].Old)
+		assert.Equal(t, 15000.0, changes[0].New)
+		assert.Equal(t, "shares", changes[1].Field)
+	})
+
+	t.Run("новые ID долей не считаются изменением", func(t *testing.T) {
+		resaved := *before
+		resaved.Shares = []service.ShareDTO{
+			{ID: 20, UserID: 1, Value: money.FromFloat(750), TransactionID: 1},
+			{ID: 21, UserID: 2, Value: money.FromFloat(750), TransactionID: 1},
+		}
+
+		changes, err := diffSnapshots(marshal(before), marshal(&resaved))
+
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("без предыдущего снимка все поля новые", func(t *testing.T) {
+		changes, err := diffSnapshots(nil, marshal(before))
+
+		require.NoError(t, err)
+		fields := make([]string, len(changes))
+		for i, change := range changes {
+			fields[i] = change.Field
+			assert.Nil(t, change.Old)
+		}
+		assert.Contains(t, fields, "name")
+		assert.Contains(t, fields, "amount")
+		assert.NotContains(t, fields, "id")
+		assert.NotContains(t, fields, "event_id")
+	})
+}
+
+func TestTransactionService_GetTransactionHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	transactionService := NewTransactionService(nil, mockTransactionRepo, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	transactionID := 1
+	actorID := int64(7)
+
+	t.Run("ревизии со снимками и изменениями", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetRevisionsByTransactionID(gomock.Any(), eventID, transactionID).Return([]models.TransactionRevision{
+			{
+				ID:            2,
+				TransactionID: transactionID,
+				EventID:       eventID,
+				Action:        models.RevisionActionUpdated,
+				ActorID:       &actorID,
+				Snapshot:      json.RawMessage(`{"id":1,"event_id":1,"name":"Ужин","amount":15000}`),
+				Diff:          json.RawMessage(`[{"field":"amount","old":1500,"new":15000}]`),
+			},
+			{
+				ID:            1,
+				TransactionID: transactionID,
+				EventID:       eventID,
+				Action:        models.RevisionActionCreated,
+				Snapshot:      json.RawMessage(`{"id":1,"event_id":1,"name":"Ужин","amount":1500}`),
+			},
+		}, nil)
+
+		result, err := transactionService.GetTransactionHistory(ctx, eventID, transactionID)
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, models.RevisionActionUpdated, result[0].Action)
+		assert.Equal(t, &actorID, result[0].ActorID)
+		assert.Equal(t, money.FromFloat(15000), result[0].Snapshot.Amount)
+		require.Len(t, result[0].Diff, 1)
+		assert.Equal(t, "amount", result[0].Diff[0].Field)
+		assert.Empty(t, result[1].Diff)
+	})
+
+	t.Run("транзакция без истории не найдена", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetRevisionsByTransactionID(gomock.Any(), eventID, transactionID).Return([]models.TransactionRevision{}, nil)
+
+		_, err := transactionService.GetTransactionHistory(ctx, eventID, transactionID)
+
+		var notFound *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFound)
+	})
+}
+
+func TestTransactionService_RevertTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Создаем in-memory SQLite БД для тестов с транзакциями
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+	transactionService := NewTransactionService(testDB, mockTransactionRepo, nil, mockUserService, mockEventService, nil, nil, nil)
+
+	eventID := int64(1)
+	transactionID := 1
+	payerID := int64(1)
+	memberID := int64(3)
+	ctx := access.WithMember(context.Background(), &models.UserEvent{UserID: memberID, EventID: eventID, Role: models.EventRoleMember})
+
+	snapshot, err := json.Marshal(service.TransactionResponse{
+		ID:           transactionID,
+		EventID:      eventID,
+		Name:         "Ужин",
+		Type:         "equal",
+		FromUser:     payerID,
+		Amount:       money.FromFloat(1500),
+		Currency:     "RUB",
+		ExchangeRate: 1,
+		Payers:       []service.PayerDTO{{UserID: payerID, Amount: money.FromFloat(1500)}},
+		Shares: []service.ShareDTO{
+			{UserID: 1, Value: money.FromFloat(750)},
+			{UserID: 2, Value: money.FromFloat(750)},
+		},
+		Debts: []service.DebtDTO{{FromUserID: 2, ToUserID: payerID, Amount: money.FromFloat(750)}},
+	})
+	require.NoError(t, err)
+	revision := &models.TransactionRevision{
+		ID:            5,
+		TransactionID: transactionID,
+		EventID:       eventID,
+		Action:        models.RevisionActionCreated,
+		Snapshot:      snapshot,
+	}
+	snapshotUsers := []models.User{{ID: 1}, {ID: 2}}
+
+	t.Run("откат восстанавливает сумму, доли и долги из снимка", func(t *testing.T) {
+		transaction := &models.Transaction{
+			ID:           transactionID,
+			EventID:      &eventID,
+			Name:         "Ужин",
+			TotalPaid:    money.FromFloat(15000),
+			PayerID:      &payerID,
+			Currency:     "RUB",
+			ExchangeRate: 1,
+		}
+
+		mockTransactionRepo.EXPECT().GetRevisionByID(gomock.Any(), revision.ID).Return(revision, nil)
+		mockUserService.EXPECT().GetUsersByInternalUserIDs(ctx, []int64{payerID, 2}).Return(snapshotUsers, nil)
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockTransactionRepo.EXPECT().UpdateTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *models.Transaction) error {
+			assert.Equal(t, money.FromFloat(1500), tx.TotalPaid)
+			return nil
+		})
+		mockTransactionRepo.EXPECT().DeleteSharesByTransactionID(gomock.Any(), transactionID).Return(nil)
+		mockTransactionRepo.EXPECT().DeleteDebtsByTransactionID(gomock.Any(), transactionID).Return(nil)
+		mockTransactionRepo.EXPECT().DeletePayersByTransactionID(gomock.Any(), transactionID).Return(nil)
+		mockTransactionRepo.EXPECT().DeleteItemsByTransactionID(gomock.Any(), transactionID).Return(nil)
+		mockTransactionRepo.EXPECT().DeleteChargesByTransactionID(gomock.Any(), transactionID).Return(nil)
+		mockTransactionRepo.EXPECT().CreateTransactionShares(gomock.Any(), []models.TransactionShare{
+			{TransactionID: transactionID, UserID: 1, Value: money.FromFloat(750)},
+			{TransactionID: transactionID, UserID: 2, Value: money.FromFloat(750)},
+		}).Return(nil)
+		mockTransactionRepo.EXPECT().CreateDebts(gomock.Any(), []models.Debt{
+			{TransactionID: transactionID, FromUserID: 2, ToUserID: payerID, Amount: money.FromFloat(750)},
+		}).Return(nil)
+		mockTransactionRepo.EXPECT().CreateTransactionPayers(gomock.Any(), gomock.Any()).Return(nil)
+		mockTransactionRepo.EXPECT().CreateTransactionCharges(gomock.Any(), gomock.Len(0)).Return(nil)
+		mockTransactionRepo.EXPECT().GetLatestRevision(gomock.Any(), transactionID).Return(&models.TransactionRevision{
+			ID:       6,
+			Snapshot: json.RawMessage(`{"id":1,"event_id":1,"name":"Ужин","amount":15000}`),
+		}, nil)
+		mockTransactionRepo.EXPECT().CreateRevision(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, created *models.TransactionRevision) error {
+			assert.Equal(t, models.RevisionActionReverted, created.Action)
+			assert.Equal(t, &revision.ID, created.RevertedFrom)
+			assert.Equal(t, &memberID, created.ActorID)
+			assert.Contains(t, string(created.Diff), `"field":"amount"`)
+			return nil
+		})
+		mockTransactionRepo.EXPECT().GetAttachmentsByTransactionID(gomock.Any(), transactionID).Return([]models.TransactionAttachment{}, nil)
+
+		result, err := transactionService.RevertTransaction(ctx, eventID, transactionID, revision.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, money.FromFloat(1500), result.Amount)
+		assert.Len(t, result.Shares, 2)
+		assert.Len(t, result.Debts, 1)
+	})
+
+	t.Run("участник снимка объединен с другим пользователем", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetRevisionByID(gomock.Any(), revision.ID).Return(revision, nil)
+		mockUserService.EXPECT().GetUsersByInternalUserIDs(ctx, []int64{payerID, 2}).Return([]models.User{{ID: 1}}, nil)
+
+		_, err := transactionService.RevertTransaction(ctx, eventID, transactionID, revision.ID)
+
+		var validationErr *customErrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Message, "участник 2")
+	})
+
+	t.Run("ревизия другой транзакции не найдена", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetRevisionByID(gomock.Any(), revision.ID).Return(revision, nil)
+
+		_, err := transactionService.RevertTransaction(ctx, eventID, transactionID+1, revision.ID)
+
+		var notFound *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("наблюдатель не может откатить транзакцию", func(t *testing.T) {
+		viewerCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 100, EventID: eventID, Role: models.EventRoleViewer})
+		mockTransactionRepo.EXPECT().GetRevisionByID(gomock.Any(), revision.ID).Return(revision, nil)
+		mockUserService.EXPECT().GetUsersByInternalUserIDs(viewerCtx, []int64{payerID, 2}).Return(snapshotUsers, nil)
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(&models.Transaction{ID: transactionID, EventID: &eventID}, nil)
+
+		_, err := transactionService.RevertTransaction(viewerCtx, eventID, transactionID, revision.ID)
+
+		var forbidden *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbidden)
+	})
+}
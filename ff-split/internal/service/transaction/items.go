@@ -0,0 +1,333 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/debt_calculator"
+)
+
+// GetTransactionItems возвращает позиции чека транзакции
+func (s *TransactionService) GetTransactionItems(ctx context.Context, transactionID int) ([]service.ItemDTO, error) {
+	// Проверяем существование транзакции
+	if _, err := s.repo.GetTransactionByID(ctx, transactionID); err != nil {
+		return nil, err
+	}
+
+	items, err := s.repo.GetItemsByTransactionID(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]service.ItemDTO, len(items))
+	for i, item := range items {
+		result[i] = mapItemToDTO(&item)
+	}
+	return result, nil
+}
+
+// CreateTransactionItem добавляет позицию в чек и пересчитывает доли и долги транзакции
+func (s *TransactionService) CreateTransactionItem(ctx context.Context, transactionID int, req *service.ItemDTO) (*service.TransactionResponse, error) {
+	return s.changeItems(ctx, transactionID, func(items []models.TransactionItem) ([]models.TransactionItem, error) {
+		return append(items, mapItemFromDTO(transactionID, req)), nil
+	}, func(ctx context.Context, items []models.TransactionItem) error {
+		item := &items[len(items)-1]
+		return s.repo.CreateTransactionItem(ctx, item)
+	})
+}
+
+// UpdateTransactionItem обновляет позицию чека и пересчитывает доли и долги транзакции
+func (s *TransactionService) UpdateTransactionItem(ctx context.Context, transactionID, itemID int, req *service.ItemDTO) (*service.TransactionResponse, error) {
+	var updated *models.TransactionItem
+	return s.changeItems(ctx, transactionID, func(items []models.TransactionItem) ([]models.TransactionItem, error) {
+		for i := range items {
+			if items[i].ID == itemID {
+				items[i] = mapItemFromDTO(transactionID, req)
+				items[i].ID = itemID
+				updated = &items[i]
+				return items, nil
+			}
+		}
+		return nil, fmt.Errorf("позиция чека %d не найдена в транзакции %d", itemID, transactionID)
+	}, func(ctx context.Context, items []models.TransactionItem) error {
+		return s.repo.UpdateTransactionItem(ctx, updated)
+	})
+}
+
+// DeleteTransactionItem удаляет позицию чека и пересчитывает доли и долги транзакции
+func (s *TransactionService) DeleteTransactionItem(ctx context.Context, transactionID, itemID int) (*service.TransactionResponse, error) {
+	return s.changeItems(ctx, transactionID, func(items []models.TransactionItem) ([]models.TransactionItem, error) {
+		for i := range items {
+			if items[i].ID == itemID {
+				return append(items[:i:i], items[i+1:]...), nil
+			}
+		}
+		return nil, fmt.Errorf("позиция чека %d не найдена в транзакции %d", itemID, transactionID)
+	}, func(ctx context.Context, items []models.TransactionItem) error {
+		return s.repo.DeleteTransactionItem(ctx, itemID)
+	})
+}
+
+// changeItems применяет изменение к позициям чека и пересчитывает транзакцию.
+// Сначала изменение применяется в памяти и проверяется расчетом долей,
+// и только затем позиции, сумма, оплаты, доли и долги сохраняются в базе.
+func (s *TransactionService) changeItems(
+	ctx context.Context,
+	transactionID int,
+	change func(items []models.TransactionItem) ([]models.TransactionItem, error),
+	persist func(ctx context.Context, items []models.TransactionItem) error,
+) (*service.TransactionResponse, error) {
+	var result *service.TransactionResponse
+	err := db.WithTx(ctx, s.db, func(ctx context.Context) error {
+		transaction, err := s.repo.GetTransactionByID(ctx, transactionID)
+		if err != nil {
+			return err
+		}
+		if err := s.requireEditableTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		if s.getSplitTypeName(transaction.SplitType) != debt_calculator.ItemsType {
+			return errors.New("позиции чека доступны только для транзакций с типом распределения items")
+		}
+
+		items, err := s.repo.GetItemsByTransactionID(ctx, transactionID)
+		if err != nil {
+			return err
+		}
+		charges, err := s.repo.GetChargesByTransactionID(ctx, transactionID)
+		if err != nil {
+			return err
+		}
+		payers, err := s.repo.GetPayersByTransactionID(ctx, transactionID)
+		if err != nil {
+			return err
+		}
+
+		items, err = change(items)
+		if err != nil {
+			return err
+		}
+
+		// Проверяем потребителей позиций
+		for _, item := range items {
+			for _, consumer := range item.Consumers {
+				if _, err := s.userService.GetUserByInternalUserID(ctx, consumer.UserID); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Пересчитываем доли и долги по новому набору позиций
+		req := buildItemsRequest(transaction, items, charges, payers)
+		calculator, err := debt_calculator.GetCalculator(debt_calculator.ItemsType)
+		if err != nil {
+			return err
+		}
+		shares, debts, err := calculator.Calculate(req, *transaction.EventID)
+		if err != nil {
+			return err
+		}
+
+		// Сохраняем изменения
+		if err := persist(ctx, items); err != nil {
+			return err
+		}
+
+		transaction.TotalPaid = req.Amount
+		if err := s.repo.UpdateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+
+		if err := s.repo.DeleteSharesByTransactionID(ctx, transactionID); err != nil {
+			return err
+		}
+		if err := s.repo.DeleteDebtsByTransactionID(ctx, transactionID); err != nil {
+			return err
+		}
+		if err := s.repo.DeletePayersByTransactionID(ctx, transactionID); err != nil {
+			return err
+		}
+
+		dbShares := make([]models.TransactionShare, len(shares))
+		for i, share := range shares {
+			dbShares[i] = models.TransactionShare{
+				TransactionID: transactionID,
+				UserID:        share.UserID,
+				Value:         share.Value,
+			}
+		}
+		if err := s.repo.CreateTransactionShares(ctx, dbShares); err != nil {
+			return err
+		}
+
+		dbDebts := convertDebts(transactionID, debts, transaction.ExchangeRate)
+		if err := s.repo.CreateDebts(ctx, dbDebts); err != nil {
+			return err
+		}
+
+		dbPayers, err := s.createPayers(ctx, transactionID, req)
+		if err != nil {
+			return err
+		}
+
+		transaction.Items = items
+		transaction.Charges = charges
+		resp, err := s.mapTransactionToDTO(transaction, dbPayers, dbShares, dbDebts)
+		if err != nil {
+			return err
+		}
+
+		if err := s.recordRevision(ctx, models.RevisionActionUpdated, resp, nil); err != nil {
+			return err
+		}
+
+		result = resp
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.loadAttachments(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// buildItemsRequest собирает запрос на расчет долей по сохраненным позициям чека.
+// Общая сумма транзакции пересчитывается как сумма позиций и надбавок; если
+// транзакцию оплатил один плательщик, вся новая сумма относится к нему.
+func buildItemsRequest(
+	transaction *models.Transaction,
+	items []models.TransactionItem,
+	charges []models.TransactionCharge,
+	payers []models.TransactionPayer,
+) *service.TransactionRequest {
+	req := &service.TransactionRequest{
+		Type: debt_calculator.ItemsType,
+		Name: transaction.Name,
+	}
+	if transaction.PayerID != nil {
+		req.FromUser = *transaction.PayerID
+	}
+
+	for _, item := range items {
+		dto := mapItemToDTO(&item)
+		req.Items = append(req.Items, dto)
+		req.Amount += dto.Total
+	}
+	for _, charge := range charges {
+		req.Charges = append(req.Charges, service.ChargeDTO{
+			ID:     charge.ID,
+			Name:   charge.Name,
+			Amount: charge.Amount,
+		})
+		req.Amount += charge.Amount
+	}
+
+	if len(payers) > 1 {
+		for _, payer := range payers {
+			req.Payers = append(req.Payers, service.PayerDTO{
+				UserID: payer.UserID,
+				Amount: payer.Amount,
+			})
+		}
+	}
+
+	return req
+}
+
+// createItems сохраняет позиции и надбавки чека из запроса
+func (s *TransactionService) createItems(ctx context.Context, transaction *models.Transaction, req *service.TransactionRequest) error {
+	if req.Type != debt_calculator.ItemsType {
+		return nil
+	}
+
+	transaction.Items = make([]models.TransactionItem, 0, len(req.Items))
+	for _, dto := range req.Items {
+		item := mapItemFromDTO(transaction.ID, &dto)
+		if err := s.repo.CreateTransactionItem(ctx, &item); err != nil {
+			return err
+		}
+		transaction.Items = append(transaction.Items, item)
+	}
+
+	transaction.Charges = make([]models.TransactionCharge, 0, len(req.Charges))
+	for _, dto := range req.Charges {
+		transaction.Charges = append(transaction.Charges, models.TransactionCharge{
+			TransactionID: transaction.ID,
+			Name:          dto.Name,
+			Amount:        dto.Amount,
+		})
+	}
+	return s.repo.CreateTransactionCharges(ctx, transaction.Charges)
+}
+
+// loadItems загружает позиции и надбавки чека для транзакций с построчным распределением
+func (s *TransactionService) loadItems(ctx context.Context, transaction *models.Transaction) error {
+	if s.getSplitTypeName(transaction.SplitType) != debt_calculator.ItemsType {
+		return nil
+	}
+
+	items, err := s.repo.GetItemsByTransactionID(ctx, transaction.ID)
+	if err != nil {
+		return err
+	}
+	charges, err := s.repo.GetChargesByTransactionID(ctx, transaction.ID)
+	if err != nil {
+		return err
+	}
+
+	transaction.Items = items
+	transaction.Charges = charges
+	return nil
+}
+
+// mapItemToDTO преобразует модель позиции чека в DTO
+func mapItemToDTO(item *models.TransactionItem) service.ItemDTO {
+	consumers := make([]int64, len(item.Consumers))
+	for i, consumer := range item.Consumers {
+		consumers[i] = consumer.UserID
+	}
+
+	dto := service.ItemDTO{
+		ID:        item.ID,
+		Name:      item.Name,
+		Price:     item.Price,
+		Quantity:  item.Quantity,
+		Consumers: consumers,
+	}
+	dto.Total = debt_calculator.ItemTotal(dto)
+	return dto
+}
+
+// mapItemFromDTO преобразует DTO позиции чека в модель
+func mapItemFromDTO(transactionID int, dto *service.ItemDTO) models.TransactionItem {
+	quantity := dto.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	consumers := make([]models.TransactionItemConsumer, 0, len(dto.Consumers))
+	seen := make(map[int64]struct{}, len(dto.Consumers))
+	for _, userID := range dto.Consumers {
+		if _, ok := seen[userID]; ok {
+			continue
+		}
+		seen[userID] = struct{}{}
+		consumers = append(consumers, models.TransactionItemConsumer{UserID: userID})
+	}
+
+	return models.TransactionItem{
+		TransactionID: transactionID,
+		Name:          dto.Name,
+		Price:         dto.Price,
+		Quantity:      quantity,
+		Consumers:     consumers,
+	}
+}
@@ -0,0 +1,166 @@
+package transaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestTransactionService_CreateTransactionItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Создаем in-memory SQLite БД для тестов с транзакциями
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+
+	transactionService := NewTransactionService(testDB, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
+
+	ctx := context.Background()
+	transactionID := 1
+	eventID := int64(1)
+	payerID := int64(1)
+
+	existingItems := []models.TransactionItem{
+		{
+			ID:            10,
+			TransactionID: transactionID,
+			Name:          "Пицца",
+			Price:         money.FromFloat(60),
+			Quantity:      1,
+			Consumers:     []models.TransactionItemConsumer{{UserID: 1}, {UserID: 2}},
+		},
+	}
+
+	t.Run("добавление позиции пересчитывает сумму и долги", func(t *testing.T) {
+		transaction := &models.Transaction{
+			ID:        transactionID,
+			EventID:   &eventID,
+			Name:      "Ужин",
+			TotalPaid: money.FromFloat(60),
+			PayerID:   &payerID,
+			SplitType: 4,
+		}
+
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(transaction, nil)
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockTransactionRepo.EXPECT().GetItemsByTransactionID(gomock.Any(), transactionID).Return(existingItems, nil)
+		mockTransactionRepo.EXPECT().GetChargesByTransactionID(gomock.Any(), transactionID).Return([]models.TransactionCharge{}, nil)
+		mockTransactionRepo.EXPECT().GetPayersByTransactionID(gomock.Any(), transactionID).Return([]models.TransactionPayer{
+			{TransactionID: transactionID, UserID: payerID, Amount: money.FromFloat(60)},
+		}, nil)
+		mockUserService.EXPECT().GetUserByInternalUserID(gomock.Any(), gomock.Any()).Return(&models.User{}, nil).AnyTimes()
+
+		mockTransactionRepo.EXPECT().CreateTransactionItem(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, item *models.TransactionItem) error {
+			assert.Equal(t, "Вино", item.Name)
+			item.ID = 11
+			return nil
+		})
+		mockTransactionRepo.EXPECT().UpdateTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *models.Transaction) error {
+			assert.Equal(t, money.FromFloat(100), tx.TotalPaid)
+			return nil
+		})
+		mockTransactionRepo.EXPECT().DeleteSharesByTransactionID(gomock.Any(), transactionID).Return(nil)
+		mockTransactionRepo.EXPECT().DeleteDebtsByTransactionID(gomock.Any(), transactionID).Return(nil)
+		mockTransactionRepo.EXPECT().DeletePayersByTransactionID(gomock.Any(), transactionID).Return(nil)
+		mockTransactionRepo.EXPECT().CreateTransactionShares(gomock.Any(), gomock.Any()).Return(nil)
+		mockTransactionRepo.EXPECT().CreateDebts(gomock.Any(), gomock.Any()).Return(nil)
+		mockTransactionRepo.EXPECT().CreateTransactionPayers(gomock.Any(), []models.TransactionPayer{
+			{TransactionID: transactionID, UserID: payerID, Amount: money.FromFloat(100)},
+		}).Return(nil)
+		mockTransactionRepo.EXPECT().GetLatestRevision(gomock.Any(), transactionID).Return(nil, nil)
+		mockTransactionRepo.EXPECT().CreateRevision(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, revision *models.TransactionRevision) error {
+			assert.Equal(t, models.RevisionActionUpdated, revision.Action)
+			return nil
+		})
+		mockTransactionRepo.EXPECT().GetAttachmentsByTransactionID(gomock.Any(), transactionID).Return([]models.TransactionAttachment{}, nil)
+
+		result, err := transactionService.CreateTransactionItem(ctx, transactionID, &service.ItemDTO{
+			Name:      "Вино",
+			Price:     money.FromFloat(40),
+			Consumers: []int64{2},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, money.FromFloat(100), result.Amount)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, 11, result.Items[1].ID)
+		assert.Equal(t, []service.DebtDTO{
+			{FromUserID: 2, ToUserID: payerID, Amount: money.FromFloat(70), TransactionID: transactionID},
+		}, result.Debts)
+	})
+
+	t.Run("транзакция без построчного распределения", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(&models.Transaction{
+			ID:        transactionID,
+			EventID:   &eventID,
+			PayerID:   &payerID,
+			SplitType: 0,
+		}, nil)
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+
+		result, err := transactionService.CreateTransactionItem(ctx, transactionID, &service.ItemDTO{
+			Name:      "Вино",
+			Price:     money.FromFloat(40),
+			Consumers: []int64{2},
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTransactionService_DeleteTransactionItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+
+	transactionService := NewTransactionService(testDB, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
+
+	ctx := context.Background()
+	transactionID := 1
+	eventID := int64(1)
+	payerID := int64(1)
+
+	t.Run("позиция из другой транзакции", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().GetTransactionByID(gomock.Any(), transactionID).Return(&models.Transaction{
+			ID:        transactionID,
+			EventID:   &eventID,
+			PayerID:   &payerID,
+			SplitType: 4,
+		}, nil)
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockTransactionRepo.EXPECT().GetItemsByTransactionID(gomock.Any(), transactionID).Return([]models.TransactionItem{
+			{ID: 10, TransactionID: transactionID, Name: "Пицца", Price: money.FromFloat(60), Quantity: 1},
+		}, nil)
+		mockTransactionRepo.EXPECT().GetChargesByTransactionID(gomock.Any(), transactionID).Return(nil, nil)
+		mockTransactionRepo.EXPECT().GetPayersByTransactionID(gomock.Any(), transactionID).Return(nil, nil)
+
+		result, err := transactionService.DeleteTransactionItem(ctx, transactionID, 99)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
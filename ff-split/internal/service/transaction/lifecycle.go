@@ -0,0 +1,43 @@
+package transaction
+
+import (
+	"context"
+	"strconv"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/lifecycle"
+)
+
+// CloseEvent закрывает мероприятие. Перед закрытием долги оптимизируются последний раз,
+// после чего план переводов фиксируется: он больше не пересчитывается, а погашения
+// принимаются только по его долгам.
+func (s *TransactionService) CloseEvent(ctx context.Context, eventID int64) (*service.OptimizationResultDTO, error) {
+	if err := access.Require(ctx, eventID, lifecycle.RequiredPermission(models.EventStatusClosed)); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, customErrors.NewEntityNotFoundError(strconv.FormatInt(eventID, 10), "event")
+	}
+	if err := lifecycle.CheckTransition(event.Status, models.EventStatusClosed); err != nil {
+		return nil, err
+	}
+
+	result, err := s.OptimizeDebtsWithAlgorithm(ctx, eventID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.eventService.ChangeStatus(ctx, eventID, models.EventStatusClosed); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
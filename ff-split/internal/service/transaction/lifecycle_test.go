@@ -0,0 +1,112 @@
+package transaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestTransactionService_CloseEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+	var db *gorm.DB
+
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+
+	t.Run("закрытие фиксирует итоговый план", func(t *testing.T) {
+		event := &models.Event{ID: eventID, Status: models.EventStatusSettling}
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(event, nil).Times(2)
+		mockTransactionRepo.EXPECT().GetDebtsVersion(gomock.Any(), eventID).Return(int64(3), nil)
+		mockTransactionRepo.EXPECT().GetDebtsByEventID(gomock.Any(), eventID).Return([]models.Debt{
+			{ID: 1, TransactionID: 1, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(40)},
+		}, nil)
+		mockSettlementRepo.EXPECT().GetSettlementsByEventID(gomock.Any(), eventID).Return([]models.Settlement{}, nil)
+		mockTransactionRepo.EXPECT().SaveOptimizedDebts(gomock.Any(), eventID, int64(3), gomock.Any()).Return(nil)
+		mockEventService.EXPECT().
+			ChangeStatus(ctx, eventID, models.EventStatusClosed).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusClosed}, nil)
+
+		result, err := transactionService.CloseEvent(ctx, eventID)
+
+		require.NoError(t, err)
+		require.Len(t, result.OptimizedDebts, 1)
+		assert.Equal(t, money.FromFloat(40), result.OptimizedDebts[0].Amount)
+	})
+
+	t.Run("закрытое мероприятие нельзя закрыть повторно", func(t *testing.T) {
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusClosed}, nil)
+
+		_, err := transactionService.CloseEvent(ctx, eventID)
+
+		var logicErr *customErrors.LogicError
+		assert.ErrorAs(t, err, &logicErr)
+	})
+
+	t.Run("участник не может закрыть мероприятие", func(t *testing.T) {
+		memberCtx := access.WithMember(ctx, &models.UserEvent{UserID: 100, EventID: eventID, Role: models.EventRoleMember})
+
+		_, err := transactionService.CloseEvent(memberCtx, eventID)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+}
+
+func TestTransactionService_CreateTransaction_Lifecycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Создаем in-memory SQLite БД для тестов с транзакциями
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+
+	transactionService := NewTransactionService(testDB, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+
+	t.Run("мероприятие на этапе расчетов не принимает транзакции", func(t *testing.T) {
+		mockEventService.EXPECT().
+			GetEventByID(gomock.Any(), eventID).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusSettling}, nil)
+
+		_, err := transactionService.CreateTransaction(ctx, eventID, &service.TransactionRequest{
+			Type:     "equal",
+			Name:     "Такси",
+			FromUser: 100,
+			Amount:   money.FromFloat(30),
+			Users:    []int64{100, 200},
+		})
+
+		var logicErr *customErrors.LogicError
+		assert.ErrorAs(t, err, &logicErr)
+	})
+}
@@ -0,0 +1,141 @@
+package transaction
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 100
+)
+
+// ListTransactions возвращает страницу транзакций мероприятия с фильтрами и сортировкой
+func (s *TransactionService) ListTransactions(ctx context.Context, eventID int64, req *service.TransactionListRequest) (*service.TransactionPageDTO, error) {
+	filter, err := buildListFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Проверяем существование мероприятия
+	if _, err := s.eventService.GetEventByID(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	// Запрашиваем на одну транзакцию больше, чтобы понять, есть ли следующая страница
+	limit := filter.Limit
+	filter.Limit++
+	transactions, err := s.repo.GetTransactionsPage(ctx, eventID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &service.TransactionPageDTO{}
+	if len(transactions) > limit {
+		transactions = transactions[:limit]
+		cursor := encodeCursor(filter, transactions[limit-1].ID)
+		page.NextCursor = &cursor
+	}
+
+	page.Transactions, err = s.mapTransactionsToDTO(ctx, transactions)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// buildListFilter проверяет параметры списка транзакций и преобразует их в фильтр репозитория
+func buildListFilter(req *service.TransactionListRequest) (*models.TransactionFilter, error) {
+	filter := &models.TransactionFilter{
+		From:          req.From,
+		To:            req.To,
+		CategoryID:    req.CategoryID,
+		PayerID:       req.PayerID,
+		ParticipantID: req.ParticipantID,
+		AmountMin:     req.AmountMin,
+		AmountMax:     req.AmountMax,
+		Search:        strings.TrimSpace(req.Search),
+		SortBy:        req.SortBy,
+		Limit:         req.Limit,
+	}
+
+	if filter.SortBy == "" {
+		filter.SortBy = models.TransactionSortDate
+	}
+	if filter.SortBy != models.TransactionSortDate && filter.SortBy != models.TransactionSortAmount {
+		return nil, customErrors.NewValidationError("sort", "допустимые значения: date, amount")
+	}
+
+	switch req.SortOrder {
+	case "", service.SortOrderDesc:
+		filter.Descending = true
+	case service.SortOrderAsc:
+		filter.Descending = false
+	default:
+		return nil, customErrors.NewValidationError("order", "допустимые значения: asc, desc")
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultListLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxListLimit {
+		return nil, customErrors.NewValidationError("limit", "значение должно быть от 1 до 100")
+	}
+
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, customErrors.NewValidationError("to", "конец периода должен быть позже начала")
+	}
+	if req.AmountMin != nil && req.AmountMax != nil && *req.AmountMin > *req.AmountMax {
+		return nil, customErrors.NewValidationError("amount_max", "максимальная сумма меньше минимальной")
+	}
+
+	if req.Cursor != "" {
+		afterID, err := decodeCursor(filter, req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.AfterID = &afterID
+	}
+
+	return filter, nil
+}
+
+// cursorPrefix возвращает сортировку, к которой привязан курсор
+func cursorPrefix(filter *models.TransactionFilter) string {
+	order := service.SortOrderAsc
+	if filter.Descending {
+		order = service.SortOrderDesc
+	}
+	return filter.SortBy + ":" + order + ":"
+}
+
+// encodeCursor кодирует курсор страницы: сортировку и ID последней транзакции страницы
+func encodeCursor(filter *models.TransactionFilter, lastID int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix(filter) + strconv.Itoa(lastID)))
+}
+
+// decodeCursor разбирает курсор и проверяет, что он получен при той же сортировке
+func decodeCursor(filter *models.TransactionFilter, cursor string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, customErrors.NewValidationError("cursor", "некорректный курсор")
+	}
+
+	idPart, ok := strings.CutPrefix(string(raw), cursorPrefix(filter))
+	if !ok {
+		return 0, customErrors.NewValidationError("cursor",
+			fmt.Sprintf("курсор получен при другой сортировке, ожидается %s", strings.TrimSuffix(cursorPrefix(filter), ":")))
+	}
+	id, err := strconv.Atoi(idPart)
+	if err != nil || id <= 0 {
+		return 0, customErrors.NewValidationError("cursor", "некорректный курсор")
+	}
+	return id, nil
+}
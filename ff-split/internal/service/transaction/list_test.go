@@ -0,0 +1,117 @@
+package transaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionService_ListTransactions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+
+	transactionService := NewTransactionService(nil, mockTransactionRepo, nil, nil, mockEventService, nil, nil, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	userID := int64(100)
+
+	t.Run("первая страница и переход по курсору", func(t *testing.T) {
+		minAmount := money.FromFloat(100)
+
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID}, nil).
+			Times(2)
+
+		mockTransactionRepo.EXPECT().
+			GetTransactionsPage(gomock.Any(), eventID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int64, filter *models.TransactionFilter) ([]models.Transaction, error) {
+				assert.Equal(t, models.TransactionSortAmount, filter.SortBy)
+				assert.False(t, filter.Descending)
+				assert.Equal(t, 2, filter.Limit, "запрашивается на одну транзакцию больше страницы")
+				assert.Equal(t, &minAmount, filter.AmountMin)
+				assert.Equal(t, "ужин", filter.Search)
+				assert.Nil(t, filter.AfterID)
+				return []models.Transaction{
+					{ID: 5, EventID: &eventID, Name: "Ужин", TotalPaid: money.FromFloat(100), PayerID: &userID},
+					{ID: 3, EventID: &eventID, Name: "Ужин в кафе", TotalPaid: money.FromFloat(200), PayerID: &userID},
+				}, nil
+			})
+		mockTransactionRepo.EXPECT().LoadTransactionDetails(gomock.Any(), gomock.Len(1)).Return(nil)
+
+		request := &service.TransactionListRequest{
+			AmountMin: &minAmount,
+			Search:    "  ужин ",
+			SortBy:    models.TransactionSortAmount,
+			SortOrder: service.SortOrderAsc,
+			Limit:     1,
+		}
+		page, err := transactionService.ListTransactions(ctx, eventID, request)
+
+		require.NoError(t, err)
+		require.Len(t, page.Transactions, 1)
+		assert.Equal(t, 5, page.Transactions[0].ID)
+		require.NotNil(t, page.NextCursor)
+
+		mockTransactionRepo.EXPECT().
+			GetTransactionsPage(gomock.Any(), eventID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int64, filter *models.TransactionFilter) ([]models.Transaction, error) {
+				require.NotNil(t, filter.AfterID)
+				assert.Equal(t, 5, *filter.AfterID)
+				return nil, nil
+			})
+
+		request.Cursor = *page.NextCursor
+		page, err = transactionService.ListTransactions(ctx, eventID, request)
+
+		require.NoError(t, err)
+		assert.Empty(t, page.Transactions)
+		assert.Nil(t, page.NextCursor)
+	})
+
+	t.Run("курсор другой сортировки", func(t *testing.T) {
+		filter := &models.TransactionFilter{SortBy: models.TransactionSortDate, Descending: true}
+		cursor := encodeCursor(filter, 7)
+
+		_, err := transactionService.ListTransactions(ctx, eventID, &service.TransactionListRequest{
+			SortBy: models.TransactionSortAmount,
+			Cursor: cursor,
+		})
+
+		var validationErr *customErrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "cursor", validationErr.Field)
+	})
+
+	t.Run("некорректные параметры", func(t *testing.T) {
+		small, large := money.FromFloat(10), money.FromFloat(5)
+		requests := map[string]*service.TransactionListRequest{
+			"sort":       {SortBy: "name"},
+			"order":      {SortOrder: "up"},
+			"limit":      {Limit: 101},
+			"amount_max": {AmountMin: &small, AmountMax: &large},
+			"cursor":     {Cursor: "!!!"},
+		}
+		for field, request := range requests {
+			_, err := transactionService.ListTransactions(ctx, eventID, request)
+
+			var validationErr *customErrors.ValidationError
+			if assert.ErrorAs(t, err, &validationErr, field) {
+				assert.Equal(t, field, validationErr.Field)
+			}
+		}
+	})
+}
@@ -0,0 +1,228 @@
+package transaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestTransactionService_OptimizeDebtsWithAlgorithm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+	var db *gorm.DB
+
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+
+	// Цепочка 100 -> 200 -> 300 переносится на существующий прямой долг 100 -> 300
+	debts := []models.Debt{
+		{ID: 1, TransactionID: 1, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(40)},
+		{ID: 2, TransactionID: 2, FromUserID: 200, ToUserID: 300, Amount: money.FromFloat(40)},
+		{ID: 3, TransactionID: 3, FromUserID: 100, ToUserID: 300, Amount: money.FromFloat(10)},
+	}
+
+	expectOptimization := func() {
+		mockTransactionRepo.EXPECT().GetDebtsVersion(gomock.Any(), eventID).Return(int64(3), nil)
+		mockTransactionRepo.EXPECT().GetDebtsByEventID(gomock.Any(), eventID).Return(debts, nil)
+		mockSettlementRepo.EXPECT().GetSettlementsByEventID(gomock.Any(), eventID).Return([]models.Settlement{}, nil)
+		mockTransactionRepo.EXPECT().SaveOptimizedDebts(gomock.Any(), eventID, int64(3), gomock.Any()).Return(nil)
+	}
+
+	t.Run("используется алгоритм мероприятия", func(t *testing.T) {
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, OptimizationAlgorithm: "edmonds_karp"}, nil)
+		expectOptimization()
+
+		result, err := transactionService.OptimizeDebtsWithAlgorithm(ctx, eventID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "edmonds_karp", result.Algorithm)
+		assert.Equal(t, 3, result.OriginalTransfers)
+		assert.Equal(t, 2, result.RemovedTransfers)
+		require.Len(t, result.OptimizedDebts, 1)
+		assert.Equal(t, int64(100), result.OptimizedDebts[0].FromUserID)
+		assert.Equal(t, int64(300), result.OptimizedDebts[0].ToUserID)
+		assert.Equal(t, money.FromFloat(50), result.OptimizedDebts[0].Amount)
+	})
+
+	t.Run("алгоритм из запроса переопределяет алгоритм мероприятия", func(t *testing.T) {
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, OptimizationAlgorithm: "edmonds_karp"}, nil)
+		expectOptimization()
+
+		result, err := transactionService.OptimizeDebtsWithAlgorithm(ctx, eventID, "greedy")
+
+		require.NoError(t, err)
+		assert.Equal(t, "greedy", result.Algorithm)
+		assert.Equal(t, 3, result.OriginalTransfers)
+	})
+
+	t.Run("алгоритм по умолчанию", func(t *testing.T) {
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID}, nil)
+		expectOptimization()
+
+		result, err := transactionService.OptimizeDebtsWithAlgorithm(ctx, eventID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "dinic", result.Algorithm)
+	})
+
+	t.Run("min_transfers сворачивает цепочку без прямого долга", func(t *testing.T) {
+		chain := []models.Debt{
+			{ID: 1, TransactionID: 1, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(40)},
+			{ID: 2, TransactionID: 2, FromUserID: 200, ToUserID: 300, Amount: money.FromFloat(40)},
+		}
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, OptimizationAlgorithm: "min_transfers"}, nil)
+		mockTransactionRepo.EXPECT().GetDebtsVersion(gomock.Any(), eventID).Return(int64(3), nil)
+		mockTransactionRepo.EXPECT().GetDebtsByEventID(gomock.Any(), eventID).Return(chain, nil)
+		mockSettlementRepo.EXPECT().GetSettlementsByEventID(gomock.Any(), eventID).Return([]models.Settlement{}, nil)
+		mockTransactionRepo.EXPECT().SaveOptimizedDebts(gomock.Any(), eventID, int64(3), gomock.Any()).Return(nil)
+
+		result, err := transactionService.OptimizeDebtsWithAlgorithm(ctx, eventID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "min_transfers", result.Algorithm)
+		assert.Equal(t, 1, result.RemovedTransfers)
+		require.Len(t, result.OptimizedDebts, 1)
+		assert.Equal(t, int64(100), result.OptimizedDebts[0].FromUserID)
+		assert.Equal(t, int64(300), result.OptimizedDebts[0].ToUserID)
+		assert.Equal(t, money.FromFloat(40), result.OptimizedDebts[0].Amount)
+	})
+
+	t.Run("неизвестный алгоритм", func(t *testing.T) {
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID}, nil)
+
+		result, err := transactionService.OptimizeDebtsWithAlgorithm(ctx, eventID, "simplex")
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Nil(t, result)
+	})
+	t.Run("план пересчитывается, если долги изменились во время оптимизации", func(t *testing.T) {
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID}, nil)
+		gomock.InOrder(
+			mockTransactionRepo.EXPECT().GetDebtsVersion(gomock.Any(), eventID).Return(int64(3), nil),
+			mockTransactionRepo.EXPECT().GetDebtsByEventID(gomock.Any(), eventID).Return(debts[:1], nil),
+			mockSettlementRepo.EXPECT().GetSettlementsByEventID(gomock.Any(), eventID).Return([]models.Settlement{}, nil),
+			mockTransactionRepo.EXPECT().SaveOptimizedDebts(gomock.Any(), eventID, int64(3), gomock.Any()).Return(repository.ErrDebtsChanged),
+			mockTransactionRepo.EXPECT().GetDebtsVersion(gomock.Any(), eventID).Return(int64(4), nil),
+			mockTransactionRepo.EXPECT().GetDebtsByEventID(gomock.Any(), eventID).Return(debts, nil),
+			mockSettlementRepo.EXPECT().GetSettlementsByEventID(gomock.Any(), eventID).Return([]models.Settlement{}, nil),
+			mockTransactionRepo.EXPECT().SaveOptimizedDebts(gomock.Any(), eventID, int64(4), gomock.Any()).Return(nil),
+		)
+
+		result, err := transactionService.OptimizeDebtsWithAlgorithm(ctx, eventID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.OriginalTransfers, "план построен по свежим долгам")
+	})
+
+	t.Run("постоянно меняющиеся долги не зацикливают оптимизацию", func(t *testing.T) {
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID}, nil)
+		mockTransactionRepo.EXPECT().GetDebtsVersion(gomock.Any(), eventID).Return(int64(3), nil).Times(maxOptimizeAttempts)
+		mockTransactionRepo.EXPECT().GetDebtsByEventID(gomock.Any(), eventID).Return(debts, nil).Times(maxOptimizeAttempts)
+		mockSettlementRepo.EXPECT().GetSettlementsByEventID(gomock.Any(), eventID).Return([]models.Settlement{}, nil).Times(maxOptimizeAttempts)
+		mockTransactionRepo.EXPECT().
+			SaveOptimizedDebts(gomock.Any(), eventID, int64(3), gomock.Any()).
+			Return(repository.ErrDebtsChanged).
+			Times(maxOptimizeAttempts)
+
+		result, err := transactionService.OptimizeDebtsWithAlgorithm(ctx, eventID, "")
+
+		assert.ErrorIs(t, err, repository.ErrDebtsChanged)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTransactionService_GetCurrentOptimizedDebts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+	var db *gorm.DB
+
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+
+	t.Run("устаревший план строится в памяти и не сохраняется", func(t *testing.T) {
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockTransactionRepo.EXPECT().IsOptimizedDebtsOutdated(gomock.Any(), eventID).Return(true, nil)
+		mockTransactionRepo.EXPECT().GetDebtsByEventID(gomock.Any(), eventID).Return([]models.Debt{
+			{ID: 1, TransactionID: 1, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(30)},
+		}, nil)
+		mockSettlementRepo.EXPECT().GetSettlementsByEventID(gomock.Any(), eventID).Return([]models.Settlement{}, nil)
+
+		result, err := transactionService.GetCurrentOptimizedDebts(ctx, eventID)
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, int64(100), result[0].FromUserID)
+		assert.Equal(t, int64(200), result[0].ToUserID)
+		assert.Equal(t, money.FromFloat(30), result[0].Amount)
+	})
+
+	t.Run("актуальный план читается из базы", func(t *testing.T) {
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockTransactionRepo.EXPECT().IsOptimizedDebtsOutdated(gomock.Any(), eventID).Return(false, nil)
+		mockTransactionRepo.EXPECT().GetOptimizedDebtsByEventIDWithUsers(gomock.Any(), eventID).Return([]models.OptimizedDebt{
+			{ID: 3, EventID: eventID, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(30), SettledAmount: money.FromFloat(10)},
+		}, nil)
+
+		result, err := transactionService.GetCurrentOptimizedDebts(ctx, eventID)
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, 3, result[0].ID)
+		assert.Equal(t, money.FromFloat(10), result[0].SettledAmount)
+	})
+
+	t.Run("план закрытого мероприятия не пересчитывается", func(t *testing.T) {
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusClosed}, nil)
+		mockTransactionRepo.EXPECT().GetOptimizedDebtsByEventIDWithUsers(gomock.Any(), eventID).Return([]models.OptimizedDebt{}, nil)
+
+		result, err := transactionService.GetCurrentOptimizedDebts(ctx, eventID)
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
@@ -0,0 +1,247 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/lifecycle"
+)
+
+// GetSettlementsByEventID возвращает погашения долгов мероприятия
+func (s *TransactionService) GetSettlementsByEventID(ctx context.Context, eventID int64) ([]service.SettlementDTO, error) {
+	// Проверяем существование мероприятия
+	_, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	settlements, err := s.settlementRepo.GetSettlementsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]service.SettlementDTO, len(settlements))
+	for i, settlement := range settlements {
+		result[i] = mapSettlementToDTO(&settlement)
+	}
+	return result, nil
+}
+
+// CreateSettlement фиксирует возврат денег одним участником другому.
+// Если указан оптимизированный долг (или найден долг между этими участниками),
+// его погашенная часть и статус обновляются.
+func (s *TransactionService) CreateSettlement(ctx context.Context, eventID int64, req *service.SettlementRequest) (*service.SettlementDTO, error) {
+	if err := access.Require(ctx, eventID, access.EditTransactions); err != nil {
+		return nil, err
+	}
+	if req.Amount <= 0 {
+		return nil, customErrors.NewValidationError("amount", "сумма погашения должна быть положительной")
+	}
+	if req.FromUserID == req.ToUserID {
+		return nil, customErrors.NewValidationError("to_user_id", "нельзя погасить долг самому себе")
+	}
+
+	var result *service.SettlementDTO
+	err := db.WithTx(ctx, s.db, func(ctx context.Context) error {
+		// Проверяем существование мероприятия и участников
+		event, err := s.eventService.GetEventByID(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if event == nil {
+			return customErrors.NewEntityNotFoundError(strconv.FormatInt(eventID, 10), "event")
+		}
+		if !lifecycle.AcceptsSettlements(event.Status) {
+			return customErrors.NewLogicError("погашения в архивном мероприятии не принимаются")
+		}
+		if _, err := s.userService.GetUserByInternalUserID(ctx, req.FromUserID); err != nil {
+			return err
+		}
+		if _, err := s.userService.GetUserByInternalUserID(ctx, req.ToUserID); err != nil {
+			return err
+		}
+
+		// Определяем погашаемый оптимизированный долг
+		debt, err := s.findSettledDebt(ctx, eventID, req)
+		if err != nil {
+			return err
+		}
+		if debt == nil && lifecycle.IsPlanFrozen(event.Status) {
+			return customErrors.NewLogicError("план переводов закрытого мероприятия зафиксирован: погашение должно соответствовать одному из долгов")
+		}
+
+		settlement := &models.Settlement{
+			EventID:    eventID,
+			FromUserID: req.FromUserID,
+			ToUserID:   req.ToUserID,
+			Amount:     req.Amount,
+			Comment:    req.Comment,
+		}
+		if debt != nil {
+			settlement.OptimizedDebtID = &debt.ID
+		}
+
+		if err := s.settlementRepo.CreateSettlement(ctx, settlement); err != nil {
+			return err
+		}
+
+		if debt != nil {
+			debt.SettledAmount += req.Amount
+			debt.Status = settlementStatus(debt)
+			if err := s.repo.UpdateOptimizedDebtSettlement(ctx, debt); err != nil {
+				return err
+			}
+		} else if err := s.repo.MarkOptimizedDebtsOutdated(ctx, eventID); err != nil {
+			// Погашение вне оптимизированных долгов меняет итоговые переводы
+			return err
+		}
+
+		dto := mapSettlementToDTO(settlement)
+		result = &dto
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeleteSettlement удаляет погашение и возвращает погашенную сумму связанному оптимизированному долгу
+func (s *TransactionService) DeleteSettlement(ctx context.Context, eventID int64, id int) error {
+	if err := access.Require(ctx, eventID, access.EditTransactions); err != nil {
+		return err
+	}
+
+	return db.WithTx(ctx, s.db, func(ctx context.Context) error {
+		event, err := s.eventService.GetEventByID(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if event == nil {
+			return customErrors.NewEntityNotFoundError(strconv.FormatInt(eventID, 10), "event")
+		}
+		if !lifecycle.AcceptsSettlements(event.Status) {
+			return customErrors.NewLogicError("погашения в архивном мероприятии не принимаются")
+		}
+
+		settlement, err := s.settlementRepo.GetSettlementByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if settlement.EventID != eventID {
+			return customErrors.NewEntityNotFoundError(strconv.Itoa(id), "settlement")
+		}
+		if settlement.OptimizedDebtID == nil && lifecycle.IsPlanFrozen(event.Status) {
+			return customErrors.NewLogicError("план переводов закрытого мероприятия зафиксирован: погашение вне плана удалить нельзя")
+		}
+
+		if err := s.settlementRepo.DeleteSettlement(ctx, id); err != nil {
+			return err
+		}
+
+		// Погашение без долга учтено в оптимизации как встречный перевод — ее нужно пересчитать
+		if settlement.OptimizedDebtID == nil {
+			return s.repo.MarkOptimizedDebtsOutdated(ctx, eventID)
+		}
+
+		// Долг мог быть пересчитан после погашения — тогда пересчитываем его снова
+		debt, err := s.repo.GetOptimizedDebtByID(ctx, *settlement.OptimizedDebtID)
+		if err != nil {
+			var notFound *customErrors.EntityNotFoundError
+			if errors.As(err, &notFound) {
+				return s.repo.MarkOptimizedDebtsOutdated(ctx, eventID)
+			}
+			return err
+		}
+
+		debt.SettledAmount -= settlement.Amount
+		if debt.SettledAmount < 0 {
+			debt.SettledAmount = 0
+		}
+		debt.Status = settlementStatus(debt)
+		return s.repo.UpdateOptimizedDebtSettlement(ctx, debt)
+	})
+}
+
+// findSettledDebt возвращает оптимизированный долг, который гасится погашением.
+// Явно указанный долг должен связывать тех же участников и покрывать сумму погашения,
+// иначе выбирается непогашенный долг между участниками, если сумма укладывается в его остаток.
+func (s *TransactionService) findSettledDebt(ctx context.Context, eventID int64, req *service.SettlementRequest) (*models.OptimizedDebt, error) {
+	if req.OptimizedDebtID != nil {
+		debt, err := s.repo.GetOptimizedDebtByID(ctx, *req.OptimizedDebtID)
+		if err != nil {
+			return nil, err
+		}
+		if debt.EventID != eventID || debt.FromUserID != req.FromUserID || debt.ToUserID != req.ToUserID {
+			return nil, customErrors.NewValidationError("optimized_debt_id", "долг не связывает указанных участников мероприятия")
+		}
+		if req.Amount > debt.Amount-debt.SettledAmount {
+			return nil, customErrors.NewValidationError("amount", "сумма погашения превышает остаток долга")
+		}
+		return debt, nil
+	}
+
+	debts, err := s.repo.GetOptimizedDebtsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	for _, debt := range debts {
+		if debt.FromUserID != req.FromUserID || debt.ToUserID != req.ToUserID || debt.Status == models.OptimizedDebtStatusSettled {
+			continue
+		}
+		// Переплата не укладывается в долг: погашение учитывается отдельно, а план пересчитывается
+		if req.Amount > debt.Amount-debt.SettledAmount {
+			return nil, nil
+		}
+		return &debt, nil
+	}
+	return nil, nil
+}
+
+// settlementStatus определяет статус оптимизированного долга по погашенной сумме
+func settlementStatus(debt *models.OptimizedDebt) string {
+	switch {
+	case debt.SettledAmount <= 0:
+		return models.OptimizedDebtStatusPending
+	case debt.SettledAmount < debt.Amount:
+		return models.OptimizedDebtStatusPartial
+	default:
+		return models.OptimizedDebtStatusSettled
+	}
+}
+
+// mapSettlementToDTO преобразует модель погашения в DTO
+func mapSettlementToDTO(settlement *models.Settlement) service.SettlementDTO {
+	return service.SettlementDTO{
+		ID:              settlement.ID,
+		EventID:         settlement.EventID,
+		FromUserID:      settlement.FromUserID,
+		ToUserID:        settlement.ToUserID,
+		Amount:          settlement.Amount,
+		OptimizedDebtID: settlement.OptimizedDebtID,
+		Comment:         settlement.Comment,
+		CreatedAt:       settlement.CreatedAt,
+		FromUser:        mapDebtsUser(settlement.FromUser),
+		ToUser:          mapDebtsUser(settlement.ToUser),
+	}
+}
+
+// mapDebtsUser преобразует пользователя в DTO для долгов, если он загружен
+func mapDebtsUser(user *models.User) *service.DebtsUserResponse {
+	if user == nil {
+		return nil
+	}
+	return &service.DebtsUserResponse{
+		ID:    user.ID,
+		Name:  getUserName(user),
+		Photo: user.PhotoUUIDCashed,
+	}
+}
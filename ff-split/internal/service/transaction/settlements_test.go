@@ -0,0 +1,280 @@
+package transaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestTransactionService_CreateSettlement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Создаем in-memory SQLite БД для тестов с транзакциями
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+
+	transactionService := NewTransactionService(testDB, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	debtorID := int64(100)
+	creditorID := int64(200)
+
+	expectParticipants := func() {
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockUserService.EXPECT().GetUserByInternalUserID(gomock.Any(), debtorID).Return(&models.User{ID: debtorID}, nil)
+		mockUserService.EXPECT().GetUserByInternalUserID(gomock.Any(), creditorID).Return(&models.User{ID: creditorID}, nil)
+	}
+
+	t.Run("частичное погашение найденного долга", func(t *testing.T) {
+		expectParticipants()
+		mockTransactionRepo.EXPECT().GetOptimizedDebtsByEventID(gomock.Any(), eventID).Return([]models.OptimizedDebt{
+			{ID: 7, EventID: eventID, FromUserID: creditorID, ToUserID: debtorID, Amount: money.FromFloat(10)},
+			{ID: 5, EventID: eventID, FromUserID: debtorID, ToUserID: creditorID, Amount: money.FromFloat(50), Status: models.OptimizedDebtStatusPending},
+		}, nil)
+		mockSettlementRepo.EXPECT().CreateSettlement(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, settlement *models.Settlement) error {
+			require.NotNil(t, settlement.OptimizedDebtID)
+			assert.Equal(t, 5, *settlement.OptimizedDebtID)
+			settlement.ID = 1
+			return nil
+		})
+		mockTransactionRepo.EXPECT().UpdateOptimizedDebtSettlement(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, debt *models.OptimizedDebt) error {
+			assert.Equal(t, 5, debt.ID)
+			assert.Equal(t, money.FromFloat(20), debt.SettledAmount)
+			assert.Equal(t, models.OptimizedDebtStatusPartial, debt.Status)
+			return nil
+		})
+
+		result, err := transactionService.CreateSettlement(ctx, eventID, &service.SettlementRequest{
+			FromUserID: debtorID,
+			ToUserID:   creditorID,
+			Amount:     money.FromFloat(20),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.ID)
+		assert.Equal(t, money.FromFloat(20), result.Amount)
+	})
+
+	t.Run("погашение без оптимизированного долга помечает долги устаревшими", func(t *testing.T) {
+		expectParticipants()
+		mockTransactionRepo.EXPECT().GetOptimizedDebtsByEventID(gomock.Any(), eventID).Return([]models.OptimizedDebt{}, nil)
+		mockSettlementRepo.EXPECT().CreateSettlement(gomock.Any(), gomock.Any()).Return(nil)
+		mockTransactionRepo.EXPECT().MarkOptimizedDebtsOutdated(gomock.Any(), eventID).Return(nil)
+
+		result, err := transactionService.CreateSettlement(ctx, eventID, &service.SettlementRequest{
+			FromUserID: debtorID,
+			ToUserID:   creditorID,
+			Amount:     money.FromFloat(20),
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, result.OptimizedDebtID)
+	})
+
+	t.Run("переплата найденного долга помечает долги устаревшими", func(t *testing.T) {
+		expectParticipants()
+		mockTransactionRepo.EXPECT().GetOptimizedDebtsByEventID(gomock.Any(), eventID).Return([]models.OptimizedDebt{
+			{ID: 5, EventID: eventID, FromUserID: debtorID, ToUserID: creditorID, Amount: money.FromFloat(50), SettledAmount: money.FromFloat(40), Status: models.OptimizedDebtStatusPartial},
+		}, nil)
+		mockSettlementRepo.EXPECT().CreateSettlement(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, settlement *models.Settlement) error {
+			assert.Nil(t, settlement.OptimizedDebtID)
+			return nil
+		})
+		mockTransactionRepo.EXPECT().MarkOptimizedDebtsOutdated(gomock.Any(), eventID).Return(nil)
+
+		result, err := transactionService.CreateSettlement(ctx, eventID, &service.SettlementRequest{
+			FromUserID: debtorID,
+			ToUserID:   creditorID,
+			Amount:     money.FromFloat(20),
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, result.OptimizedDebtID)
+	})
+
+	t.Run("полное погашение указанного долга", func(t *testing.T) {
+		debtID := 5
+		expectParticipants()
+		mockTransactionRepo.EXPECT().GetOptimizedDebtByID(gomock.Any(), debtID).Return(&models.OptimizedDebt{
+			ID: debtID, EventID: eventID, FromUserID: debtorID, ToUserID: creditorID,
+			Amount: money.FromFloat(50), SettledAmount: money.FromFloat(20), Status: models.OptimizedDebtStatusPartial,
+		}, nil)
+		mockSettlementRepo.EXPECT().CreateSettlement(gomock.Any(), gomock.Any()).Return(nil)
+		mockTransactionRepo.EXPECT().UpdateOptimizedDebtSettlement(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, debt *models.OptimizedDebt) error {
+			assert.Equal(t, money.FromFloat(50), debt.SettledAmount)
+			assert.Equal(t, models.OptimizedDebtStatusSettled, debt.Status)
+			return nil
+		})
+
+		_, err := transactionService.CreateSettlement(ctx, eventID, &service.SettlementRequest{
+			FromUserID:      debtorID,
+			ToUserID:        creditorID,
+			Amount:          money.FromFloat(30),
+			OptimizedDebtID: &debtID,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("сумма превышает остаток указанного долга", func(t *testing.T) {
+		debtID := 5
+		expectParticipants()
+		mockTransactionRepo.EXPECT().GetOptimizedDebtByID(gomock.Any(), debtID).Return(&models.OptimizedDebt{
+			ID: debtID, EventID: eventID, FromUserID: debtorID, ToUserID: creditorID,
+			Amount: money.FromFloat(50), SettledAmount: money.FromFloat(20),
+		}, nil)
+
+		result, err := transactionService.CreateSettlement(ctx, eventID, &service.SettlementRequest{
+			FromUserID:      debtorID,
+			ToUserID:        creditorID,
+			Amount:          money.FromFloat(31),
+			OptimizedDebtID: &debtID,
+		})
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Nil(t, result)
+	})
+
+	t.Run("погашение самому себе", func(t *testing.T) {
+		result, err := transactionService.CreateSettlement(ctx, eventID, &service.SettlementRequest{
+			FromUserID: debtorID,
+			ToUserID:   debtorID,
+			Amount:     money.FromFloat(10),
+		})
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTransactionService_DeleteSettlement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+
+	transactionService := NewTransactionService(testDB, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	debtID := 5
+
+	t.Run("удаление возвращает сумму долгу", func(t *testing.T) {
+		mockSettlementRepo.EXPECT().GetSettlementByID(gomock.Any(), 1).Return(&models.Settlement{
+			ID: 1, EventID: eventID, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(20), OptimizedDebtID: &debtID,
+		}, nil)
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockSettlementRepo.EXPECT().DeleteSettlement(gomock.Any(), 1).Return(nil)
+		mockTransactionRepo.EXPECT().GetOptimizedDebtByID(gomock.Any(), debtID).Return(&models.OptimizedDebt{
+			ID: debtID, EventID: eventID, Amount: money.FromFloat(50), SettledAmount: money.FromFloat(20), Status: models.OptimizedDebtStatusPartial,
+		}, nil)
+		mockTransactionRepo.EXPECT().UpdateOptimizedDebtSettlement(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, debt *models.OptimizedDebt) error {
+			assert.Equal(t, money.Zero, debt.SettledAmount)
+			assert.Equal(t, models.OptimizedDebtStatusPending, debt.Status)
+			return nil
+		})
+
+		assert.NoError(t, transactionService.DeleteSettlement(ctx, eventID, 1))
+	})
+
+	t.Run("погашение другого мероприятия", func(t *testing.T) {
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockSettlementRepo.EXPECT().GetSettlementByID(gomock.Any(), 2).Return(&models.Settlement{ID: 2, EventID: 42}, nil)
+
+		err := transactionService.DeleteSettlement(ctx, eventID, 2)
+
+		var notFoundErr *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestTransactionService_SettlementsReduceDebts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
+	mockUserService := serviceMock.NewMockUser(ctrl)
+	mockEventService := serviceMock.NewMockEvent(ctrl)
+	var db *gorm.DB
+
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
+
+	ctx := context.Background()
+	eventID := int64(1)
+	debts := []models.Debt{
+		{ID: 1, TransactionID: 1, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(50)},
+	}
+	settlements := []models.Settlement{
+		{ID: 1, EventID: eventID, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(20)},
+	}
+
+	t.Run("погашения не попадают в список долгов", func(t *testing.T) {
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockTransactionRepo.EXPECT().GetDebtsByEventID(gomock.Any(), eventID).Return(debts, nil)
+
+		result, err := transactionService.GetDebtsByEventID(ctx, eventID, nil)
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, 1, result[0].TransactionID)
+		assert.Equal(t, money.FromFloat(50), result[0].Amount)
+	})
+
+	t.Run("оптимизация учитывает погашения", func(t *testing.T) {
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockTransactionRepo.EXPECT().GetDebtsVersion(gomock.Any(), eventID).Return(int64(3), nil)
+		mockTransactionRepo.EXPECT().GetDebtsByEventID(gomock.Any(), eventID).Return(debts, nil)
+		mockSettlementRepo.EXPECT().GetSettlementsByEventID(gomock.Any(), eventID).Return(settlements, nil)
+		mockTransactionRepo.EXPECT().SaveOptimizedDebts(gomock.Any(), eventID, int64(3), gomock.Any()).Return(nil)
+
+		result, err := transactionService.OptimizeDebts(ctx, eventID)
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, int64(100), result[0].FromUserID)
+		assert.Equal(t, int64(200), result[0].ToUserID)
+		assert.Equal(t, money.FromFloat(30), result[0].Amount)
+		assert.Equal(t, models.OptimizedDebtStatusPending, result[0].Status)
+	})
+
+	t.Run("статистика оптимизации не учитывает погашения", func(t *testing.T) {
+		mockEventService.EXPECT().GetEventByID(gomock.Any(), eventID).Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil)
+		mockTransactionRepo.EXPECT().GetDebtsVersion(gomock.Any(), eventID).Return(int64(3), nil)
+		mockTransactionRepo.EXPECT().GetDebtsByEventID(gomock.Any(), eventID).Return(debts, nil)
+		mockSettlementRepo.EXPECT().GetSettlementsByEventID(gomock.Any(), eventID).Return(settlements, nil)
+		mockTransactionRepo.EXPECT().SaveOptimizedDebts(gomock.Any(), eventID, int64(3), gomock.Any()).Return(nil)
+
+		result, err := transactionService.optimizeDebts(ctx, eventID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.OriginalTransfers)
+		assert.Equal(t, 0, result.RemovedTransfers)
+	})
+}
@@ -2,44 +2,70 @@ package transaction
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ivasnev/FinFlow/ff-common/optimizers"
-	"github.com/ivasnev/FinFlow/ff-common/optimizers/dinic"
+	"github.com/ivasnev/FinFlow/ff-split/internal/adapters"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
 	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/activity"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/currency"
 	"github.com/ivasnev/FinFlow/ff-split/internal/service/debt_calculator"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/lifecycle"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/optimizer"
 	"gorm.io/gorm"
 )
 
+// maxOptimizeAttempts - сколько раз строится план переводов, если долги мероприятия
+// меняются во время оптимизации
+const maxOptimizeAttempts = 3
+
 // TransactionService реализует сервис для работы с транзакциями
 type TransactionService struct {
-	db           *gorm.DB
-	repo         repository.Transaction
-	userService  service.User
-	eventService service.Event
+	db             *gorm.DB
+	repo           repository.Transaction
+	settlementRepo repository.Settlement
+	userService    service.User
+	eventService   service.Event
+	rateProvider   service.RateProvider
+	activities     service.ActivityRecorder
+	files          adapters.FilesAdapter
 }
 
 // NewTransactionService создает новый сервис для работы с транзакциями
 func NewTransactionService(
 	db *gorm.DB,
 	repo repository.Transaction,
+	settlementRepo repository.Settlement,
 	userService service.User,
 	eventService service.Event,
+	rateProvider service.RateProvider,
+	activities service.ActivityRecorder,
+	files adapters.FilesAdapter,
 ) *TransactionService {
 	return &TransactionService{
-		db:           db,
-		repo:         repo,
-		userService:  userService,
-		eventService: eventService,
+		db:             db,
+		repo:           repo,
+		settlementRepo: settlementRepo,
+		userService:    userService,
+		eventService:   eventService,
+		rateProvider:   rateProvider,
+		activities:     activities,
+		files:          files,
 	}
 }
 
-// GetTransactionsByEventID возвращает список транзакций мероприятия
+// GetTransactionsByEventID возвращает список транзакций мероприятия.
+// Временные ссылки на вложения не запрашиваются: список нужен для выгрузки, где они не используются
 func (s *TransactionService) GetTransactionsByEventID(ctx context.Context, eventID int64) ([]service.TransactionResponse, error) {
 	// Проверяем существование мероприятия
 	_, err := s.eventService.GetEventByID(ctx, eventID)
@@ -48,32 +74,38 @@ func (s *TransactionService) GetTransactionsByEventID(ctx context.Context, event
 	}
 
 	// Получаем транзакции
-	transactions, err := s.repo.GetTransactionsByEventID(eventID)
+	transactions, err := s.repo.GetTransactionsByEventID(ctx, eventID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Преобразуем в DTO
+	return s.mapTransactionsToDTO(ctx, transactions)
+}
+
+// mapTransactionsToDTO загружает доли, плательщиков, позиции и долги транзакций и преобразует их в DTO.
+// Связанные данные читаются пакетно, поэтому число запросов не зависит от количества транзакций
+func (s *TransactionService) mapTransactionsToDTO(ctx context.Context, transactions []models.Transaction) ([]service.TransactionResponse, error) {
 	result := make([]service.TransactionResponse, len(transactions))
-	for i, tx := range transactions {
-		// Получаем доли
-		shares, err := s.repo.GetSharesByTransactionID(tx.ID)
-		if err != nil {
-			return nil, err
-		}
+	if len(transactions) == 0 {
+		return result, nil
+	}
 
-		// Получаем долги
-		debts, err := s.repo.GetDebtsByTransactionID(tx.ID)
-		if err != nil {
-			return nil, err
+	if err := s.repo.LoadTransactionDetails(ctx, transactions); err != nil {
+		return nil, err
+	}
+
+	for i := range transactions {
+		tx := &transactions[i]
+		// Позиции и надбавки чека показываются только для распределения по позициям
+		if s.getSplitTypeName(tx.SplitType) != debt_calculator.ItemsType {
+			tx.Items = nil
+			tx.Charges = nil
 		}
 
-		// Преобразуем в DTO
-		txResponse, err := s.mapTransactionToDTO(&tx, shares, debts)
+		txResponse, err := s.mapTransactionToDTO(tx, tx.Payers, tx.Shares, tx.Debts)
 		if err != nil {
 			return nil, err
 		}
-
 		result[i] = *txResponse
 	}
 
@@ -83,60 +115,90 @@ func (s *TransactionService) GetTransactionsByEventID(ctx context.Context, event
 // GetTransactionByID возвращает транзакцию по ID
 func (s *TransactionService) GetTransactionByID(ctx context.Context, id int) (*service.TransactionResponse, error) {
 	// Получаем транзакцию
-	tx, err := s.repo.GetTransactionByID(id)
+	tx, err := s.repo.GetTransactionByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	// Получаем доли
-	shares, err := s.repo.GetSharesByTransactionID(tx.ID)
+	shares, err := s.repo.GetSharesByTransactionID(ctx, tx.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Получаем плательщиков
+	payers, err := s.repo.GetPayersByTransactionID(ctx, tx.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Получаем позиции чека
+	if err := s.loadItems(ctx, tx); err != nil {
+		return nil, err
+	}
+
 	// Получаем долги
-	debts, err := s.repo.GetDebtsByTransactionID(tx.ID)
+	debts, err := s.repo.GetDebtsByTransactionID(ctx, tx.ID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Преобразуем в DTO
-	return s.mapTransactionToDTO(tx, shares, debts)
+	result, err := s.mapTransactionToDTO(tx, payers, shares, debts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Получаем вложения со временными ссылками
+	if err := s.loadAttachments(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // CreateTransaction создает новую транзакцию
 func (s *TransactionService) CreateTransaction(ctx context.Context, eventID int64, req *service.TransactionRequest) (*service.TransactionResponse, error) {
+	if err := access.Require(ctx, eventID, access.EditTransactions); err != nil {
+		return nil, err
+	}
+
 	// Начинаем транзакцию в базе данных
 	var result *service.TransactionResponse
-	err := s.db.Transaction(func(tx *gorm.DB) error {
+	var created *models.Transaction
+	err := db.WithTx(ctx, s.db, func(ctx context.Context) error {
 		// Проверяем существование мероприятия
-		_, err := s.eventService.GetEventByID(ctx, eventID)
+		event, err := s.eventService.GetEventByID(ctx, eventID)
 		if err != nil {
 			return err
 		}
+		if event != nil {
+			if err := lifecycle.CheckAcceptsTransactions(event.Status); err != nil {
+				return err
+			}
+		}
 
-		// Проверяем, что плательщик существует
-		payer, err := s.userService.GetUserByInternalUserID(ctx, req.FromUser)
+		// Определяем валюту транзакции и курс пересчета в валюту мероприятия
+		var baseCurrency string
+		if event != nil {
+			baseCurrency = event.Currency
+		}
+		txCurrency, rate, err := s.resolveExchangeRate(ctx, baseCurrency, req)
 		if err != nil {
 			return err
 		}
 
-		// Создаем запись о транзакции
-		transaction := &models.Transaction{
-			EventID:               &eventID,
-			Name:                  req.Name,
-			TransactionCategoryID: req.TransactionCategoryID,
-			Datetime:              time.Now(),
-			TotalPaid:             req.Amount,
-			PayerID:               &payer.ID,
-			SplitType:             s.getSplitTypeID(req.Type),
+		// Проверяем, что плательщик существует
+		payer, err := s.userService.GetUserByInternalUserID(ctx, req.FromUser)
+		if err != nil {
+			return err
 		}
 
-		if err := s.repo.CreateTransaction(transaction); err != nil {
+		// Проверяем остальных плательщиков и их оплаты
+		if err := s.validatePayers(ctx, req); err != nil {
 			return err
 		}
 
-		// Рассчитываем доли и долги
+		// Рассчитываем доли и долги до записи в базу, чтобы не сохранять некорректную транзакцию
 		calculator, err := debt_calculator.GetCalculator(req.Type)
 		if err != nil {
 			return err
@@ -147,6 +209,29 @@ func (s *TransactionService) CreateTransaction(ctx context.Context, eventID int6
 			return err
 		}
 
+		datetime := time.Now()
+		if req.Datetime != nil {
+			datetime = *req.Datetime
+		}
+
+		// Создаем запись о транзакции
+		transaction := &models.Transaction{
+			EventID:               &eventID,
+			Name:                  req.Name,
+			TransactionCategoryID: req.TransactionCategoryID,
+			Datetime:              datetime,
+			TotalPaid:             req.Amount,
+			PayerID:               &payer.ID,
+			SplitType:             s.getSplitTypeID(req.Type),
+			Currency:              txCurrency,
+			ExchangeRate:          rate,
+		}
+
+		if err := s.repo.CreateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		created = transaction
+
 		// Преобразуем внутренние Share в модель TransactionShare
 		dbShares := make([]models.TransactionShare, len(shares))
 		for i, share := range shares {
@@ -158,32 +243,39 @@ func (s *TransactionService) CreateTransaction(ctx context.Context, eventID int6
 		}
 
 		// Сохраняем доли в базе
-		if err := s.repo.CreateTransactionShares(dbShares); err != nil {
+		if err := s.repo.CreateTransactionShares(ctx, dbShares); err != nil {
 			return err
 		}
 
-		// Преобразуем внутренние Debt в модель Debt
-		dbDebts := make([]models.Debt, len(debts))
-		for i, debt := range debts {
-			dbDebts[i] = models.Debt{
-				TransactionID: transaction.ID,
-				FromUserID:    debt.FromUserID,
-				ToUserID:      debt.ToUserID,
-				Amount:        debt.Amount,
-			}
-		}
+		// Преобразуем внутренние Debt в модель Debt в валюте мероприятия
+		dbDebts := convertDebts(transaction.ID, debts, transaction.ExchangeRate)
 
 		// Сохраняем долги в базе
-		if err := s.repo.CreateDebts(dbDebts); err != nil {
+		if err := s.repo.CreateDebts(ctx, dbDebts); err != nil {
+			return err
+		}
+
+		// Сохраняем суммы, оплаченные каждым плательщиком
+		dbPayers, err := s.createPayers(ctx, transaction.ID, req)
+		if err != nil {
+			return err
+		}
+
+		// Сохраняем позиции и надбавки чека
+		if err := s.createItems(ctx, transaction, req); err != nil {
 			return err
 		}
 
 		// Формируем ответ
-		resp, err := s.mapTransactionToDTO(transaction, dbShares, dbDebts)
+		resp, err := s.mapTransactionToDTO(transaction, dbPayers, dbShares, dbDebts)
 		if err != nil {
 			return err
 		}
 
+		if err := s.recordRevision(ctx, models.RevisionActionCreated, resp, nil); err != nil {
+			return err
+		}
+
 		result = resp
 		return nil
 	})
@@ -192,6 +284,7 @@ func (s *TransactionService) CreateTransaction(ctx context.Context, eventID int6
 		return nil, err
 	}
 
+	activity.Record(ctx, s.activities, eventID, models.ActivityTypeTransactionCreated, transactionPayload(created))
 	return result, nil
 }
 
@@ -199,12 +292,16 @@ func (s *TransactionService) CreateTransaction(ctx context.Context, eventID int6
 func (s *TransactionService) UpdateTransaction(ctx context.Context, id int, req *service.TransactionRequest) (*service.TransactionResponse, error) {
 	// Начинаем транзакцию в базе данных
 	var result *service.TransactionResponse
-	err := s.db.Transaction(func(tx *gorm.DB) error {
+	var updated *models.Transaction
+	err := db.WithTx(ctx, s.db, func(ctx context.Context) error {
 		// Получаем транзакцию
-		transaction, err := s.repo.GetTransactionByID(id)
+		transaction, err := s.repo.GetTransactionByID(ctx, id)
 		if err != nil {
 			return err
 		}
+		if err := s.requireEditableTransaction(ctx, transaction); err != nil {
+			return err
+		}
 
 		// Проверяем, что плательщик существует
 		payer, err := s.userService.GetUserByInternalUserID(ctx, req.FromUser)
@@ -212,35 +309,78 @@ func (s *TransactionService) UpdateTransaction(ctx context.Context, id int, req
 			return err
 		}
 
+		// Проверяем остальных плательщиков и их оплаты
+		if err := s.validatePayers(ctx, req); err != nil {
+			return err
+		}
+
+		// Рассчитываем новые доли и долги до изменения данных
+		calculator, err := debt_calculator.GetCalculator(req.Type)
+		if err != nil {
+			return err
+		}
+
+		eventID := *transaction.EventID
+		shares, debts, err := calculator.Calculate(req, eventID)
+		if err != nil {
+			return err
+		}
+
+		// Курс пересчитывается, только если изменилась валюта или курс указан явно,
+		// иначе сохраняется курс на момент ввода транзакции
+		txCurrency, rate := transaction.Currency, transaction.ExchangeRate
+		if req.ExchangeRate != nil || (req.Currency != "" && !strings.EqualFold(req.Currency, transaction.Currency)) {
+			event, err := s.eventService.GetEventByID(ctx, eventID)
+			if err != nil {
+				return err
+			}
+			var baseCurrency string
+			if event != nil {
+				baseCurrency = event.Currency
+			}
+			txCurrency, rate, err = s.resolveExchangeRate(ctx, baseCurrency, req)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Обновляем данные транзакции
 		transaction.Name = req.Name
 		transaction.TransactionCategoryID = req.TransactionCategoryID
 		transaction.TotalPaid = req.Amount
 		transaction.PayerID = &payer.ID
 		transaction.SplitType = s.getSplitTypeID(req.Type)
+		transaction.Currency = txCurrency
+		transaction.ExchangeRate = rate
+		// Дата меняется, только если указана явно
+		if req.Datetime != nil {
+			transaction.Datetime = *req.Datetime
+		}
 
-		if err := s.repo.UpdateTransaction(transaction); err != nil {
+		if err := s.repo.UpdateTransaction(ctx, transaction); err != nil {
 			return err
 		}
+		updated = transaction
 
 		// Удаляем старые доли и долги
-		if err := s.repo.DeleteSharesByTransactionID(id); err != nil {
+		if err := s.repo.DeleteSharesByTransactionID(ctx, id); err != nil {
 			return err
 		}
 
-		if err := s.repo.DeleteDebtsByTransactionID(id); err != nil {
+		if err := s.repo.DeleteDebtsByTransactionID(ctx, id); err != nil {
 			return err
 		}
 
-		// Рассчитываем новые доли и долги
-		calculator, err := debt_calculator.GetCalculator(req.Type)
-		if err != nil {
+		if err := s.repo.DeletePayersByTransactionID(ctx, id); err != nil {
 			return err
 		}
 
-		eventID := *transaction.EventID
-		shares, debts, err := calculator.Calculate(req, eventID)
-		if err != nil {
+		// Удаляем старые позиции и надбавки чека
+		if err := s.repo.DeleteItemsByTransactionID(ctx, id); err != nil {
+			return err
+		}
+
+		if err := s.repo.DeleteChargesByTransactionID(ctx, id); err != nil {
 			return err
 		}
 
@@ -255,32 +395,39 @@ func (s *TransactionService) UpdateTransaction(ctx context.Context, id int, req
 		}
 
 		// Сохраняем доли в базе
-		if err := s.repo.CreateTransactionShares(dbShares); err != nil {
+		if err := s.repo.CreateTransactionShares(ctx, dbShares); err != nil {
 			return err
 		}
 
-		// Преобразуем внутренние Debt в модель Debt
-		dbDebts := make([]models.Debt, len(debts))
-		for i, debt := range debts {
-			dbDebts[i] = models.Debt{
-				TransactionID: transaction.ID,
-				FromUserID:    debt.FromUserID,
-				ToUserID:      debt.ToUserID,
-				Amount:        debt.Amount,
-			}
-		}
+		// Преобразуем внутренние Debt в модель Debt в валюте мероприятия
+		dbDebts := convertDebts(transaction.ID, debts, transaction.ExchangeRate)
 
 		// Сохраняем долги в базе
-		if err := s.repo.CreateDebts(dbDebts); err != nil {
+		if err := s.repo.CreateDebts(ctx, dbDebts); err != nil {
+			return err
+		}
+
+		// Сохраняем суммы, оплаченные каждым плательщиком
+		dbPayers, err := s.createPayers(ctx, transaction.ID, req)
+		if err != nil {
+			return err
+		}
+
+		// Сохраняем позиции и надбавки чека
+		if err := s.createItems(ctx, transaction, req); err != nil {
 			return err
 		}
 
 		// Формируем ответ
-		resp, err := s.mapTransactionToDTO(transaction, dbShares, dbDebts)
+		resp, err := s.mapTransactionToDTO(transaction, dbPayers, dbShares, dbDebts)
 		if err != nil {
 			return err
 		}
 
+		if err := s.recordRevision(ctx, models.RevisionActionUpdated, resp, nil); err != nil {
+			return err
+		}
+
 		result = resp
 		return nil
 	})
@@ -289,12 +436,67 @@ func (s *TransactionService) UpdateTransaction(ctx context.Context, id int, req
 		return nil, err
 	}
 
+	if updated.EventID != nil {
+		activity.Record(ctx, s.activities, *updated.EventID, models.ActivityTypeTransactionUpdated, transactionPayload(updated))
+	}
+
+	// Вложения при изменении транзакции не меняются
+	if err := s.loadAttachments(ctx, result); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
-// DeleteTransaction удаляет транзакцию
+// DeleteTransaction переносит транзакцию в корзину
 func (s *TransactionService) DeleteTransaction(ctx context.Context, id int) error {
-	return s.repo.DeleteTransaction(id)
+	transaction, err := s.repo.GetTransactionByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.requireEditableTransaction(ctx, transaction); err != nil {
+		return err
+	}
+
+	// Снимок берется до удаления: доли и долги транзакции в корзине не меняются
+	responses, err := s.mapTransactionsToDTO(ctx, []models.Transaction{*transaction})
+	if err != nil {
+		return err
+	}
+
+	err = db.WithTx(ctx, s.db, func(ctx context.Context) error {
+		if err := s.repo.DeleteTransaction(ctx, id); err != nil {
+			return err
+		}
+		return s.recordRevision(ctx, models.RevisionActionDeleted, &responses[0], nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	if transaction.EventID != nil {
+		activity.Record(ctx, s.activities, *transaction.EventID, models.ActivityTypeTransactionDeleted, transactionPayload(transaction))
+	}
+	return nil
+}
+
+// requireEditableTransaction проверяет, что участник может вести транзакции мероприятия,
+// к которому относится транзакция, а само мероприятие принимает изменения транзакций
+func (s *TransactionService) requireEditableTransaction(ctx context.Context, transaction *models.Transaction) error {
+	if transaction.EventID == nil {
+		return nil
+	}
+	if err := access.Require(ctx, *transaction.EventID, access.EditTransactions); err != nil {
+		return err
+	}
+
+	event, err := s.eventService.GetEventByID(ctx, *transaction.EventID)
+	if err != nil {
+		return err
+	}
+	if event == nil {
+		return customErrors.NewEntityNotFoundError(strconv.FormatInt(*transaction.EventID, 10), "event")
+	}
+	return lifecycle.CheckAcceptsTransactions(event.Status)
 }
 
 // GetDebtsByEventID возвращает долги в рамках мероприятия
@@ -307,7 +509,7 @@ func (s *TransactionService) GetDebtsByEventID(ctx context.Context, eventID int6
 	var debts []service.DebtDTO
 	if userID == nil {
 		// Получаем долги
-		eventDebts, err := s.repo.GetDebtsByEventID(eventID)
+		eventDebts, err := s.repo.GetDebtsByEventID(ctx, eventID)
 		if err != nil {
 			return nil, err
 		}
@@ -341,12 +543,12 @@ func (s *TransactionService) GetDebtsByEventID(ctx context.Context, eventID int6
 		if err != nil {
 			return nil, fmt.Errorf("ошибка при получении пользователя: %w", err)
 		}
-		debtsToUser, err := s.GetDebtsByEventIDToUser(eventID, user.ID)
+		debtsToUser, err := s.GetDebtsByEventIDToUser(ctx, eventID, user.ID)
 		if err != nil {
 			return nil, fmt.Errorf("ошибка при получении долгов пользователю: %w", err)
 		}
 		debts = append(debts, debtsToUser...)
-		debtsFromUser, err := s.GetDebtsByEventIDFromUser(eventID, user.ID)
+		debtsFromUser, err := s.GetDebtsByEventIDFromUser(ctx, eventID, user.ID)
 		if err != nil {
 			return nil, fmt.Errorf("ошибка при получении долгов пользователю: %w", err)
 		}
@@ -356,8 +558,8 @@ func (s *TransactionService) GetDebtsByEventID(ctx context.Context, eventID int6
 	return debts, nil
 }
 
-func (s *TransactionService) GetDebtsByEventIDFromUser(eventID int64, userID int64) ([]service.DebtDTO, error) {
-	debtsFromUser, err := s.repo.GetDebtsByEventIDFromUser(eventID, userID)
+func (s *TransactionService) GetDebtsByEventIDFromUser(ctx context.Context, eventID int64, userID int64) ([]service.DebtDTO, error) {
+	debtsFromUser, err := s.repo.GetDebtsByEventIDFromUser(ctx, eventID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при получении долгов пользователю: %w", err)
 	}
@@ -380,8 +582,8 @@ func (s *TransactionService) GetDebtsByEventIDFromUser(eventID int64, userID int
 	return result, nil
 }
 
-func (s *TransactionService) GetDebtsByEventIDToUser(eventID int64, userID int64) ([]service.DebtDTO, error) {
-	debtsToUser, err := s.repo.GetDebtsByEventIDToUser(eventID, userID)
+func (s *TransactionService) GetDebtsByEventIDToUser(ctx context.Context, eventID int64, userID int64) ([]service.DebtDTO, error) {
+	debtsToUser, err := s.repo.GetDebtsByEventIDToUser(ctx, eventID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при получении долгов пользователю: %w", err)
 	}
@@ -414,38 +616,138 @@ func getUserName(user *models.User) string {
 	}
 }
 
-// OptimizeDebts оптимизирует долги для мероприятия и сохраняет результат
+// OptimizeDebts оптимизирует долги для мероприятия алгоритмом мероприятия и сохраняет результат
 func (s *TransactionService) OptimizeDebts(ctx context.Context, eventID int64) ([]service.OptimizedDebtDTO, error) {
+	result, err := s.OptimizeDebtsWithAlgorithm(ctx, eventID, "")
+	if err != nil {
+		return nil, err
+	}
+	return result.OptimizedDebts, nil
+}
+
+// OptimizeDebtsWithAlgorithm оптимизирует долги для мероприятия и сохраняет результат.
+// Если алгоритм не указан, используется алгоритм, выбранный для мероприятия.
+func (s *TransactionService) OptimizeDebtsWithAlgorithm(ctx context.Context, eventID int64, algorithm string) (*service.OptimizationResultDTO, error) {
+	result, err := s.optimizeDebts(ctx, eventID, algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	activity.Record(ctx, s.activities, eventID, models.ActivityTypeDebtsOptimized, models.DebtsOptimizedActivityPayload{
+		Algorithm:  result.Algorithm,
+		DebtsCount: len(result.OptimizedDebts),
+	})
+	return result, nil
+}
+
+// optimizeDebts оптимизирует долги мероприятия без записи в ленту активности
+func (s *TransactionService) optimizeDebts(ctx context.Context, eventID int64, algorithm string) (*service.OptimizationResultDTO, error) {
 	// Проверяем существование мероприятия
-	_, err := s.eventService.GetEventByID(ctx, eventID)
+	event, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event != nil && lifecycle.IsPlanFrozen(event.Status) {
+		return nil, customErrors.NewLogicError("план переводов закрытого мероприятия зафиксирован")
+	}
+	if algorithm == "" {
+		algorithm = eventAlgorithm(event)
+	}
+
+	opt, algorithm, err := optimizer.Get(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	// Если долги изменились, пока строился план, он устарел: строим его заново по свежим данным
+	for attempt := 1; ; attempt++ {
+		result, err := s.saveOptimizedDebts(ctx, eventID, opt, algorithm)
+		if errors.Is(err, repository.ErrDebtsChanged) && attempt < maxOptimizeAttempts {
+			continue
+		}
+		return result, err
+	}
+}
+
+// eventAlgorithm возвращает алгоритм оптимизации, выбранный в мероприятии
+func eventAlgorithm(event *models.Event) string {
+	if event == nil {
+		return ""
+	}
+	return event.OptimizationAlgorithm
+}
+
+// saveOptimizedDebts строит план переводов по текущим долгам мероприятия и сохраняет его
+func (s *TransactionService) saveOptimizedDebts(ctx context.Context, eventID int64, opt optimizers.Optimizer, algorithm string) (*service.OptimizationResultDTO, error) {
+	// Версию читаем до долгов: если долги изменятся после чтения, план не будет сохранен
+	version, err := s.repo.GetDebtsVersion(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.buildOptimizedDebts(ctx, eventID, opt, algorithm)
 	if err != nil {
 		return nil, err
 	}
 
+	modelsToSave := make([]models.OptimizedDebt, 0, len(result.OptimizedDebts))
+	for _, debt := range result.OptimizedDebts {
+		modelsToSave = append(modelsToSave, models.OptimizedDebt{
+			EventID:    eventID,
+			FromUserID: debt.FromUserID,
+			ToUserID:   debt.ToUserID,
+			Amount:     debt.Amount,
+			Status:     debt.Status,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		})
+	}
+
+	// Сохраняем оптимизированные долги в базе
+	if err := s.repo.SaveOptimizedDebts(ctx, eventID, version, modelsToSave); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// buildOptimizedDebts строит план переводов по текущим долгам мероприятия, не сохраняя его
+func (s *TransactionService) buildOptimizedDebts(ctx context.Context, eventID int64, opt optimizers.Optimizer, algorithm string) (*service.OptimizationResultDTO, error) {
 	// Получаем все долги мероприятия
-	debts, err := s.repo.GetDebtsByEventID(eventID)
+	debts, err := s.repo.GetDebtsByEventID(ctx, eventID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Собираем переводы для оптимизатора (From — должник, To — кредитор)
+	// Собираем переводы для оптимизатора (From — должник, To — кредитор).
+	// Суммы передаются в копейках, чтобы результат совпадал с исходными долгами до копейки
 	transfers := make([]optimizers.Transfer, 0, len(debts))
 	for _, debt := range debts {
 		transfers = append(transfers, optimizers.Transfer{
 			From:   strconv.FormatInt(debt.FromUserID, 10),
 			To:     strconv.FormatInt(debt.ToUserID, 10),
-			Amount: int(math.Round(debt.Amount)),
+			Amount: int(debt.Amount.Minor()),
 		})
 	}
 
-	optimized, err := dinic.New().Optimize(transfers)
+	// Погашение — встречный перевод: получатель денег теперь должен вернувшему их
+	settlements, err := s.settlementRepo.GetSettlementsByEventID(ctx, eventID)
 	if err != nil {
 		return nil, err
 	}
+	for _, settlement := range settlements {
+		transfers = append(transfers, optimizers.Transfer{
+			From:   strconv.FormatInt(settlement.ToUserID, 10),
+			To:     strconv.FormatInt(settlement.FromUserID, 10),
+			Amount: int(settlement.Amount.Minor()),
+		})
+	}
 
-	result := make([]service.OptimizedDebtDTO, 0, len(optimized))
-	modelsToSave := make([]models.OptimizedDebt, 0, len(optimized))
+	optimized, err := opt.Optimize(transfers)
+	if err != nil {
+		return nil, err
+	}
 
+	result := make([]service.OptimizedDebtDTO, 0, len(optimized))
 	for _, t := range optimized {
 		if t.Amount <= 0 {
 			continue
@@ -453,100 +755,130 @@ func (s *TransactionService) OptimizeDebts(ctx context.Context, eventID int64) (
 
 		fromID, _ := strconv.ParseInt(t.From, 10, 64)
 		toID, _ := strconv.ParseInt(t.To, 10, 64)
-
-		modelsToSave = append(modelsToSave, models.OptimizedDebt{
-			EventID:    eventID,
-			FromUserID: fromID,
-			ToUserID:   toID,
-			Amount:     float64(t.Amount),
-			CreatedAt:  time.Now(),
-			UpdatedAt:  time.Now(),
-		})
 		result = append(result, service.OptimizedDebtDTO{
 			FromUserID: fromID,
 			ToUserID:   toID,
-			Amount:     float64(t.Amount),
+			Amount:     money.FromMinor(int64(t.Amount)),
+			Status:     models.OptimizedDebtStatusPending,
 			EventID:    eventID,
 		})
 	}
 
-	// Сохраняем оптимизированные долги в базе
-	if err := s.repo.SaveOptimizedDebts(eventID, modelsToSave); err != nil {
-		return nil, err
+	// Статистика считается по долгам транзакций: встречные переводы погашений
+	// не являются долгами и не должны увеличивать число сокращенных переводов
+	removed := len(debts) - len(result)
+	if removed < 0 {
+		removed = 0
 	}
 
-	return result, nil
+	return &service.OptimizationResultDTO{
+		Algorithm:         algorithm,
+		OriginalTransfers: len(debts),
+		RemovedTransfers:  removed,
+		OptimizedDebts:    result,
+	}, nil
 }
 
 // GetOptimizedDebtsByEventID возвращает оптимизированные долги по ID мероприятия
 func (s *TransactionService) GetOptimizedDebtsByEventID(ctx context.Context, eventID int64, userID *int64) ([]service.OptimizedDebtDTO, error) {
 	// Проверяем существование мероприятия
-	_, err := s.eventService.GetEventByID(ctx, eventID)
+	event, err := s.eventService.GetEventByID(ctx, eventID)
 	if err != nil {
 		return nil, err
 	}
 
-	var debts []service.OptimizedDebtDTO
+	// Пересчитываем оптимизированные долги, если после последней оптимизации долги изменились
+	if err := s.refreshOptimizedDebts(ctx, event, eventID); err != nil {
+		return nil, err
+	}
+
 	if userID == nil {
-		// Получаем все оптимизированные долги мероприятия
-		optimizedDebts, err := s.repo.GetOptimizedDebtsByEventIDWithUsers(eventID)
-		if err != nil {
-			return nil, err
-		}
+		return s.getStoredOptimizedDebts(ctx, eventID)
+	}
 
-		// Если оптимизированных долгов нет, вызываем оптимизацию
-		if len(optimizedDebts) == 0 {
-			return s.OptimizeDebts(ctx, eventID)
-		}
+	user, err := s.userService.GetUserByExternalUserID(ctx, *userID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении пользователя: %w", err)
+	}
+	debts, err := s.GetOptimizedDebtsByEventIDToUser(ctx, eventID, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении оптимизированных долгов пользователю: %w", err)
+	}
+	debtsFromUser, err := s.GetOptimizedDebtsByEventIDFromUser(ctx, eventID, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении оптимизированных долгов от пользователя: %w", err)
+	}
+	return append(debts, debtsFromUser...), nil
+}
 
-		// Преобразуем в DTO
-		for _, debt := range optimizedDebts {
-			debtDTO := service.OptimizedDebtDTO{
-				ID:         debt.ID,
-				FromUserID: debt.FromUserID,
-				ToUserID:   debt.ToUserID,
-				Amount:     debt.Amount,
-				EventID:    debt.EventID,
-			}
-			if debt.FromUser != nil {
-				debtDTO.FromUser = &service.DebtsUserResponse{
-					ID:    debt.FromUser.ID,
-					Name:  getUserName(debt.FromUser),
-					Photo: debt.FromUser.PhotoUUIDCashed,
-				}
-			}
-			if debt.ToUser != nil {
-				debtDTO.ToUser = &service.DebtsUserResponse{
-					ID:    debt.ToUser.ID,
-					Name:  getUserName(debt.ToUser),
-					Photo: debt.ToUser.PhotoUUIDCashed,
-				}
-			}
-			debts = append(debts, debtDTO)
-		}
-	} else {
-		user, err := s.userService.GetUserByExternalUserID(ctx, *userID)
-		if err != nil {
-			return nil, fmt.Errorf("ошибка при получении пользователя: %w", err)
+// GetCurrentOptimizedDebts возвращает актуальный план переводов мероприятия, ничего не сохраняя:
+// устаревший план строится заново в памяти, а сохраненный план остается прежним
+func (s *TransactionService) GetCurrentOptimizedDebts(ctx context.Context, eventID int64) ([]service.OptimizedDebtDTO, error) {
+	// Проверяем существование мероприятия
+	event, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	outdated, err := s.isPlanOutdated(ctx, event, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !outdated {
+		return s.getStoredOptimizedDebts(ctx, eventID)
+	}
+
+	opt, algorithm, err := optimizer.Get(eventAlgorithm(event))
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.buildOptimizedDebts(ctx, eventID, opt, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return result.OptimizedDebts, nil
+}
+
+// getStoredOptimizedDebts возвращает сохраненный план переводов мероприятия
+func (s *TransactionService) getStoredOptimizedDebts(ctx context.Context, eventID int64) ([]service.OptimizedDebtDTO, error) {
+	optimizedDebts, err := s.repo.GetOptimizedDebtsByEventIDWithUsers(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var debts []service.OptimizedDebtDTO
+	for _, debt := range optimizedDebts {
+		debtDTO := service.OptimizedDebtDTO{
+			ID:            debt.ID,
+			FromUserID:    debt.FromUserID,
+			ToUserID:      debt.ToUserID,
+			Amount:        debt.Amount,
+			SettledAmount: debt.SettledAmount,
+			Status:        debt.Status,
+			EventID:       debt.EventID,
 		}
-		debtsToUser, err := s.GetOptimizedDebtsByEventIDToUser(eventID, user.ID)
-		if err != nil {
-			return nil, fmt.Errorf("ошибка при получении оптимизированных долгов пользователю: %w", err)
+		if debt.FromUser != nil {
+			debtDTO.FromUser = &service.DebtsUserResponse{
+				ID:    debt.FromUser.ID,
+				Name:  getUserName(debt.FromUser),
+				Photo: debt.FromUser.PhotoUUIDCashed,
+			}
 		}
-		debts = append(debts, debtsToUser...)
-		debtsFromUser, err := s.GetOptimizedDebtsByEventIDFromUser(eventID, user.ID)
-		if err != nil {
-			return nil, fmt.Errorf("ошибка при получении оптимизированных долгов от пользователя: %w", err)
+		if debt.ToUser != nil {
+			debtDTO.ToUser = &service.DebtsUserResponse{
+				ID:    debt.ToUser.ID,
+				Name:  getUserName(debt.ToUser),
+				Photo: debt.ToUser.PhotoUUIDCashed,
+			}
 		}
-		debts = append(debts, debtsFromUser...)
+		debts = append(debts, debtDTO)
 	}
-
 	return debts, nil
 }
 
 // GetOptimizedDebtsByEventIDFromUser возвращает оптимизированные долги от пользователя в мероприятии
-func (s *TransactionService) GetOptimizedDebtsByEventIDFromUser(eventID int64, userID int64) ([]service.OptimizedDebtDTO, error) {
-	optimizedDebts, err := s.repo.GetOptimizedDebtsByUserIDWithUsers(eventID, userID)
+func (s *TransactionService) GetOptimizedDebtsByEventIDFromUser(ctx context.Context, eventID int64, userID int64) ([]service.OptimizedDebtDTO, error) {
+	optimizedDebts, err := s.repo.GetOptimizedDebtsByUserIDWithUsers(ctx, eventID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при получении оптимизированных долгов от пользователя: %w", err)
 	}
@@ -555,11 +887,13 @@ func (s *TransactionService) GetOptimizedDebtsByEventIDFromUser(eventID int64, u
 	for _, debt := range optimizedDebts {
 		if debt.FromUserID == userID {
 			result = append(result, service.OptimizedDebtDTO{
-				ID:         debt.ID,
-				FromUserID: debt.FromUserID,
-				ToUserID:   debt.ToUserID,
-				Amount:     -debt.Amount,
-				EventID:    debt.EventID,
+				ID:            debt.ID,
+				FromUserID:    debt.FromUserID,
+				ToUserID:      debt.ToUserID,
+				Amount:        -debt.Amount,
+				SettledAmount: debt.SettledAmount,
+				Status:        debt.Status,
+				EventID:       debt.EventID,
 
 				Requestor: &service.DebtsUserResponse{
 					ID:    debt.ToUser.ID,
@@ -573,8 +907,8 @@ func (s *TransactionService) GetOptimizedDebtsByEventIDFromUser(eventID int64, u
 }
 
 // GetOptimizedDebtsByEventIDToUser возвращает оптимизированные долги к пользователю в мероприятии
-func (s *TransactionService) GetOptimizedDebtsByEventIDToUser(eventID int64, userID int64) ([]service.OptimizedDebtDTO, error) {
-	optimizedDebts, err := s.repo.GetOptimizedDebtsByUserIDWithUsers(eventID, userID)
+func (s *TransactionService) GetOptimizedDebtsByEventIDToUser(ctx context.Context, eventID int64, userID int64) ([]service.OptimizedDebtDTO, error) {
+	optimizedDebts, err := s.repo.GetOptimizedDebtsByUserIDWithUsers(ctx, eventID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при получении оптимизированных долгов к пользователю: %w", err)
 	}
@@ -583,11 +917,13 @@ func (s *TransactionService) GetOptimizedDebtsByEventIDToUser(eventID int64, use
 	for _, debt := range optimizedDebts {
 		if debt.ToUserID == userID {
 			result = append(result, service.OptimizedDebtDTO{
-				ID:         debt.ID,
-				FromUserID: debt.FromUserID,
-				ToUserID:   debt.ToUserID,
-				Amount:     debt.Amount,
-				EventID:    debt.EventID,
+				ID:            debt.ID,
+				FromUserID:    debt.FromUserID,
+				ToUserID:      debt.ToUserID,
+				Amount:        debt.Amount,
+				SettledAmount: debt.SettledAmount,
+				Status:        debt.Status,
+				EventID:       debt.EventID,
 
 				Requestor: &service.DebtsUserResponse{
 					ID:    debt.FromUser.ID,
@@ -603,7 +939,7 @@ func (s *TransactionService) GetOptimizedDebtsByEventIDToUser(eventID int64, use
 // GetOptimizedDebtsByUserID возвращает оптимизированные долги по ID пользователя в мероприятии
 func (s *TransactionService) GetOptimizedDebtsByUserID(ctx context.Context, eventID, userID int64) ([]service.OptimizedDebtDTO, error) {
 	// Проверяем существование мероприятия
-	_, err := s.eventService.GetEventByID(ctx, eventID)
+	event, err := s.eventService.GetEventByID(ctx, eventID)
 	if err != nil {
 		return nil, err
 	}
@@ -614,38 +950,28 @@ func (s *TransactionService) GetOptimizedDebtsByUserID(ctx context.Context, even
 		return nil, err
 	}
 
-	// Получаем оптимизированные долги
-	optimizedDebts, err := s.repo.GetOptimizedDebtsByUserIDWithUsers(eventID, userID)
-	if err != nil {
+	// Пересчитываем оптимизированные долги, если после последней оптимизации долги изменились
+	if err := s.refreshOptimizedDebts(ctx, event, eventID); err != nil {
 		return nil, err
 	}
 
-	// Если оптимизированных долгов нет, вызываем оптимизацию и затем фильтруем
-	if len(optimizedDebts) == 0 {
-		allDebts, err := s.OptimizeDebts(ctx, eventID)
-		if err != nil {
-			return nil, err
-		}
-
-		// Фильтруем долги, связанные с пользователем
-		userDebts := make([]service.OptimizedDebtDTO, 0)
-		for _, debt := range allDebts {
-			if debt.FromUserID == userID || debt.ToUserID == userID {
-				userDebts = append(userDebts, debt)
-			}
-		}
-		return userDebts, nil
+	// Получаем оптимизированные долги
+	optimizedDebts, err := s.repo.GetOptimizedDebtsByUserIDWithUsers(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Формируем ответ
 	result := make([]service.OptimizedDebtDTO, len(optimizedDebts))
 	for i, debt := range optimizedDebts {
 		debtDTO := service.OptimizedDebtDTO{
-			ID:         debt.ID,
-			FromUserID: debt.FromUserID,
-			ToUserID:   debt.ToUserID,
-			Amount:     debt.Amount,
-			EventID:    debt.EventID,
+			ID:            debt.ID,
+			FromUserID:    debt.FromUserID,
+			ToUserID:      debt.ToUserID,
+			Amount:        debt.Amount,
+			SettledAmount: debt.SettledAmount,
+			Status:        debt.Status,
+			EventID:       debt.EventID,
 		}
 		if debt.FromUser != nil {
 			debtDTO.FromUser = &service.DebtsUserResponse{
@@ -669,9 +995,143 @@ func (s *TransactionService) GetOptimizedDebtsByUserID(ctx context.Context, even
 
 // Вспомогательные методы
 
+// refreshOptimizedDebts пересчитывает оптимизированные долги мероприятия, если они устарели.
+// Признак устаревания выставляет репозиторий при любом изменении долгов мероприятия
+func (s *TransactionService) refreshOptimizedDebts(ctx context.Context, event *models.Event, eventID int64) error {
+	outdated, err := s.isPlanOutdated(ctx, event, eventID)
+	if err != nil || !outdated {
+		return err
+	}
+	// Автоматический пересчет не попадает в ленту активности
+	_, err = s.optimizeDebts(ctx, eventID, "")
+	return err
+}
+
+// isPlanOutdated проверяет, нужно ли пересчитать план переводов мероприятия
+func (s *TransactionService) isPlanOutdated(ctx context.Context, event *models.Event, eventID int64) (bool, error) {
+	// План переводов закрытого мероприятия зафиксирован и не пересчитывается
+	if event != nil && lifecycle.IsPlanFrozen(event.Status) {
+		return false, nil
+	}
+	return s.repo.IsOptimizedDebtsOutdated(ctx, eventID)
+}
+
+// transactionPayload возвращает данные транзакции для ленты активности
+func transactionPayload(transaction *models.Transaction) models.TransactionActivityPayload {
+	return models.TransactionActivityPayload{
+		TransactionID: transaction.ID,
+		Name:          transaction.Name,
+		Amount:        transaction.TotalPaid.String(),
+		Currency:      transaction.Currency,
+	}
+}
+
+// validatePayers проверяет, что оплаты покрывают сумму транзакции и все плательщики существуют
+func (s *TransactionService) validatePayers(ctx context.Context, req *service.TransactionRequest) error {
+	if _, err := debt_calculator.GetPayers(req); err != nil {
+		return err
+	}
+	for _, p := range req.Payers {
+		if p.UserID == req.FromUser {
+			continue
+		}
+		if _, err := s.userService.GetUserByInternalUserID(ctx, p.UserID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createPayers сохраняет суммы, оплаченные плательщиками транзакции
+func (s *TransactionService) createPayers(ctx context.Context, transactionID int, req *service.TransactionRequest) ([]models.TransactionPayer, error) {
+	payers, err := debt_calculator.GetPayers(req)
+	if err != nil {
+		return nil, err
+	}
+
+	dbPayers := make([]models.TransactionPayer, len(payers))
+	for i, payer := range payers {
+		dbPayers[i] = models.TransactionPayer{
+			TransactionID: transactionID,
+			UserID:        payer.UserID,
+			Amount:        payer.Amount,
+		}
+	}
+
+	if err := s.repo.CreateTransactionPayers(ctx, dbPayers); err != nil {
+		return nil, err
+	}
+	return dbPayers, nil
+}
+
+// resolveExchangeRate определяет валюту транзакции и курс ее пересчета в базовую валюту мероприятия.
+// Явно указанный в запросе курс имеет приоритет над курсом из RateProvider.
+func (s *TransactionService) resolveExchangeRate(ctx context.Context, baseCurrency string, req *service.TransactionRequest) (string, float64, error) {
+	baseCurrency, err := currency.Normalize(baseCurrency)
+	if err != nil {
+		return "", 0, err
+	}
+	txCurrency := baseCurrency
+	if req.Currency != "" {
+		txCurrency, err = currency.Normalize(req.Currency)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+
+	if txCurrency == baseCurrency {
+		return txCurrency, 1, nil
+	}
+
+	if req.ExchangeRate != nil {
+		if *req.ExchangeRate <= 0 {
+			return "", 0, customErrors.NewValidationError("exchange_rate", "курс должен быть положительным")
+		}
+		return txCurrency, *req.ExchangeRate, nil
+	}
+
+	if s.rateProvider == nil {
+		return "", 0, fmt.Errorf("не задан курс %s/%s", txCurrency, baseCurrency)
+	}
+	rate, err := s.rateProvider.GetRate(ctx, txCurrency, baseCurrency)
+	if err != nil {
+		return "", 0, fmt.Errorf("ошибка при получении курса %s/%s: %w", txCurrency, baseCurrency, err)
+	}
+	return txCurrency, rate, nil
+}
+
+// convertDebts преобразует рассчитанные долги в модели, пересчитывая суммы в валюту мероприятия.
+// Долги, которые после пересчета меньше копейки, не сохраняются.
+func convertDebts(transactionID int, debts []debt_calculator.Debt, rate float64) []models.Debt {
+	rate = exchangeRate(rate)
+	dbDebts := make([]models.Debt, 0, len(debts))
+	for _, debt := range debts {
+		amount := debt.Amount.MulFloat(rate)
+		if amount <= 0 {
+			continue
+		}
+		dbDebts = append(dbDebts, models.Debt{
+			TransactionID: transactionID,
+			FromUserID:    debt.FromUserID,
+			ToUserID:      debt.ToUserID,
+			Amount:        amount,
+		})
+	}
+	return dbDebts
+}
+
+// exchangeRate возвращает курс транзакции, считая незаданный курс равным 1
+func exchangeRate(rate float64) float64 {
+	if rate <= 0 {
+		return 1
+	}
+	return rate
+}
+
 // mapTransactionToDTO преобразует модель Transaction в DTO
 func (s *TransactionService) mapTransactionToDTO(
 	tx *models.Transaction,
+	payers []models.TransactionPayer,
 	shares []models.TransactionShare,
 	debts []models.Debt,
 ) (*service.TransactionResponse, error) {
@@ -710,6 +1170,42 @@ func (s *TransactionService) mapTransactionToDTO(
 		fromUser = *tx.PayerID
 	}
 
+	// Преобразуем плательщиков в DTO.
+	// Для транзакций без записей об оплатах вся сумма относится к основному плательщику
+	payerDTOs := make([]service.PayerDTO, 0, len(payers))
+	for _, payer := range payers {
+		payerDTOs = append(payerDTOs, service.PayerDTO{
+			UserID: payer.UserID,
+			Amount: payer.Amount,
+		})
+	}
+	if len(payerDTOs) == 0 && tx.PayerID != nil {
+		payerDTOs = append(payerDTOs, service.PayerDTO{
+			UserID: fromUser,
+			Amount: tx.TotalPaid,
+		})
+	}
+
+	// Преобразуем позиции и надбавки чека в DTO
+	var itemDTOs []service.ItemDTO
+	for _, item := range tx.Items {
+		itemDTOs = append(itemDTOs, mapItemToDTO(&item))
+	}
+	var chargeDTOs []service.ChargeDTO
+	for _, charge := range tx.Charges {
+		chargeDTOs = append(chargeDTOs, service.ChargeDTO{
+			ID:     charge.ID,
+			Name:   charge.Name,
+			Amount: charge.Amount,
+		})
+	}
+
+	// Вложения без временных ссылок: ссылки запрашиваются в ff-files только для ответов клиенту
+	var attachmentDTOs []service.AttachmentDTO
+	for _, attachment := range tx.Attachments {
+		attachmentDTOs = append(attachmentDTOs, mapAttachmentToDTO(&attachment))
+	}
+
 	return &service.TransactionResponse{
 		ID:                    tx.ID,
 		EventID:               eventID,
@@ -718,21 +1214,32 @@ func (s *TransactionService) mapTransactionToDTO(
 		Type:                  s.getSplitTypeName(tx.SplitType),
 		FromUser:              fromUser,
 		Amount:                tx.TotalPaid,
+		Currency:              tx.Currency,
+		ExchangeRate:          exchangeRate(tx.ExchangeRate),
 		Datetime:              tx.Datetime,
+		Payers:                payerDTOs,
+		Items:                 itemDTOs,
+		Charges:               chargeDTOs,
 		Debts:                 debtDTOs,
 		Shares:                shareDTOs,
+		Attachments:           attachmentDTOs,
+		DeletedAt:             tx.DeletedAt,
 	}, nil
 }
 
 // getSplitTypeID преобразует строковое представление типа распределения в числовой ID
 func (s *TransactionService) getSplitTypeID(splitType string) int {
 	switch splitType {
+	case debt_calculator.EqualType:
+		return 0
 	case debt_calculator.PercentType:
 		return 1
 	case debt_calculator.AmountType:
 		return 2
 	case debt_calculator.UnitsType:
 		return 3
+	case debt_calculator.ItemsType:
+		return 4
 	default:
 		return 0 // По умолчанию (поровну)
 	}
@@ -747,7 +1254,9 @@ func (s *TransactionService) getSplitTypeName(splitTypeID int) string {
 		return debt_calculator.AmountType
 	case 3:
 		return debt_calculator.UnitsType
+	case 4:
+		return debt_calculator.ItemsType
 	default:
-		return "equal" // По умолчанию (поровну)
+		return debt_calculator.EqualType // По умолчанию (поровну)
 	}
 }
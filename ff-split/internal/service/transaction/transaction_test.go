@@ -8,9 +8,16 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/debt_calculator"
 	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
 	serviceMock "github.com/ivasnev/FinFlow/ff-split/internal/service/mock"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -19,11 +26,12 @@ func TestTransactionService_GetTransactionByID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockEventService := serviceMock.NewMockEvent(ctrl)
 	var db *gorm.DB
 
-	transactionService := NewTransactionService(db, mockTransactionRepo, mockUserService, mockEventService)
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
 
 	ctx := context.Background()
 	transactionID := 1
@@ -35,46 +43,57 @@ func TestTransactionService_GetTransactionByID(t *testing.T) {
 			ID:        transactionID,
 			EventID:   &eventID,
 			Name:      "Test Transaction",
-			TotalPaid: 100.0,
+			TotalPaid: money.FromFloat(100),
 			PayerID:   &userID,
 			Datetime:  time.Now(),
 		}
 
 		shares := []models.TransactionShare{
-			{ID: 1, TransactionID: transactionID, UserID: userID, Value: 50.0},
+			{ID: 1, TransactionID: transactionID, UserID: userID, Value: money.FromFloat(50)},
 		}
 
 		debts := []models.Debt{
-			{ID: 1, TransactionID: transactionID, FromUserID: userID, ToUserID: 200, Amount: 50.0},
+			{ID: 1, TransactionID: transactionID, FromUserID: userID, ToUserID: 200, Amount: money.FromFloat(50)},
 		}
 
 		mockTransactionRepo.EXPECT().
-			GetTransactionByID(transactionID).
+			GetTransactionByID(gomock.Any(), transactionID).
 			Return(transaction, nil).
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetSharesByTransactionID(transactionID).
+			GetSharesByTransactionID(gomock.Any(), transactionID).
 			Return(shares, nil).
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetDebtsByTransactionID(transactionID).
+			GetPayersByTransactionID(gomock.Any(), transactionID).
+			Return([]models.TransactionPayer{}, nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			GetDebtsByTransactionID(gomock.Any(), transactionID).
 			Return(debts, nil).
 			Times(1)
 
+		mockTransactionRepo.EXPECT().
+			GetAttachmentsByTransactionID(gomock.Any(), transactionID).
+			Return([]models.TransactionAttachment{}, nil).
+			Times(1)
+
 		result, err := transactionService.GetTransactionByID(ctx, transactionID)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, transactionID, result.ID)
 		assert.Equal(t, "Test Transaction", result.Name)
+		assert.Equal(t, []service.PayerDTO{{UserID: userID, Amount: money.FromFloat(100)}}, result.Payers)
 	})
 
 	t.Run("транзакция не найдена", func(t *testing.T) {
 		expectedErr := errors.New("transaction not found")
 		mockTransactionRepo.EXPECT().
-			GetTransactionByID(transactionID).
+			GetTransactionByID(gomock.Any(), transactionID).
 			Return(nil, expectedErr).
 			Times(1)
 
@@ -91,20 +110,50 @@ func TestTransactionService_DeleteTransaction(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockEventService := serviceMock.NewMockEvent(ctrl)
-	var db *gorm.DB
 
-	transactionService := NewTransactionService(db, mockTransactionRepo, mockUserService, mockEventService)
+	// Удаление и запись ревизии выполняются в одной транзакции БД
+	testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	transactionService := NewTransactionService(testDB, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
 
 	ctx := context.Background()
 	transactionID := 1
+	eventID := int64(1)
+	transaction := &models.Transaction{ID: transactionID, EventID: &eventID}
 
 	t.Run("успешное удаление транзакции", func(t *testing.T) {
 		mockTransactionRepo.EXPECT().
-			DeleteTransaction(transactionID).
+			GetTransactionByID(gomock.Any(), transactionID).
+			Return(transaction, nil).
+			Times(1)
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
+			Times(1)
+		mockTransactionRepo.EXPECT().
+			LoadTransactionDetails(gomock.Any(), gomock.Len(1)).
 			Return(nil).
 			Times(1)
+		mockTransactionRepo.EXPECT().
+			DeleteTransaction(gomock.Any(), transactionID).
+			Return(nil).
+			Times(1)
+		mockTransactionRepo.EXPECT().
+			GetLatestRevision(gomock.Any(), transactionID).
+			Return(nil, nil).
+			Times(1)
+		mockTransactionRepo.EXPECT().
+			CreateRevision(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, revision *models.TransactionRevision) error {
+				assert.Equal(t, models.RevisionActionDeleted, revision.Action)
+				assert.Equal(t, transactionID, revision.TransactionID)
+				return nil
+			}).
+			Times(1)
 
 		err := transactionService.DeleteTransaction(ctx, transactionID)
 
@@ -114,7 +163,19 @@ func TestTransactionService_DeleteTransaction(t *testing.T) {
 	t.Run("ошибка удаления транзакции", func(t *testing.T) {
 		expectedErr := errors.New("delete error")
 		mockTransactionRepo.EXPECT().
-			DeleteTransaction(transactionID).
+			GetTransactionByID(gomock.Any(), transactionID).
+			Return(transaction, nil).
+			Times(1)
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
+			Times(1)
+		mockTransactionRepo.EXPECT().
+			LoadTransactionDetails(gomock.Any(), gomock.Len(1)).
+			Return(nil).
+			Times(1)
+		mockTransactionRepo.EXPECT().
+			DeleteTransaction(gomock.Any(), transactionID).
 			Return(expectedErr).
 			Times(1)
 
@@ -123,6 +184,35 @@ func TestTransactionService_DeleteTransaction(t *testing.T) {
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, expectedErr)
 	})
+
+	t.Run("наблюдатель не может удалить транзакцию", func(t *testing.T) {
+		viewerCtx := access.WithMember(ctx, &models.UserEvent{UserID: 100, EventID: eventID, Role: models.EventRoleViewer})
+		mockTransactionRepo.EXPECT().
+			GetTransactionByID(gomock.Any(), transactionID).
+			Return(transaction, nil).
+			Times(1)
+
+		err := transactionService.DeleteTransaction(viewerCtx, transactionID)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("транзакцию закрытого мероприятия удалить нельзя", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().
+			GetTransactionByID(gomock.Any(), transactionID).
+			Return(transaction, nil).
+			Times(1)
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusClosed}, nil).
+			Times(1)
+
+		err := transactionService.DeleteTransaction(ctx, transactionID)
+
+		var logicErr *customErrors.LogicError
+		assert.ErrorAs(t, err, &logicErr)
+	})
 }
 
 func TestTransactionService_GetDebtsByEventID(t *testing.T) {
@@ -130,28 +220,29 @@ func TestTransactionService_GetDebtsByEventID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockEventService := serviceMock.NewMockEvent(ctrl)
 	var db *gorm.DB
 
-	transactionService := NewTransactionService(db, mockTransactionRepo, mockUserService, mockEventService)
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
 
 	ctx := context.Background()
 	eventID := int64(1)
 
 	t.Run("успешное получение долгов", func(t *testing.T) {
 		debts := []models.Debt{
-			{ID: 1, TransactionID: 1, FromUserID: 100, ToUserID: 200, Amount: 50.0},
-			{ID: 2, TransactionID: 2, FromUserID: 200, ToUserID: 100, Amount: 30.0},
+			{ID: 1, TransactionID: 1, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(50)},
+			{ID: 2, TransactionID: 2, FromUserID: 200, ToUserID: 100, Amount: money.FromFloat(30)},
 		}
 
 		mockEventService.EXPECT().
 			GetEventByID(ctx, eventID).
-			Return(&models.Event{ID: eventID}, nil).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetDebtsByEventID(eventID).
+			GetDebtsByEventID(gomock.Any(), eventID).
 			Return(debts, nil).
 			Times(1)
 
@@ -167,11 +258,11 @@ func TestTransactionService_GetDebtsByEventID(t *testing.T) {
 
 		mockEventService.EXPECT().
 			GetEventByID(ctx, eventID).
-			Return(&models.Event{ID: eventID}, nil).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetDebtsByEventID(eventID).
+			GetDebtsByEventID(gomock.Any(), eventID).
 			Return(nil, expectedErr).
 			Times(1)
 
@@ -188,12 +279,14 @@ func TestTransactionService_GetDebtsByEventIDFromUser(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockEventService := serviceMock.NewMockEvent(ctrl)
 	var db *gorm.DB
 
-	transactionService := NewTransactionService(db, mockTransactionRepo, mockUserService, mockEventService)
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
 
+	ctx := context.Background()
 	eventID := int64(1)
 	userID := int64(100)
 
@@ -206,17 +299,17 @@ func TestTransactionService_GetDebtsByEventIDFromUser(t *testing.T) {
 				TransactionID: 1,
 				FromUserID:    userID,
 				ToUserID:      toUserID,
-				Amount:        50.0,
+				Amount:        money.FromFloat(50),
 				ToUser:        toUser,
 			},
 		}
 
 		mockTransactionRepo.EXPECT().
-			GetDebtsByEventIDFromUser(eventID, userID).
+			GetDebtsByEventIDFromUser(gomock.Any(), eventID, userID).
 			Return(debts, nil).
 			Times(1)
 
-		result, err := transactionService.GetDebtsByEventIDFromUser(eventID, userID)
+		result, err := transactionService.GetDebtsByEventIDFromUser(ctx, eventID, userID)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -227,11 +320,11 @@ func TestTransactionService_GetDebtsByEventIDFromUser(t *testing.T) {
 	t.Run("ошибка получения долгов", func(t *testing.T) {
 		expectedErr := errors.New("database error")
 		mockTransactionRepo.EXPECT().
-			GetDebtsByEventIDFromUser(eventID, userID).
+			GetDebtsByEventIDFromUser(gomock.Any(), eventID, userID).
 			Return(nil, expectedErr).
 			Times(1)
 
-		result, err := transactionService.GetDebtsByEventIDFromUser(eventID, userID)
+		result, err := transactionService.GetDebtsByEventIDFromUser(ctx, eventID, userID)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -244,12 +337,14 @@ func TestTransactionService_GetDebtsByEventIDToUser(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockEventService := serviceMock.NewMockEvent(ctrl)
 	var db *gorm.DB
 
-	transactionService := NewTransactionService(db, mockTransactionRepo, mockUserService, mockEventService)
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
 
+	ctx := context.Background()
 	eventID := int64(1)
 	userID := int64(200)
 
@@ -262,17 +357,17 @@ func TestTransactionService_GetDebtsByEventIDToUser(t *testing.T) {
 				TransactionID: 1,
 				FromUserID:    fromUserID,
 				ToUserID:      userID,
-				Amount:        50.0,
+				Amount:        money.FromFloat(50),
 				FromUser:      fromUser,
 			},
 		}
 
 		mockTransactionRepo.EXPECT().
-			GetDebtsByEventIDToUser(eventID, userID).
+			GetDebtsByEventIDToUser(gomock.Any(), eventID, userID).
 			Return(debts, nil).
 			Times(1)
 
-		result, err := transactionService.GetDebtsByEventIDToUser(eventID, userID)
+		result, err := transactionService.GetDebtsByEventIDToUser(ctx, eventID, userID)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -283,11 +378,11 @@ func TestTransactionService_GetDebtsByEventIDToUser(t *testing.T) {
 	t.Run("ошибка получения долгов", func(t *testing.T) {
 		expectedErr := errors.New("database error")
 		mockTransactionRepo.EXPECT().
-			GetDebtsByEventIDToUser(eventID, userID).
+			GetDebtsByEventIDToUser(gomock.Any(), eventID, userID).
 			Return(nil, expectedErr).
 			Times(1)
 
-		result, err := transactionService.GetDebtsByEventIDToUser(eventID, userID)
+		result, err := transactionService.GetDebtsByEventIDToUser(ctx, eventID, userID)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -300,11 +395,12 @@ func TestTransactionService_GetTransactionsByEventID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockEventService := serviceMock.NewMockEvent(ctrl)
 	var db *gorm.DB
 
-	transactionService := NewTransactionService(db, mockTransactionRepo, mockUserService, mockEventService)
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
 
 	ctx := context.Background()
 	eventID := int64(1)
@@ -316,7 +412,7 @@ func TestTransactionService_GetTransactionsByEventID(t *testing.T) {
 				ID:        1,
 				EventID:   &eventID,
 				Name:      "Transaction 1",
-				TotalPaid: 100.0,
+				TotalPaid: money.FromFloat(100),
 				PayerID:   &userID,
 				Datetime:  time.Now(),
 			},
@@ -324,54 +420,51 @@ func TestTransactionService_GetTransactionsByEventID(t *testing.T) {
 				ID:        2,
 				EventID:   &eventID,
 				Name:      "Transaction 2",
-				TotalPaid: 200.0,
+				TotalPaid: money.FromFloat(200),
 				PayerID:   &userID,
 				Datetime:  time.Now(),
 			},
 		}
 
 		shares1 := []models.TransactionShare{
-			{ID: 1, TransactionID: 1, UserID: userID, Value: 50.0},
+			{ID: 1, TransactionID: 1, UserID: userID, Value: money.FromFloat(50)},
+		}
+		payers1 := []models.TransactionPayer{
+			{ID: 1, TransactionID: 1, UserID: userID, Amount: money.FromFloat(100)},
 		}
 		debts1 := []models.Debt{
-			{ID: 1, TransactionID: 1, FromUserID: userID, ToUserID: 200, Amount: 50.0},
+			{ID: 1, TransactionID: 1, FromUserID: userID, ToUserID: 200, Amount: money.FromFloat(50)},
 		}
 
 		shares2 := []models.TransactionShare{
-			{ID: 2, TransactionID: 2, UserID: userID, Value: 100.0},
+			{ID: 2, TransactionID: 2, UserID: userID, Value: money.FromFloat(100)},
+		}
+		payers2 := []models.TransactionPayer{
+			{ID: 2, TransactionID: 2, UserID: userID, Amount: money.FromFloat(150)},
+			{ID: 3, TransactionID: 2, UserID: 200, Amount: money.FromFloat(50)},
 		}
 		debts2 := []models.Debt{
-			{ID: 2, TransactionID: 2, FromUserID: userID, ToUserID: 200, Amount: 100.0},
+			{ID: 2, TransactionID: 2, FromUserID: userID, ToUserID: 200, Amount: money.FromFloat(100)},
 		}
 
 		mockEventService.EXPECT().
 			GetEventByID(ctx, eventID).
-			Return(&models.Event{ID: eventID}, nil).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetTransactionsByEventID(eventID).
+			GetTransactionsByEventID(gomock.Any(), eventID).
 			Return(transactions, nil).
 			Times(1)
 
+		// Связанные данные загружаются одним пакетным вызовом для всех транзакций
 		mockTransactionRepo.EXPECT().
-			GetSharesByTransactionID(1).
-			Return(shares1, nil).
-			Times(1)
-
-		mockTransactionRepo.EXPECT().
-			GetDebtsByTransactionID(1).
-			Return(debts1, nil).
-			Times(1)
-
-		mockTransactionRepo.EXPECT().
-			GetSharesByTransactionID(2).
-			Return(shares2, nil).
-			Times(1)
-
-		mockTransactionRepo.EXPECT().
-			GetDebtsByTransactionID(2).
-			Return(debts2, nil).
+			LoadTransactionDetails(gomock.Any(), gomock.Len(2)).
+			DoAndReturn(func(_ context.Context, txs []models.Transaction) error {
+				txs[0].Shares, txs[0].Payers, txs[0].Debts = shares1, payers1, debts1
+				txs[1].Shares, txs[1].Payers, txs[1].Debts = shares2, payers2, debts2
+				return nil
+			}).
 			Times(1)
 
 		result, err := transactionService.GetTransactionsByEventID(ctx, eventID)
@@ -381,6 +474,9 @@ func TestTransactionService_GetTransactionsByEventID(t *testing.T) {
 		assert.Equal(t, 2, len(result))
 		assert.Equal(t, "Transaction 1", result[0].Name)
 		assert.Equal(t, "Transaction 2", result[1].Name)
+		assert.Len(t, result[1].Payers, 2)
+		assert.Len(t, result[1].Debts, 1)
+		assert.Len(t, result[0].Shares, 1)
 	})
 
 	t.Run("мероприятие не найдено", func(t *testing.T) {
@@ -403,11 +499,11 @@ func TestTransactionService_GetTransactionsByEventID(t *testing.T) {
 
 		mockEventService.EXPECT().
 			GetEventByID(ctx, eventID).
-			Return(&models.Event{ID: eventID}, nil).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetTransactionsByEventID(eventID).
+			GetTransactionsByEventID(gomock.Any(), eventID).
 			Return(nil, expectedErr).
 			Times(1)
 
@@ -424,34 +520,45 @@ func TestTransactionService_OptimizeDebts(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockEventService := serviceMock.NewMockEvent(ctrl)
 	var db *gorm.DB
 
-	transactionService := NewTransactionService(db, mockTransactionRepo, mockUserService, mockEventService)
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
 
 	ctx := context.Background()
 	eventID := int64(1)
 
 	t.Run("успешная оптимизация долгов", func(t *testing.T) {
 		debts := []models.Debt{
-			{ID: 1, TransactionID: 1, FromUserID: 100, ToUserID: 200, Amount: 50.0},
-			{ID: 2, TransactionID: 2, FromUserID: 200, ToUserID: 100, Amount: 30.0},
+			{ID: 1, TransactionID: 1, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(50)},
+			{ID: 2, TransactionID: 2, FromUserID: 200, ToUserID: 100, Amount: money.FromFloat(30)},
 		}
 
 		mockEventService.EXPECT().
 			GetEventByID(ctx, eventID).
-			Return(&models.Event{ID: eventID}, nil).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetDebtsByEventID(eventID).
+			GetDebtsByEventID(gomock.Any(), eventID).
 			Return(debts, nil).
 			Times(1)
 
+		mockSettlementRepo.EXPECT().
+			GetSettlementsByEventID(gomock.Any(), eventID).
+			Return([]models.Settlement{}, nil).
+			Times(1)
+
 		mockTransactionRepo.EXPECT().
-			SaveOptimizedDebts(eventID, gomock.Any()).
-			DoAndReturn(func(eID int64, optimizedDebts []models.OptimizedDebt) error {
+			GetDebtsVersion(gomock.Any(), eventID).
+			Return(int64(3), nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			SaveOptimizedDebts(gomock.Any(), eventID, int64(3), gomock.Any()).
+			DoAndReturn(func(_ context.Context, eID int64, version int64, optimizedDebts []models.OptimizedDebt) error {
 				// Проверяем, что оптимизированные долги созданы
 				assert.Greater(t, len(optimizedDebts), 0)
 				return nil
@@ -466,6 +573,52 @@ func TestTransactionService_OptimizeDebts(t *testing.T) {
 		assert.GreaterOrEqual(t, len(result), 0)
 	})
 
+	t.Run("оптимизация сохраняет копейки", func(t *testing.T) {
+		debts := []models.Debt{
+			{ID: 1, TransactionID: 1, FromUserID: 100, ToUserID: 200, Amount: money.FromMinor(10050)},
+			{ID: 2, TransactionID: 2, FromUserID: 200, ToUserID: 300, Amount: money.FromMinor(3333)},
+		}
+
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			GetDebtsByEventID(gomock.Any(), eventID).
+			Return(debts, nil).
+			Times(1)
+
+		mockSettlementRepo.EXPECT().
+			GetSettlementsByEventID(gomock.Any(), eventID).
+			Return([]models.Settlement{}, nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			GetDebtsVersion(gomock.Any(), eventID).
+			Return(int64(3), nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			SaveOptimizedDebts(gomock.Any(), eventID, int64(3), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		result, err := transactionService.OptimizeDebts(ctx, eventID)
+
+		assert.NoError(t, err)
+
+		// Балансы после оптимизации должны совпадать с исходными до копейки
+		balances := make(map[int64]money.Money)
+		for _, debt := range result {
+			balances[debt.FromUserID] -= debt.Amount
+			balances[debt.ToUserID] += debt.Amount
+		}
+		assert.Equal(t, money.FromMinor(-10050), balances[100])
+		assert.Equal(t, money.FromMinor(10050-3333), balances[200])
+		assert.Equal(t, money.FromMinor(3333), balances[300])
+	})
+
 	t.Run("мероприятие не найдено", func(t *testing.T) {
 		expectedErr := errors.New("event not found")
 
@@ -486,11 +639,16 @@ func TestTransactionService_OptimizeDebts(t *testing.T) {
 
 		mockEventService.EXPECT().
 			GetEventByID(ctx, eventID).
-			Return(&models.Event{ID: eventID}, nil).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			GetDebtsVersion(gomock.Any(), eventID).
+			Return(int64(3), nil).
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetDebtsByEventID(eventID).
+			GetDebtsByEventID(gomock.Any(), eventID).
 			Return(nil, expectedErr).
 			Times(1)
 
@@ -507,28 +665,34 @@ func TestTransactionService_GetOptimizedDebtsByEventID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockEventService := serviceMock.NewMockEvent(ctrl)
 	var db *gorm.DB
 
-	transactionService := NewTransactionService(db, mockTransactionRepo, mockUserService, mockEventService)
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
 
 	ctx := context.Background()
 	eventID := int64(1)
 
 	t.Run("успешное получение оптимизированных долгов", func(t *testing.T) {
 		optimizedDebts := []models.OptimizedDebt{
-			{ID: 1, EventID: eventID, FromUserID: 100, ToUserID: 200, Amount: 20.0},
-			{ID: 2, EventID: eventID, FromUserID: 200, ToUserID: 300, Amount: 50.0},
+			{ID: 1, EventID: eventID, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(20)},
+			{ID: 2, EventID: eventID, FromUserID: 200, ToUserID: 300, Amount: money.FromFloat(50)},
 		}
 
 		mockEventService.EXPECT().
 			GetEventByID(ctx, eventID).
-			Return(&models.Event{ID: eventID}, nil).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetOptimizedDebtsByEventIDWithUsers(eventID).
+			IsOptimizedDebtsOutdated(gomock.Any(), eventID).
+			Return(false, nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			GetOptimizedDebtsByEventIDWithUsers(gomock.Any(), eventID).
 			Return(optimizedDebts, nil).
 			Times(1)
 
@@ -553,14 +717,19 @@ func TestTransactionService_GetOptimizedDebtsByEventID(t *testing.T) {
 				EventID:   eventID,
 				FromUserID: internalUserID,
 				ToUserID:   toUserID,
-				Amount:    20.0,
+				Amount:    money.FromFloat(20),
 				ToUser:    toUser,
 			},
 		}
 
 		mockEventService.EXPECT().
 			GetEventByID(ctx, eventID).
-			Return(&models.Event{ID: eventID}, nil).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			IsOptimizedDebtsOutdated(gomock.Any(), eventID).
+			Return(false, nil).
 			Times(1)
 
 		mockUserService.EXPECT().
@@ -569,7 +738,7 @@ func TestTransactionService_GetOptimizedDebtsByEventID(t *testing.T) {
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetOptimizedDebtsByUserIDWithUsers(eventID, internalUserID).
+			GetOptimizedDebtsByUserIDWithUsers(gomock.Any(), eventID, internalUserID).
 			Return(optimizedDebtsFromUser, nil).
 			Times(2) // Вызывается дважды - для ToUser и FromUser
 
@@ -580,6 +749,57 @@ func TestTransactionService_GetOptimizedDebtsByEventID(t *testing.T) {
 		assert.GreaterOrEqual(t, len(result), 1)
 	})
 
+	t.Run("устаревшие долги пересчитываются перед чтением", func(t *testing.T) {
+		debts := []models.Debt{
+			{ID: 1, TransactionID: 1, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(30)},
+		}
+		saved := []models.OptimizedDebt{
+			{ID: 3, EventID: eventID, FromUserID: 100, ToUserID: 200, Amount: money.FromFloat(30), Status: models.OptimizedDebtStatusPending},
+		}
+
+		mockEventService.EXPECT().
+			GetEventByID(ctx, eventID).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
+			Times(2)
+
+		mockTransactionRepo.EXPECT().
+			IsOptimizedDebtsOutdated(gomock.Any(), eventID).
+			Return(true, nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			GetDebtsByEventID(gomock.Any(), eventID).
+			Return(debts, nil).
+			Times(1)
+
+		mockSettlementRepo.EXPECT().
+			GetSettlementsByEventID(gomock.Any(), eventID).
+			Return([]models.Settlement{}, nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			GetDebtsVersion(gomock.Any(), eventID).
+			Return(int64(3), nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			SaveOptimizedDebts(gomock.Any(), eventID, int64(3), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			GetOptimizedDebtsByEventIDWithUsers(gomock.Any(), eventID).
+			Return(saved, nil).
+			Times(1)
+
+		result, err := transactionService.GetOptimizedDebtsByEventID(ctx, eventID, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(result))
+		assert.Equal(t, 3, result[0].ID)
+		assert.Equal(t, money.FromFloat(30), result[0].Amount)
+	})
+
 	t.Run("мероприятие не найдено", func(t *testing.T) {
 		expectedErr := errors.New("event not found")
 
@@ -601,12 +821,14 @@ func TestTransactionService_GetOptimizedDebtsByEventIDFromUser(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockEventService := serviceMock.NewMockEvent(ctrl)
 	var db *gorm.DB
 
-	transactionService := NewTransactionService(db, mockTransactionRepo, mockUserService, mockEventService)
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
 
+	ctx := context.Background()
 	eventID := int64(1)
 	userID := int64(100)
 
@@ -618,17 +840,17 @@ func TestTransactionService_GetOptimizedDebtsByEventIDFromUser(t *testing.T) {
 				EventID:   eventID,
 				FromUserID: userID,
 				ToUserID:   toUserID,
-				Amount:    20.0,
+				Amount:    money.FromFloat(20),
 				ToUser:    &models.User{ID: 1, UserID: &toUserID},
 			},
 		}
 
 		mockTransactionRepo.EXPECT().
-			GetOptimizedDebtsByUserIDWithUsers(eventID, userID).
+			GetOptimizedDebtsByUserIDWithUsers(gomock.Any(), eventID, userID).
 			Return(optimizedDebts, nil).
 			Times(1)
 
-		result, err := transactionService.GetOptimizedDebtsByEventIDFromUser(eventID, userID)
+		result, err := transactionService.GetOptimizedDebtsByEventIDFromUser(ctx, eventID, userID)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -640,11 +862,11 @@ func TestTransactionService_GetOptimizedDebtsByEventIDFromUser(t *testing.T) {
 		expectedErr := errors.New("database error")
 
 		mockTransactionRepo.EXPECT().
-			GetOptimizedDebtsByUserIDWithUsers(eventID, userID).
+			GetOptimizedDebtsByUserIDWithUsers(gomock.Any(), eventID, userID).
 			Return(nil, expectedErr).
 			Times(1)
 
-		result, err := transactionService.GetOptimizedDebtsByEventIDFromUser(eventID, userID)
+		result, err := transactionService.GetOptimizedDebtsByEventIDFromUser(ctx, eventID, userID)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -657,12 +879,14 @@ func TestTransactionService_GetOptimizedDebtsByEventIDToUser(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockEventService := serviceMock.NewMockEvent(ctrl)
 	var db *gorm.DB
 
-	transactionService := NewTransactionService(db, mockTransactionRepo, mockUserService, mockEventService)
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
 
+	ctx := context.Background()
 	eventID := int64(1)
 	userID := int64(200)
 
@@ -674,17 +898,17 @@ func TestTransactionService_GetOptimizedDebtsByEventIDToUser(t *testing.T) {
 				EventID:   eventID,
 				FromUserID: fromUserID,
 				ToUserID:   userID,
-				Amount:    20.0,
+				Amount:    money.FromFloat(20),
 				FromUser:  &models.User{ID: 1, UserID: &fromUserID},
 			},
 		}
 
 		mockTransactionRepo.EXPECT().
-			GetOptimizedDebtsByUserIDWithUsers(eventID, userID).
+			GetOptimizedDebtsByUserIDWithUsers(gomock.Any(), eventID, userID).
 			Return(optimizedDebts, nil).
 			Times(1)
 
-		result, err := transactionService.GetOptimizedDebtsByEventIDToUser(eventID, userID)
+		result, err := transactionService.GetOptimizedDebtsByEventIDToUser(ctx, eventID, userID)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -696,11 +920,11 @@ func TestTransactionService_GetOptimizedDebtsByEventIDToUser(t *testing.T) {
 		expectedErr := errors.New("database error")
 
 		mockTransactionRepo.EXPECT().
-			GetOptimizedDebtsByUserIDWithUsers(eventID, userID).
+			GetOptimizedDebtsByUserIDWithUsers(gomock.Any(), eventID, userID).
 			Return(nil, expectedErr).
 			Times(1)
 
-		result, err := transactionService.GetOptimizedDebtsByEventIDToUser(eventID, userID)
+		result, err := transactionService.GetOptimizedDebtsByEventIDToUser(ctx, eventID, userID)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -713,11 +937,12 @@ func TestTransactionService_GetOptimizedDebtsByUserID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+	mockSettlementRepo := repositoryMock.NewMockSettlement(ctrl)
 	mockUserService := serviceMock.NewMockUser(ctrl)
 	mockEventService := serviceMock.NewMockEvent(ctrl)
 	var db *gorm.DB
 
-	transactionService := NewTransactionService(db, mockTransactionRepo, mockUserService, mockEventService)
+	transactionService := NewTransactionService(db, mockTransactionRepo, mockSettlementRepo, mockUserService, mockEventService, nil, nil, nil)
 
 	ctx := context.Background()
 	eventID := int64(1)
@@ -725,13 +950,13 @@ func TestTransactionService_GetOptimizedDebtsByUserID(t *testing.T) {
 
 	t.Run("успешное получение оптимизированных долгов по пользователю", func(t *testing.T) {
 		optimizedDebts := []models.OptimizedDebt{
-			{ID: 1, EventID: eventID, FromUserID: userID, ToUserID: 200, Amount: 20.0},
-			{ID: 2, EventID: eventID, FromUserID: 300, ToUserID: userID, Amount: 50.0},
+			{ID: 1, EventID: eventID, FromUserID: userID, ToUserID: 200, Amount: money.FromFloat(20)},
+			{ID: 2, EventID: eventID, FromUserID: 300, ToUserID: userID, Amount: money.FromFloat(50)},
 		}
 
 		mockEventService.EXPECT().
 			GetEventByID(ctx, eventID).
-			Return(&models.Event{ID: eventID}, nil).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
 			Times(1)
 
 		mockUserService.EXPECT().
@@ -740,7 +965,12 @@ func TestTransactionService_GetOptimizedDebtsByUserID(t *testing.T) {
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetOptimizedDebtsByUserIDWithUsers(eventID, userID).
+			IsOptimizedDebtsOutdated(gomock.Any(), eventID).
+			Return(false, nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			GetOptimizedDebtsByUserIDWithUsers(gomock.Any(), eventID, userID).
 			Return(optimizedDebts, nil).
 			Times(1)
 
@@ -756,7 +986,7 @@ func TestTransactionService_GetOptimizedDebtsByUserID(t *testing.T) {
 
 		mockEventService.EXPECT().
 			GetEventByID(ctx, eventID).
-			Return(&models.Event{ID: eventID}, nil).
+			Return(&models.Event{ID: eventID, Status: models.EventStatusActive}, nil).
 			Times(1)
 
 		mockUserService.EXPECT().
@@ -765,7 +995,12 @@ func TestTransactionService_GetOptimizedDebtsByUserID(t *testing.T) {
 			Times(1)
 
 		mockTransactionRepo.EXPECT().
-			GetOptimizedDebtsByUserIDWithUsers(eventID, userID).
+			IsOptimizedDebtsOutdated(gomock.Any(), eventID).
+			Return(false, nil).
+			Times(1)
+
+		mockTransactionRepo.EXPECT().
+			GetOptimizedDebtsByUserIDWithUsers(gomock.Any(), eventID, userID).
 			Return(nil, expectedErr).
 			Times(1)
 
@@ -777,3 +1012,22 @@ func TestTransactionService_GetOptimizedDebtsByUserID(t *testing.T) {
 	})
 }
 
+
+func TestTransactionService_SplitType(t *testing.T) {
+	transactionService := NewTransactionService(nil, nil, nil, nil, nil, nil, nil, nil)
+
+	for _, splitType := range []string{
+		debt_calculator.EqualType,
+		debt_calculator.PercentType,
+		debt_calculator.AmountType,
+		debt_calculator.UnitsType,
+	} {
+		id := transactionService.getSplitTypeID(splitType)
+		assert.Equal(t, splitType, transactionService.getSplitTypeName(id))
+
+		_, err := debt_calculator.GetCalculator(transactionService.getSplitTypeName(id))
+		assert.NoError(t, err, splitType)
+	}
+
+	assert.Equal(t, 0, transactionService.getSplitTypeID(debt_calculator.EqualType))
+}
@@ -0,0 +1,75 @@
+package transaction
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/db"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/activity"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/lifecycle"
+)
+
+// GetDeletedTransactions возвращает корзину мероприятия: удаленные транзакции, начиная с удаленных последними
+func (s *TransactionService) GetDeletedTransactions(ctx context.Context, eventID int64) ([]service.TransactionResponse, error) {
+	event, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, customErrors.NewEntityNotFoundError(strconv.FormatInt(eventID, 10), "event")
+	}
+
+	transactions, err := s.repo.GetDeletedTransactionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.mapTransactionsToDTO(ctx, transactions)
+}
+
+// RestoreTransaction возвращает транзакцию из корзины мероприятия вместе с ее долями и долгами
+func (s *TransactionService) RestoreTransaction(ctx context.Context, eventID int64, id int) (*service.TransactionResponse, error) {
+	if err := access.Require(ctx, eventID, access.EditTransactions); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, customErrors.NewEntityNotFoundError(strconv.FormatInt(eventID, 10), "event")
+	}
+	if err := lifecycle.CheckAcceptsTransactions(event.Status); err != nil {
+		return nil, err
+	}
+
+	var transaction *models.Transaction
+	var responses []service.TransactionResponse
+	err = db.WithTx(ctx, s.db, func(ctx context.Context) error {
+		if err := s.repo.RestoreTransaction(ctx, eventID, id); err != nil {
+			return err
+		}
+
+		var err error
+		transaction, err = s.repo.GetTransactionByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		responses, err = s.mapTransactionsToDTO(ctx, []models.Transaction{*transaction})
+		if err != nil {
+			return err
+		}
+		return s.recordRevision(ctx, models.RevisionActionRestored, &responses[0], nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	activity.Record(ctx, s.activities, eventID, models.ActivityTypeTransactionRestored, transactionPayload(transaction))
+	return &responses[0], nil
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// TrashPurgeResult - число окончательно удаленных записей при очистке корзины
+type TrashPurgeResult struct {
+	Transactions int64
+	Events       int
+	Files        int // Удаленные из ff-files вложения транзакций
+}
+
+// Trash определяет методы очистки корзины
+type Trash interface {
+	// PurgeExpired окончательно удаляет транзакции и мероприятия, пролежавшие в корзине дольше срока хранения
+	PurgeExpired(ctx context.Context, now time.Time) (*TrashPurgeResult, error)
+}
@@ -0,0 +1,104 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/adapters"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// defaultRetention - срок хранения удаленных записей, если в конфигурации он не задан
+const defaultRetention = 30 * 24 * time.Hour
+
+// TrashService реализует интерфейс service.Trash
+type TrashService struct {
+	transactions repository.Transaction
+	events       repository.Event
+	files        adapters.FilesAdapter
+	retention    time.Duration
+}
+
+// NewTrashService создает сервис очистки корзины со сроком хранения retention
+func NewTrashService(transactions repository.Transaction, events repository.Event, files adapters.FilesAdapter, retention time.Duration) *TrashService {
+	if retention <= 0 {
+		retention = defaultRetention
+	}
+	return &TrashService{
+		transactions: transactions,
+		events:       events,
+		files:        files,
+		retention:    retention,
+	}
+}
+
+// PurgeExpired окончательно удаляет записи, перенесенные в корзину раньше now минус срок хранения.
+// Мероприятия очищаются первыми: вместе с ними удаляются все их транзакции.
+// Файлы вложений удаляются из ff-files только после очистки БД и только для тех вложений,
+// записи о которых действительно удалены: при сбое очистки файлы остаются для следующего запуска.
+func (s *TrashService) PurgeExpired(ctx context.Context, now time.Time) (*service.TrashPurgeResult, error) {
+	before := now.Add(-s.retention)
+	result := &service.TrashPurgeResult{}
+
+	// Вложения собираются заранее, пока записи о них еще есть в БД
+	attachments, err := s.transactions.GetExpiredAttachments(ctx, before)
+	if err != nil {
+		return result, fmt.Errorf("ошибка при получении вложений: %w", err)
+	}
+
+	var errs []error
+	events, err := s.events.PurgeDeleted(ctx, before)
+	result.Events = events
+	if err != nil {
+		errs = append(errs, fmt.Errorf("ошибка при очистке мероприятий: %w", err))
+	}
+
+	transactions, err := s.transactions.PurgeDeletedTransactions(ctx, before)
+	result.Transactions = transactions
+	if err != nil {
+		errs = append(errs, fmt.Errorf("ошибка при очистке транзакций: %w", err))
+	}
+
+	if len(attachments) > 0 {
+		files, err := s.purgeAttachments(ctx, before, attachments)
+		result.Files = files
+		if err != nil {
+			errs = append(errs, fmt.Errorf("ошибка при удалении вложений: %w", err))
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// purgeAttachments удаляет из ff-files файлы вложений, записи о которых очищены из БД.
+// Вложения, оставшиеся в БД после неудачной очистки, пропускаются.
+// Уже удаленные файлы тоже пропускаются, поэтому после сбоя очистку можно повторить.
+func (s *TrashService) purgeAttachments(ctx context.Context, before time.Time, attachments []models.TransactionAttachment) (int, error) {
+	remaining, err := s.transactions.GetExpiredAttachments(ctx, before)
+	if err != nil {
+		return 0, err
+	}
+	kept := make(map[int]struct{}, len(remaining))
+	for _, attachment := range remaining {
+		kept[attachment.ID] = struct{}{}
+	}
+
+	deleted := 0
+	var errs []error
+	for _, attachment := range attachments {
+		if _, ok := kept[attachment.ID]; ok {
+			continue
+		}
+		err := s.files.DeleteFile(ctx, attachment.ObjectID)
+		if err != nil && !errors.Is(err, adapters.ErrFileNotFound) {
+			errs = append(errs, fmt.Errorf("файл %s: %w", attachment.ObjectID, err))
+			continue
+		}
+		deleted++
+	}
+	return deleted, errors.Join(errs...)
+}
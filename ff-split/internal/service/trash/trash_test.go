@@ -0,0 +1,186 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/adapters"
+	adaptersMock "github.com/ivasnev/FinFlow/ff-split/internal/adapters/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrashService_PurgeExpired(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	retention := 7 * 24 * time.Hour
+	before := now.Add(-retention)
+
+	t.Run("очищает мероприятия и транзакции старше срока хранения", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+		mockEventRepo := repositoryMock.NewMockEvent(ctrl)
+		mockFiles := adaptersMock.NewMockFilesAdapter(ctrl)
+		svc := NewTrashService(mockTransactionRepo, mockEventRepo, mockFiles, retention)
+
+		gomock.InOrder(
+			mockTransactionRepo.EXPECT().GetExpiredAttachments(gomock.Any(), before).Return([]models.TransactionAttachment{}, nil),
+			mockEventRepo.EXPECT().PurgeDeleted(gomock.Any(), before).Return(2, nil),
+			mockTransactionRepo.EXPECT().PurgeDeletedTransactions(gomock.Any(), before).Return(int64(5), nil),
+		)
+
+		result, err := svc.PurgeExpired(context.Background(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Events)
+		assert.Equal(t, int64(5), result.Transactions)
+	})
+
+	t.Run("ошибка очистки мероприятий не мешает очистке транзакций", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+		mockEventRepo := repositoryMock.NewMockEvent(ctrl)
+		mockFiles := adaptersMock.NewMockFilesAdapter(ctrl)
+		svc := NewTrashService(mockTransactionRepo, mockEventRepo, mockFiles, retention)
+
+		dbErr := errors.New("db error")
+		mockTransactionRepo.EXPECT().GetExpiredAttachments(gomock.Any(), before).Return(nil, nil)
+		mockEventRepo.EXPECT().PurgeDeleted(gomock.Any(), before).Return(0, dbErr)
+		mockTransactionRepo.EXPECT().PurgeDeletedTransactions(gomock.Any(), before).Return(int64(3), nil)
+
+		result, err := svc.PurgeExpired(context.Background(), now)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+		assert.Equal(t, int64(3), result.Transactions)
+	})
+
+	t.Run("без срока хранения используется срок по умолчанию", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+		mockEventRepo := repositoryMock.NewMockEvent(ctrl)
+		mockFiles := adaptersMock.NewMockFilesAdapter(ctrl)
+		svc := NewTrashService(mockTransactionRepo, mockEventRepo, mockFiles, 0)
+
+		mockTransactionRepo.EXPECT().GetExpiredAttachments(gomock.Any(), now.Add(-defaultRetention)).Return(nil, nil)
+		mockEventRepo.EXPECT().PurgeDeleted(gomock.Any(), now.Add(-defaultRetention)).Return(0, nil)
+		mockTransactionRepo.EXPECT().PurgeDeletedTransactions(gomock.Any(), now.Add(-defaultRetention)).Return(int64(0), nil)
+
+		_, err := svc.PurgeExpired(context.Background(), now)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("удаляет файлы вложений из ff-files после очистки транзакций", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+		mockEventRepo := repositoryMock.NewMockEvent(ctrl)
+		mockFiles := adaptersMock.NewMockFilesAdapter(ctrl)
+		svc := NewTrashService(mockTransactionRepo, mockEventRepo, mockFiles, retention)
+
+		gomock.InOrder(
+			mockTransactionRepo.EXPECT().GetExpiredAttachments(gomock.Any(), before).Return([]models.TransactionAttachment{
+				{ID: 1, TransactionID: 10, ObjectID: "3f2b8c1e-0d4a-4c5e-9a7b-1c2d3e4f5a6b"},
+				{ID: 2, TransactionID: 11, ObjectID: "7a6b5c4d-3e2f-4a1b-8c9d-0e1f2a3b4c5d"},
+			}, nil),
+			mockEventRepo.EXPECT().PurgeDeleted(gomock.Any(), before).Return(0, nil),
+			mockTransactionRepo.EXPECT().PurgeDeletedTransactions(gomock.Any(), before).Return(int64(2), nil),
+			mockTransactionRepo.EXPECT().GetExpiredAttachments(gomock.Any(), before).Return([]models.TransactionAttachment{}, nil),
+			mockFiles.EXPECT().DeleteFile(gomock.Any(), "3f2b8c1e-0d4a-4c5e-9a7b-1c2d3e4f5a6b").Return(nil),
+			// Уже удаленный файл не считается ошибкой
+			mockFiles.EXPECT().DeleteFile(gomock.Any(), "7a6b5c4d-3e2f-4a1b-8c9d-0e1f2a3b4c5d").Return(adapters.ErrFileNotFound),
+		)
+
+		result, err := svc.PurgeExpired(context.Background(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Files)
+		assert.Equal(t, int64(2), result.Transactions)
+	})
+
+	t.Run("ошибка ff-files не отменяет очистку корзины", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+		mockEventRepo := repositoryMock.NewMockEvent(ctrl)
+		mockFiles := adaptersMock.NewMockFilesAdapter(ctrl)
+		svc := NewTrashService(mockTransactionRepo, mockEventRepo, mockFiles, retention)
+
+		filesErr := errors.New("ff-files unavailable")
+		attachments := []models.TransactionAttachment{
+			{ID: 1, TransactionID: 10, ObjectID: "3f2b8c1e-0d4a-4c5e-9a7b-1c2d3e4f5a6b"},
+		}
+		gomock.InOrder(
+			mockTransactionRepo.EXPECT().GetExpiredAttachments(gomock.Any(), before).Return(attachments, nil),
+			mockEventRepo.EXPECT().PurgeDeleted(gomock.Any(), before).Return(0, nil),
+			mockTransactionRepo.EXPECT().PurgeDeletedTransactions(gomock.Any(), before).Return(int64(1), nil),
+			mockTransactionRepo.EXPECT().GetExpiredAttachments(gomock.Any(), before).Return(nil, nil),
+			mockFiles.EXPECT().DeleteFile(gomock.Any(), "3f2b8c1e-0d4a-4c5e-9a7b-1c2d3e4f5a6b").Return(filesErr),
+		)
+
+		result, err := svc.PurgeExpired(context.Background(), now)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, filesErr)
+		assert.Equal(t, 0, result.Files)
+		assert.Equal(t, int64(1), result.Transactions)
+	})
+
+	t.Run("файлы не удаляются, если записи о вложениях остались в БД", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+		mockEventRepo := repositoryMock.NewMockEvent(ctrl)
+		mockFiles := adaptersMock.NewMockFilesAdapter(ctrl)
+		svc := NewTrashService(mockTransactionRepo, mockEventRepo, mockFiles, retention)
+
+		dbErr := errors.New("db error")
+		purged := models.TransactionAttachment{ID: 1, TransactionID: 10, ObjectID: "3f2b8c1e-0d4a-4c5e-9a7b-1c2d3e4f5a6b"}
+		kept := models.TransactionAttachment{ID: 2, TransactionID: 11, ObjectID: "7a6b5c4d-3e2f-4a1b-8c9d-0e1f2a3b4c5d"}
+		gomock.InOrder(
+			mockTransactionRepo.EXPECT().GetExpiredAttachments(gomock.Any(), before).Return([]models.TransactionAttachment{purged, kept}, nil),
+			mockEventRepo.EXPECT().PurgeDeleted(gomock.Any(), before).Return(1, nil),
+			mockTransactionRepo.EXPECT().PurgeDeletedTransactions(gomock.Any(), before).Return(int64(0), dbErr),
+			mockTransactionRepo.EXPECT().GetExpiredAttachments(gomock.Any(), before).Return([]models.TransactionAttachment{kept}, nil),
+			mockFiles.EXPECT().DeleteFile(gomock.Any(), purged.ObjectID).Return(nil),
+		)
+
+		result, err := svc.PurgeExpired(context.Background(), now)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+		assert.Equal(t, 1, result.Files)
+	})
+
+	t.Run("без списка вложений очистка не выполняется", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockTransactionRepo := repositoryMock.NewMockTransaction(ctrl)
+		mockEventRepo := repositoryMock.NewMockEvent(ctrl)
+		mockFiles := adaptersMock.NewMockFilesAdapter(ctrl)
+		svc := NewTrashService(mockTransactionRepo, mockEventRepo, mockFiles, retention)
+
+		dbErr := errors.New("db error")
+		mockTransactionRepo.EXPECT().GetExpiredAttachments(gomock.Any(), before).Return(nil, dbErr)
+
+		_, err := svc.PurgeExpired(context.Background(), now)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
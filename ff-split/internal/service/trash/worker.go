@@ -0,0 +1,56 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+)
+
+// defaultWorkerInterval - период очистки, если в конфигурации он не задан
+const defaultWorkerInterval = time.Hour
+
+// Worker периодически очищает корзину от записей с истекшим сроком хранения
+type Worker struct {
+	service  service.Trash
+	interval time.Duration
+}
+
+// NewWorker создает обработчик, очищающий корзину раз в interval
+func NewWorker(service service.Trash, interval time.Duration) *Worker {
+	if interval <= 0 {
+		interval = defaultWorkerInterval
+	}
+	return &Worker{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run запускает очистку и блокируется до отмены ctx
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.process(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// process очищает корзину
+func (w *Worker) process(ctx context.Context) {
+	result, err := w.service.PurgeExpired(ctx, time.Now())
+	if err != nil {
+		fmt.Printf("⛔️ Error purging trash: %v\n", err)
+	}
+	if result != nil && (result.Events > 0 || result.Transactions > 0) {
+		fmt.Printf("🗑 Purged %d events, %d transactions and %d attachment files from trash\n", result.Events, result.Transactions, result.Files)
+	}
+}
@@ -125,9 +125,24 @@ type User interface {
 	// AddUsersToEvent добавляет пользователей в мероприятие
 	AddUsersToEvent(ctx context.Context, ids []int64, eventID int64) error
 
+	// AddEventMember добавляет пользователя в мероприятие с ролью
+	AddEventMember(ctx context.Context, eventID, userID int64, role models.EventRole) error
+
 	// RemoveUserFromEvent удаляет пользователя из мероприятия
 	RemoveUserFromEvent(ctx context.Context, userID, eventID int64) error
 
+	// GetEventMembers возвращает участников мероприятия с ролями
+	GetEventMembers(ctx context.Context, eventID int64) ([]models.UserEvent, error)
+
+	// ChangeEventRole меняет роль участника мероприятия
+	ChangeEventRole(ctx context.Context, eventID, userID int64, role models.EventRole) error
+
+	// TransferEventOwnership передает владение мероприятием участнику
+	TransferEventOwnership(ctx context.Context, eventID, newOwnerID int64) error
+
+	// MergeDummyUser переносит данные dummy-пользователя на реального пользователя и удаляет dummy-пользователя
+	MergeDummyUser(ctx context.Context, dummyID, userID int64) error
+
 	// SyncUserWithIDService синхронизирует данные пользователя с ID-сервисом
 	SyncUserWithIDService(ctx context.Context, userID int64) (*models.User, error)
 
@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/ivasnev/FinFlow/ff-split/internal/adapters"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
 	"github.com/ivasnev/FinFlow/ff-split/internal/common/slices"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
 	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/activity"
 	"gorm.io/gorm"
 )
 
@@ -18,16 +22,19 @@ type UserService struct {
 	db             *gorm.DB
 	userRepository repository.User
 	idAdapter      adapters.IDAdapter
+	activities     service.ActivityRecorder
 }
 
 // NewUserService создает новый сервис пользователей
 func NewUserService(
 	userRepository repository.User,
 	idAdapter adapters.IDAdapter,
+	activities service.ActivityRecorder,
 ) *UserService {
 	return &UserService{
 		userRepository: userRepository,
 		idAdapter:      idAdapter,
+		activities:     activities,
 	}
 }
 
@@ -49,6 +56,10 @@ func (s *UserService) CreateUser(ctx context.Context, user *models.User) (*model
 
 // CreateDummyUser создает нового dummy-пользователя для мероприятия
 func (s *UserService) CreateDummyUser(ctx context.Context, name string, eventID int64) (*models.User, error) {
+	if err := access.Require(ctx, eventID, access.ManageMembers); err != nil {
+		return nil, err
+	}
+
 	// Создаем dummy пользователя без UserID (он будет заполнен автоматически)
 	dummyUser := &models.User{
 		NameCashed: name,
@@ -238,11 +249,68 @@ func (s *UserService) GetInternalUserIdsByExternalUserIds(ctx context.Context, e
 	return internalUserIds, nil
 }
 
-// AddUsersToEvent добавляет пользователей в мероприятие
+// AddUsersToEvent добавляет пользователей в мероприятие с ролью member
 func (s *UserService) AddUsersToEvent(ctx context.Context, internalUserIds []int64, eventID int64) error {
+	if err := access.Require(ctx, eventID, access.ManageMembers); err != nil {
+		return err
+	}
+
+	// Уже состоящие в мероприятии пользователи не попадают в ленту активности повторно
+	members, err := s.userRepository.GetEventMembers(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("ошибка при получении участников мероприятия: %w", err)
+	}
+	existing := make(map[int64]bool, len(members))
+	for _, member := range members {
+		existing[member.UserID] = true
+	}
+
 	if err := s.userRepository.AddUsersToEvent(ctx, internalUserIds, eventID); err != nil {
 		return fmt.Errorf("ошибка при добавлении пользователей в мероприятие: %w", err)
 	}
+
+	for _, userID := range internalUserIds {
+		if existing[userID] {
+			continue
+		}
+		existing[userID] = true
+		activity.Record(ctx, s.activities, eventID, models.ActivityTypeMemberJoined, models.MemberActivityPayload{
+			UserID: userID,
+			Role:   string(models.EventRoleMember),
+		})
+	}
+	return nil
+}
+
+// AddEventMember добавляет пользователя в мероприятие с ролью.
+// Владелец назначается только передачей владения, повторно добавить участника нельзя.
+func (s *UserService) AddEventMember(ctx context.Context, eventID, userID int64, role models.EventRole) error {
+	if err := access.Require(ctx, eventID, access.ManageMembers); err != nil {
+		return err
+	}
+	if !access.IsValidRole(role) {
+		return customErrors.NewValidationError("role", fmt.Sprintf("неизвестная роль %q", role))
+	}
+	if role == models.EventRoleOwner {
+		return customErrors.NewValidationError("role", "владелец назначается передачей владения")
+	}
+
+	member, err := s.userRepository.GetEventMember(ctx, userID, eventID)
+	if err != nil {
+		return fmt.Errorf("ошибка при получении участника мероприятия: %w", err)
+	}
+	if member != nil {
+		return customErrors.NewAlreadyExistsError("event member", "пользователь уже участвует в мероприятии")
+	}
+
+	if err := s.userRepository.AddEventMember(ctx, userID, eventID, role); err != nil {
+		return fmt.Errorf("ошибка при добавлении пользователя в мероприятие: %w", err)
+	}
+
+	activity.Record(ctx, s.activities, eventID, models.ActivityTypeMemberJoined, models.MemberActivityPayload{
+		UserID: userID,
+		Role:   string(role),
+	})
 	return nil
 }
 
@@ -253,14 +321,145 @@ func (s *UserService) AddUserToEvent(ctx context.Context, idUser, eventID int64)
 		return fmt.Errorf("ошибка при добавлении пользователя в мероприятие: %w", err)
 	}
 
+	activity.Record(ctx, s.activities, eventID, models.ActivityTypeMemberJoined, models.MemberActivityPayload{
+		UserID: idUser,
+		Role:   string(models.EventRoleMember),
+	})
 	return nil
 }
 
-// RemoveUserFromEvent удаляет пользователя из мероприятия
+// RemoveUserFromEvent удаляет пользователя из мероприятия.
+// Владельца удалить нельзя. Участник может выйти сам, а удалить другого -
+// только при праве управления участниками и роли старше, чем у удаляемого.
 func (s *UserService) RemoveUserFromEvent(ctx context.Context, userID, eventID int64) error {
+	target, err := s.userRepository.GetEventMember(ctx, userID, eventID)
+	if err != nil {
+		return fmt.Errorf("ошибка при получении участника мероприятия: %w", err)
+	}
+	if target == nil {
+		return customErrors.NewEntityNotFoundError(strconv.FormatInt(userID, 10), "event member")
+	}
+	if target.Role == models.EventRoleOwner {
+		return customErrors.NewLogicError("владельца нельзя удалить из мероприятия, сначала передайте владение")
+	}
+
+	if actor, ok := access.MemberFromContext(ctx); ok && actor.UserID != userID {
+		if err := access.Require(ctx, eventID, access.ManageMembers); err != nil {
+			return err
+		}
+		if access.Rank(actor.Role) <= access.Rank(target.Role) {
+			return customErrors.NewForbiddenError("нельзя удалить участника с такой же или более высокой ролью")
+		}
+	}
+
 	if err := s.userRepository.RemoveUserFromEvent(ctx, userID, eventID); err != nil {
 		return fmt.Errorf("ошибка при удалении пользователя из мероприятия: %w", err)
 	}
+
+	activity.Record(ctx, s.activities, eventID, models.ActivityTypeMemberLeft, models.MemberActivityPayload{
+		UserID: userID,
+		Role:   string(target.Role),
+	})
+	return nil
+}
+
+// GetEventMembers возвращает участников мероприятия с ролями
+func (s *UserService) GetEventMembers(ctx context.Context, eventID int64) ([]models.UserEvent, error) {
+	members, err := s.userRepository.GetEventMembers(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при получении участников мероприятия: %w", err)
+	}
+	return members, nil
+}
+
+// ChangeEventRole меняет роль участника мероприятия.
+// Владельца назначает только передача владения, а роль владельца изменить нельзя.
+func (s *UserService) ChangeEventRole(ctx context.Context, eventID, userID int64, role models.EventRole) error {
+	if err := access.Require(ctx, eventID, access.ManageRoles); err != nil {
+		return err
+	}
+	if !access.IsValidRole(role) {
+		return customErrors.NewValidationError("role", fmt.Sprintf("неизвестная роль %q", role))
+	}
+	if role == models.EventRoleOwner {
+		return customErrors.NewValidationError("role", "владелец назначается передачей владения")
+	}
+
+	target, err := s.userRepository.GetEventMember(ctx, userID, eventID)
+	if err != nil {
+		return fmt.Errorf("ошибка при получении участника мероприятия: %w", err)
+	}
+	if target == nil {
+		return customErrors.NewEntityNotFoundError(strconv.FormatInt(userID, 10), "event member")
+	}
+	if target.Role == models.EventRoleOwner {
+		return customErrors.NewLogicError("роль владельца нельзя изменить, сначала передайте владение")
+	}
+
+	if err := s.userRepository.SetEventRole(ctx, userID, eventID, role); err != nil {
+		return fmt.Errorf("ошибка при изменении роли участника: %w", err)
+	}
+	return nil
+}
+
+// TransferEventOwnership передает владение мероприятием участнику, прежний владелец становится администратором.
+// Передать владение может только текущий владелец; dummy-пользователь владельцем стать не может.
+func (s *UserService) TransferEventOwnership(ctx context.Context, eventID, newOwnerID int64) error {
+	if actor, ok := access.MemberFromContext(ctx); ok && (actor.EventID != eventID || actor.Role != models.EventRoleOwner) {
+		return customErrors.NewForbiddenError("передать владение может только владелец мероприятия")
+	}
+
+	target, err := s.userRepository.GetEventMember(ctx, newOwnerID, eventID)
+	if err != nil {
+		return fmt.Errorf("ошибка при получении участника мероприятия: %w", err)
+	}
+	if target == nil {
+		return customErrors.NewEntityNotFoundError(strconv.FormatInt(newOwnerID, 10), "event member")
+	}
+	if target.Role == models.EventRoleOwner {
+		return nil
+	}
+
+	user, err := s.userRepository.GetByInternalUserID(ctx, newOwnerID)
+	if err != nil {
+		return fmt.Errorf("ошибка при получении пользователя: %w", err)
+	}
+	if user.IsDummy {
+		return customErrors.NewValidationError("user_id", "dummy-пользователь не может быть владельцем мероприятия")
+	}
+
+	if err := s.userRepository.TransferEventOwnership(ctx, eventID, newOwnerID); err != nil {
+		return fmt.Errorf("ошибка при передаче владения мероприятием: %w", err)
+	}
+	return nil
+}
+
+// MergeDummyUser переносит доли, долги, задачи и активности dummy-пользователя на реального пользователя
+// и удаляет dummy-пользователя. Права на слияние проверяет вызывающий код.
+func (s *UserService) MergeDummyUser(ctx context.Context, dummyID, userID int64) error {
+	if dummyID == userID {
+		return customErrors.NewValidationError("user_id", "нельзя объединить пользователя с самим собой")
+	}
+
+	dummy, err := s.userRepository.GetByInternalUserID(ctx, dummyID)
+	if err != nil {
+		return fmt.Errorf("ошибка при получении пользователя: %w", err)
+	}
+	if !dummy.IsDummy {
+		return customErrors.NewValidationError("dummy_id", "объединять можно только dummy-пользователя")
+	}
+
+	user, err := s.userRepository.GetByInternalUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("ошибка при получении пользователя: %w", err)
+	}
+	if user.IsDummy {
+		return customErrors.NewValidationError("user_id", "dummy-пользователя нельзя объединить с другим dummy-пользователем")
+	}
+
+	if err := s.userRepository.MergeUsers(ctx, dummyID, userID); err != nil {
+		return fmt.Errorf("ошибка при объединении пользователей: %w", err)
+	}
 	return nil
 }
 
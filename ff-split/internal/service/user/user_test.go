@@ -8,9 +8,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/ivasnev/FinFlow/ff-split/internal/adapters"
 	adaptersMock "github.com/ivasnev/FinFlow/ff-split/internal/adapters/mock"
+	customErrors "github.com/ivasnev/FinFlow/ff-split/internal/common/errors"
 	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"github.com/ivasnev/FinFlow/ff-split/internal/repository"
 	repositoryMock "github.com/ivasnev/FinFlow/ff-split/internal/repository/mock"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service/access"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,7 +22,7 @@ func TestUserService_CreateUser(t *testing.T) {
 
 	mockUserRepo := repositoryMock.NewMockUser(ctrl)
 	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
-	userService := NewUserService(mockUserRepo, mockIDAdapter)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
 
 	ctx := context.Background()
 	userID := int64(1)
@@ -89,7 +91,7 @@ func TestUserService_CreateDummyUser(t *testing.T) {
 
 	mockUserRepo := repositoryMock.NewMockUser(ctrl)
 	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
-	userService := NewUserService(mockUserRepo, mockIDAdapter)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
 
 	ctx := context.Background()
 	eventID := int64(1)
@@ -145,7 +147,7 @@ func TestUserService_GetUserByInternalUserID(t *testing.T) {
 
 	mockUserRepo := repositoryMock.NewMockUser(ctrl)
 	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
-	userService := NewUserService(mockUserRepo, mockIDAdapter)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
 
 	ctx := context.Background()
 	internalID := int64(1)
@@ -189,7 +191,7 @@ func TestUserService_GetUserByExternalUserID(t *testing.T) {
 
 	mockUserRepo := repositoryMock.NewMockUser(ctrl)
 	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
-	userService := NewUserService(mockUserRepo, mockIDAdapter)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
 
 	ctx := context.Background()
 	externalID := int64(100)
@@ -265,7 +267,7 @@ func TestUserService_GetUsersByExternalUserIDs(t *testing.T) {
 
 	mockUserRepo := repositoryMock.NewMockUser(ctrl)
 	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
-	userService := NewUserService(mockUserRepo, mockIDAdapter)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
 
 	ctx := context.Background()
 	userIDs := []int64{100, 200}
@@ -309,7 +311,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 
 	mockUserRepo := repositoryMock.NewMockUser(ctrl)
 	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
-	userService := NewUserService(mockUserRepo, mockIDAdapter)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
 
 	ctx := context.Background()
 	userID := int64(1)
@@ -345,7 +347,7 @@ func TestUserService_AddUserToEvent(t *testing.T) {
 
 	mockUserRepo := repositoryMock.NewMockUser(ctrl)
 	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
-	userService := NewUserService(mockUserRepo, mockIDAdapter)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
 
 	ctx := context.Background()
 	userID := int64(1)
@@ -382,13 +384,15 @@ func TestUserService_RemoveUserFromEvent(t *testing.T) {
 
 	mockUserRepo := repositoryMock.NewMockUser(ctrl)
 	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
-	userService := NewUserService(mockUserRepo, mockIDAdapter)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
 
 	ctx := context.Background()
 	userID := int64(1)
 	eventID := int64(100)
+	member := &models.UserEvent{UserID: userID, EventID: eventID, Role: models.EventRoleMember}
 
 	t.Run("успешное удаление пользователя из мероприятия", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetEventMember(ctx, userID, eventID).Return(member, nil)
 		mockUserRepo.EXPECT().
 			RemoveUserFromEvent(ctx, userID, eventID).
 			Return(nil).
@@ -401,6 +405,7 @@ func TestUserService_RemoveUserFromEvent(t *testing.T) {
 
 	t.Run("ошибка удаления пользователя из мероприятия", func(t *testing.T) {
 		expectedErr := errors.New("remove error")
+		mockUserRepo.EXPECT().GetEventMember(ctx, userID, eventID).Return(member, nil)
 		mockUserRepo.EXPECT().
 			RemoveUserFromEvent(ctx, userID, eventID).
 			Return(expectedErr).
@@ -411,4 +416,312 @@ func TestUserService_RemoveUserFromEvent(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "ошибка при удалении пользователя из мероприятия")
 	})
+
+	t.Run("пользователь не участник", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetEventMember(ctx, userID, eventID).Return(nil, nil)
+
+		err := userService.RemoveUserFromEvent(ctx, userID, eventID)
+
+		var notFoundErr *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("владельца удалить нельзя", func(t *testing.T) {
+		owner := &models.UserEvent{UserID: userID, EventID: eventID, Role: models.EventRoleOwner}
+		mockUserRepo.EXPECT().GetEventMember(ctx, userID, eventID).Return(owner, nil)
+
+		err := userService.RemoveUserFromEvent(ctx, userID, eventID)
+
+		var logicErr *customErrors.LogicError
+		assert.ErrorAs(t, err, &logicErr)
+	})
+
+	t.Run("участник выходит сам", func(t *testing.T) {
+		selfCtx := access.WithMember(ctx, member)
+		mockUserRepo.EXPECT().GetEventMember(selfCtx, userID, eventID).Return(member, nil)
+		mockUserRepo.EXPECT().RemoveUserFromEvent(selfCtx, userID, eventID).Return(nil)
+
+		err := userService.RemoveUserFromEvent(selfCtx, userID, eventID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("участник не может удалить другого", func(t *testing.T) {
+		actorCtx := access.WithMember(ctx, &models.UserEvent{UserID: 2, EventID: eventID, Role: models.EventRoleMember})
+		mockUserRepo.EXPECT().GetEventMember(actorCtx, userID, eventID).Return(member, nil)
+
+		err := userService.RemoveUserFromEvent(actorCtx, userID, eventID)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("администратор не может удалить администратора", func(t *testing.T) {
+		admin := &models.UserEvent{UserID: userID, EventID: eventID, Role: models.EventRoleAdmin}
+		actorCtx := access.WithMember(ctx, &models.UserEvent{UserID: 2, EventID: eventID, Role: models.EventRoleAdmin})
+		mockUserRepo.EXPECT().GetEventMember(actorCtx, userID, eventID).Return(admin, nil)
+
+		err := userService.RemoveUserFromEvent(actorCtx, userID, eventID)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("администратор удаляет участника", func(t *testing.T) {
+		actorCtx := access.WithMember(ctx, &models.UserEvent{UserID: 2, EventID: eventID, Role: models.EventRoleAdmin})
+		mockUserRepo.EXPECT().GetEventMember(actorCtx, userID, eventID).Return(member, nil)
+		mockUserRepo.EXPECT().RemoveUserFromEvent(actorCtx, userID, eventID).Return(nil)
+
+		err := userService.RemoveUserFromEvent(actorCtx, userID, eventID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestUserService_AddUsersToEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repositoryMock.NewMockUser(ctrl)
+	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
+
+	ctx := context.Background()
+	eventID := int64(100)
+	ids := []int64{2, 3}
+
+	t.Run("администратор добавляет участников", func(t *testing.T) {
+		actorCtx := access.WithMember(ctx, &models.UserEvent{UserID: 1, EventID: eventID, Role: models.EventRoleAdmin})
+		mockUserRepo.EXPECT().GetEventMembers(actorCtx, eventID).Return(nil, nil)
+		mockUserRepo.EXPECT().AddUsersToEvent(actorCtx, ids, eventID).Return(nil)
+
+		err := userService.AddUsersToEvent(actorCtx, ids, eventID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("участник не может добавлять участников", func(t *testing.T) {
+		actorCtx := access.WithMember(ctx, &models.UserEvent{UserID: 1, EventID: eventID, Role: models.EventRoleMember})
+
+		err := userService.AddUsersToEvent(actorCtx, ids, eventID)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+}
+
+func TestUserService_AddEventMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repositoryMock.NewMockUser(ctrl)
+	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
+
+	ctx := context.Background()
+	userID := int64(2)
+	eventID := int64(100)
+
+	t.Run("добавление с ролью", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetEventMember(ctx, userID, eventID).Return(nil, nil)
+		mockUserRepo.EXPECT().AddEventMember(ctx, userID, eventID, models.EventRoleViewer).Return(nil)
+
+		err := userService.AddEventMember(ctx, eventID, userID, models.EventRoleViewer)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("пользователь уже участник", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetEventMember(ctx, userID, eventID).
+			Return(&models.UserEvent{UserID: userID, EventID: eventID, Role: models.EventRoleMember}, nil)
+
+		err := userService.AddEventMember(ctx, eventID, userID, models.EventRoleAdmin)
+
+		var alreadyExistsErr *customErrors.AlreadyExistsError
+		assert.ErrorAs(t, err, &alreadyExistsErr)
+	})
+
+	t.Run("владельца добавить нельзя", func(t *testing.T) {
+		err := userService.AddEventMember(ctx, eventID, userID, models.EventRoleOwner)
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestUserService_ChangeEventRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repositoryMock.NewMockUser(ctrl)
+	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
+
+	userID := int64(2)
+	eventID := int64(100)
+	ownerCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 1, EventID: eventID, Role: models.EventRoleOwner})
+
+	t.Run("владелец назначает администратора", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetEventMember(ownerCtx, userID, eventID).
+			Return(&models.UserEvent{UserID: userID, EventID: eventID, Role: models.EventRoleMember}, nil)
+		mockUserRepo.EXPECT().SetEventRole(ownerCtx, userID, eventID, models.EventRoleAdmin).Return(nil)
+
+		err := userService.ChangeEventRole(ownerCtx, eventID, userID, models.EventRoleAdmin)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("администратор не может менять роли", func(t *testing.T) {
+		adminCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 3, EventID: eventID, Role: models.EventRoleAdmin})
+
+		err := userService.ChangeEventRole(adminCtx, eventID, userID, models.EventRoleViewer)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("неизвестная роль", func(t *testing.T) {
+		err := userService.ChangeEventRole(ownerCtx, eventID, userID, models.EventRole("superuser"))
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("роль владельца назначается только передачей владения", func(t *testing.T) {
+		err := userService.ChangeEventRole(ownerCtx, eventID, userID, models.EventRoleOwner)
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("роль владельца изменить нельзя", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetEventMember(ownerCtx, int64(1), eventID).
+			Return(&models.UserEvent{UserID: 1, EventID: eventID, Role: models.EventRoleOwner}, nil)
+
+		err := userService.ChangeEventRole(ownerCtx, eventID, 1, models.EventRoleAdmin)
+
+		var logicErr *customErrors.LogicError
+		assert.ErrorAs(t, err, &logicErr)
+	})
+
+	t.Run("пользователь не участник", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetEventMember(ownerCtx, userID, eventID).Return(nil, nil)
+
+		err := userService.ChangeEventRole(ownerCtx, eventID, userID, models.EventRoleViewer)
+
+		var notFoundErr *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestUserService_TransferEventOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repositoryMock.NewMockUser(ctrl)
+	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
+
+	newOwnerID := int64(2)
+	eventID := int64(100)
+	ownerCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 1, EventID: eventID, Role: models.EventRoleOwner})
+	target := &models.UserEvent{UserID: newOwnerID, EventID: eventID, Role: models.EventRoleMember}
+
+	t.Run("владелец передает владение", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetEventMember(ownerCtx, newOwnerID, eventID).Return(target, nil)
+		mockUserRepo.EXPECT().GetByInternalUserID(ownerCtx, newOwnerID).Return(&models.User{ID: newOwnerID}, nil)
+		mockUserRepo.EXPECT().TransferEventOwnership(ownerCtx, eventID, newOwnerID).Return(nil)
+
+		err := userService.TransferEventOwnership(ownerCtx, eventID, newOwnerID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("администратор не может передать владение", func(t *testing.T) {
+		adminCtx := access.WithMember(context.Background(), &models.UserEvent{UserID: 3, EventID: eventID, Role: models.EventRoleAdmin})
+
+		err := userService.TransferEventOwnership(adminCtx, eventID, newOwnerID)
+
+		var forbiddenErr *customErrors.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("dummy-пользователь не может стать владельцем", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetEventMember(ownerCtx, newOwnerID, eventID).Return(target, nil)
+		mockUserRepo.EXPECT().GetByInternalUserID(ownerCtx, newOwnerID).Return(&models.User{ID: newOwnerID, IsDummy: true}, nil)
+
+		err := userService.TransferEventOwnership(ownerCtx, eventID, newOwnerID)
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("пользователь не участник", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetEventMember(ownerCtx, newOwnerID, eventID).Return(nil, nil)
+
+		err := userService.TransferEventOwnership(ownerCtx, eventID, newOwnerID)
+
+		var notFoundErr *customErrors.EntityNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("внутренний вызов назначает владельца нового мероприятия", func(t *testing.T) {
+		ctx := context.Background()
+		mockUserRepo.EXPECT().GetEventMember(ctx, newOwnerID, eventID).Return(target, nil)
+		mockUserRepo.EXPECT().GetByInternalUserID(ctx, newOwnerID).Return(&models.User{ID: newOwnerID}, nil)
+		mockUserRepo.EXPECT().TransferEventOwnership(ctx, eventID, newOwnerID).Return(nil)
+
+		err := userService.TransferEventOwnership(ctx, eventID, newOwnerID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestUserService_MergeDummyUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repositoryMock.NewMockUser(ctrl)
+	mockIDAdapter := adaptersMock.NewMockIDAdapter(ctrl)
+	userService := NewUserService(mockUserRepo, mockIDAdapter, nil)
+
+	ctx := context.Background()
+	dummyID := int64(5)
+	userID := int64(2)
+
+	t.Run("успешное объединение", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetByInternalUserID(ctx, dummyID).Return(&models.User{ID: dummyID, IsDummy: true}, nil)
+		mockUserRepo.EXPECT().GetByInternalUserID(ctx, userID).Return(&models.User{ID: userID}, nil)
+		mockUserRepo.EXPECT().MergeUsers(ctx, dummyID, userID).Return(nil)
+
+		err := userService.MergeDummyUser(ctx, dummyID, userID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("источник не dummy-пользователь", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetByInternalUserID(ctx, int64(3)).Return(&models.User{ID: 3}, nil)
+
+		err := userService.MergeDummyUser(ctx, 3, userID)
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("объединение двух dummy-пользователей", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetByInternalUserID(ctx, dummyID).Return(&models.User{ID: dummyID, IsDummy: true}, nil)
+		mockUserRepo.EXPECT().GetByInternalUserID(ctx, int64(6)).Return(&models.User{ID: 6, IsDummy: true}, nil)
+
+		err := userService.MergeDummyUser(ctx, dummyID, 6)
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("объединение с самим собой", func(t *testing.T) {
+		err := userService.MergeDummyUser(ctx, dummyID, dummyID)
+
+		var validationErr *customErrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
 }
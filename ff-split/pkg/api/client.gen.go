@@ -96,13 +96,19 @@ type ClientInterface interface {
 	GetCategoryByID(ctx context.Context, id int, params *GetCategoryByIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetEvents request
-	GetEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetEvents(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateEventWithBody request with any body
 	CreateEventWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateEvent(ctx context.Context, body CreateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDeletedEvents request
+	GetDeletedEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreEvent request
+	RestoreEvent(ctx context.Context, idDeletedEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteEvent request
 	DeleteEvent(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -133,14 +139,96 @@ type ClientInterface interface {
 
 	UpdateActivity(ctx context.Context, idEvent int64, idActivity int, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetEventAnalytics request
+	GetEventAnalytics(ctx context.Context, idEvent int64, params *GetEventAnalyticsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArchiveEvent request
+	ArchiveEvent(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDummyClaims request
+	GetDummyClaims(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApproveDummyClaim request
+	ApproveDummyClaim(ctx context.Context, idEvent int64, idClaim int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RejectDummyClaim request
+	RejectDummyClaim(ctx context.Context, idEvent int64, idClaim int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloseEvent request
+	CloseEvent(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDebtsByEventID request
 	GetDebtsByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExportEvent request
+	ExportEvent(ctx context.Context, idEvent int64, params *ExportEventParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetActivityFeed request
+	GetActivityFeed(ctx context.Context, idEvent int64, params *GetActivityFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportTransactionsWithBody request with any body
+	ImportTransactionsWithBody(ctx context.Context, idEvent int64, params *ImportTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEventInvites request
+	GetEventInvites(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateEventInviteWithBody request with any body
+	CreateEventInviteWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateEventInvite(ctx context.Context, idEvent int64, body CreateEventInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeEventInvite request
+	RevokeEventInvite(ctx context.Context, idEvent int64, idInvite int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetOptimizedDebtsByEventID request
 	GetOptimizedDebtsByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// OptimizeDebts request
-	OptimizeDebts(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	OptimizeDebts(ctx context.Context, idEvent int64, params *OptimizeDebtsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferEventOwnershipWithBody request with any body
+	TransferEventOwnershipWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TransferEventOwnership(ctx context.Context, idEvent int64, body TransferEventOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecurringTransactions request
+	GetRecurringTransactions(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRecurringTransactionWithBody request with any body
+	CreateRecurringTransactionWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRecurringTransaction(ctx context.Context, idEvent int64, body CreateRecurringTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRecurringTransaction request
+	DeleteRecurringTransaction(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRecurringTransactionWithBody request with any body
+	UpdateRecurringTransactionWithBody(ctx context.Context, idEvent int64, idRecurring int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRecurringTransaction(ctx context.Context, idEvent int64, idRecurring int, body UpdateRecurringTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PauseRecurringTransaction request
+	PauseRecurringTransaction(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResumeRecurringTransaction request
+	ResumeRecurringTransaction(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReopenEvent request
+	ReopenEvent(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SettleEvent request
+	SettleEvent(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSettlementsByEventID request
+	GetSettlementsByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSettlementWithBody request with any body
+	CreateSettlementWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSettlement(ctx context.Context, idEvent int64, body CreateSettlementJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSettlement request
+	DeleteSettlement(ctx context.Context, idEvent int64, idSettlement int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetTasksByEventID request
 	GetTasksByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -162,7 +250,7 @@ type ClientInterface interface {
 	UpdateTask(ctx context.Context, idEvent int64, idTask int, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetTransactionsByEventID request
-	GetTransactionsByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetTransactionsByEventID(ctx context.Context, idEvent int64, params *GetTransactionsByEventIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateTransactionWithBody request with any body
 	CreateTransactionWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -180,6 +268,45 @@ type ClientInterface interface {
 
 	UpdateTransaction(ctx context.Context, idEvent int64, idTransaction int, body UpdateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AttachTransactionFileWithBody request with any body
+	AttachTransactionFileWithBody(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AttachTransactionFile(ctx context.Context, idEvent int64, idTransaction int, body AttachTransactionFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DetachTransactionFile request
+	DetachTransactionFile(ctx context.Context, idEvent int64, idTransaction int, idAttachment int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransactionAttachmentUrl request
+	GetTransactionAttachmentUrl(ctx context.Context, idEvent int64, idTransaction int, idAttachment int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransactionHistory request
+	GetTransactionHistory(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevertTransaction request
+	RevertTransaction(ctx context.Context, idEvent int64, idTransaction int, idRevision int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransactionItems request
+	GetTransactionItems(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTransactionItemWithBody request with any body
+	CreateTransactionItemWithBody(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTransactionItem(ctx context.Context, idEvent int64, idTransaction int, body CreateTransactionItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTransactionItem request
+	DeleteTransactionItem(ctx context.Context, idEvent int64, idTransaction int, idItem int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTransactionItemWithBody request with any body
+	UpdateTransactionItemWithBody(ctx context.Context, idEvent int64, idTransaction int, idItem int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTransactionItem(ctx context.Context, idEvent int64, idTransaction int, idItem int, body UpdateTransactionItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreTransaction request
+	RestoreTransaction(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEventTrash request
+	GetEventTrash(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUsersByEventID request
 	GetUsersByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -199,9 +326,23 @@ type ClientInterface interface {
 	// RemoveUserFromEvent request
 	RemoveUserFromEvent(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateDummyClaim request
+	CreateDummyClaim(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetOptimizedDebtsByUserID request
 	GetOptimizedDebtsByUserID(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateEventUserRoleWithBody request with any body
+	UpdateEventUserRoleWithBody(ctx context.Context, idEvent int64, idUser int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateEventUserRole(ctx context.Context, idEvent int64, idUser int64, body UpdateEventUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExchangeRates request
+	GetExchangeRates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// JoinEventByInvite request
+	JoinEventByInvite(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateCategoryWithBody request with any body
 	CreateCategoryWithBody(ctx context.Context, params *CreateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -215,6 +356,14 @@ type ClientInterface interface {
 
 	UpdateCategory(ctx context.Context, id int, params *UpdateCategoryParams, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SetExchangeRateWithBody request with any body
+	SetExchangeRateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetExchangeRate(ctx context.Context, body SetExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteExchangeRate request
+	DeleteExchangeRate(ctx context.Context, currencyFrom string, currencyTo string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetIcons request
 	GetIcons(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -270,8 +419,8 @@ func (c *Client) GetCategoryByID(ctx context.Context, id int, params *GetCategor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetEventsRequest(c.Server)
+func (c *Client) GetEvents(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -306,6 +455,30 @@ func (c *Client) CreateEvent(ctx context.Context, body CreateEventJSONRequestBod
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetDeletedEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeletedEventsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreEvent(ctx context.Context, idDeletedEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreEventRequest(c.Server, idDeletedEvent)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeleteEvent(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeleteEventRequest(c.Server, idEvent)
 	if err != nil {
@@ -438,8 +611,8 @@ func (c *Client) UpdateActivity(ctx context.Context, idEvent int64, idActivity i
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDebtsByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDebtsByEventIDRequest(c.Server, idEvent)
+func (c *Client) GetEventAnalytics(ctx context.Context, idEvent int64, params *GetEventAnalyticsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventAnalyticsRequest(c.Server, idEvent, params)
 	if err != nil {
 		return nil, err
 	}
@@ -450,8 +623,8 @@ func (c *Client) GetDebtsByEventID(ctx context.Context, idEvent int64, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOptimizedDebtsByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOptimizedDebtsByEventIDRequest(c.Server, idEvent)
+func (c *Client) ArchiveEvent(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveEventRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -462,8 +635,8 @@ func (c *Client) GetOptimizedDebtsByEventID(ctx context.Context, idEvent int64,
 	return c.Client.Do(req)
 }
 
-func (c *Client) OptimizeDebts(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewOptimizeDebtsRequest(c.Server, idEvent)
+func (c *Client) GetDummyClaims(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDummyClaimsRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -474,8 +647,8 @@ func (c *Client) OptimizeDebts(ctx context.Context, idEvent int64, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTasksByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTasksByEventIDRequest(c.Server, idEvent)
+func (c *Client) ApproveDummyClaim(ctx context.Context, idEvent int64, idClaim int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveDummyClaimRequest(c.Server, idEvent, idClaim)
 	if err != nil {
 		return nil, err
 	}
@@ -486,8 +659,8 @@ func (c *Client) GetTasksByEventID(ctx context.Context, idEvent int64, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTaskWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTaskRequestWithBody(c.Server, idEvent, contentType, body)
+func (c *Client) RejectDummyClaim(ctx context.Context, idEvent int64, idClaim int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectDummyClaimRequest(c.Server, idEvent, idClaim)
 	if err != nil {
 		return nil, err
 	}
@@ -498,8 +671,8 @@ func (c *Client) CreateTaskWithBody(ctx context.Context, idEvent int64, contentT
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTask(ctx context.Context, idEvent int64, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTaskRequest(c.Server, idEvent, body)
+func (c *Client) CloseEvent(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloseEventRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -510,8 +683,8 @@ func (c *Client) CreateTask(ctx context.Context, idEvent int64, body CreateTaskJ
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTask(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTaskRequest(c.Server, idEvent, idTask)
+func (c *Client) GetDebtsByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDebtsByEventIDRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -522,8 +695,8 @@ func (c *Client) DeleteTask(ctx context.Context, idEvent int64, idTask int, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTaskByID(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTaskByIDRequest(c.Server, idEvent, idTask)
+func (c *Client) ExportEvent(ctx context.Context, idEvent int64, params *ExportEventParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportEventRequest(c.Server, idEvent, params)
 	if err != nil {
 		return nil, err
 	}
@@ -534,8 +707,8 @@ func (c *Client) GetTaskByID(ctx context.Context, idEvent int64, idTask int, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTaskWithBody(ctx context.Context, idEvent int64, idTask int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTaskRequestWithBody(c.Server, idEvent, idTask, contentType, body)
+func (c *Client) GetActivityFeed(ctx context.Context, idEvent int64, params *GetActivityFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetActivityFeedRequest(c.Server, idEvent, params)
 	if err != nil {
 		return nil, err
 	}
@@ -546,8 +719,8 @@ func (c *Client) UpdateTaskWithBody(ctx context.Context, idEvent int64, idTask i
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTask(ctx context.Context, idEvent int64, idTask int, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTaskRequest(c.Server, idEvent, idTask, body)
+func (c *Client) ImportTransactionsWithBody(ctx context.Context, idEvent int64, params *ImportTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportTransactionsRequestWithBody(c.Server, idEvent, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -558,8 +731,8 @@ func (c *Client) UpdateTask(ctx context.Context, idEvent int64, idTask int, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTransactionsByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTransactionsByEventIDRequest(c.Server, idEvent)
+func (c *Client) GetEventInvites(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventInvitesRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -570,8 +743,8 @@ func (c *Client) GetTransactionsByEventID(ctx context.Context, idEvent int64, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTransactionWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTransactionRequestWithBody(c.Server, idEvent, contentType, body)
+func (c *Client) CreateEventInviteWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEventInviteRequestWithBody(c.Server, idEvent, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -582,8 +755,8 @@ func (c *Client) CreateTransactionWithBody(ctx context.Context, idEvent int64, c
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTransaction(ctx context.Context, idEvent int64, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTransactionRequest(c.Server, idEvent, body)
+func (c *Client) CreateEventInvite(ctx context.Context, idEvent int64, body CreateEventInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEventInviteRequest(c.Server, idEvent, body)
 	if err != nil {
 		return nil, err
 	}
@@ -594,8 +767,8 @@ func (c *Client) CreateTransaction(ctx context.Context, idEvent int64, body Crea
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTransaction(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTransactionRequest(c.Server, idEvent, idTransaction)
+func (c *Client) RevokeEventInvite(ctx context.Context, idEvent int64, idInvite int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeEventInviteRequest(c.Server, idEvent, idInvite)
 	if err != nil {
 		return nil, err
 	}
@@ -606,8 +779,8 @@ func (c *Client) DeleteTransaction(ctx context.Context, idEvent int64, idTransac
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTransactionByID(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTransactionByIDRequest(c.Server, idEvent, idTransaction)
+func (c *Client) GetOptimizedDebtsByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOptimizedDebtsByEventIDRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -618,8 +791,8 @@ func (c *Client) GetTransactionByID(ctx context.Context, idEvent int64, idTransa
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTransactionWithBody(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTransactionRequestWithBody(c.Server, idEvent, idTransaction, contentType, body)
+func (c *Client) OptimizeDebts(ctx context.Context, idEvent int64, params *OptimizeDebtsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOptimizeDebtsRequest(c.Server, idEvent, params)
 	if err != nil {
 		return nil, err
 	}
@@ -630,8 +803,8 @@ func (c *Client) UpdateTransactionWithBody(ctx context.Context, idEvent int64, i
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTransaction(ctx context.Context, idEvent int64, idTransaction int, body UpdateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTransactionRequest(c.Server, idEvent, idTransaction, body)
+func (c *Client) TransferEventOwnershipWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferEventOwnershipRequestWithBody(c.Server, idEvent, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -642,8 +815,8 @@ func (c *Client) UpdateTransaction(ctx context.Context, idEvent int64, idTransac
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUsersByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUsersByEventIDRequest(c.Server, idEvent)
+func (c *Client) TransferEventOwnership(ctx context.Context, idEvent int64, body TransferEventOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferEventOwnershipRequest(c.Server, idEvent, body)
 	if err != nil {
 		return nil, err
 	}
@@ -654,8 +827,8 @@ func (c *Client) GetUsersByEventID(ctx context.Context, idEvent int64, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddUsersToEventWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddUsersToEventRequestWithBody(c.Server, idEvent, contentType, body)
+func (c *Client) GetRecurringTransactions(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecurringTransactionsRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -666,8 +839,8 @@ func (c *Client) AddUsersToEventWithBody(ctx context.Context, idEvent int64, con
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddUsersToEvent(ctx context.Context, idEvent int64, body AddUsersToEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddUsersToEventRequest(c.Server, idEvent, body)
+func (c *Client) CreateRecurringTransactionWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRecurringTransactionRequestWithBody(c.Server, idEvent, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -678,8 +851,8 @@ func (c *Client) AddUsersToEvent(ctx context.Context, idEvent int64, body AddUse
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDummiesByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDummiesByEventIDRequest(c.Server, idEvent)
+func (c *Client) CreateRecurringTransaction(ctx context.Context, idEvent int64, body CreateRecurringTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRecurringTransactionRequest(c.Server, idEvent, body)
 	if err != nil {
 		return nil, err
 	}
@@ -690,8 +863,8 @@ func (c *Client) GetDummiesByEventID(ctx context.Context, idEvent int64, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDummyUserWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDummyUserRequestWithBody(c.Server, idEvent, contentType, body)
+func (c *Client) DeleteRecurringTransaction(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRecurringTransactionRequest(c.Server, idEvent, idRecurring)
 	if err != nil {
 		return nil, err
 	}
@@ -702,8 +875,8 @@ func (c *Client) CreateDummyUserWithBody(ctx context.Context, idEvent int64, con
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDummyUser(ctx context.Context, idEvent int64, body CreateDummyUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDummyUserRequest(c.Server, idEvent, body)
+func (c *Client) UpdateRecurringTransactionWithBody(ctx context.Context, idEvent int64, idRecurring int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRecurringTransactionRequestWithBody(c.Server, idEvent, idRecurring, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -714,8 +887,8 @@ func (c *Client) CreateDummyUser(ctx context.Context, idEvent int64, body Create
 	return c.Client.Do(req)
 }
 
-func (c *Client) RemoveUserFromEvent(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRemoveUserFromEventRequest(c.Server, idEvent, idUser)
+func (c *Client) UpdateRecurringTransaction(ctx context.Context, idEvent int64, idRecurring int, body UpdateRecurringTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRecurringTransactionRequest(c.Server, idEvent, idRecurring, body)
 	if err != nil {
 		return nil, err
 	}
@@ -726,8 +899,8 @@ func (c *Client) RemoveUserFromEvent(ctx context.Context, idEvent int64, idUser
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOptimizedDebtsByUserID(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOptimizedDebtsByUserIDRequest(c.Server, idEvent, idUser)
+func (c *Client) PauseRecurringTransaction(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPauseRecurringTransactionRequest(c.Server, idEvent, idRecurring)
 	if err != nil {
 		return nil, err
 	}
@@ -738,8 +911,8 @@ func (c *Client) GetOptimizedDebtsByUserID(ctx context.Context, idEvent int64, i
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCategoryWithBody(ctx context.Context, params *CreateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCategoryRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) ResumeRecurringTransaction(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResumeRecurringTransactionRequest(c.Server, idEvent, idRecurring)
 	if err != nil {
 		return nil, err
 	}
@@ -750,8 +923,8 @@ func (c *Client) CreateCategoryWithBody(ctx context.Context, params *CreateCateg
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCategory(ctx context.Context, params *CreateCategoryParams, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCategoryRequest(c.Server, params, body)
+func (c *Client) ReopenEvent(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReopenEventRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -762,8 +935,8 @@ func (c *Client) CreateCategory(ctx context.Context, params *CreateCategoryParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteCategory(ctx context.Context, id int, params *DeleteCategoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteCategoryRequest(c.Server, id, params)
+func (c *Client) SettleEvent(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSettleEventRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -774,8 +947,8 @@ func (c *Client) DeleteCategory(ctx context.Context, id int, params *DeleteCateg
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCategoryWithBody(ctx context.Context, id int, params *UpdateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCategoryRequestWithBody(c.Server, id, params, contentType, body)
+func (c *Client) GetSettlementsByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSettlementsByEventIDRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -786,8 +959,8 @@ func (c *Client) UpdateCategoryWithBody(ctx context.Context, id int, params *Upd
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCategory(ctx context.Context, id int, params *UpdateCategoryParams, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCategoryRequest(c.Server, id, params, body)
+func (c *Client) CreateSettlementWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSettlementRequestWithBody(c.Server, idEvent, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -798,8 +971,8 @@ func (c *Client) UpdateCategory(ctx context.Context, id int, params *UpdateCateg
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetIcons(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetIconsRequest(c.Server)
+func (c *Client) CreateSettlement(ctx context.Context, idEvent int64, body CreateSettlementJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSettlementRequest(c.Server, idEvent, body)
 	if err != nil {
 		return nil, err
 	}
@@ -810,8 +983,8 @@ func (c *Client) GetIcons(ctx context.Context, reqEditors ...RequestEditorFn) (*
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateIconWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateIconRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeleteSettlement(ctx context.Context, idEvent int64, idSettlement int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSettlementRequest(c.Server, idEvent, idSettlement)
 	if err != nil {
 		return nil, err
 	}
@@ -822,8 +995,8 @@ func (c *Client) CreateIconWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateIcon(ctx context.Context, body CreateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateIconRequest(c.Server, body)
+func (c *Client) GetTasksByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTasksByEventIDRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -834,8 +1007,8 @@ func (c *Client) CreateIcon(ctx context.Context, body CreateIconJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteIcon(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteIconRequest(c.Server, id)
+func (c *Client) CreateTaskWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskRequestWithBody(c.Server, idEvent, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -846,8 +1019,8 @@ func (c *Client) DeleteIcon(ctx context.Context, id int, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetIconByID(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetIconByIDRequest(c.Server, id)
+func (c *Client) CreateTask(ctx context.Context, idEvent int64, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskRequest(c.Server, idEvent, body)
 	if err != nil {
 		return nil, err
 	}
@@ -858,8 +1031,8 @@ func (c *Client) GetIconByID(ctx context.Context, id int, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateIconWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateIconRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) DeleteTask(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTaskRequest(c.Server, idEvent, idTask)
 	if err != nil {
 		return nil, err
 	}
@@ -870,8 +1043,8 @@ func (c *Client) UpdateIconWithBody(ctx context.Context, id int, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateIcon(ctx context.Context, id int, body UpdateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateIconRequest(c.Server, id, body)
+func (c *Client) GetTaskByID(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskByIDRequest(c.Server, idEvent, idTask)
 	if err != nil {
 		return nil, err
 	}
@@ -882,8 +1055,8 @@ func (c *Client) UpdateIcon(ctx context.Context, id int, body UpdateIconJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUsersByExternalIDs(ctx context.Context, params *GetUsersByExternalIDsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUsersByExternalIDsRequest(c.Server, params)
+func (c *Client) UpdateTaskWithBody(ctx context.Context, idEvent int64, idTask int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTaskRequestWithBody(c.Server, idEvent, idTask, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -894,8 +1067,8 @@ func (c *Client) GetUsersByExternalIDs(ctx context.Context, params *GetUsersByEx
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUserByID(ctx context.Context, idUser int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserByIDRequest(c.Server, idUser)
+func (c *Client) UpdateTask(ctx context.Context, idEvent int64, idTask int, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTaskRequest(c.Server, idEvent, idTask, body)
 	if err != nil {
 		return nil, err
 	}
@@ -906,8 +1079,8 @@ func (c *Client) GetUserByID(ctx context.Context, idUser int64, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) SyncUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSyncUsersRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetTransactionsByEventID(ctx context.Context, idEvent int64, params *GetTransactionsByEventIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionsByEventIDRequest(c.Server, idEvent, params)
 	if err != nil {
 		return nil, err
 	}
@@ -918,8 +1091,8 @@ func (c *Client) SyncUsersWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) SyncUsers(ctx context.Context, body SyncUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSyncUsersRequest(c.Server, body)
+func (c *Client) CreateTransactionWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransactionRequestWithBody(c.Server, idEvent, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -930,666 +1103,628 @@ func (c *Client) SyncUsers(ctx context.Context, body SyncUsersJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-// NewGetCategoriesRequest generates requests for GetCategories
-func NewGetCategoriesRequest(server string, params *GetCategoriesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateTransaction(ctx context.Context, idEvent int64, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransactionRequest(c.Server, idEvent, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/category")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteTransaction(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTransactionRequest(c.Server, idEvent, idTransaction)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_type", runtime.ParamLocationQuery, params.CategoryType); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetTransactionByID(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionByIDRequest(c.Server, idEvent, idTransaction)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetCategoryByIDRequest generates requests for GetCategoryByID
-func NewGetCategoryByIDRequest(server string, id int, params *GetCategoryByIDParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) UpdateTransactionWithBody(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTransactionRequestWithBody(c.Server, idEvent, idTransaction, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateTransaction(ctx context.Context, idEvent int64, idTransaction int, body UpdateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTransactionRequest(c.Server, idEvent, idTransaction, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/category/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AttachTransactionFileWithBody(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachTransactionFileRequestWithBody(c.Server, idEvent, idTransaction, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_type", runtime.ParamLocationQuery, params.CategoryType); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) AttachTransactionFile(ctx context.Context, idEvent int64, idTransaction int, body AttachTransactionFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachTransactionFileRequest(c.Server, idEvent, idTransaction, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetEventsRequest generates requests for GetEvents
-func NewGetEventsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DetachTransactionFile(ctx context.Context, idEvent int64, idTransaction int, idAttachment int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDetachTransactionFileRequest(c.Server, idEvent, idTransaction, idAttachment)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetTransactionAttachmentUrl(ctx context.Context, idEvent int64, idTransaction int, idAttachment int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionAttachmentUrlRequest(c.Server, idEvent, idTransaction, idAttachment)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateEventRequest calls the generic CreateEvent builder with application/json body
-func NewCreateEventRequest(server string, body CreateEventJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetTransactionHistory(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionHistoryRequest(c.Server, idEvent, idTransaction)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateEventRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateEventRequestWithBody generates requests for CreateEvent with any type of body
-func NewCreateEventRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RevertTransaction(ctx context.Context, idEvent int64, idTransaction int, idRevision int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevertTransactionRequest(c.Server, idEvent, idTransaction, idRevision)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetTransactionItems(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionItemsRequest(c.Server, idEvent, idTransaction)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteEventRequest generates requests for DeleteEvent
-func NewDeleteEventRequest(server string, idEvent int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) CreateTransactionItemWithBody(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransactionItemRequestWithBody(c.Server, idEvent, idTransaction, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateTransactionItem(ctx context.Context, idEvent int64, idTransaction int, body CreateTransactionItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransactionItemRequest(c.Server, idEvent, idTransaction, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteTransactionItem(ctx context.Context, idEvent int64, idTransaction int, idItem int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTransactionItemRequest(c.Server, idEvent, idTransaction, idItem)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetEventByIDRequest generates requests for GetEventByID
-func NewGetEventByIDRequest(server string, idEvent int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) UpdateTransactionItemWithBody(ctx context.Context, idEvent int64, idTransaction int, idItem int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTransactionItemRequestWithBody(c.Server, idEvent, idTransaction, idItem, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateTransactionItem(ctx context.Context, idEvent int64, idTransaction int, idItem int, body UpdateTransactionItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTransactionItemRequest(c.Server, idEvent, idTransaction, idItem, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RestoreTransaction(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreTransactionRequest(c.Server, idEvent, idTransaction)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateEventRequest calls the generic UpdateEvent builder with application/json body
-func NewUpdateEventRequest(server string, idEvent int64, body UpdateEventJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetEventTrash(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventTrashRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateEventRequestWithBody(server, idEvent, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateEventRequestWithBody generates requests for UpdateEvent with any type of body
-func NewUpdateEventRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) GetUsersByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersByEventIDRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AddUsersToEventWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddUsersToEventRequestWithBody(c.Server, idEvent, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddUsersToEvent(ctx context.Context, idEvent int64, body AddUsersToEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddUsersToEventRequest(c.Server, idEvent, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetActivitiesByEventIDRequest generates requests for GetActivitiesByEventID
-func NewGetActivitiesByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) GetDummiesByEventID(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDummiesByEventIDRequest(c.Server, idEvent)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateDummyUserWithBody(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDummyUserRequestWithBody(c.Server, idEvent, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event/%s/activity", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateDummyUser(ctx context.Context, idEvent int64, body CreateDummyUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDummyUserRequest(c.Server, idEvent, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) RemoveUserFromEvent(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveUserFromEventRequest(c.Server, idEvent, idUser)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateActivityRequest calls the generic CreateActivity builder with application/json body
-func NewCreateActivityRequest(server string, idEvent int64, body CreateActivityJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateDummyClaim(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDummyClaimRequest(c.Server, idEvent, idUser)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateActivityRequestWithBody(server, idEvent, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateActivityRequestWithBody generates requests for CreateActivity with any type of body
-func NewCreateActivityRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) GetOptimizedDebtsByUserID(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOptimizedDebtsByUserIDRequest(c.Server, idEvent, idUser)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateEventUserRoleWithBody(ctx context.Context, idEvent int64, idUser int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEventUserRoleRequestWithBody(c.Server, idEvent, idUser, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event/%s/activity", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateEventUserRole(ctx context.Context, idEvent int64, idUser int64, body UpdateEventUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEventUserRoleRequest(c.Server, idEvent, idUser, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteActivityRequest generates requests for DeleteActivity
-func NewDeleteActivityRequest(server string, idEvent int64, idActivity int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) GetExchangeRates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExchangeRatesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_activity", runtime.ParamLocationPath, idActivity)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) JoinEventByInvite(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewJoinEventByInviteRequest(c.Server, token)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event/%s/activity/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateCategoryWithBody(ctx context.Context, params *CreateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCategoryRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetActivityByIDRequest generates requests for GetActivityByID
-func NewGetActivityByIDRequest(server string, idEvent int64, idActivity int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) CreateCategory(ctx context.Context, params *CreateCategoryParams, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCategoryRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_activity", runtime.ParamLocationPath, idActivity)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteCategory(ctx context.Context, id int, params *DeleteCategoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCategoryRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event/%s/activity/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateCategoryWithBody(ctx context.Context, id int, params *UpdateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCategoryRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateActivityRequest calls the generic UpdateActivity builder with application/json body
-func NewUpdateActivityRequest(server string, idEvent int64, idActivity int, body UpdateActivityJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateCategory(ctx context.Context, id int, params *UpdateCategoryParams, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCategoryRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateActivityRequestWithBody(server, idEvent, idActivity, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateActivityRequestWithBody generates requests for UpdateActivity with any type of body
-func NewUpdateActivityRequestWithBody(server string, idEvent int64, idActivity int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) SetExchangeRateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetExchangeRateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_activity", runtime.ParamLocationPath, idActivity)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SetExchangeRate(ctx context.Context, body SetExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetExchangeRateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event/%s/activity/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteExchangeRate(ctx context.Context, currencyFrom string, currencyTo string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteExchangeRateRequest(c.Server, currencyFrom, currencyTo)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDebtsByEventIDRequest generates requests for GetDebtsByEventID
-func NewGetDebtsByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) GetIcons(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIconsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateIconWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIconRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event/%s/debts", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateIcon(ctx context.Context, body CreateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIconRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetOptimizedDebtsByEventIDRequest generates requests for GetOptimizedDebtsByEventID
-func NewGetOptimizedDebtsByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) DeleteIcon(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteIconRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetIconByID(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIconByIDRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/event/%s/optimized-debts", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateIconWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIconRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewOptimizeDebtsRequest generates requests for OptimizeDebts
-func NewOptimizeDebtsRequest(server string, idEvent int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) UpdateIcon(ctx context.Context, id int, body UpdateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIconRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/optimized-debts", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetUsersByExternalIDs(ctx context.Context, params *GetUsersByExternalIDsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersByExternalIDsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetTasksByEventIDRequest generates requests for GetTasksByEventID
-func NewGetTasksByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+func (c *Client) GetUserByID(ctx context.Context, idUser int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserByIDRequest(c.Server, idUser)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/task", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SyncUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncUsersRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateTaskRequest calls the generic CreateTask builder with application/json body
-func NewCreateTaskRequest(server string, idEvent int64, body CreateTaskJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) SyncUsers(ctx context.Context, body SyncUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncUsersRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTaskRequestWithBody(server, idEvent, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateTaskRequestWithBody generates requests for CreateTask with any type of body
-func NewCreateTaskRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetCategoriesRequest generates requests for GetCategories
+func NewGetCategoriesRequest(server string, params *GetCategoriesParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/task", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/category")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1599,30 +1734,39 @@ func NewCreateTaskRequestWithBody(server string, idEvent int64, contentType stri
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_type", runtime.ParamLocationQuery, params.CategoryType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteTaskRequest generates requests for DeleteTask
-func NewDeleteTaskRequest(server string, idEvent int64, idTask int) (*http.Request, error) {
+// NewGetCategoryByIDRequest generates requests for GetCategoryByID
+func NewGetCategoryByIDRequest(server string, id int, params *GetCategoryByIDParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_task", runtime.ParamLocationPath, idTask)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1632,7 +1776,7 @@ func NewDeleteTaskRequest(server string, idEvent int64, idTask int) (*http.Reque
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/task/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/category/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1642,38 +1786,42 @@ func NewDeleteTaskRequest(server string, idEvent int64, idTask int) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// NewGetTaskByIDRequest generates requests for GetTaskByID
-func NewGetTaskByIDRequest(server string, idEvent int64, idTask int) (*http.Request, error) {
-	var err error
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_type", runtime.ParamLocationQuery, params.CategoryType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	var pathParam0 string
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	return req, nil
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_task", runtime.ParamLocationPath, idTask)
-	if err != nil {
-		return nil, err
-	}
+// NewGetEventsRequest generates requests for GetEvents
+func NewGetEventsRequest(server string, params *GetEventsParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/task/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/event")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1683,6 +1831,28 @@ func NewGetTaskByIDRequest(server string, idEvent int64, idTask int) (*http.Requ
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeArchived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_archived", runtime.ParamLocationQuery, *params.IncludeArchived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1691,41 +1861,27 @@ func NewGetTaskByIDRequest(server string, idEvent int64, idTask int) (*http.Requ
 	return req, nil
 }
 
-// NewUpdateTaskRequest calls the generic UpdateTask builder with application/json body
-func NewUpdateTaskRequest(server string, idEvent int64, idTask int, body UpdateTaskJSONRequestBody) (*http.Request, error) {
+// NewCreateEventRequest calls the generic CreateEvent builder with application/json body
+func NewCreateEventRequest(server string, body CreateEventJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTaskRequestWithBody(server, idEvent, idTask, "application/json", bodyReader)
+	return NewCreateEventRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateTaskRequestWithBody generates requests for UpdateTask with any type of body
-func NewUpdateTaskRequestWithBody(server string, idEvent int64, idTask int, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateEventRequestWithBody generates requests for CreateEvent with any type of body
+func NewCreateEventRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_task", runtime.ParamLocationPath, idTask)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/task/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/event")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1735,7 +1891,7 @@ func NewUpdateTaskRequestWithBody(server string, idEvent int64, idTask int, cont
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1745,23 +1901,16 @@ func NewUpdateTaskRequestWithBody(server string, idEvent int64, idTask int, cont
 	return req, nil
 }
 
-// NewGetTransactionsByEventIDRequest generates requests for GetTransactionsByEventID
-func NewGetTransactionsByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
+// NewGetDeletedEventsRequest generates requests for GetDeletedEvents
+func NewGetDeletedEventsRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/trash")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1779,24 +1928,13 @@ func NewGetTransactionsByEventIDRequest(server string, idEvent int64) (*http.Req
 	return req, nil
 }
 
-// NewCreateTransactionRequest calls the generic CreateTransaction builder with application/json body
-func NewCreateTransactionRequest(server string, idEvent int64, body CreateTransactionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTransactionRequestWithBody(server, idEvent, "application/json", bodyReader)
-}
-
-// NewCreateTransactionRequestWithBody generates requests for CreateTransaction with any type of body
-func NewCreateTransactionRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewRestoreEventRequest generates requests for RestoreEvent
+func NewRestoreEventRequest(server string, idDeletedEvent int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_deleted_event", runtime.ParamLocationPath, idDeletedEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -1806,7 +1944,7 @@ func NewCreateTransactionRequestWithBody(server string, idEvent int64, contentTy
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/trash/%s/restore", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1816,18 +1954,16 @@ func NewCreateTransactionRequestWithBody(server string, idEvent int64, contentTy
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteTransactionRequest generates requests for DeleteTransaction
-func NewDeleteTransactionRequest(server string, idEvent int64, idTransaction int) (*http.Request, error) {
+// NewDeleteEventRequest generates requests for DeleteEvent
+func NewDeleteEventRequest(server string, idEvent int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1837,19 +1973,12 @@ func NewDeleteTransactionRequest(server string, idEvent int64, idTransaction int
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/event/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1867,8 +1996,8 @@ func NewDeleteTransactionRequest(server string, idEvent int64, idTransaction int
 	return req, nil
 }
 
-// NewGetTransactionByIDRequest generates requests for GetTransactionByID
-func NewGetTransactionByIDRequest(server string, idEvent int64, idTransaction int) (*http.Request, error) {
+// NewGetEventByIDRequest generates requests for GetEventByID
+func NewGetEventByIDRequest(server string, idEvent int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1878,19 +2007,12 @@ func NewGetTransactionByIDRequest(server string, idEvent int64, idTransaction in
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/event/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1908,19 +2030,19 @@ func NewGetTransactionByIDRequest(server string, idEvent int64, idTransaction in
 	return req, nil
 }
 
-// NewUpdateTransactionRequest calls the generic UpdateTransaction builder with application/json body
-func NewUpdateTransactionRequest(server string, idEvent int64, idTransaction int, body UpdateTransactionJSONRequestBody) (*http.Request, error) {
+// NewUpdateEventRequest calls the generic UpdateEvent builder with application/json body
+func NewUpdateEventRequest(server string, idEvent int64, body UpdateEventJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTransactionRequestWithBody(server, idEvent, idTransaction, "application/json", bodyReader)
+	return NewUpdateEventRequestWithBody(server, idEvent, "application/json", bodyReader)
 }
 
-// NewUpdateTransactionRequestWithBody generates requests for UpdateTransaction with any type of body
-func NewUpdateTransactionRequestWithBody(server string, idEvent int64, idTransaction int, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateEventRequestWithBody generates requests for UpdateEvent with any type of body
+func NewUpdateEventRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1930,19 +2052,12 @@ func NewUpdateTransactionRequestWithBody(server string, idEvent int64, idTransac
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/event/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1962,8 +2077,8 @@ func NewUpdateTransactionRequestWithBody(server string, idEvent int64, idTransac
 	return req, nil
 }
 
-// NewGetUsersByEventIDRequest generates requests for GetUsersByEventID
-func NewGetUsersByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
+// NewGetActivitiesByEventIDRequest generates requests for GetActivitiesByEventID
+func NewGetActivitiesByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1978,7 +2093,7 @@ func NewGetUsersByEventIDRequest(server string, idEvent int64) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/user", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/activity", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1996,19 +2111,19 @@ func NewGetUsersByEventIDRequest(server string, idEvent int64) (*http.Request, e
 	return req, nil
 }
 
-// NewAddUsersToEventRequest calls the generic AddUsersToEvent builder with application/json body
-func NewAddUsersToEventRequest(server string, idEvent int64, body AddUsersToEventJSONRequestBody) (*http.Request, error) {
+// NewCreateActivityRequest calls the generic CreateActivity builder with application/json body
+func NewCreateActivityRequest(server string, idEvent int64, body CreateActivityJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAddUsersToEventRequestWithBody(server, idEvent, "application/json", bodyReader)
+	return NewCreateActivityRequestWithBody(server, idEvent, "application/json", bodyReader)
 }
 
-// NewAddUsersToEventRequestWithBody generates requests for AddUsersToEvent with any type of body
-func NewAddUsersToEventRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateActivityRequestWithBody generates requests for CreateActivity with any type of body
+func NewCreateActivityRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2023,7 +2138,7 @@ func NewAddUsersToEventRequestWithBody(server string, idEvent int64, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/user", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/activity", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2043,8 +2158,8 @@ func NewAddUsersToEventRequestWithBody(server string, idEvent int64, contentType
 	return req, nil
 }
 
-// NewGetDummiesByEventIDRequest generates requests for GetDummiesByEventID
-func NewGetDummiesByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
+// NewDeleteActivityRequest generates requests for DeleteActivity
+func NewDeleteActivityRequest(server string, idEvent int64, idActivity int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2054,12 +2169,19 @@ func NewGetDummiesByEventIDRequest(server string, idEvent int64) (*http.Request,
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_activity", runtime.ParamLocationPath, idActivity)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/user/dummies", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/activity/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2069,7 +2191,7 @@ func NewGetDummiesByEventIDRequest(server string, idEvent int64) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2077,19 +2199,8 @@ func NewGetDummiesByEventIDRequest(server string, idEvent int64) (*http.Request,
 	return req, nil
 }
 
-// NewCreateDummyUserRequest calls the generic CreateDummyUser builder with application/json body
-func NewCreateDummyUserRequest(server string, idEvent int64, body CreateDummyUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDummyUserRequestWithBody(server, idEvent, "application/json", bodyReader)
-}
-
-// NewCreateDummyUserRequestWithBody generates requests for CreateDummyUser with any type of body
-func NewCreateDummyUserRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetActivityByIDRequest generates requests for GetActivityByID
+func NewGetActivityByIDRequest(server string, idEvent int64, idActivity int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2099,12 +2210,19 @@ func NewCreateDummyUserRequestWithBody(server string, idEvent int64, contentType
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_activity", runtime.ParamLocationPath, idActivity)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/user/dummy", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/activity/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2114,18 +2232,27 @@ func NewCreateDummyUserRequestWithBody(server string, idEvent int64, contentType
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewRemoveUserFromEventRequest generates requests for RemoveUserFromEvent
-func NewRemoveUserFromEventRequest(server string, idEvent int64, idUser int64) (*http.Request, error) {
+// NewUpdateActivityRequest calls the generic UpdateActivity builder with application/json body
+func NewUpdateActivityRequest(server string, idEvent int64, idActivity int, body UpdateActivityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateActivityRequestWithBody(server, idEvent, idActivity, "application/json", bodyReader)
+}
+
+// NewUpdateActivityRequestWithBody generates requests for UpdateActivity with any type of body
+func NewUpdateActivityRequestWithBody(server string, idEvent int64, idActivity int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2137,7 +2264,7 @@ func NewRemoveUserFromEventRequest(server string, idEvent int64, idUser int64) (
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_user", runtime.ParamLocationPath, idUser)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_activity", runtime.ParamLocationPath, idActivity)
 	if err != nil {
 		return nil, err
 	}
@@ -2147,7 +2274,7 @@ func NewRemoveUserFromEventRequest(server string, idEvent int64, idUser int64) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/user/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/activity/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2157,16 +2284,18 @@ func NewRemoveUserFromEventRequest(server string, idEvent int64, idUser int64) (
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetOptimizedDebtsByUserIDRequest generates requests for GetOptimizedDebtsByUserID
-func NewGetOptimizedDebtsByUserIDRequest(server string, idEvent int64, idUser int64) (*http.Request, error) {
+// NewGetEventAnalyticsRequest generates requests for GetEventAnalytics
+func NewGetEventAnalyticsRequest(server string, idEvent int64, params *GetEventAnalyticsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2176,19 +2305,12 @@ func NewGetOptimizedDebtsByUserIDRequest(server string, idEvent int64, idUser in
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_user", runtime.ParamLocationPath, idUser)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/event/%s/user/%s/optimized-debts", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/analytics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2198,6 +2320,76 @@ func NewGetOptimizedDebtsByUserIDRequest(server string, idEvent int64, idUser in
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Interval != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interval", runtime.ParamLocationQuery, *params.Interval); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Top != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "top", runtime.ParamLocationQuery, *params.Top); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2206,27 +2398,23 @@ func NewGetOptimizedDebtsByUserIDRequest(server string, idEvent int64, idUser in
 	return req, nil
 }
 
-// NewCreateCategoryRequest calls the generic CreateCategory builder with application/json body
-func NewCreateCategoryRequest(server string, params *CreateCategoryParams, body CreateCategoryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewArchiveEventRequest generates requests for ArchiveEvent
+func NewArchiveEventRequest(server string, idEvent int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateCategoryRequestWithBody(server, params, "application/json", bodyReader)
-}
-
-// NewCreateCategoryRequestWithBody generates requests for CreateCategory with any type of body
-func NewCreateCategoryRequestWithBody(server string, params *CreateCategoryParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/manage/category")
+	operationPath := fmt.Sprintf("/api/v1/event/%s/archive", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2236,41 +2424,21 @@ func NewCreateCategoryRequestWithBody(server string, params *CreateCategoryParam
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_type", runtime.ParamLocationQuery, params.CategoryType); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteCategoryRequest generates requests for DeleteCategory
-func NewDeleteCategoryRequest(server string, id int, params *DeleteCategoryParams) (*http.Request, error) {
+// NewGetDummyClaimsRequest generates requests for GetDummyClaims
+func NewGetDummyClaimsRequest(server string, idEvent int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -2280,7 +2448,7 @@ func NewDeleteCategoryRequest(server string, id int, params *DeleteCategoryParam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/manage/category/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/claim", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2290,25 +2458,7 @@ func NewDeleteCategoryRequest(server string, id int, params *DeleteCategoryParam
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_type", runtime.ParamLocationQuery, params.CategoryType); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2316,24 +2466,20 @@ func NewDeleteCategoryRequest(server string, id int, params *DeleteCategoryParam
 	return req, nil
 }
 
-// NewUpdateCategoryRequest calls the generic UpdateCategory builder with application/json body
-func NewUpdateCategoryRequest(server string, id int, params *UpdateCategoryParams, body UpdateCategoryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewApproveDummyClaimRequest generates requests for ApproveDummyClaim
+func NewApproveDummyClaimRequest(server string, idEvent int64, idClaim int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCategoryRequestWithBody(server, id, params, "application/json", bodyReader)
-}
-
-// NewUpdateCategoryRequestWithBody generates requests for UpdateCategory with any type of body
-func NewUpdateCategoryRequestWithBody(server string, id int, params *UpdateCategoryParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_claim", runtime.ParamLocationPath, idClaim)
 	if err != nil {
 		return nil, err
 	}
@@ -2343,7 +2489,7 @@ func NewUpdateCategoryRequestWithBody(server string, id int, params *UpdateCateg
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/manage/category/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/claim/%s/approve", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2353,44 +2499,38 @@ func NewUpdateCategoryRequestWithBody(server string, id int, params *UpdateCateg
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_type", runtime.ParamLocationQuery, params.CategoryType); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetIconsRequest generates requests for GetIcons
-func NewGetIconsRequest(server string) (*http.Request, error) {
+// NewRejectDummyClaimRequest generates requests for RejectDummyClaim
+func NewRejectDummyClaimRequest(server string, idEvent int64, idClaim int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_claim", runtime.ParamLocationPath, idClaim)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/manage/icons")
+	operationPath := fmt.Sprintf("/api/v1/event/%s/claim/%s/reject", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2400,7 +2540,7 @@ func NewGetIconsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2408,27 +2548,23 @@ func NewGetIconsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateIconRequest calls the generic CreateIcon builder with application/json body
-func NewCreateIconRequest(server string, body CreateIconJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewCloseEventRequest generates requests for CloseEvent
+func NewCloseEventRequest(server string, idEvent int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateIconRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCreateIconRequestWithBody generates requests for CreateIcon with any type of body
-func NewCreateIconRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/manage/icons")
+	operationPath := fmt.Sprintf("/api/v1/event/%s/close", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2438,23 +2574,21 @@ func NewCreateIconRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteIconRequest generates requests for DeleteIcon
-func NewDeleteIconRequest(server string, id int) (*http.Request, error) {
+// NewGetDebtsByEventIDRequest generates requests for GetDebtsByEventID
+func NewGetDebtsByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -2464,7 +2598,7 @@ func NewDeleteIconRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/manage/icons/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/debts", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2474,7 +2608,7 @@ func NewDeleteIconRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2482,13 +2616,13 @@ func NewDeleteIconRequest(server string, id int) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetIconByIDRequest generates requests for GetIconByID
-func NewGetIconByIDRequest(server string, id int) (*http.Request, error) {
+// NewExportEventRequest generates requests for ExportEvent
+func NewExportEventRequest(server string, idEvent int64, params *ExportEventParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -2498,7 +2632,7 @@ func NewGetIconByIDRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/manage/icons/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/export", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2508,6 +2642,28 @@ func NewGetIconByIDRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2516,24 +2672,13 @@ func NewGetIconByIDRequest(server string, id int) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateIconRequest calls the generic UpdateIcon builder with application/json body
-func NewUpdateIconRequest(server string, id int, body UpdateIconJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateIconRequestWithBody(server, id, "application/json", bodyReader)
-}
-
-// NewUpdateIconRequestWithBody generates requests for UpdateIcon with any type of body
-func NewUpdateIconRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetActivityFeedRequest generates requests for GetActivityFeed
+func NewGetActivityFeedRequest(server string, idEvent int64, params *GetActivityFeedParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -2543,7 +2688,7 @@ func NewUpdateIconRequestWithBody(server string, id int, contentType string, bod
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/manage/icons/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/feed", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2553,26 +2698,133 @@ func NewUpdateIconRequestWithBody(server string, id int, contentType string, bod
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetUsersByExternalIDsRequest generates requests for GetUsersByExternalIDs
-func NewGetUsersByExternalIDsRequest(server string, params *GetUsersByExternalIDsParams) (*http.Request, error) {
+// NewImportTransactionsRequestWithBody generates requests for ImportTransactions with any type of body
+func NewImportTransactionsRequestWithBody(server string, idEvent int64, params *ImportTransactionsParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/user/external")
+	operationPath := fmt.Sprintf("/api/v1/event/%s/import", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2585,36 +2837,58 @@ func NewGetUsersByExternalIDsRequest(server string, params *GetUsersByExternalID
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "uids", runtime.ParamLocationQuery, params.Uids); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetUserByIDRequest generates requests for GetUserByID
-func NewGetUserByIDRequest(server string, idUser int64) (*http.Request, error) {
+// NewGetEventInvitesRequest generates requests for GetEventInvites
+func NewGetEventInvitesRequest(server string, idEvent int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_user", runtime.ParamLocationPath, idUser)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -2624,7 +2898,7 @@ func NewGetUserByIDRequest(server string, idUser int64) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/user/internal/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/invite", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2642,27 +2916,34 @@ func NewGetUserByIDRequest(server string, idUser int64) (*http.Request, error) {
 	return req, nil
 }
 
-// NewSyncUsersRequest calls the generic SyncUsers builder with application/json body
-func NewSyncUsersRequest(server string, body SyncUsersJSONRequestBody) (*http.Request, error) {
+// NewCreateEventInviteRequest calls the generic CreateEventInvite builder with application/json body
+func NewCreateEventInviteRequest(server string, idEvent int64, body CreateEventInviteJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSyncUsersRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateEventInviteRequestWithBody(server, idEvent, "application/json", bodyReader)
 }
 
-// NewSyncUsersRequestWithBody generates requests for SyncUsers with any type of body
-func NewSyncUsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateEventInviteRequestWithBody generates requests for CreateEventInvite with any type of body
+func NewCreateEventInviteRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/user/sync")
+	operationPath := fmt.Sprintf("/api/v1/event/%s/invite", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2682,1726 +2963,8236 @@ func NewSyncUsersRequestWithBody(server string, contentType string, body io.Read
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewRevokeEventInviteRequest generates requests for RevokeEventInvite
+func NewRevokeEventInviteRequest(server string, idEvent int64, idInvite int) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_invite", runtime.ParamLocationPath, idInvite)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetCategoriesWithResponse request
-	GetCategoriesWithResponse(ctx context.Context, params *GetCategoriesParams, reqEditors ...RequestEditorFn) (*GetCategoriesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCategoryByIDWithResponse request
-	GetCategoryByIDWithResponse(ctx context.Context, id int, params *GetCategoryByIDParams, reqEditors ...RequestEditorFn) (*GetCategoryByIDResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/invite/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetEventsWithResponse request
-	GetEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEventsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateEventWithBodyWithResponse request with any body
-	CreateEventWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEventResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateEventWithResponse(ctx context.Context, body CreateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEventResponse, error)
+	return req, nil
+}
 
-	// DeleteEventWithResponse request
-	DeleteEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*DeleteEventResponse, error)
+// NewGetOptimizedDebtsByEventIDRequest generates requests for GetOptimizedDebtsByEventID
+func NewGetOptimizedDebtsByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
+	var err error
 
-	// GetEventByIDWithResponse request
-	GetEventByIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetEventByIDResponse, error)
+	var pathParam0 string
 
-	// UpdateEventWithBodyWithResponse request with any body
-	UpdateEventWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEventResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateEventWithResponse(ctx context.Context, idEvent int64, body UpdateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEventResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetActivitiesByEventIDWithResponse request
-	GetActivitiesByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetActivitiesByEventIDResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/optimized-debts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreateActivityWithBodyWithResponse request with any body
-	CreateActivityWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateActivityWithResponse(ctx context.Context, idEvent int64, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteActivityWithResponse request
-	DeleteActivityWithResponse(ctx context.Context, idEvent int64, idActivity int, reqEditors ...RequestEditorFn) (*DeleteActivityResponse, error)
-
-	// GetActivityByIDWithResponse request
-	GetActivityByIDWithResponse(ctx context.Context, idEvent int64, idActivity int, reqEditors ...RequestEditorFn) (*GetActivityByIDResponse, error)
+	return req, nil
+}
 
-	// UpdateActivityWithBodyWithResponse request with any body
-	UpdateActivityWithBodyWithResponse(ctx context.Context, idEvent int64, idActivity int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error)
+// NewOptimizeDebtsRequest generates requests for OptimizeDebts
+func NewOptimizeDebtsRequest(server string, idEvent int64, params *OptimizeDebtsParams) (*http.Request, error) {
+	var err error
 
-	UpdateActivityWithResponse(ctx context.Context, idEvent int64, idActivity int, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error)
+	var pathParam0 string
 
-	// GetDebtsByEventIDWithResponse request
-	GetDebtsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetDebtsByEventIDResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOptimizedDebtsByEventIDWithResponse request
-	GetOptimizedDebtsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetOptimizedDebtsByEventIDResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// OptimizeDebtsWithResponse request
-	OptimizeDebtsWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*OptimizeDebtsResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/optimized-debts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetTasksByEventIDWithResponse request
-	GetTasksByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetTasksByEventIDResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateTaskWithBodyWithResponse request with any body
-	CreateTaskWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	CreateTaskWithResponse(ctx context.Context, idEvent int64, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error)
+		if params.Algorithm != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "algorithm", runtime.ParamLocationQuery, *params.Algorithm); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// DeleteTaskWithResponse request
-	DeleteTaskWithResponse(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*DeleteTaskResponse, error)
+		}
 
-	// GetTaskByIDWithResponse request
-	GetTaskByIDWithResponse(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*GetTaskByIDResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// UpdateTaskWithBodyWithResponse request with any body
-	UpdateTaskWithBodyWithResponse(ctx context.Context, idEvent int64, idTask int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateTaskWithResponse(ctx context.Context, idEvent int64, idTask int, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error)
+	return req, nil
+}
 
-	// GetTransactionsByEventIDWithResponse request
-	GetTransactionsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetTransactionsByEventIDResponse, error)
+// NewTransferEventOwnershipRequest calls the generic TransferEventOwnership builder with application/json body
+func NewTransferEventOwnershipRequest(server string, idEvent int64, body TransferEventOwnershipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTransferEventOwnershipRequestWithBody(server, idEvent, "application/json", bodyReader)
+}
 
-	// CreateTransactionWithBodyWithResponse request with any body
-	CreateTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error)
+// NewTransferEventOwnershipRequestWithBody generates requests for TransferEventOwnership with any type of body
+func NewTransferEventOwnershipRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	CreateTransactionWithResponse(ctx context.Context, idEvent int64, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error)
+	var pathParam0 string
 
-	// DeleteTransactionWithResponse request
-	DeleteTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*DeleteTransactionResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetTransactionByIDWithResponse request
-	GetTransactionByIDWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*GetTransactionByIDResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateTransactionWithBodyWithResponse request with any body
-	UpdateTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransactionResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/owner", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	UpdateTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, body UpdateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransactionResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetUsersByEventIDWithResponse request
-	GetUsersByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetUsersByEventIDResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddUsersToEventWithBodyWithResponse request with any body
-	AddUsersToEventWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddUsersToEventResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	AddUsersToEventWithResponse(ctx context.Context, idEvent int64, body AddUsersToEventJSONRequestBody, reqEditors ...RequestEditorFn) (*AddUsersToEventResponse, error)
+	return req, nil
+}
 
-	// GetDummiesByEventIDWithResponse request
-	GetDummiesByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetDummiesByEventIDResponse, error)
+// NewGetRecurringTransactionsRequest generates requests for GetRecurringTransactions
+func NewGetRecurringTransactionsRequest(server string, idEvent int64) (*http.Request, error) {
+	var err error
 
-	// CreateDummyUserWithBodyWithResponse request with any body
-	CreateDummyUserWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDummyUserResponse, error)
+	var pathParam0 string
 
-	CreateDummyUserWithResponse(ctx context.Context, idEvent int64, body CreateDummyUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDummyUserResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
 
-	// RemoveUserFromEventWithResponse request
-	RemoveUserFromEventWithResponse(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*RemoveUserFromEventResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOptimizedDebtsByUserIDWithResponse request
-	GetOptimizedDebtsByUserIDWithResponse(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*GetOptimizedDebtsByUserIDResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/recurring", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreateCategoryWithBodyWithResponse request with any body
-	CreateCategoryWithBodyWithResponse(ctx context.Context, params *CreateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateCategoryWithResponse(ctx context.Context, params *CreateCategoryParams, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteCategoryWithResponse request
-	DeleteCategoryWithResponse(ctx context.Context, id int, params *DeleteCategoryParams, reqEditors ...RequestEditorFn) (*DeleteCategoryResponse, error)
+	return req, nil
+}
 
-	// UpdateCategoryWithBodyWithResponse request with any body
-	UpdateCategoryWithBodyWithResponse(ctx context.Context, id int, params *UpdateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error)
+// NewCreateRecurringTransactionRequest calls the generic CreateRecurringTransaction builder with application/json body
+func NewCreateRecurringTransactionRequest(server string, idEvent int64, body CreateRecurringTransactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRecurringTransactionRequestWithBody(server, idEvent, "application/json", bodyReader)
+}
 
-	UpdateCategoryWithResponse(ctx context.Context, id int, params *UpdateCategoryParams, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error)
+// NewCreateRecurringTransactionRequestWithBody generates requests for CreateRecurringTransaction with any type of body
+func NewCreateRecurringTransactionRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetIconsWithResponse request
-	GetIconsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIconsResponse, error)
+	var pathParam0 string
 
-	// CreateIconWithBodyWithResponse request with any body
-	CreateIconWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIconResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateIconWithResponse(ctx context.Context, body CreateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIconResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteIconWithResponse request
-	DeleteIconWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteIconResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/recurring", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetIconByIDWithResponse request
-	GetIconByIDWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetIconByIDResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateIconWithBodyWithResponse request with any body
-	UpdateIconWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIconResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateIconWithResponse(ctx context.Context, id int, body UpdateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIconResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetUsersByExternalIDsWithResponse request
-	GetUsersByExternalIDsWithResponse(ctx context.Context, params *GetUsersByExternalIDsParams, reqEditors ...RequestEditorFn) (*GetUsersByExternalIDsResponse, error)
+	return req, nil
+}
 
-	// GetUserByIDWithResponse request
-	GetUserByIDWithResponse(ctx context.Context, idUser int64, reqEditors ...RequestEditorFn) (*GetUserByIDResponse, error)
+// NewDeleteRecurringTransactionRequest generates requests for DeleteRecurringTransaction
+func NewDeleteRecurringTransactionRequest(server string, idEvent int64, idRecurring int) (*http.Request, error) {
+	var err error
 
-	// SyncUsersWithBodyWithResponse request with any body
-	SyncUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncUsersResponse, error)
+	var pathParam0 string
 
-	SyncUsersWithResponse(ctx context.Context, body SyncUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*SyncUsersResponse, error)
-}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
 
-type GetCategoriesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CategoryListResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetCategoriesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_recurring", runtime.ParamLocationPath, idRecurring)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCategoriesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCategoryByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CategoryResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/recurring/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetCategoryByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCategoryByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type GetEventsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *EventListResponse
-	JSON500      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r GetEventsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateRecurringTransactionRequest calls the generic UpdateRecurringTransaction builder with application/json body
+func NewUpdateRecurringTransactionRequest(server string, idEvent int64, idRecurring int, body UpdateRecurringTransactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRecurringTransactionRequestWithBody(server, idEvent, idRecurring, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetEventsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUpdateRecurringTransactionRequestWithBody generates requests for UpdateRecurringTransaction with any type of body
+func NewUpdateRecurringTransactionRequestWithBody(server string, idEvent int64, idRecurring int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateEventResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *EventResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r CreateEventResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_recurring", runtime.ParamLocationPath, idRecurring)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateEventResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteEventResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/recurring/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteEventResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteEventResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetEventByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *EventResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetEventByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetEventByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPauseRecurringTransactionRequest generates requests for PauseRecurringTransaction
+func NewPauseRecurringTransactionRequest(server string, idEvent int64, idRecurring int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateEventResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *EventResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r UpdateEventResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_recurring", runtime.ParamLocationPath, idRecurring)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateEventResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetActivitiesByEventIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ActivityListResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/recurring/%s/pause", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetActivitiesByEventIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetActivitiesByEventIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateActivityResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ActivityResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateActivityResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewResumeRecurringTransactionRequest generates requests for ResumeRecurringTransaction
+func NewResumeRecurringTransactionRequest(server string, idEvent int64, idRecurring int) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateActivityResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteActivityResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteActivityResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_recurring", runtime.ParamLocationPath, idRecurring)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteActivityResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetActivityByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ActivityResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/recurring/%s/resume", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetActivityByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetActivityByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateActivityResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ActivityResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateActivityResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateActivityResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewReopenEventRequest generates requests for ReopenEvent
+func NewReopenEventRequest(server string, idEvent int64) (*http.Request, error) {
+	var err error
 
-type GetDebtsByEventIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DebtListResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetDebtsByEventIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDebtsByEventIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetOptimizedDebtsByEventIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OptimizedDebtListResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/reopen", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetOptimizedDebtsByEventIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOptimizedDebtsByEventIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type OptimizeDebtsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OptimizedDebtListResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r OptimizeDebtsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSettleEventRequest generates requests for SettleEvent
+func NewSettleEventRequest(server string, idEvent int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r OptimizeDebtsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetTasksByEventIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TaskListResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/settle", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetTasksByEventIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTasksByEventIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TaskResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetSettlementsByEventIDRequest generates requests for GetSettlementsByEventID
+func NewGetSettlementsByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/settlement", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetTaskByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TaskResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTaskByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateSettlementRequest calls the generic CreateSettlement builder with application/json body
+func NewCreateSettlementRequest(server string, idEvent int64, body CreateSettlementJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSettlementRequestWithBody(server, idEvent, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTaskByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewCreateSettlementRequestWithBody generates requests for CreateSettlement with any type of body
+func NewCreateSettlementRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type UpdateTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TaskResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r UpdateTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetTransactionsByEventIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TransactionListResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/settlement", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetTransactionsByEventIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTransactionsByEventIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateTransactionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TransactionResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r CreateTransactionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTransactionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewDeleteSettlementRequest generates requests for DeleteSettlement
+func NewDeleteSettlementRequest(server string, idEvent int64, idSettlement int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteTransactionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteTransactionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_settlement", runtime.ParamLocationPath, idSettlement)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTransactionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetTransactionByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TransactionResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/settlement/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetTransactionByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTransactionByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateTransactionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TransactionResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateTransactionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetTasksByEventIDRequest generates requests for GetTasksByEventID
+func NewGetTasksByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTransactionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetUsersByEventIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserListResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/task", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetUsersByEventIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersByEventIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AddUsersToEventResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AddUsersToEventResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateTaskRequest calls the generic CreateTask builder with application/json body
+func NewCreateTaskRequest(server string, idEvent int64, body CreateTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTaskRequestWithBody(server, idEvent, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddUsersToEventResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewCreateTaskRequestWithBody generates requests for CreateTask with any type of body
+func NewCreateTaskRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetDummiesByEventIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserListResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetDummiesByEventIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDummiesByEventIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDummyUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *UserProfileDTO
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/task", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateDummyUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDummyUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type RemoveUserFromEventResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r RemoveUserFromEventResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RemoveUserFromEventResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewDeleteTaskRequest generates requests for DeleteTask
+func NewDeleteTaskRequest(server string, idEvent int64, idTask int) (*http.Request, error) {
+	var err error
 
-type GetOptimizedDebtsByUserIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OptimizedDebtListResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetOptimizedDebtsByUserIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOptimizedDebtsByUserIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateCategoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CategoryResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r CreateCategoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_task", runtime.ParamLocationPath, idTask)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCategoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteCategoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/task/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteCategoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCategoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateCategoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CategoryResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateCategoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetTaskByIDRequest generates requests for GetTaskByID
+func NewGetTaskByIDRequest(server string, idEvent int64, idTask int) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCategoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetIconsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]IconDTO
-	JSON500      *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetIconsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_task", runtime.ParamLocationPath, idTask)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetIconsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateIconResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *IconDTO
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/task/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateIconResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateIconResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteIconResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteIconResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateTaskRequest calls the generic UpdateTask builder with application/json body
+func NewUpdateTaskRequest(server string, idEvent int64, idTask int, body UpdateTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTaskRequestWithBody(server, idEvent, idTask, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteIconResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUpdateTaskRequestWithBody generates requests for UpdateTask with any type of body
+func NewUpdateTaskRequestWithBody(server string, idEvent int64, idTask int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetIconByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IconDTO
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetIconByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_task", runtime.ParamLocationPath, idTask)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetIconByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateIconResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IconDTO
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/event/%s/task/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateIconResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateIconResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetUsersByExternalIDsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserListResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUsersByExternalIDsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetTransactionsByEventIDRequest generates requests for GetTransactionsByEventID
+func NewGetTransactionsByEventIDRequest(server string, idEvent int64, params *GetTransactionsByEventIDParams) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersByExternalIDsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetUserByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserProfileDTO
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetUserByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type SyncUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r SyncUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SyncUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CategoryId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_id", runtime.ParamLocationQuery, *params.CategoryId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PayerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "payer_id", runtime.ParamLocationQuery, *params.PayerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParticipantId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "participant_id", runtime.ParamLocationQuery, *params.ParticipantId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AmountMin != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount_min", runtime.ParamLocationQuery, *params.AmountMin); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AmountMax != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount_max", runtime.ParamLocationQuery, *params.AmountMax); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return 0
-}
 
-// GetCategoriesWithResponse request returning *GetCategoriesResponse
-func (c *ClientWithResponses) GetCategoriesWithResponse(ctx context.Context, params *GetCategoriesParams, reqEditors ...RequestEditorFn) (*GetCategoriesResponse, error) {
-	rsp, err := c.GetCategories(ctx, params, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCategoriesResponse(rsp)
+
+	return req, nil
 }
 
-// GetCategoryByIDWithResponse request returning *GetCategoryByIDResponse
-func (c *ClientWithResponses) GetCategoryByIDWithResponse(ctx context.Context, id int, params *GetCategoryByIDParams, reqEditors ...RequestEditorFn) (*GetCategoryByIDResponse, error) {
-	rsp, err := c.GetCategoryByID(ctx, id, params, reqEditors...)
+// NewCreateTransactionRequest calls the generic CreateTransaction builder with application/json body
+func NewCreateTransactionRequest(server string, idEvent int64, body CreateTransactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCategoryByIDResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTransactionRequestWithBody(server, idEvent, "application/json", bodyReader)
 }
 
-// GetEventsWithResponse request returning *GetEventsResponse
-func (c *ClientWithResponses) GetEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEventsResponse, error) {
-	rsp, err := c.GetEvents(ctx, reqEditors...)
+// NewCreateTransactionRequestWithBody generates requests for CreateTransaction with any type of body
+func NewCreateTransactionRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetEventsResponse(rsp)
-}
 
-// CreateEventWithBodyWithResponse request with arbitrary body returning *CreateEventResponse
-func (c *ClientWithResponses) CreateEventWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEventResponse, error) {
-	rsp, err := c.CreateEventWithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateEventResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateEventWithResponse(ctx context.Context, body CreateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEventResponse, error) {
-	rsp, err := c.CreateEvent(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseCreateEventResponse(rsp)
-}
 
-// DeleteEventWithResponse request returning *DeleteEventResponse
-func (c *ClientWithResponses) DeleteEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*DeleteEventResponse, error) {
-	rsp, err := c.DeleteEvent(ctx, idEvent, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteEventResponse(rsp)
-}
 
-// GetEventByIDWithResponse request returning *GetEventByIDResponse
-func (c *ClientWithResponses) GetEventByIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetEventByIDResponse, error) {
-	rsp, err := c.GetEventByID(ctx, idEvent, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetEventByIDResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// UpdateEventWithBodyWithResponse request with arbitrary body returning *UpdateEventResponse
-func (c *ClientWithResponses) UpdateEventWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEventResponse, error) {
-	rsp, err := c.UpdateEventWithBody(ctx, idEvent, contentType, body, reqEditors...)
+// NewDeleteTransactionRequest generates requests for DeleteTransaction
+func NewDeleteTransactionRequest(server string, idEvent int64, idTransaction int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateEventResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateEventWithResponse(ctx context.Context, idEvent int64, body UpdateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEventResponse, error) {
-	rsp, err := c.UpdateEvent(ctx, idEvent, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateEventResponse(rsp)
-}
 
-// GetActivitiesByEventIDWithResponse request returning *GetActivitiesByEventIDResponse
-func (c *ClientWithResponses) GetActivitiesByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetActivitiesByEventIDResponse, error) {
-	rsp, err := c.GetActivitiesByEventID(ctx, idEvent, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetActivitiesByEventIDResponse(rsp)
-}
 
-// CreateActivityWithBodyWithResponse request with arbitrary body returning *CreateActivityResponse
-func (c *ClientWithResponses) CreateActivityWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error) {
-	rsp, err := c.CreateActivityWithBody(ctx, idEvent, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseCreateActivityResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateActivityWithResponse(ctx context.Context, idEvent int64, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error) {
-	rsp, err := c.CreateActivity(ctx, idEvent, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateActivityResponse(rsp)
-}
 
-// DeleteActivityWithResponse request returning *DeleteActivityResponse
-func (c *ClientWithResponses) DeleteActivityWithResponse(ctx context.Context, idEvent int64, idActivity int, reqEditors ...RequestEditorFn) (*DeleteActivityResponse, error) {
-	rsp, err := c.DeleteActivity(ctx, idEvent, idActivity, reqEditors...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteActivityResponse(rsp)
+
+	return req, nil
 }
 
-// GetActivityByIDWithResponse request returning *GetActivityByIDResponse
-func (c *ClientWithResponses) GetActivityByIDWithResponse(ctx context.Context, idEvent int64, idActivity int, reqEditors ...RequestEditorFn) (*GetActivityByIDResponse, error) {
-	rsp, err := c.GetActivityByID(ctx, idEvent, idActivity, reqEditors...)
+// NewGetTransactionByIDRequest generates requests for GetTransactionByID
+func NewGetTransactionByIDRequest(server string, idEvent int64, idTransaction int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetActivityByIDResponse(rsp)
-}
 
-// UpdateActivityWithBodyWithResponse request with arbitrary body returning *UpdateActivityResponse
-func (c *ClientWithResponses) UpdateActivityWithBodyWithResponse(ctx context.Context, idEvent int64, idActivity int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error) {
-	rsp, err := c.UpdateActivityWithBody(ctx, idEvent, idActivity, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateActivityResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateActivityWithResponse(ctx context.Context, idEvent int64, idActivity int, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error) {
-	rsp, err := c.UpdateActivity(ctx, idEvent, idActivity, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateActivityResponse(rsp)
-}
 
-// GetDebtsByEventIDWithResponse request returning *GetDebtsByEventIDResponse
-func (c *ClientWithResponses) GetDebtsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetDebtsByEventIDResponse, error) {
-	rsp, err := c.GetDebtsByEventID(ctx, idEvent, reqEditors...)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDebtsByEventIDResponse(rsp)
-}
 
-// GetOptimizedDebtsByEventIDWithResponse request returning *GetOptimizedDebtsByEventIDResponse
-func (c *ClientWithResponses) GetOptimizedDebtsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetOptimizedDebtsByEventIDResponse, error) {
-	rsp, err := c.GetOptimizedDebtsByEventID(ctx, idEvent, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetOptimizedDebtsByEventIDResponse(rsp)
+
+	return req, nil
 }
 
-// OptimizeDebtsWithResponse request returning *OptimizeDebtsResponse
-func (c *ClientWithResponses) OptimizeDebtsWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*OptimizeDebtsResponse, error) {
-	rsp, err := c.OptimizeDebts(ctx, idEvent, reqEditors...)
+// NewUpdateTransactionRequest calls the generic UpdateTransaction builder with application/json body
+func NewUpdateTransactionRequest(server string, idEvent int64, idTransaction int, body UpdateTransactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseOptimizeDebtsResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTransactionRequestWithBody(server, idEvent, idTransaction, "application/json", bodyReader)
 }
 
-// GetTasksByEventIDWithResponse request returning *GetTasksByEventIDResponse
-func (c *ClientWithResponses) GetTasksByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetTasksByEventIDResponse, error) {
-	rsp, err := c.GetTasksByEventID(ctx, idEvent, reqEditors...)
+// NewUpdateTransactionRequestWithBody generates requests for UpdateTransaction with any type of body
+func NewUpdateTransactionRequestWithBody(server string, idEvent int64, idTransaction int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTasksByEventIDResponse(rsp)
-}
 
-// CreateTaskWithBodyWithResponse request with arbitrary body returning *CreateTaskResponse
-func (c *ClientWithResponses) CreateTaskWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error) {
-	rsp, err := c.CreateTaskWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTaskResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateTaskWithResponse(ctx context.Context, idEvent int64, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error) {
-	rsp, err := c.CreateTask(ctx, idEvent, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTaskResponse(rsp)
-}
 
-// DeleteTaskWithResponse request returning *DeleteTaskResponse
-func (c *ClientWithResponses) DeleteTaskWithResponse(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*DeleteTaskResponse, error) {
-	rsp, err := c.DeleteTask(ctx, idEvent, idTask, reqEditors...)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteTaskResponse(rsp)
-}
 
-// GetTaskByIDWithResponse request returning *GetTaskByIDResponse
-func (c *ClientWithResponses) GetTaskByIDWithResponse(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*GetTaskByIDResponse, error) {
-	rsp, err := c.GetTaskByID(ctx, idEvent, idTask, reqEditors...)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTaskByIDResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// UpdateTaskWithBodyWithResponse request with arbitrary body returning *UpdateTaskResponse
-func (c *ClientWithResponses) UpdateTaskWithBodyWithResponse(ctx context.Context, idEvent int64, idTask int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error) {
-	rsp, err := c.UpdateTaskWithBody(ctx, idEvent, idTask, contentType, body, reqEditors...)
+// NewAttachTransactionFileRequest calls the generic AttachTransactionFile builder with application/json body
+func NewAttachTransactionFileRequest(server string, idEvent int64, idTransaction int, body AttachTransactionFileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateTaskResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewAttachTransactionFileRequestWithBody(server, idEvent, idTransaction, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) UpdateTaskWithResponse(ctx context.Context, idEvent int64, idTask int, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error) {
-	rsp, err := c.UpdateTask(ctx, idEvent, idTask, body, reqEditors...)
+// NewAttachTransactionFileRequestWithBody generates requests for AttachTransactionFile with any type of body
+func NewAttachTransactionFileRequestWithBody(server string, idEvent int64, idTransaction int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateTaskResponse(rsp)
-}
 
-// GetTransactionsByEventIDWithResponse request returning *GetTransactionsByEventIDResponse
-func (c *ClientWithResponses) GetTransactionsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetTransactionsByEventIDResponse, error) {
-	rsp, err := c.GetTransactionsByEventID(ctx, idEvent, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTransactionsByEventIDResponse(rsp)
-}
 
-// CreateTransactionWithBodyWithResponse request with arbitrary body returning *CreateTransactionResponse
-func (c *ClientWithResponses) CreateTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error) {
-	rsp, err := c.CreateTransactionWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTransactionResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateTransactionWithResponse(ctx context.Context, idEvent int64, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error) {
-	rsp, err := c.CreateTransaction(ctx, idEvent, body, reqEditors...)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s/attachments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTransactionResponse(rsp)
-}
 
-// DeleteTransactionWithResponse request returning *DeleteTransactionResponse
-func (c *ClientWithResponses) DeleteTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*DeleteTransactionResponse, error) {
-	rsp, err := c.DeleteTransaction(ctx, idEvent, idTransaction, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteTransactionResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// GetTransactionByIDWithResponse request returning *GetTransactionByIDResponse
-func (c *ClientWithResponses) GetTransactionByIDWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*GetTransactionByIDResponse, error) {
-	rsp, err := c.GetTransactionByID(ctx, idEvent, idTransaction, reqEditors...)
+// NewDetachTransactionFileRequest generates requests for DetachTransactionFile
+func NewDetachTransactionFileRequest(server string, idEvent int64, idTransaction int, idAttachment int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTransactionByIDResponse(rsp)
-}
 
-// UpdateTransactionWithBodyWithResponse request with arbitrary body returning *UpdateTransactionResponse
-func (c *ClientWithResponses) UpdateTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransactionResponse, error) {
-	rsp, err := c.UpdateTransactionWithBody(ctx, idEvent, idTransaction, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateTransactionResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, body UpdateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransactionResponse, error) {
-	rsp, err := c.UpdateTransaction(ctx, idEvent, idTransaction, body, reqEditors...)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id_attachment", runtime.ParamLocationPath, idAttachment)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateTransactionResponse(rsp)
-}
 
-// GetUsersByEventIDWithResponse request returning *GetUsersByEventIDResponse
-func (c *ClientWithResponses) GetUsersByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetUsersByEventIDResponse, error) {
-	rsp, err := c.GetUsersByEventID(ctx, idEvent, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUsersByEventIDResponse(rsp)
-}
 
-// AddUsersToEventWithBodyWithResponse request with arbitrary body returning *AddUsersToEventResponse
-func (c *ClientWithResponses) AddUsersToEventWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddUsersToEventResponse, error) {
-	rsp, err := c.AddUsersToEventWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s/attachments/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddUsersToEventResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddUsersToEventWithResponse(ctx context.Context, idEvent int64, body AddUsersToEventJSONRequestBody, reqEditors ...RequestEditorFn) (*AddUsersToEventResponse, error) {
-	rsp, err := c.AddUsersToEvent(ctx, idEvent, body, reqEditors...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddUsersToEventResponse(rsp)
+
+	return req, nil
 }
 
-// GetDummiesByEventIDWithResponse request returning *GetDummiesByEventIDResponse
-func (c *ClientWithResponses) GetDummiesByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetDummiesByEventIDResponse, error) {
-	rsp, err := c.GetDummiesByEventID(ctx, idEvent, reqEditors...)
+// NewGetTransactionAttachmentUrlRequest generates requests for GetTransactionAttachmentUrl
+func NewGetTransactionAttachmentUrlRequest(server string, idEvent int64, idTransaction int, idAttachment int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDummiesByEventIDResponse(rsp)
-}
 
-// CreateDummyUserWithBodyWithResponse request with arbitrary body returning *CreateDummyUserResponse
-func (c *ClientWithResponses) CreateDummyUserWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDummyUserResponse, error) {
-	rsp, err := c.CreateDummyUserWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDummyUserResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateDummyUserWithResponse(ctx context.Context, idEvent int64, body CreateDummyUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDummyUserResponse, error) {
-	rsp, err := c.CreateDummyUser(ctx, idEvent, body, reqEditors...)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id_attachment", runtime.ParamLocationPath, idAttachment)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDummyUserResponse(rsp)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s/attachments/%s/url", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// RemoveUserFromEventWithResponse request returning *RemoveUserFromEventResponse
-func (c *ClientWithResponses) RemoveUserFromEventWithResponse(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*RemoveUserFromEventResponse, error) {
-	rsp, err := c.RemoveUserFromEvent(ctx, idEvent, idUser, reqEditors...)
+// NewGetTransactionHistoryRequest generates requests for GetTransactionHistory
+func NewGetTransactionHistoryRequest(server string, idEvent int64, idTransaction int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRemoveUserFromEventResponse(rsp)
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s/history", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// GetOptimizedDebtsByUserIDWithResponse request returning *GetOptimizedDebtsByUserIDResponse
-func (c *ClientWithResponses) GetOptimizedDebtsByUserIDWithResponse(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*GetOptimizedDebtsByUserIDResponse, error) {
-	rsp, err := c.GetOptimizedDebtsByUserID(ctx, idEvent, idUser, reqEditors...)
-	if err != nil {
-		return nil, err
+// NewRevertTransactionRequest generates requests for RevertTransaction
+func NewRevertTransactionRequest(server string, idEvent int64, idTransaction int, idRevision int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id_revision", runtime.ParamLocationPath, idRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s/history/%s/revert", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTransactionItemsRequest generates requests for GetTransactionItems
+func NewGetTransactionItemsRequest(server string, idEvent int64, idTransaction int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s/item", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTransactionItemRequest calls the generic CreateTransactionItem builder with application/json body
+func NewCreateTransactionItemRequest(server string, idEvent int64, idTransaction int, body CreateTransactionItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTransactionItemRequestWithBody(server, idEvent, idTransaction, "application/json", bodyReader)
+}
+
+// NewCreateTransactionItemRequestWithBody generates requests for CreateTransactionItem with any type of body
+func NewCreateTransactionItemRequestWithBody(server string, idEvent int64, idTransaction int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s/item", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTransactionItemRequest generates requests for DeleteTransactionItem
+func NewDeleteTransactionItemRequest(server string, idEvent int64, idTransaction int, idItem int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id_item", runtime.ParamLocationPath, idItem)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s/item/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTransactionItemRequest calls the generic UpdateTransactionItem builder with application/json body
+func NewUpdateTransactionItemRequest(server string, idEvent int64, idTransaction int, idItem int, body UpdateTransactionItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTransactionItemRequestWithBody(server, idEvent, idTransaction, idItem, "application/json", bodyReader)
+}
+
+// NewUpdateTransactionItemRequestWithBody generates requests for UpdateTransactionItem with any type of body
+func NewUpdateTransactionItemRequestWithBody(server string, idEvent int64, idTransaction int, idItem int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id_item", runtime.ParamLocationPath, idItem)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s/item/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestoreTransactionRequest generates requests for RestoreTransaction
+func NewRestoreTransactionRequest(server string, idEvent int64, idTransaction int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_transaction", runtime.ParamLocationPath, idTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/transaction/%s/restore", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEventTrashRequest generates requests for GetEventTrash
+func NewGetEventTrashRequest(server string, idEvent int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/trash", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersByEventIDRequest generates requests for GetUsersByEventID
+func NewGetUsersByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/user", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddUsersToEventRequest calls the generic AddUsersToEvent builder with application/json body
+func NewAddUsersToEventRequest(server string, idEvent int64, body AddUsersToEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddUsersToEventRequestWithBody(server, idEvent, "application/json", bodyReader)
+}
+
+// NewAddUsersToEventRequestWithBody generates requests for AddUsersToEvent with any type of body
+func NewAddUsersToEventRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/user", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDummiesByEventIDRequest generates requests for GetDummiesByEventID
+func NewGetDummiesByEventIDRequest(server string, idEvent int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/user/dummies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDummyUserRequest calls the generic CreateDummyUser builder with application/json body
+func NewCreateDummyUserRequest(server string, idEvent int64, body CreateDummyUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDummyUserRequestWithBody(server, idEvent, "application/json", bodyReader)
+}
+
+// NewCreateDummyUserRequestWithBody generates requests for CreateDummyUser with any type of body
+func NewCreateDummyUserRequestWithBody(server string, idEvent int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/user/dummy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveUserFromEventRequest generates requests for RemoveUserFromEvent
+func NewRemoveUserFromEventRequest(server string, idEvent int64, idUser int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_user", runtime.ParamLocationPath, idUser)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/user/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDummyClaimRequest generates requests for CreateDummyClaim
+func NewCreateDummyClaimRequest(server string, idEvent int64, idUser int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_user", runtime.ParamLocationPath, idUser)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/user/%s/claim", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOptimizedDebtsByUserIDRequest generates requests for GetOptimizedDebtsByUserID
+func NewGetOptimizedDebtsByUserIDRequest(server string, idEvent int64, idUser int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_user", runtime.ParamLocationPath, idUser)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/user/%s/optimized-debts", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateEventUserRoleRequest calls the generic UpdateEventUserRole builder with application/json body
+func NewUpdateEventUserRoleRequest(server string, idEvent int64, idUser int64, body UpdateEventUserRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateEventUserRoleRequestWithBody(server, idEvent, idUser, "application/json", bodyReader)
+}
+
+// NewUpdateEventUserRoleRequestWithBody generates requests for UpdateEventUserRole with any type of body
+func NewUpdateEventUserRoleRequestWithBody(server string, idEvent int64, idUser int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_event", runtime.ParamLocationPath, idEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id_user", runtime.ParamLocationPath, idUser)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event/%s/user/%s/role", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetExchangeRatesRequest generates requests for GetExchangeRates
+func NewGetExchangeRatesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/exchange-rate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewJoinEventByInviteRequest generates requests for JoinEventByInvite
+func NewJoinEventByInviteRequest(server string, token string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/invite/%s/join", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateCategoryRequest calls the generic CreateCategory builder with application/json body
+func NewCreateCategoryRequest(server string, params *CreateCategoryParams, body CreateCategoryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCategoryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateCategoryRequestWithBody generates requests for CreateCategory with any type of body
+func NewCreateCategoryRequestWithBody(server string, params *CreateCategoryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/manage/category")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_type", runtime.ParamLocationQuery, params.CategoryType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteCategoryRequest generates requests for DeleteCategory
+func NewDeleteCategoryRequest(server string, id int, params *DeleteCategoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/manage/category/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_type", runtime.ParamLocationQuery, params.CategoryType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCategoryRequest calls the generic UpdateCategory builder with application/json body
+func NewUpdateCategoryRequest(server string, id int, params *UpdateCategoryParams, body UpdateCategoryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCategoryRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateCategoryRequestWithBody generates requests for UpdateCategory with any type of body
+func NewUpdateCategoryRequestWithBody(server string, id int, params *UpdateCategoryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/manage/category/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_type", runtime.ParamLocationQuery, params.CategoryType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetExchangeRateRequest calls the generic SetExchangeRate builder with application/json body
+func NewSetExchangeRateRequest(server string, body SetExchangeRateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetExchangeRateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetExchangeRateRequestWithBody generates requests for SetExchangeRate with any type of body
+func NewSetExchangeRateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/manage/exchange-rate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteExchangeRateRequest generates requests for DeleteExchangeRate
+func NewDeleteExchangeRateRequest(server string, currencyFrom string, currencyTo string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "currency_from", runtime.ParamLocationPath, currencyFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "currency_to", runtime.ParamLocationPath, currencyTo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/manage/exchange-rate/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetIconsRequest generates requests for GetIcons
+func NewGetIconsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/manage/icons")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateIconRequest calls the generic CreateIcon builder with application/json body
+func NewCreateIconRequest(server string, body CreateIconJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateIconRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateIconRequestWithBody generates requests for CreateIcon with any type of body
+func NewCreateIconRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/manage/icons")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteIconRequest generates requests for DeleteIcon
+func NewDeleteIconRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/manage/icons/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetIconByIDRequest generates requests for GetIconByID
+func NewGetIconByIDRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/manage/icons/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateIconRequest calls the generic UpdateIcon builder with application/json body
+func NewUpdateIconRequest(server string, id int, body UpdateIconJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateIconRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateIconRequestWithBody generates requests for UpdateIcon with any type of body
+func NewUpdateIconRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/manage/icons/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersByExternalIDsRequest generates requests for GetUsersByExternalIDs
+func NewGetUsersByExternalIDsRequest(server string, params *GetUsersByExternalIDsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/user/external")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "uids", runtime.ParamLocationQuery, params.Uids); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserByIDRequest generates requests for GetUserByID
+func NewGetUserByIDRequest(server string, idUser int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id_user", runtime.ParamLocationPath, idUser)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/user/internal/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSyncUsersRequest calls the generic SyncUsers builder with application/json body
+func NewSyncUsersRequest(server string, body SyncUsersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSyncUsersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSyncUsersRequestWithBody generates requests for SyncUsers with any type of body
+func NewSyncUsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/user/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetCategoriesWithResponse request
+	GetCategoriesWithResponse(ctx context.Context, params *GetCategoriesParams, reqEditors ...RequestEditorFn) (*GetCategoriesResponse, error)
+
+	// GetCategoryByIDWithResponse request
+	GetCategoryByIDWithResponse(ctx context.Context, id int, params *GetCategoryByIDParams, reqEditors ...RequestEditorFn) (*GetCategoryByIDResponse, error)
+
+	// GetEventsWithResponse request
+	GetEventsWithResponse(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*GetEventsResponse, error)
+
+	// CreateEventWithBodyWithResponse request with any body
+	CreateEventWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEventResponse, error)
+
+	CreateEventWithResponse(ctx context.Context, body CreateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEventResponse, error)
+
+	// GetDeletedEventsWithResponse request
+	GetDeletedEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDeletedEventsResponse, error)
+
+	// RestoreEventWithResponse request
+	RestoreEventWithResponse(ctx context.Context, idDeletedEvent int64, reqEditors ...RequestEditorFn) (*RestoreEventResponse, error)
+
+	// DeleteEventWithResponse request
+	DeleteEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*DeleteEventResponse, error)
+
+	// GetEventByIDWithResponse request
+	GetEventByIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetEventByIDResponse, error)
+
+	// UpdateEventWithBodyWithResponse request with any body
+	UpdateEventWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEventResponse, error)
+
+	UpdateEventWithResponse(ctx context.Context, idEvent int64, body UpdateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEventResponse, error)
+
+	// GetActivitiesByEventIDWithResponse request
+	GetActivitiesByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetActivitiesByEventIDResponse, error)
+
+	// CreateActivityWithBodyWithResponse request with any body
+	CreateActivityWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error)
+
+	CreateActivityWithResponse(ctx context.Context, idEvent int64, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error)
+
+	// DeleteActivityWithResponse request
+	DeleteActivityWithResponse(ctx context.Context, idEvent int64, idActivity int, reqEditors ...RequestEditorFn) (*DeleteActivityResponse, error)
+
+	// GetActivityByIDWithResponse request
+	GetActivityByIDWithResponse(ctx context.Context, idEvent int64, idActivity int, reqEditors ...RequestEditorFn) (*GetActivityByIDResponse, error)
+
+	// UpdateActivityWithBodyWithResponse request with any body
+	UpdateActivityWithBodyWithResponse(ctx context.Context, idEvent int64, idActivity int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error)
+
+	UpdateActivityWithResponse(ctx context.Context, idEvent int64, idActivity int, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error)
+
+	// GetEventAnalyticsWithResponse request
+	GetEventAnalyticsWithResponse(ctx context.Context, idEvent int64, params *GetEventAnalyticsParams, reqEditors ...RequestEditorFn) (*GetEventAnalyticsResponse, error)
+
+	// ArchiveEventWithResponse request
+	ArchiveEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*ArchiveEventResponse, error)
+
+	// GetDummyClaimsWithResponse request
+	GetDummyClaimsWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetDummyClaimsResponse, error)
+
+	// ApproveDummyClaimWithResponse request
+	ApproveDummyClaimWithResponse(ctx context.Context, idEvent int64, idClaim int, reqEditors ...RequestEditorFn) (*ApproveDummyClaimResponse, error)
+
+	// RejectDummyClaimWithResponse request
+	RejectDummyClaimWithResponse(ctx context.Context, idEvent int64, idClaim int, reqEditors ...RequestEditorFn) (*RejectDummyClaimResponse, error)
+
+	// CloseEventWithResponse request
+	CloseEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*CloseEventResponse, error)
+
+	// GetDebtsByEventIDWithResponse request
+	GetDebtsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetDebtsByEventIDResponse, error)
+
+	// ExportEventWithResponse request
+	ExportEventWithResponse(ctx context.Context, idEvent int64, params *ExportEventParams, reqEditors ...RequestEditorFn) (*ExportEventResponse, error)
+
+	// GetActivityFeedWithResponse request
+	GetActivityFeedWithResponse(ctx context.Context, idEvent int64, params *GetActivityFeedParams, reqEditors ...RequestEditorFn) (*GetActivityFeedResponse, error)
+
+	// ImportTransactionsWithBodyWithResponse request with any body
+	ImportTransactionsWithBodyWithResponse(ctx context.Context, idEvent int64, params *ImportTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTransactionsResponse, error)
+
+	// GetEventInvitesWithResponse request
+	GetEventInvitesWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetEventInvitesResponse, error)
+
+	// CreateEventInviteWithBodyWithResponse request with any body
+	CreateEventInviteWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEventInviteResponse, error)
+
+	CreateEventInviteWithResponse(ctx context.Context, idEvent int64, body CreateEventInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEventInviteResponse, error)
+
+	// RevokeEventInviteWithResponse request
+	RevokeEventInviteWithResponse(ctx context.Context, idEvent int64, idInvite int, reqEditors ...RequestEditorFn) (*RevokeEventInviteResponse, error)
+
+	// GetOptimizedDebtsByEventIDWithResponse request
+	GetOptimizedDebtsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetOptimizedDebtsByEventIDResponse, error)
+
+	// OptimizeDebtsWithResponse request
+	OptimizeDebtsWithResponse(ctx context.Context, idEvent int64, params *OptimizeDebtsParams, reqEditors ...RequestEditorFn) (*OptimizeDebtsResponse, error)
+
+	// TransferEventOwnershipWithBodyWithResponse request with any body
+	TransferEventOwnershipWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferEventOwnershipResponse, error)
+
+	TransferEventOwnershipWithResponse(ctx context.Context, idEvent int64, body TransferEventOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*TransferEventOwnershipResponse, error)
+
+	// GetRecurringTransactionsWithResponse request
+	GetRecurringTransactionsWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetRecurringTransactionsResponse, error)
+
+	// CreateRecurringTransactionWithBodyWithResponse request with any body
+	CreateRecurringTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRecurringTransactionResponse, error)
+
+	CreateRecurringTransactionWithResponse(ctx context.Context, idEvent int64, body CreateRecurringTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRecurringTransactionResponse, error)
+
+	// DeleteRecurringTransactionWithResponse request
+	DeleteRecurringTransactionWithResponse(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*DeleteRecurringTransactionResponse, error)
+
+	// UpdateRecurringTransactionWithBodyWithResponse request with any body
+	UpdateRecurringTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, idRecurring int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRecurringTransactionResponse, error)
+
+	UpdateRecurringTransactionWithResponse(ctx context.Context, idEvent int64, idRecurring int, body UpdateRecurringTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRecurringTransactionResponse, error)
+
+	// PauseRecurringTransactionWithResponse request
+	PauseRecurringTransactionWithResponse(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*PauseRecurringTransactionResponse, error)
+
+	// ResumeRecurringTransactionWithResponse request
+	ResumeRecurringTransactionWithResponse(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*ResumeRecurringTransactionResponse, error)
+
+	// ReopenEventWithResponse request
+	ReopenEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*ReopenEventResponse, error)
+
+	// SettleEventWithResponse request
+	SettleEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*SettleEventResponse, error)
+
+	// GetSettlementsByEventIDWithResponse request
+	GetSettlementsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetSettlementsByEventIDResponse, error)
+
+	// CreateSettlementWithBodyWithResponse request with any body
+	CreateSettlementWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSettlementResponse, error)
+
+	CreateSettlementWithResponse(ctx context.Context, idEvent int64, body CreateSettlementJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSettlementResponse, error)
+
+	// DeleteSettlementWithResponse request
+	DeleteSettlementWithResponse(ctx context.Context, idEvent int64, idSettlement int, reqEditors ...RequestEditorFn) (*DeleteSettlementResponse, error)
+
+	// GetTasksByEventIDWithResponse request
+	GetTasksByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetTasksByEventIDResponse, error)
+
+	// CreateTaskWithBodyWithResponse request with any body
+	CreateTaskWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error)
+
+	CreateTaskWithResponse(ctx context.Context, idEvent int64, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error)
+
+	// DeleteTaskWithResponse request
+	DeleteTaskWithResponse(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*DeleteTaskResponse, error)
+
+	// GetTaskByIDWithResponse request
+	GetTaskByIDWithResponse(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*GetTaskByIDResponse, error)
+
+	// UpdateTaskWithBodyWithResponse request with any body
+	UpdateTaskWithBodyWithResponse(ctx context.Context, idEvent int64, idTask int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error)
+
+	UpdateTaskWithResponse(ctx context.Context, idEvent int64, idTask int, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error)
+
+	// GetTransactionsByEventIDWithResponse request
+	GetTransactionsByEventIDWithResponse(ctx context.Context, idEvent int64, params *GetTransactionsByEventIDParams, reqEditors ...RequestEditorFn) (*GetTransactionsByEventIDResponse, error)
+
+	// CreateTransactionWithBodyWithResponse request with any body
+	CreateTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error)
+
+	CreateTransactionWithResponse(ctx context.Context, idEvent int64, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error)
+
+	// DeleteTransactionWithResponse request
+	DeleteTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*DeleteTransactionResponse, error)
+
+	// GetTransactionByIDWithResponse request
+	GetTransactionByIDWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*GetTransactionByIDResponse, error)
+
+	// UpdateTransactionWithBodyWithResponse request with any body
+	UpdateTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransactionResponse, error)
+
+	UpdateTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, body UpdateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransactionResponse, error)
+
+	// AttachTransactionFileWithBodyWithResponse request with any body
+	AttachTransactionFileWithBodyWithResponse(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachTransactionFileResponse, error)
+
+	AttachTransactionFileWithResponse(ctx context.Context, idEvent int64, idTransaction int, body AttachTransactionFileJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachTransactionFileResponse, error)
+
+	// DetachTransactionFileWithResponse request
+	DetachTransactionFileWithResponse(ctx context.Context, idEvent int64, idTransaction int, idAttachment int, reqEditors ...RequestEditorFn) (*DetachTransactionFileResponse, error)
+
+	// GetTransactionAttachmentUrlWithResponse request
+	GetTransactionAttachmentUrlWithResponse(ctx context.Context, idEvent int64, idTransaction int, idAttachment int, reqEditors ...RequestEditorFn) (*GetTransactionAttachmentUrlResponse, error)
+
+	// GetTransactionHistoryWithResponse request
+	GetTransactionHistoryWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*GetTransactionHistoryResponse, error)
+
+	// RevertTransactionWithResponse request
+	RevertTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, idRevision int, reqEditors ...RequestEditorFn) (*RevertTransactionResponse, error)
+
+	// GetTransactionItemsWithResponse request
+	GetTransactionItemsWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*GetTransactionItemsResponse, error)
+
+	// CreateTransactionItemWithBodyWithResponse request with any body
+	CreateTransactionItemWithBodyWithResponse(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionItemResponse, error)
+
+	CreateTransactionItemWithResponse(ctx context.Context, idEvent int64, idTransaction int, body CreateTransactionItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionItemResponse, error)
+
+	// DeleteTransactionItemWithResponse request
+	DeleteTransactionItemWithResponse(ctx context.Context, idEvent int64, idTransaction int, idItem int, reqEditors ...RequestEditorFn) (*DeleteTransactionItemResponse, error)
+
+	// UpdateTransactionItemWithBodyWithResponse request with any body
+	UpdateTransactionItemWithBodyWithResponse(ctx context.Context, idEvent int64, idTransaction int, idItem int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransactionItemResponse, error)
+
+	UpdateTransactionItemWithResponse(ctx context.Context, idEvent int64, idTransaction int, idItem int, body UpdateTransactionItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransactionItemResponse, error)
+
+	// RestoreTransactionWithResponse request
+	RestoreTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*RestoreTransactionResponse, error)
+
+	// GetEventTrashWithResponse request
+	GetEventTrashWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetEventTrashResponse, error)
+
+	// GetUsersByEventIDWithResponse request
+	GetUsersByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetUsersByEventIDResponse, error)
+
+	// AddUsersToEventWithBodyWithResponse request with any body
+	AddUsersToEventWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddUsersToEventResponse, error)
+
+	AddUsersToEventWithResponse(ctx context.Context, idEvent int64, body AddUsersToEventJSONRequestBody, reqEditors ...RequestEditorFn) (*AddUsersToEventResponse, error)
+
+	// GetDummiesByEventIDWithResponse request
+	GetDummiesByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetDummiesByEventIDResponse, error)
+
+	// CreateDummyUserWithBodyWithResponse request with any body
+	CreateDummyUserWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDummyUserResponse, error)
+
+	CreateDummyUserWithResponse(ctx context.Context, idEvent int64, body CreateDummyUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDummyUserResponse, error)
+
+	// RemoveUserFromEventWithResponse request
+	RemoveUserFromEventWithResponse(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*RemoveUserFromEventResponse, error)
+
+	// CreateDummyClaimWithResponse request
+	CreateDummyClaimWithResponse(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*CreateDummyClaimResponse, error)
+
+	// GetOptimizedDebtsByUserIDWithResponse request
+	GetOptimizedDebtsByUserIDWithResponse(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*GetOptimizedDebtsByUserIDResponse, error)
+
+	// UpdateEventUserRoleWithBodyWithResponse request with any body
+	UpdateEventUserRoleWithBodyWithResponse(ctx context.Context, idEvent int64, idUser int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEventUserRoleResponse, error)
+
+	UpdateEventUserRoleWithResponse(ctx context.Context, idEvent int64, idUser int64, body UpdateEventUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEventUserRoleResponse, error)
+
+	// GetExchangeRatesWithResponse request
+	GetExchangeRatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetExchangeRatesResponse, error)
+
+	// JoinEventByInviteWithResponse request
+	JoinEventByInviteWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*JoinEventByInviteResponse, error)
+
+	// CreateCategoryWithBodyWithResponse request with any body
+	CreateCategoryWithBodyWithResponse(ctx context.Context, params *CreateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+
+	CreateCategoryWithResponse(ctx context.Context, params *CreateCategoryParams, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+
+	// DeleteCategoryWithResponse request
+	DeleteCategoryWithResponse(ctx context.Context, id int, params *DeleteCategoryParams, reqEditors ...RequestEditorFn) (*DeleteCategoryResponse, error)
+
+	// UpdateCategoryWithBodyWithResponse request with any body
+	UpdateCategoryWithBodyWithResponse(ctx context.Context, id int, params *UpdateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error)
+
+	UpdateCategoryWithResponse(ctx context.Context, id int, params *UpdateCategoryParams, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error)
+
+	// SetExchangeRateWithBodyWithResponse request with any body
+	SetExchangeRateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetExchangeRateResponse, error)
+
+	SetExchangeRateWithResponse(ctx context.Context, body SetExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*SetExchangeRateResponse, error)
+
+	// DeleteExchangeRateWithResponse request
+	DeleteExchangeRateWithResponse(ctx context.Context, currencyFrom string, currencyTo string, reqEditors ...RequestEditorFn) (*DeleteExchangeRateResponse, error)
+
+	// GetIconsWithResponse request
+	GetIconsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIconsResponse, error)
+
+	// CreateIconWithBodyWithResponse request with any body
+	CreateIconWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIconResponse, error)
+
+	CreateIconWithResponse(ctx context.Context, body CreateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIconResponse, error)
+
+	// DeleteIconWithResponse request
+	DeleteIconWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteIconResponse, error)
+
+	// GetIconByIDWithResponse request
+	GetIconByIDWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetIconByIDResponse, error)
+
+	// UpdateIconWithBodyWithResponse request with any body
+	UpdateIconWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIconResponse, error)
+
+	UpdateIconWithResponse(ctx context.Context, id int, body UpdateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIconResponse, error)
+
+	// GetUsersByExternalIDsWithResponse request
+	GetUsersByExternalIDsWithResponse(ctx context.Context, params *GetUsersByExternalIDsParams, reqEditors ...RequestEditorFn) (*GetUsersByExternalIDsResponse, error)
+
+	// GetUserByIDWithResponse request
+	GetUserByIDWithResponse(ctx context.Context, idUser int64, reqEditors ...RequestEditorFn) (*GetUserByIDResponse, error)
+
+	// SyncUsersWithBodyWithResponse request with any body
+	SyncUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncUsersResponse, error)
+
+	SyncUsersWithResponse(ctx context.Context, body SyncUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*SyncUsersResponse, error)
+}
+
+type GetCategoriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CategoryListResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCategoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCategoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCategoryByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CategoryResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCategoryByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCategoryByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventListResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *EventResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeletedEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventListResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeletedEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeletedEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEventByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetActivitiesByEventIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ActivityListResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetActivitiesByEventIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetActivitiesByEventIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateActivityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ActivityResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteActivityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetActivityByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ActivityResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetActivityByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetActivityByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateActivityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ActivityResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEventAnalyticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventAnalyticsResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventAnalyticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventAnalyticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ArchiveEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ArchiveEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArchiveEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDummyClaimsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DummyClaimListResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDummyClaimsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDummyClaimsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveDummyClaimResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DummyClaimDTO
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveDummyClaimResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveDummyClaimResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RejectDummyClaimResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DummyClaimDTO
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RejectDummyClaimResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RejectDummyClaimResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloseEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OptimizationResultResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CloseEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloseEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDebtsByEventIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DebtListResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDebtsByEventIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDebtsByEventIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetActivityFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ActivityFeedResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetActivityFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetActivityFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportTransactionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportReportResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEventInvitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventInviteListResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventInvitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventInvitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateEventInviteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *EventInviteDTO
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEventInviteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEventInviteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeEventInviteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeEventInviteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeEventInviteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOptimizedDebtsByEventIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OptimizedDebtListResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOptimizedDebtsByEventIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOptimizedDebtsByEventIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OptimizeDebtsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OptimizationResultResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r OptimizeDebtsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OptimizeDebtsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferEventOwnershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferEventOwnershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferEventOwnershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRecurringTransactionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecurringTransactionListResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRecurringTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecurringTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRecurringTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RecurringTransactionDTO
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRecurringTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRecurringTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRecurringTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRecurringTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRecurringTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRecurringTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecurringTransactionDTO
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRecurringTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRecurringTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PauseRecurringTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecurringTransactionDTO
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PauseRecurringTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PauseRecurringTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResumeRecurringTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecurringTransactionDTO
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ResumeRecurringTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResumeRecurringTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReopenEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReopenEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReopenEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SettleEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SettleEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SettleEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSettlementsByEventIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SettlementListResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSettlementsByEventIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSettlementsByEventIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSettlementResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SettlementDTO
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSettlementResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSettlementResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSettlementResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSettlementResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSettlementResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTasksByEventIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskListResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTasksByEventIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTasksByEventIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TaskResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionsByEventIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionListResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionsByEventIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionsByEventIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TransactionResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AttachTransactionFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TransactionAttachment
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachTransactionFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachTransactionFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DetachTransactionFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DetachTransactionFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DetachTransactionFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionAttachmentUrlResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionAttachment
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionAttachmentUrlResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionAttachmentUrlResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionHistoryResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevertTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevertTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevertTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemListResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTransactionItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TransactionResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTransactionItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTransactionItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTransactionItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTransactionItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTransactionItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTransactionItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTransactionItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTransactionItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEventTrashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventTrashResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventTrashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventTrashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersByEventIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserListResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersByEventIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersByEventIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddUsersToEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddUsersToEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddUsersToEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDummiesByEventIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserListResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDummiesByEventIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDummiesByEventIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDummyUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UserProfileDTO
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDummyUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDummyUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveUserFromEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveUserFromEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveUserFromEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDummyClaimResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *DummyClaimDTO
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDummyClaimResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDummyClaimResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOptimizedDebtsByUserIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OptimizedDebtListResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOptimizedDebtsByUserIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOptimizedDebtsByUserIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateEventUserRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateEventUserRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateEventUserRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetExchangeRatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ExchangeRateDTO
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExchangeRatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExchangeRatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type JoinEventByInviteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JoinEventResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r JoinEventByInviteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r JoinEventByInviteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CategoryResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CategoryResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetExchangeRateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExchangeRateDTO
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetExchangeRateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetExchangeRateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteExchangeRateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteExchangeRateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteExchangeRateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIconsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]IconDTO
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIconsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIconsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateIconResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IconDTO
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateIconResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateIconResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteIconResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteIconResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteIconResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIconByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IconDTO
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIconByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIconByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateIconResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IconDTO
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateIconResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateIconResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersByExternalIDsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserListResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersByExternalIDsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersByExternalIDsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserProfileDTO
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SyncUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SyncUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SyncUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetCategoriesWithResponse request returning *GetCategoriesResponse
+func (c *ClientWithResponses) GetCategoriesWithResponse(ctx context.Context, params *GetCategoriesParams, reqEditors ...RequestEditorFn) (*GetCategoriesResponse, error) {
+	rsp, err := c.GetCategories(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCategoriesResponse(rsp)
+}
+
+// GetCategoryByIDWithResponse request returning *GetCategoryByIDResponse
+func (c *ClientWithResponses) GetCategoryByIDWithResponse(ctx context.Context, id int, params *GetCategoryByIDParams, reqEditors ...RequestEditorFn) (*GetCategoryByIDResponse, error) {
+	rsp, err := c.GetCategoryByID(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCategoryByIDResponse(rsp)
+}
+
+// GetEventsWithResponse request returning *GetEventsResponse
+func (c *ClientWithResponses) GetEventsWithResponse(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*GetEventsResponse, error) {
+	rsp, err := c.GetEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventsResponse(rsp)
+}
+
+// CreateEventWithBodyWithResponse request with arbitrary body returning *CreateEventResponse
+func (c *ClientWithResponses) CreateEventWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEventResponse, error) {
+	rsp, err := c.CreateEventWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEventResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateEventWithResponse(ctx context.Context, body CreateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEventResponse, error) {
+	rsp, err := c.CreateEvent(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEventResponse(rsp)
+}
+
+// GetDeletedEventsWithResponse request returning *GetDeletedEventsResponse
+func (c *ClientWithResponses) GetDeletedEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDeletedEventsResponse, error) {
+	rsp, err := c.GetDeletedEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeletedEventsResponse(rsp)
+}
+
+// RestoreEventWithResponse request returning *RestoreEventResponse
+func (c *ClientWithResponses) RestoreEventWithResponse(ctx context.Context, idDeletedEvent int64, reqEditors ...RequestEditorFn) (*RestoreEventResponse, error) {
+	rsp, err := c.RestoreEvent(ctx, idDeletedEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreEventResponse(rsp)
+}
+
+// DeleteEventWithResponse request returning *DeleteEventResponse
+func (c *ClientWithResponses) DeleteEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*DeleteEventResponse, error) {
+	rsp, err := c.DeleteEvent(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteEventResponse(rsp)
+}
+
+// GetEventByIDWithResponse request returning *GetEventByIDResponse
+func (c *ClientWithResponses) GetEventByIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetEventByIDResponse, error) {
+	rsp, err := c.GetEventByID(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventByIDResponse(rsp)
+}
+
+// UpdateEventWithBodyWithResponse request with arbitrary body returning *UpdateEventResponse
+func (c *ClientWithResponses) UpdateEventWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEventResponse, error) {
+	rsp, err := c.UpdateEventWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEventResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateEventWithResponse(ctx context.Context, idEvent int64, body UpdateEventJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEventResponse, error) {
+	rsp, err := c.UpdateEvent(ctx, idEvent, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEventResponse(rsp)
+}
+
+// GetActivitiesByEventIDWithResponse request returning *GetActivitiesByEventIDResponse
+func (c *ClientWithResponses) GetActivitiesByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetActivitiesByEventIDResponse, error) {
+	rsp, err := c.GetActivitiesByEventID(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetActivitiesByEventIDResponse(rsp)
+}
+
+// CreateActivityWithBodyWithResponse request with arbitrary body returning *CreateActivityResponse
+func (c *ClientWithResponses) CreateActivityWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error) {
+	rsp, err := c.CreateActivityWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateActivityResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateActivityWithResponse(ctx context.Context, idEvent int64, body CreateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateActivityResponse, error) {
+	rsp, err := c.CreateActivity(ctx, idEvent, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateActivityResponse(rsp)
+}
+
+// DeleteActivityWithResponse request returning *DeleteActivityResponse
+func (c *ClientWithResponses) DeleteActivityWithResponse(ctx context.Context, idEvent int64, idActivity int, reqEditors ...RequestEditorFn) (*DeleteActivityResponse, error) {
+	rsp, err := c.DeleteActivity(ctx, idEvent, idActivity, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteActivityResponse(rsp)
+}
+
+// GetActivityByIDWithResponse request returning *GetActivityByIDResponse
+func (c *ClientWithResponses) GetActivityByIDWithResponse(ctx context.Context, idEvent int64, idActivity int, reqEditors ...RequestEditorFn) (*GetActivityByIDResponse, error) {
+	rsp, err := c.GetActivityByID(ctx, idEvent, idActivity, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetActivityByIDResponse(rsp)
+}
+
+// UpdateActivityWithBodyWithResponse request with arbitrary body returning *UpdateActivityResponse
+func (c *ClientWithResponses) UpdateActivityWithBodyWithResponse(ctx context.Context, idEvent int64, idActivity int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error) {
+	rsp, err := c.UpdateActivityWithBody(ctx, idEvent, idActivity, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateActivityResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateActivityWithResponse(ctx context.Context, idEvent int64, idActivity int, body UpdateActivityJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateActivityResponse, error) {
+	rsp, err := c.UpdateActivity(ctx, idEvent, idActivity, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateActivityResponse(rsp)
+}
+
+// GetEventAnalyticsWithResponse request returning *GetEventAnalyticsResponse
+func (c *ClientWithResponses) GetEventAnalyticsWithResponse(ctx context.Context, idEvent int64, params *GetEventAnalyticsParams, reqEditors ...RequestEditorFn) (*GetEventAnalyticsResponse, error) {
+	rsp, err := c.GetEventAnalytics(ctx, idEvent, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventAnalyticsResponse(rsp)
+}
+
+// ArchiveEventWithResponse request returning *ArchiveEventResponse
+func (c *ClientWithResponses) ArchiveEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*ArchiveEventResponse, error) {
+	rsp, err := c.ArchiveEvent(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveEventResponse(rsp)
+}
+
+// GetDummyClaimsWithResponse request returning *GetDummyClaimsResponse
+func (c *ClientWithResponses) GetDummyClaimsWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetDummyClaimsResponse, error) {
+	rsp, err := c.GetDummyClaims(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDummyClaimsResponse(rsp)
+}
+
+// ApproveDummyClaimWithResponse request returning *ApproveDummyClaimResponse
+func (c *ClientWithResponses) ApproveDummyClaimWithResponse(ctx context.Context, idEvent int64, idClaim int, reqEditors ...RequestEditorFn) (*ApproveDummyClaimResponse, error) {
+	rsp, err := c.ApproveDummyClaim(ctx, idEvent, idClaim, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveDummyClaimResponse(rsp)
+}
+
+// RejectDummyClaimWithResponse request returning *RejectDummyClaimResponse
+func (c *ClientWithResponses) RejectDummyClaimWithResponse(ctx context.Context, idEvent int64, idClaim int, reqEditors ...RequestEditorFn) (*RejectDummyClaimResponse, error) {
+	rsp, err := c.RejectDummyClaim(ctx, idEvent, idClaim, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectDummyClaimResponse(rsp)
+}
+
+// CloseEventWithResponse request returning *CloseEventResponse
+func (c *ClientWithResponses) CloseEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*CloseEventResponse, error) {
+	rsp, err := c.CloseEvent(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloseEventResponse(rsp)
+}
+
+// GetDebtsByEventIDWithResponse request returning *GetDebtsByEventIDResponse
+func (c *ClientWithResponses) GetDebtsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetDebtsByEventIDResponse, error) {
+	rsp, err := c.GetDebtsByEventID(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDebtsByEventIDResponse(rsp)
+}
+
+// ExportEventWithResponse request returning *ExportEventResponse
+func (c *ClientWithResponses) ExportEventWithResponse(ctx context.Context, idEvent int64, params *ExportEventParams, reqEditors ...RequestEditorFn) (*ExportEventResponse, error) {
+	rsp, err := c.ExportEvent(ctx, idEvent, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportEventResponse(rsp)
+}
+
+// GetActivityFeedWithResponse request returning *GetActivityFeedResponse
+func (c *ClientWithResponses) GetActivityFeedWithResponse(ctx context.Context, idEvent int64, params *GetActivityFeedParams, reqEditors ...RequestEditorFn) (*GetActivityFeedResponse, error) {
+	rsp, err := c.GetActivityFeed(ctx, idEvent, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetActivityFeedResponse(rsp)
+}
+
+// ImportTransactionsWithBodyWithResponse request with arbitrary body returning *ImportTransactionsResponse
+func (c *ClientWithResponses) ImportTransactionsWithBodyWithResponse(ctx context.Context, idEvent int64, params *ImportTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTransactionsResponse, error) {
+	rsp, err := c.ImportTransactionsWithBody(ctx, idEvent, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportTransactionsResponse(rsp)
+}
+
+// GetEventInvitesWithResponse request returning *GetEventInvitesResponse
+func (c *ClientWithResponses) GetEventInvitesWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetEventInvitesResponse, error) {
+	rsp, err := c.GetEventInvites(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventInvitesResponse(rsp)
+}
+
+// CreateEventInviteWithBodyWithResponse request with arbitrary body returning *CreateEventInviteResponse
+func (c *ClientWithResponses) CreateEventInviteWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEventInviteResponse, error) {
+	rsp, err := c.CreateEventInviteWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEventInviteResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateEventInviteWithResponse(ctx context.Context, idEvent int64, body CreateEventInviteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEventInviteResponse, error) {
+	rsp, err := c.CreateEventInvite(ctx, idEvent, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEventInviteResponse(rsp)
+}
+
+// RevokeEventInviteWithResponse request returning *RevokeEventInviteResponse
+func (c *ClientWithResponses) RevokeEventInviteWithResponse(ctx context.Context, idEvent int64, idInvite int, reqEditors ...RequestEditorFn) (*RevokeEventInviteResponse, error) {
+	rsp, err := c.RevokeEventInvite(ctx, idEvent, idInvite, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeEventInviteResponse(rsp)
+}
+
+// GetOptimizedDebtsByEventIDWithResponse request returning *GetOptimizedDebtsByEventIDResponse
+func (c *ClientWithResponses) GetOptimizedDebtsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetOptimizedDebtsByEventIDResponse, error) {
+	rsp, err := c.GetOptimizedDebtsByEventID(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOptimizedDebtsByEventIDResponse(rsp)
+}
+
+// OptimizeDebtsWithResponse request returning *OptimizeDebtsResponse
+func (c *ClientWithResponses) OptimizeDebtsWithResponse(ctx context.Context, idEvent int64, params *OptimizeDebtsParams, reqEditors ...RequestEditorFn) (*OptimizeDebtsResponse, error) {
+	rsp, err := c.OptimizeDebts(ctx, idEvent, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOptimizeDebtsResponse(rsp)
+}
+
+// TransferEventOwnershipWithBodyWithResponse request with arbitrary body returning *TransferEventOwnershipResponse
+func (c *ClientWithResponses) TransferEventOwnershipWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferEventOwnershipResponse, error) {
+	rsp, err := c.TransferEventOwnershipWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferEventOwnershipResponse(rsp)
+}
+
+func (c *ClientWithResponses) TransferEventOwnershipWithResponse(ctx context.Context, idEvent int64, body TransferEventOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*TransferEventOwnershipResponse, error) {
+	rsp, err := c.TransferEventOwnership(ctx, idEvent, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferEventOwnershipResponse(rsp)
+}
+
+// GetRecurringTransactionsWithResponse request returning *GetRecurringTransactionsResponse
+func (c *ClientWithResponses) GetRecurringTransactionsWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetRecurringTransactionsResponse, error) {
+	rsp, err := c.GetRecurringTransactions(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecurringTransactionsResponse(rsp)
+}
+
+// CreateRecurringTransactionWithBodyWithResponse request with arbitrary body returning *CreateRecurringTransactionResponse
+func (c *ClientWithResponses) CreateRecurringTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRecurringTransactionResponse, error) {
+	rsp, err := c.CreateRecurringTransactionWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRecurringTransactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRecurringTransactionWithResponse(ctx context.Context, idEvent int64, body CreateRecurringTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRecurringTransactionResponse, error) {
+	rsp, err := c.CreateRecurringTransaction(ctx, idEvent, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRecurringTransactionResponse(rsp)
+}
+
+// DeleteRecurringTransactionWithResponse request returning *DeleteRecurringTransactionResponse
+func (c *ClientWithResponses) DeleteRecurringTransactionWithResponse(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*DeleteRecurringTransactionResponse, error) {
+	rsp, err := c.DeleteRecurringTransaction(ctx, idEvent, idRecurring, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRecurringTransactionResponse(rsp)
+}
+
+// UpdateRecurringTransactionWithBodyWithResponse request with arbitrary body returning *UpdateRecurringTransactionResponse
+func (c *ClientWithResponses) UpdateRecurringTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, idRecurring int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRecurringTransactionResponse, error) {
+	rsp, err := c.UpdateRecurringTransactionWithBody(ctx, idEvent, idRecurring, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRecurringTransactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRecurringTransactionWithResponse(ctx context.Context, idEvent int64, idRecurring int, body UpdateRecurringTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRecurringTransactionResponse, error) {
+	rsp, err := c.UpdateRecurringTransaction(ctx, idEvent, idRecurring, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRecurringTransactionResponse(rsp)
+}
+
+// PauseRecurringTransactionWithResponse request returning *PauseRecurringTransactionResponse
+func (c *ClientWithResponses) PauseRecurringTransactionWithResponse(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*PauseRecurringTransactionResponse, error) {
+	rsp, err := c.PauseRecurringTransaction(ctx, idEvent, idRecurring, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePauseRecurringTransactionResponse(rsp)
+}
+
+// ResumeRecurringTransactionWithResponse request returning *ResumeRecurringTransactionResponse
+func (c *ClientWithResponses) ResumeRecurringTransactionWithResponse(ctx context.Context, idEvent int64, idRecurring int, reqEditors ...RequestEditorFn) (*ResumeRecurringTransactionResponse, error) {
+	rsp, err := c.ResumeRecurringTransaction(ctx, idEvent, idRecurring, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResumeRecurringTransactionResponse(rsp)
+}
+
+// ReopenEventWithResponse request returning *ReopenEventResponse
+func (c *ClientWithResponses) ReopenEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*ReopenEventResponse, error) {
+	rsp, err := c.ReopenEvent(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReopenEventResponse(rsp)
+}
+
+// SettleEventWithResponse request returning *SettleEventResponse
+func (c *ClientWithResponses) SettleEventWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*SettleEventResponse, error) {
+	rsp, err := c.SettleEvent(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSettleEventResponse(rsp)
+}
+
+// GetSettlementsByEventIDWithResponse request returning *GetSettlementsByEventIDResponse
+func (c *ClientWithResponses) GetSettlementsByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetSettlementsByEventIDResponse, error) {
+	rsp, err := c.GetSettlementsByEventID(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSettlementsByEventIDResponse(rsp)
+}
+
+// CreateSettlementWithBodyWithResponse request with arbitrary body returning *CreateSettlementResponse
+func (c *ClientWithResponses) CreateSettlementWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSettlementResponse, error) {
+	rsp, err := c.CreateSettlementWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSettlementResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSettlementWithResponse(ctx context.Context, idEvent int64, body CreateSettlementJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSettlementResponse, error) {
+	rsp, err := c.CreateSettlement(ctx, idEvent, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSettlementResponse(rsp)
+}
+
+// DeleteSettlementWithResponse request returning *DeleteSettlementResponse
+func (c *ClientWithResponses) DeleteSettlementWithResponse(ctx context.Context, idEvent int64, idSettlement int, reqEditors ...RequestEditorFn) (*DeleteSettlementResponse, error) {
+	rsp, err := c.DeleteSettlement(ctx, idEvent, idSettlement, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSettlementResponse(rsp)
+}
+
+// GetTasksByEventIDWithResponse request returning *GetTasksByEventIDResponse
+func (c *ClientWithResponses) GetTasksByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetTasksByEventIDResponse, error) {
+	rsp, err := c.GetTasksByEventID(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTasksByEventIDResponse(rsp)
+}
+
+// CreateTaskWithBodyWithResponse request with arbitrary body returning *CreateTaskResponse
+func (c *ClientWithResponses) CreateTaskWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error) {
+	rsp, err := c.CreateTaskWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTaskWithResponse(ctx context.Context, idEvent int64, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error) {
+	rsp, err := c.CreateTask(ctx, idEvent, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskResponse(rsp)
+}
+
+// DeleteTaskWithResponse request returning *DeleteTaskResponse
+func (c *ClientWithResponses) DeleteTaskWithResponse(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*DeleteTaskResponse, error) {
+	rsp, err := c.DeleteTask(ctx, idEvent, idTask, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTaskResponse(rsp)
+}
+
+// GetTaskByIDWithResponse request returning *GetTaskByIDResponse
+func (c *ClientWithResponses) GetTaskByIDWithResponse(ctx context.Context, idEvent int64, idTask int, reqEditors ...RequestEditorFn) (*GetTaskByIDResponse, error) {
+	rsp, err := c.GetTaskByID(ctx, idEvent, idTask, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskByIDResponse(rsp)
+}
+
+// UpdateTaskWithBodyWithResponse request with arbitrary body returning *UpdateTaskResponse
+func (c *ClientWithResponses) UpdateTaskWithBodyWithResponse(ctx context.Context, idEvent int64, idTask int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error) {
+	rsp, err := c.UpdateTaskWithBody(ctx, idEvent, idTask, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTaskWithResponse(ctx context.Context, idEvent int64, idTask int, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error) {
+	rsp, err := c.UpdateTask(ctx, idEvent, idTask, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTaskResponse(rsp)
+}
+
+// GetTransactionsByEventIDWithResponse request returning *GetTransactionsByEventIDResponse
+func (c *ClientWithResponses) GetTransactionsByEventIDWithResponse(ctx context.Context, idEvent int64, params *GetTransactionsByEventIDParams, reqEditors ...RequestEditorFn) (*GetTransactionsByEventIDResponse, error) {
+	rsp, err := c.GetTransactionsByEventID(ctx, idEvent, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionsByEventIDResponse(rsp)
+}
+
+// CreateTransactionWithBodyWithResponse request with arbitrary body returning *CreateTransactionResponse
+func (c *ClientWithResponses) CreateTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error) {
+	rsp, err := c.CreateTransactionWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTransactionWithResponse(ctx context.Context, idEvent int64, body CreateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionResponse, error) {
+	rsp, err := c.CreateTransaction(ctx, idEvent, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransactionResponse(rsp)
+}
+
+// DeleteTransactionWithResponse request returning *DeleteTransactionResponse
+func (c *ClientWithResponses) DeleteTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*DeleteTransactionResponse, error) {
+	rsp, err := c.DeleteTransaction(ctx, idEvent, idTransaction, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTransactionResponse(rsp)
+}
+
+// GetTransactionByIDWithResponse request returning *GetTransactionByIDResponse
+func (c *ClientWithResponses) GetTransactionByIDWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*GetTransactionByIDResponse, error) {
+	rsp, err := c.GetTransactionByID(ctx, idEvent, idTransaction, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionByIDResponse(rsp)
+}
+
+// UpdateTransactionWithBodyWithResponse request with arbitrary body returning *UpdateTransactionResponse
+func (c *ClientWithResponses) UpdateTransactionWithBodyWithResponse(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransactionResponse, error) {
+	rsp, err := c.UpdateTransactionWithBody(ctx, idEvent, idTransaction, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTransactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, body UpdateTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransactionResponse, error) {
+	rsp, err := c.UpdateTransaction(ctx, idEvent, idTransaction, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTransactionResponse(rsp)
+}
+
+// AttachTransactionFileWithBodyWithResponse request with arbitrary body returning *AttachTransactionFileResponse
+func (c *ClientWithResponses) AttachTransactionFileWithBodyWithResponse(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachTransactionFileResponse, error) {
+	rsp, err := c.AttachTransactionFileWithBody(ctx, idEvent, idTransaction, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachTransactionFileResponse(rsp)
+}
+
+func (c *ClientWithResponses) AttachTransactionFileWithResponse(ctx context.Context, idEvent int64, idTransaction int, body AttachTransactionFileJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachTransactionFileResponse, error) {
+	rsp, err := c.AttachTransactionFile(ctx, idEvent, idTransaction, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachTransactionFileResponse(rsp)
+}
+
+// DetachTransactionFileWithResponse request returning *DetachTransactionFileResponse
+func (c *ClientWithResponses) DetachTransactionFileWithResponse(ctx context.Context, idEvent int64, idTransaction int, idAttachment int, reqEditors ...RequestEditorFn) (*DetachTransactionFileResponse, error) {
+	rsp, err := c.DetachTransactionFile(ctx, idEvent, idTransaction, idAttachment, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDetachTransactionFileResponse(rsp)
+}
+
+// GetTransactionAttachmentUrlWithResponse request returning *GetTransactionAttachmentUrlResponse
+func (c *ClientWithResponses) GetTransactionAttachmentUrlWithResponse(ctx context.Context, idEvent int64, idTransaction int, idAttachment int, reqEditors ...RequestEditorFn) (*GetTransactionAttachmentUrlResponse, error) {
+	rsp, err := c.GetTransactionAttachmentUrl(ctx, idEvent, idTransaction, idAttachment, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionAttachmentUrlResponse(rsp)
+}
+
+// GetTransactionHistoryWithResponse request returning *GetTransactionHistoryResponse
+func (c *ClientWithResponses) GetTransactionHistoryWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*GetTransactionHistoryResponse, error) {
+	rsp, err := c.GetTransactionHistory(ctx, idEvent, idTransaction, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionHistoryResponse(rsp)
+}
+
+// RevertTransactionWithResponse request returning *RevertTransactionResponse
+func (c *ClientWithResponses) RevertTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, idRevision int, reqEditors ...RequestEditorFn) (*RevertTransactionResponse, error) {
+	rsp, err := c.RevertTransaction(ctx, idEvent, idTransaction, idRevision, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevertTransactionResponse(rsp)
+}
+
+// GetTransactionItemsWithResponse request returning *GetTransactionItemsResponse
+func (c *ClientWithResponses) GetTransactionItemsWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*GetTransactionItemsResponse, error) {
+	rsp, err := c.GetTransactionItems(ctx, idEvent, idTransaction, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionItemsResponse(rsp)
+}
+
+// CreateTransactionItemWithBodyWithResponse request with arbitrary body returning *CreateTransactionItemResponse
+func (c *ClientWithResponses) CreateTransactionItemWithBodyWithResponse(ctx context.Context, idEvent int64, idTransaction int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionItemResponse, error) {
+	rsp, err := c.CreateTransactionItemWithBody(ctx, idEvent, idTransaction, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransactionItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTransactionItemWithResponse(ctx context.Context, idEvent int64, idTransaction int, body CreateTransactionItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionItemResponse, error) {
+	rsp, err := c.CreateTransactionItem(ctx, idEvent, idTransaction, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransactionItemResponse(rsp)
+}
+
+// DeleteTransactionItemWithResponse request returning *DeleteTransactionItemResponse
+func (c *ClientWithResponses) DeleteTransactionItemWithResponse(ctx context.Context, idEvent int64, idTransaction int, idItem int, reqEditors ...RequestEditorFn) (*DeleteTransactionItemResponse, error) {
+	rsp, err := c.DeleteTransactionItem(ctx, idEvent, idTransaction, idItem, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTransactionItemResponse(rsp)
+}
+
+// UpdateTransactionItemWithBodyWithResponse request with arbitrary body returning *UpdateTransactionItemResponse
+func (c *ClientWithResponses) UpdateTransactionItemWithBodyWithResponse(ctx context.Context, idEvent int64, idTransaction int, idItem int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransactionItemResponse, error) {
+	rsp, err := c.UpdateTransactionItemWithBody(ctx, idEvent, idTransaction, idItem, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTransactionItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTransactionItemWithResponse(ctx context.Context, idEvent int64, idTransaction int, idItem int, body UpdateTransactionItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransactionItemResponse, error) {
+	rsp, err := c.UpdateTransactionItem(ctx, idEvent, idTransaction, idItem, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTransactionItemResponse(rsp)
+}
+
+// RestoreTransactionWithResponse request returning *RestoreTransactionResponse
+func (c *ClientWithResponses) RestoreTransactionWithResponse(ctx context.Context, idEvent int64, idTransaction int, reqEditors ...RequestEditorFn) (*RestoreTransactionResponse, error) {
+	rsp, err := c.RestoreTransaction(ctx, idEvent, idTransaction, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreTransactionResponse(rsp)
+}
+
+// GetEventTrashWithResponse request returning *GetEventTrashResponse
+func (c *ClientWithResponses) GetEventTrashWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetEventTrashResponse, error) {
+	rsp, err := c.GetEventTrash(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventTrashResponse(rsp)
+}
+
+// GetUsersByEventIDWithResponse request returning *GetUsersByEventIDResponse
+func (c *ClientWithResponses) GetUsersByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetUsersByEventIDResponse, error) {
+	rsp, err := c.GetUsersByEventID(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersByEventIDResponse(rsp)
+}
+
+// AddUsersToEventWithBodyWithResponse request with arbitrary body returning *AddUsersToEventResponse
+func (c *ClientWithResponses) AddUsersToEventWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddUsersToEventResponse, error) {
+	rsp, err := c.AddUsersToEventWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddUsersToEventResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddUsersToEventWithResponse(ctx context.Context, idEvent int64, body AddUsersToEventJSONRequestBody, reqEditors ...RequestEditorFn) (*AddUsersToEventResponse, error) {
+	rsp, err := c.AddUsersToEvent(ctx, idEvent, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddUsersToEventResponse(rsp)
+}
+
+// GetDummiesByEventIDWithResponse request returning *GetDummiesByEventIDResponse
+func (c *ClientWithResponses) GetDummiesByEventIDWithResponse(ctx context.Context, idEvent int64, reqEditors ...RequestEditorFn) (*GetDummiesByEventIDResponse, error) {
+	rsp, err := c.GetDummiesByEventID(ctx, idEvent, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDummiesByEventIDResponse(rsp)
+}
+
+// CreateDummyUserWithBodyWithResponse request with arbitrary body returning *CreateDummyUserResponse
+func (c *ClientWithResponses) CreateDummyUserWithBodyWithResponse(ctx context.Context, idEvent int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDummyUserResponse, error) {
+	rsp, err := c.CreateDummyUserWithBody(ctx, idEvent, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDummyUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDummyUserWithResponse(ctx context.Context, idEvent int64, body CreateDummyUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDummyUserResponse, error) {
+	rsp, err := c.CreateDummyUser(ctx, idEvent, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDummyUserResponse(rsp)
+}
+
+// RemoveUserFromEventWithResponse request returning *RemoveUserFromEventResponse
+func (c *ClientWithResponses) RemoveUserFromEventWithResponse(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*RemoveUserFromEventResponse, error) {
+	rsp, err := c.RemoveUserFromEvent(ctx, idEvent, idUser, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveUserFromEventResponse(rsp)
+}
+
+// CreateDummyClaimWithResponse request returning *CreateDummyClaimResponse
+func (c *ClientWithResponses) CreateDummyClaimWithResponse(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*CreateDummyClaimResponse, error) {
+	rsp, err := c.CreateDummyClaim(ctx, idEvent, idUser, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDummyClaimResponse(rsp)
+}
+
+// GetOptimizedDebtsByUserIDWithResponse request returning *GetOptimizedDebtsByUserIDResponse
+func (c *ClientWithResponses) GetOptimizedDebtsByUserIDWithResponse(ctx context.Context, idEvent int64, idUser int64, reqEditors ...RequestEditorFn) (*GetOptimizedDebtsByUserIDResponse, error) {
+	rsp, err := c.GetOptimizedDebtsByUserID(ctx, idEvent, idUser, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOptimizedDebtsByUserIDResponse(rsp)
+}
+
+// UpdateEventUserRoleWithBodyWithResponse request with arbitrary body returning *UpdateEventUserRoleResponse
+func (c *ClientWithResponses) UpdateEventUserRoleWithBodyWithResponse(ctx context.Context, idEvent int64, idUser int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEventUserRoleResponse, error) {
+	rsp, err := c.UpdateEventUserRoleWithBody(ctx, idEvent, idUser, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEventUserRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateEventUserRoleWithResponse(ctx context.Context, idEvent int64, idUser int64, body UpdateEventUserRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEventUserRoleResponse, error) {
+	rsp, err := c.UpdateEventUserRole(ctx, idEvent, idUser, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEventUserRoleResponse(rsp)
+}
+
+// GetExchangeRatesWithResponse request returning *GetExchangeRatesResponse
+func (c *ClientWithResponses) GetExchangeRatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetExchangeRatesResponse, error) {
+	rsp, err := c.GetExchangeRates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExchangeRatesResponse(rsp)
+}
+
+// JoinEventByInviteWithResponse request returning *JoinEventByInviteResponse
+func (c *ClientWithResponses) JoinEventByInviteWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*JoinEventByInviteResponse, error) {
+	rsp, err := c.JoinEventByInvite(ctx, token, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJoinEventByInviteResponse(rsp)
+}
+
+// CreateCategoryWithBodyWithResponse request with arbitrary body returning *CreateCategoryResponse
+func (c *ClientWithResponses) CreateCategoryWithBodyWithResponse(ctx context.Context, params *CreateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
+	rsp, err := c.CreateCategoryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCategoryResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCategoryWithResponse(ctx context.Context, params *CreateCategoryParams, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
+	rsp, err := c.CreateCategory(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCategoryResponse(rsp)
+}
+
+// DeleteCategoryWithResponse request returning *DeleteCategoryResponse
+func (c *ClientWithResponses) DeleteCategoryWithResponse(ctx context.Context, id int, params *DeleteCategoryParams, reqEditors ...RequestEditorFn) (*DeleteCategoryResponse, error) {
+	rsp, err := c.DeleteCategory(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCategoryResponse(rsp)
+}
+
+// UpdateCategoryWithBodyWithResponse request with arbitrary body returning *UpdateCategoryResponse
+func (c *ClientWithResponses) UpdateCategoryWithBodyWithResponse(ctx context.Context, id int, params *UpdateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error) {
+	rsp, err := c.UpdateCategoryWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCategoryResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCategoryWithResponse(ctx context.Context, id int, params *UpdateCategoryParams, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error) {
+	rsp, err := c.UpdateCategory(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCategoryResponse(rsp)
+}
+
+// SetExchangeRateWithBodyWithResponse request with arbitrary body returning *SetExchangeRateResponse
+func (c *ClientWithResponses) SetExchangeRateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetExchangeRateResponse, error) {
+	rsp, err := c.SetExchangeRateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetExchangeRateResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetExchangeRateWithResponse(ctx context.Context, body SetExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*SetExchangeRateResponse, error) {
+	rsp, err := c.SetExchangeRate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetExchangeRateResponse(rsp)
+}
+
+// DeleteExchangeRateWithResponse request returning *DeleteExchangeRateResponse
+func (c *ClientWithResponses) DeleteExchangeRateWithResponse(ctx context.Context, currencyFrom string, currencyTo string, reqEditors ...RequestEditorFn) (*DeleteExchangeRateResponse, error) {
+	rsp, err := c.DeleteExchangeRate(ctx, currencyFrom, currencyTo, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteExchangeRateResponse(rsp)
+}
+
+// GetIconsWithResponse request returning *GetIconsResponse
+func (c *ClientWithResponses) GetIconsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIconsResponse, error) {
+	rsp, err := c.GetIcons(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIconsResponse(rsp)
+}
+
+// CreateIconWithBodyWithResponse request with arbitrary body returning *CreateIconResponse
+func (c *ClientWithResponses) CreateIconWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIconResponse, error) {
+	rsp, err := c.CreateIconWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIconResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateIconWithResponse(ctx context.Context, body CreateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIconResponse, error) {
+	rsp, err := c.CreateIcon(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIconResponse(rsp)
+}
+
+// DeleteIconWithResponse request returning *DeleteIconResponse
+func (c *ClientWithResponses) DeleteIconWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteIconResponse, error) {
+	rsp, err := c.DeleteIcon(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteIconResponse(rsp)
+}
+
+// GetIconByIDWithResponse request returning *GetIconByIDResponse
+func (c *ClientWithResponses) GetIconByIDWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetIconByIDResponse, error) {
+	rsp, err := c.GetIconByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIconByIDResponse(rsp)
+}
+
+// UpdateIconWithBodyWithResponse request with arbitrary body returning *UpdateIconResponse
+func (c *ClientWithResponses) UpdateIconWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIconResponse, error) {
+	rsp, err := c.UpdateIconWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIconResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateIconWithResponse(ctx context.Context, id int, body UpdateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIconResponse, error) {
+	rsp, err := c.UpdateIcon(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIconResponse(rsp)
+}
+
+// GetUsersByExternalIDsWithResponse request returning *GetUsersByExternalIDsResponse
+func (c *ClientWithResponses) GetUsersByExternalIDsWithResponse(ctx context.Context, params *GetUsersByExternalIDsParams, reqEditors ...RequestEditorFn) (*GetUsersByExternalIDsResponse, error) {
+	rsp, err := c.GetUsersByExternalIDs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersByExternalIDsResponse(rsp)
+}
+
+// GetUserByIDWithResponse request returning *GetUserByIDResponse
+func (c *ClientWithResponses) GetUserByIDWithResponse(ctx context.Context, idUser int64, reqEditors ...RequestEditorFn) (*GetUserByIDResponse, error) {
+	rsp, err := c.GetUserByID(ctx, idUser, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserByIDResponse(rsp)
+}
+
+// SyncUsersWithBodyWithResponse request with arbitrary body returning *SyncUsersResponse
+func (c *ClientWithResponses) SyncUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncUsersResponse, error) {
+	rsp, err := c.SyncUsersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncUsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) SyncUsersWithResponse(ctx context.Context, body SyncUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*SyncUsersResponse, error) {
+	rsp, err := c.SyncUsers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncUsersResponse(rsp)
+}
+
+// ParseGetCategoriesResponse parses an HTTP response from a GetCategoriesWithResponse call
+func ParseGetCategoriesResponse(rsp *http.Response) (*GetCategoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCategoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CategoryListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCategoryByIDResponse parses an HTTP response from a GetCategoryByIDWithResponse call
+func ParseGetCategoryByIDResponse(rsp *http.Response) (*GetCategoryByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCategoryByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CategoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEventsResponse parses an HTTP response from a GetEventsWithResponse call
+func ParseGetEventsResponse(rsp *http.Response) (*GetEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateEventResponse parses an HTTP response from a CreateEventWithResponse call
+func ParseCreateEventResponse(rsp *http.Response) (*CreateEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest EventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeletedEventsResponse parses an HTTP response from a GetDeletedEventsWithResponse call
+func ParseGetDeletedEventsResponse(rsp *http.Response) (*GetDeletedEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeletedEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreEventResponse parses an HTTP response from a RestoreEventWithResponse call
+func ParseRestoreEventResponse(rsp *http.Response) (*RestoreEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteEventResponse parses an HTTP response from a DeleteEventWithResponse call
+func ParseDeleteEventResponse(rsp *http.Response) (*DeleteEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEventByIDResponse parses an HTTP response from a GetEventByIDWithResponse call
+func ParseGetEventByIDResponse(rsp *http.Response) (*GetEventByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateEventResponse parses an HTTP response from a UpdateEventWithResponse call
+func ParseUpdateEventResponse(rsp *http.Response) (*UpdateEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetActivitiesByEventIDResponse parses an HTTP response from a GetActivitiesByEventIDWithResponse call
+func ParseGetActivitiesByEventIDResponse(rsp *http.Response) (*GetActivitiesByEventIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetActivitiesByEventIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ActivityListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateActivityResponse parses an HTTP response from a CreateActivityWithResponse call
+func ParseCreateActivityResponse(rsp *http.Response) (*CreateActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ActivityResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteActivityResponse parses an HTTP response from a DeleteActivityWithResponse call
+func ParseDeleteActivityResponse(rsp *http.Response) (*DeleteActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetActivityByIDResponse parses an HTTP response from a GetActivityByIDWithResponse call
+func ParseGetActivityByIDResponse(rsp *http.Response) (*GetActivityByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetActivityByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ActivityResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateActivityResponse parses an HTTP response from a UpdateActivityWithResponse call
+func ParseUpdateActivityResponse(rsp *http.Response) (*UpdateActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ActivityResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEventAnalyticsResponse parses an HTTP response from a GetEventAnalyticsWithResponse call
+func ParseGetEventAnalyticsResponse(rsp *http.Response) (*GetEventAnalyticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventAnalyticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventAnalyticsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseArchiveEventResponse parses an HTTP response from a ArchiveEventWithResponse call
+func ParseArchiveEventResponse(rsp *http.Response) (*ArchiveEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ArchiveEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDummyClaimsResponse parses an HTTP response from a GetDummyClaimsWithResponse call
+func ParseGetDummyClaimsResponse(rsp *http.Response) (*GetDummyClaimsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDummyClaimsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DummyClaimListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApproveDummyClaimResponse parses an HTTP response from a ApproveDummyClaimWithResponse call
+func ParseApproveDummyClaimResponse(rsp *http.Response) (*ApproveDummyClaimResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApproveDummyClaimResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DummyClaimDTO
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRejectDummyClaimResponse parses an HTTP response from a RejectDummyClaimWithResponse call
+func ParseRejectDummyClaimResponse(rsp *http.Response) (*RejectDummyClaimResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RejectDummyClaimResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DummyClaimDTO
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloseEventResponse parses an HTTP response from a CloseEventWithResponse call
+func ParseCloseEventResponse(rsp *http.Response) (*CloseEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloseEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OptimizationResultResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDebtsByEventIDResponse parses an HTTP response from a GetDebtsByEventIDWithResponse call
+func ParseGetDebtsByEventIDResponse(rsp *http.Response) (*GetDebtsByEventIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDebtsByEventIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DebtListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportEventResponse parses an HTTP response from a ExportEventWithResponse call
+func ParseExportEventResponse(rsp *http.Response) (*ExportEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetActivityFeedResponse parses an HTTP response from a GetActivityFeedWithResponse call
+func ParseGetActivityFeedResponse(rsp *http.Response) (*GetActivityFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetActivityFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ActivityFeedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportTransactionsResponse parses an HTTP response from a ImportTransactionsWithResponse call
+func ParseImportTransactionsResponse(rsp *http.Response) (*ImportTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEventInvitesResponse parses an HTTP response from a GetEventInvitesWithResponse call
+func ParseGetEventInvitesResponse(rsp *http.Response) (*GetEventInvitesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventInvitesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventInviteListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateEventInviteResponse parses an HTTP response from a CreateEventInviteWithResponse call
+func ParseCreateEventInviteResponse(rsp *http.Response) (*CreateEventInviteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateEventInviteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest EventInviteDTO
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeEventInviteResponse parses an HTTP response from a RevokeEventInviteWithResponse call
+func ParseRevokeEventInviteResponse(rsp *http.Response) (*RevokeEventInviteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeEventInviteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOptimizedDebtsByEventIDResponse parses an HTTP response from a GetOptimizedDebtsByEventIDWithResponse call
+func ParseGetOptimizedDebtsByEventIDResponse(rsp *http.Response) (*GetOptimizedDebtsByEventIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOptimizedDebtsByEventIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OptimizedDebtListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOptimizeDebtsResponse parses an HTTP response from a OptimizeDebtsWithResponse call
+func ParseOptimizeDebtsResponse(rsp *http.Response) (*OptimizeDebtsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OptimizeDebtsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OptimizationResultResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTransferEventOwnershipResponse parses an HTTP response from a TransferEventOwnershipWithResponse call
+func ParseTransferEventOwnershipResponse(rsp *http.Response) (*TransferEventOwnershipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferEventOwnershipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRecurringTransactionsResponse parses an HTTP response from a GetRecurringTransactionsWithResponse call
+func ParseGetRecurringTransactionsResponse(rsp *http.Response) (*GetRecurringTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecurringTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecurringTransactionListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRecurringTransactionResponse parses an HTTP response from a CreateRecurringTransactionWithResponse call
+func ParseCreateRecurringTransactionResponse(rsp *http.Response) (*CreateRecurringTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRecurringTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RecurringTransactionDTO
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRecurringTransactionResponse parses an HTTP response from a DeleteRecurringTransactionWithResponse call
+func ParseDeleteRecurringTransactionResponse(rsp *http.Response) (*DeleteRecurringTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRecurringTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRecurringTransactionResponse parses an HTTP response from a UpdateRecurringTransactionWithResponse call
+func ParseUpdateRecurringTransactionResponse(rsp *http.Response) (*UpdateRecurringTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRecurringTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecurringTransactionDTO
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePauseRecurringTransactionResponse parses an HTTP response from a PauseRecurringTransactionWithResponse call
+func ParsePauseRecurringTransactionResponse(rsp *http.Response) (*PauseRecurringTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PauseRecurringTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecurringTransactionDTO
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResumeRecurringTransactionResponse parses an HTTP response from a ResumeRecurringTransactionWithResponse call
+func ParseResumeRecurringTransactionResponse(rsp *http.Response) (*ResumeRecurringTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResumeRecurringTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecurringTransactionDTO
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReopenEventResponse parses an HTTP response from a ReopenEventWithResponse call
+func ParseReopenEventResponse(rsp *http.Response) (*ReopenEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReopenEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSettleEventResponse parses an HTTP response from a SettleEventWithResponse call
+func ParseSettleEventResponse(rsp *http.Response) (*SettleEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SettleEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSettlementsByEventIDResponse parses an HTTP response from a GetSettlementsByEventIDWithResponse call
+func ParseGetSettlementsByEventIDResponse(rsp *http.Response) (*GetSettlementsByEventIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSettlementsByEventIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SettlementListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSettlementResponse parses an HTTP response from a CreateSettlementWithResponse call
+func ParseCreateSettlementResponse(rsp *http.Response) (*CreateSettlementResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSettlementResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SettlementDTO
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetOptimizedDebtsByUserIDResponse(rsp)
-}
 
-// CreateCategoryWithBodyWithResponse request with arbitrary body returning *CreateCategoryResponse
-func (c *ClientWithResponses) CreateCategoryWithBodyWithResponse(ctx context.Context, params *CreateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
-	rsp, err := c.CreateCategoryWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateCategoryResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateCategoryWithResponse(ctx context.Context, params *CreateCategoryParams, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
-	rsp, err := c.CreateCategory(ctx, params, body, reqEditors...)
+// ParseDeleteSettlementResponse parses an HTTP response from a DeleteSettlementWithResponse call
+func ParseDeleteSettlementResponse(rsp *http.Response) (*DeleteSettlementResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCategoryResponse(rsp)
-}
 
-// DeleteCategoryWithResponse request returning *DeleteCategoryResponse
-func (c *ClientWithResponses) DeleteCategoryWithResponse(ctx context.Context, id int, params *DeleteCategoryParams, reqEditors ...RequestEditorFn) (*DeleteCategoryResponse, error) {
-	rsp, err := c.DeleteCategory(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteSettlementResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteCategoryResponse(rsp)
-}
 
-// UpdateCategoryWithBodyWithResponse request with arbitrary body returning *UpdateCategoryResponse
-func (c *ClientWithResponses) UpdateCategoryWithBodyWithResponse(ctx context.Context, id int, params *UpdateCategoryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error) {
-	rsp, err := c.UpdateCategoryWithBody(ctx, id, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateCategoryResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-func (c *ClientWithResponses) UpdateCategoryWithResponse(ctx context.Context, id int, params *UpdateCategoryParams, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error) {
-	rsp, err := c.UpdateCategory(ctx, id, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateCategoryResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// GetIconsWithResponse request returning *GetIconsResponse
-func (c *ClientWithResponses) GetIconsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIconsResponse, error) {
-	rsp, err := c.GetIcons(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetIconsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// CreateIconWithBodyWithResponse request with arbitrary body returning *CreateIconResponse
-func (c *ClientWithResponses) CreateIconWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIconResponse, error) {
-	rsp, err := c.CreateIconWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseCreateIconResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateIconWithResponse(ctx context.Context, body CreateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIconResponse, error) {
-	rsp, err := c.CreateIcon(ctx, body, reqEditors...)
+// ParseGetTasksByEventIDResponse parses an HTTP response from a GetTasksByEventIDWithResponse call
+func ParseGetTasksByEventIDResponse(rsp *http.Response) (*GetTasksByEventIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateIconResponse(rsp)
-}
 
-// DeleteIconWithResponse request returning *DeleteIconResponse
-func (c *ClientWithResponses) DeleteIconWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteIconResponse, error) {
-	rsp, err := c.DeleteIcon(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetTasksByEventIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteIconResponse(rsp)
-}
 
-// GetIconByIDWithResponse request returning *GetIconByIDResponse
-func (c *ClientWithResponses) GetIconByIDWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetIconByIDResponse, error) {
-	rsp, err := c.GetIconByID(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetIconByIDResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateIconWithBodyWithResponse request with arbitrary body returning *UpdateIconResponse
-func (c *ClientWithResponses) UpdateIconWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIconResponse, error) {
-	rsp, err := c.UpdateIconWithBody(ctx, id, contentType, body, reqEditors...)
+// ParseCreateTaskResponse parses an HTTP response from a CreateTaskWithResponse call
+func ParseCreateTaskResponse(rsp *http.Response) (*CreateTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateIconResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateIconWithResponse(ctx context.Context, id int, body UpdateIconJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIconResponse, error) {
-	rsp, err := c.UpdateIcon(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateIconResponse(rsp)
-}
 
-// GetUsersByExternalIDsWithResponse request returning *GetUsersByExternalIDsResponse
-func (c *ClientWithResponses) GetUsersByExternalIDsWithResponse(ctx context.Context, params *GetUsersByExternalIDsParams, reqEditors ...RequestEditorFn) (*GetUsersByExternalIDsResponse, error) {
-	rsp, err := c.GetUsersByExternalIDs(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TaskResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetUsersByExternalIDsResponse(rsp)
+
+	return response, nil
 }
 
-// GetUserByIDWithResponse request returning *GetUserByIDResponse
-func (c *ClientWithResponses) GetUserByIDWithResponse(ctx context.Context, idUser int64, reqEditors ...RequestEditorFn) (*GetUserByIDResponse, error) {
-	rsp, err := c.GetUserByID(ctx, idUser, reqEditors...)
+// ParseDeleteTaskResponse parses an HTTP response from a DeleteTaskWithResponse call
+func ParseDeleteTaskResponse(rsp *http.Response) (*DeleteTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUserByIDResponse(rsp)
-}
 
-// SyncUsersWithBodyWithResponse request with arbitrary body returning *SyncUsersResponse
-func (c *ClientWithResponses) SyncUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncUsersResponse, error) {
-	rsp, err := c.SyncUsersWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSyncUsersResponse(rsp)
-}
 
-func (c *ClientWithResponses) SyncUsersWithResponse(ctx context.Context, body SyncUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*SyncUsersResponse, error) {
-	rsp, err := c.SyncUsers(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseSyncUsersResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetCategoriesResponse parses an HTTP response from a GetCategoriesWithResponse call
-func ParseGetCategoriesResponse(rsp *http.Response) (*GetCategoriesResponse, error) {
+// ParseGetTaskByIDResponse parses an HTTP response from a GetTaskByIDWithResponse call
+func ParseGetTaskByIDResponse(rsp *http.Response) (*GetTaskByIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCategoriesResponse{
+	response := &GetTaskByIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CategoryListResponse
+		var dest TaskResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -4415,27 +11206,41 @@ func ParseGetCategoriesResponse(rsp *http.Response) (*GetCategoriesResponse, err
 	return response, nil
 }
 
-// ParseGetCategoryByIDResponse parses an HTTP response from a GetCategoryByIDWithResponse call
-func ParseGetCategoryByIDResponse(rsp *http.Response) (*GetCategoryByIDResponse, error) {
+// ParseUpdateTaskResponse parses an HTTP response from a UpdateTaskWithResponse call
+func ParseUpdateTaskResponse(rsp *http.Response) (*UpdateTaskResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCategoryByIDResponse{
+	response := &UpdateTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CategoryResponse
+		var dest TaskResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4455,27 +11260,41 @@ func ParseGetCategoryByIDResponse(rsp *http.Response) (*GetCategoryByIDResponse,
 	return response, nil
 }
 
-// ParseGetEventsResponse parses an HTTP response from a GetEventsWithResponse call
-func ParseGetEventsResponse(rsp *http.Response) (*GetEventsResponse, error) {
+// ParseGetTransactionsByEventIDResponse parses an HTTP response from a GetTransactionsByEventIDWithResponse call
+func ParseGetTransactionsByEventIDResponse(rsp *http.Response) (*GetTransactionsByEventIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEventsResponse{
+	response := &GetTransactionsByEventIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventListResponse
+		var dest TransactionListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4488,22 +11307,22 @@ func ParseGetEventsResponse(rsp *http.Response) (*GetEventsResponse, error) {
 	return response, nil
 }
 
-// ParseCreateEventResponse parses an HTTP response from a CreateEventWithResponse call
-func ParseCreateEventResponse(rsp *http.Response) (*CreateEventResponse, error) {
+// ParseCreateTransactionResponse parses an HTTP response from a CreateTransactionWithResponse call
+func ParseCreateTransactionResponse(rsp *http.Response) (*CreateTransactionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateEventResponse{
+	response := &CreateTransactionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest EventResponse
+		var dest TransactionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4516,6 +11335,13 @@ func ParseCreateEventResponse(rsp *http.Response) (*CreateEventResponse, error)
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4528,15 +11354,15 @@ func ParseCreateEventResponse(rsp *http.Response) (*CreateEventResponse, error)
 	return response, nil
 }
 
-// ParseDeleteEventResponse parses an HTTP response from a DeleteEventWithResponse call
-func ParseDeleteEventResponse(rsp *http.Response) (*DeleteEventResponse, error) {
+// ParseDeleteTransactionResponse parses an HTTP response from a DeleteTransactionWithResponse call
+func ParseDeleteTransactionResponse(rsp *http.Response) (*DeleteTransactionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteEventResponse{
+	response := &DeleteTransactionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4549,6 +11375,13 @@ func ParseDeleteEventResponse(rsp *http.Response) (*DeleteEventResponse, error)
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4568,27 +11401,34 @@ func ParseDeleteEventResponse(rsp *http.Response) (*DeleteEventResponse, error)
 	return response, nil
 }
 
-// ParseGetEventByIDResponse parses an HTTP response from a GetEventByIDWithResponse call
-func ParseGetEventByIDResponse(rsp *http.Response) (*GetEventByIDResponse, error) {
+// ParseGetTransactionByIDResponse parses an HTTP response from a GetTransactionByIDWithResponse call
+func ParseGetTransactionByIDResponse(rsp *http.Response) (*GetTransactionByIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEventByIDResponse{
+	response := &GetTransactionByIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventResponse
+		var dest TransactionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4608,22 +11448,22 @@ func ParseGetEventByIDResponse(rsp *http.Response) (*GetEventByIDResponse, error
 	return response, nil
 }
 
-// ParseUpdateEventResponse parses an HTTP response from a UpdateEventWithResponse call
-func ParseUpdateEventResponse(rsp *http.Response) (*UpdateEventResponse, error) {
+// ParseUpdateTransactionResponse parses an HTTP response from a UpdateTransactionWithResponse call
+func ParseUpdateTransactionResponse(rsp *http.Response) (*UpdateTransactionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateEventResponse{
+	response := &UpdateTransactionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventResponse
+		var dest TransactionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4636,6 +11476,13 @@ func ParseUpdateEventResponse(rsp *http.Response) (*UpdateEventResponse, error)
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4655,26 +11502,54 @@ func ParseUpdateEventResponse(rsp *http.Response) (*UpdateEventResponse, error)
 	return response, nil
 }
 
-// ParseGetActivitiesByEventIDResponse parses an HTTP response from a GetActivitiesByEventIDWithResponse call
-func ParseGetActivitiesByEventIDResponse(rsp *http.Response) (*GetActivitiesByEventIDResponse, error) {
+// ParseAttachTransactionFileResponse parses an HTTP response from a AttachTransactionFileWithResponse call
+func ParseAttachTransactionFileResponse(rsp *http.Response) (*AttachTransactionFileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetActivitiesByEventIDResponse{
+	response := &AttachTransactionFileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ActivityListResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TransactionAttachment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -4688,26 +11563,26 @@ func ParseGetActivitiesByEventIDResponse(rsp *http.Response) (*GetActivitiesByEv
 	return response, nil
 }
 
-// ParseCreateActivityResponse parses an HTTP response from a CreateActivityWithResponse call
-func ParseCreateActivityResponse(rsp *http.Response) (*CreateActivityResponse, error) {
+// ParseDetachTransactionFileResponse parses an HTTP response from a DetachTransactionFileWithResponse call
+func ParseDetachTransactionFileResponse(rsp *http.Response) (*DetachTransactionFileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateActivityResponse{
+	response := &DetachTransactionFileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ActivityResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
@@ -4716,6 +11591,20 @@ func ParseCreateActivityResponse(rsp *http.Response) (*CreateActivityResponse, e
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4728,22 +11617,22 @@ func ParseCreateActivityResponse(rsp *http.Response) (*CreateActivityResponse, e
 	return response, nil
 }
 
-// ParseDeleteActivityResponse parses an HTTP response from a DeleteActivityWithResponse call
-func ParseDeleteActivityResponse(rsp *http.Response) (*DeleteActivityResponse, error) {
+// ParseGetTransactionAttachmentUrlResponse parses an HTTP response from a GetTransactionAttachmentUrlWithResponse call
+func ParseGetTransactionAttachmentUrlResponse(rsp *http.Response) (*GetTransactionAttachmentUrlResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteActivityResponse{
+	response := &GetTransactionAttachmentUrlResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
+		var dest TransactionAttachment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4768,27 +11657,34 @@ func ParseDeleteActivityResponse(rsp *http.Response) (*DeleteActivityResponse, e
 	return response, nil
 }
 
-// ParseGetActivityByIDResponse parses an HTTP response from a GetActivityByIDWithResponse call
-func ParseGetActivityByIDResponse(rsp *http.Response) (*GetActivityByIDResponse, error) {
+// ParseGetTransactionHistoryResponse parses an HTTP response from a GetTransactionHistoryWithResponse call
+func ParseGetTransactionHistoryResponse(rsp *http.Response) (*GetTransactionHistoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetActivityByIDResponse{
+	response := &GetTransactionHistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ActivityResponse
+		var dest TransactionHistoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4808,22 +11704,22 @@ func ParseGetActivityByIDResponse(rsp *http.Response) (*GetActivityByIDResponse,
 	return response, nil
 }
 
-// ParseUpdateActivityResponse parses an HTTP response from a UpdateActivityWithResponse call
-func ParseUpdateActivityResponse(rsp *http.Response) (*UpdateActivityResponse, error) {
+// ParseRevertTransactionResponse parses an HTTP response from a RevertTransactionWithResponse call
+func ParseRevertTransactionResponse(rsp *http.Response) (*RevertTransactionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateActivityResponse{
+	response := &RevertTransactionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ActivityResponse
+		var dest TransactionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4836,6 +11732,13 @@ func ParseUpdateActivityResponse(rsp *http.Response) (*UpdateActivityResponse, e
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4855,27 +11758,41 @@ func ParseUpdateActivityResponse(rsp *http.Response) (*UpdateActivityResponse, e
 	return response, nil
 }
 
-// ParseGetDebtsByEventIDResponse parses an HTTP response from a GetDebtsByEventIDWithResponse call
-func ParseGetDebtsByEventIDResponse(rsp *http.Response) (*GetDebtsByEventIDResponse, error) {
+// ParseGetTransactionItemsResponse parses an HTTP response from a GetTransactionItemsWithResponse call
+func ParseGetTransactionItemsResponse(rsp *http.Response) (*GetTransactionItemsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDebtsByEventIDResponse{
+	response := &GetTransactionItemsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DebtListResponse
+		var dest ItemListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4888,26 +11805,47 @@ func ParseGetDebtsByEventIDResponse(rsp *http.Response) (*GetDebtsByEventIDRespo
 	return response, nil
 }
 
-// ParseGetOptimizedDebtsByEventIDResponse parses an HTTP response from a GetOptimizedDebtsByEventIDWithResponse call
-func ParseGetOptimizedDebtsByEventIDResponse(rsp *http.Response) (*GetOptimizedDebtsByEventIDResponse, error) {
+// ParseCreateTransactionItemResponse parses an HTTP response from a CreateTransactionItemWithResponse call
+func ParseCreateTransactionItemResponse(rsp *http.Response) (*CreateTransactionItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOptimizedDebtsByEventIDResponse{
+	response := &CreateTransactionItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OptimizedDebtListResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TransactionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -4921,27 +11859,41 @@ func ParseGetOptimizedDebtsByEventIDResponse(rsp *http.Response) (*GetOptimizedD
 	return response, nil
 }
 
-// ParseOptimizeDebtsResponse parses an HTTP response from a OptimizeDebtsWithResponse call
-func ParseOptimizeDebtsResponse(rsp *http.Response) (*OptimizeDebtsResponse, error) {
+// ParseDeleteTransactionItemResponse parses an HTTP response from a DeleteTransactionItemWithResponse call
+func ParseDeleteTransactionItemResponse(rsp *http.Response) (*DeleteTransactionItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &OptimizeDebtsResponse{
+	response := &DeleteTransactionItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OptimizedDebtListResponse
+		var dest TransactionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4954,27 +11906,48 @@ func ParseOptimizeDebtsResponse(rsp *http.Response) (*OptimizeDebtsResponse, err
 	return response, nil
 }
 
-// ParseGetTasksByEventIDResponse parses an HTTP response from a GetTasksByEventIDWithResponse call
-func ParseGetTasksByEventIDResponse(rsp *http.Response) (*GetTasksByEventIDResponse, error) {
+// ParseUpdateTransactionItemResponse parses an HTTP response from a UpdateTransactionItemWithResponse call
+func ParseUpdateTransactionItemResponse(rsp *http.Response) (*UpdateTransactionItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTasksByEventIDResponse{
+	response := &UpdateTransactionItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TaskListResponse
+		var dest TransactionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4987,26 +11960,26 @@ func ParseGetTasksByEventIDResponse(rsp *http.Response) (*GetTasksByEventIDRespo
 	return response, nil
 }
 
-// ParseCreateTaskResponse parses an HTTP response from a CreateTaskWithResponse call
-func ParseCreateTaskResponse(rsp *http.Response) (*CreateTaskResponse, error) {
+// ParseRestoreTransactionResponse parses an HTTP response from a RestoreTransactionWithResponse call
+func ParseRestoreTransactionResponse(rsp *http.Response) (*RestoreTransactionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateTaskResponse{
+	response := &RestoreTransactionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TaskResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
@@ -5015,6 +11988,20 @@ func ParseCreateTaskResponse(rsp *http.Response) (*CreateTaskResponse, error) {
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5027,27 +12014,34 @@ func ParseCreateTaskResponse(rsp *http.Response) (*CreateTaskResponse, error) {
 	return response, nil
 }
 
-// ParseDeleteTaskResponse parses an HTTP response from a DeleteTaskWithResponse call
-func ParseDeleteTaskResponse(rsp *http.Response) (*DeleteTaskResponse, error) {
+// ParseGetEventTrashResponse parses an HTTP response from a GetEventTrashWithResponse call
+func ParseGetEventTrashResponse(rsp *http.Response) (*GetEventTrashResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteTaskResponse{
+	response := &GetEventTrashResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
+		var dest EventTrashResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5067,33 +12061,33 @@ func ParseDeleteTaskResponse(rsp *http.Response) (*DeleteTaskResponse, error) {
 	return response, nil
 }
 
-// ParseGetTaskByIDResponse parses an HTTP response from a GetTaskByIDWithResponse call
-func ParseGetTaskByIDResponse(rsp *http.Response) (*GetTaskByIDResponse, error) {
+// ParseGetUsersByEventIDResponse parses an HTTP response from a GetUsersByEventIDWithResponse call
+func ParseGetUsersByEventIDResponse(rsp *http.Response) (*GetUsersByEventIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTaskByIDResponse{
+	response := &GetUsersByEventIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TaskResponse
+		var dest UserListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -5107,22 +12101,22 @@ func ParseGetTaskByIDResponse(rsp *http.Response) (*GetTaskByIDResponse, error)
 	return response, nil
 }
 
-// ParseUpdateTaskResponse parses an HTTP response from a UpdateTaskWithResponse call
-func ParseUpdateTaskResponse(rsp *http.Response) (*UpdateTaskResponse, error) {
+// ParseAddUsersToEventResponse parses an HTTP response from a AddUsersToEventWithResponse call
+func ParseAddUsersToEventResponse(rsp *http.Response) (*AddUsersToEventResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateTaskResponse{
+	response := &AddUsersToEventResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TaskResponse
+		var dest SuccessResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5135,12 +12129,12 @@ func ParseUpdateTaskResponse(rsp *http.Response) (*UpdateTaskResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -5154,27 +12148,34 @@ func ParseUpdateTaskResponse(rsp *http.Response) (*UpdateTaskResponse, error) {
 	return response, nil
 }
 
-// ParseGetTransactionsByEventIDResponse parses an HTTP response from a GetTransactionsByEventIDWithResponse call
-func ParseGetTransactionsByEventIDResponse(rsp *http.Response) (*GetTransactionsByEventIDResponse, error) {
+// ParseGetDummiesByEventIDResponse parses an HTTP response from a GetDummiesByEventIDWithResponse call
+func ParseGetDummiesByEventIDResponse(rsp *http.Response) (*GetDummiesByEventIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTransactionsByEventIDResponse{
+	response := &GetDummiesByEventIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TransactionListResponse
+		var dest UserListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5187,22 +12188,22 @@ func ParseGetTransactionsByEventIDResponse(rsp *http.Response) (*GetTransactions
 	return response, nil
 }
 
-// ParseCreateTransactionResponse parses an HTTP response from a CreateTransactionWithResponse call
-func ParseCreateTransactionResponse(rsp *http.Response) (*CreateTransactionResponse, error) {
+// ParseCreateDummyUserResponse parses an HTTP response from a CreateDummyUserWithResponse call
+func ParseCreateDummyUserResponse(rsp *http.Response) (*CreateDummyUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateTransactionResponse{
+	response := &CreateDummyUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TransactionResponse
+		var dest UserProfileDTO
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5215,6 +12216,13 @@ func ParseCreateTransactionResponse(rsp *http.Response) (*CreateTransactionRespo
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5227,15 +12235,15 @@ func ParseCreateTransactionResponse(rsp *http.Response) (*CreateTransactionRespo
 	return response, nil
 }
 
-// ParseDeleteTransactionResponse parses an HTTP response from a DeleteTransactionWithResponse call
-func ParseDeleteTransactionResponse(rsp *http.Response) (*DeleteTransactionResponse, error) {
+// ParseRemoveUserFromEventResponse parses an HTTP response from a RemoveUserFromEventWithResponse call
+func ParseRemoveUserFromEventResponse(rsp *http.Response) (*RemoveUserFromEventResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteTransactionResponse{
+	response := &RemoveUserFromEventResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5248,6 +12256,13 @@ func ParseDeleteTransactionResponse(rsp *http.Response) (*DeleteTransactionRespo
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5267,80 +12282,54 @@ func ParseDeleteTransactionResponse(rsp *http.Response) (*DeleteTransactionRespo
 	return response, nil
 }
 
-// ParseGetTransactionByIDResponse parses an HTTP response from a GetTransactionByIDWithResponse call
-func ParseGetTransactionByIDResponse(rsp *http.Response) (*GetTransactionByIDResponse, error) {
+// ParseCreateDummyClaimResponse parses an HTTP response from a CreateDummyClaimWithResponse call
+func ParseCreateDummyClaimResponse(rsp *http.Response) (*CreateDummyClaimResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTransactionByIDResponse{
+	response := &CreateDummyClaimResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TransactionResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DummyClaimDTO
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseUpdateTransactionResponse parses an HTTP response from a UpdateTransactionWithResponse call
-func ParseUpdateTransactionResponse(rsp *http.Response) (*UpdateTransactionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &UpdateTransactionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TransactionResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -5354,27 +12343,34 @@ func ParseUpdateTransactionResponse(rsp *http.Response) (*UpdateTransactionRespo
 	return response, nil
 }
 
-// ParseGetUsersByEventIDResponse parses an HTTP response from a GetUsersByEventIDWithResponse call
-func ParseGetUsersByEventIDResponse(rsp *http.Response) (*GetUsersByEventIDResponse, error) {
+// ParseGetOptimizedDebtsByUserIDResponse parses an HTTP response from a GetOptimizedDebtsByUserIDWithResponse call
+func ParseGetOptimizedDebtsByUserIDResponse(rsp *http.Response) (*GetOptimizedDebtsByUserIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUsersByEventIDResponse{
+	response := &GetOptimizedDebtsByUserIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserListResponse
+		var dest OptimizedDebtListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5387,15 +12383,15 @@ func ParseGetUsersByEventIDResponse(rsp *http.Response) (*GetUsersByEventIDRespo
 	return response, nil
 }
 
-// ParseAddUsersToEventResponse parses an HTTP response from a AddUsersToEventWithResponse call
-func ParseAddUsersToEventResponse(rsp *http.Response) (*AddUsersToEventResponse, error) {
+// ParseUpdateEventUserRoleResponse parses an HTTP response from a UpdateEventUserRoleWithResponse call
+func ParseUpdateEventUserRoleResponse(rsp *http.Response) (*UpdateEventUserRoleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddUsersToEventResponse{
+	response := &UpdateEventUserRoleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5415,6 +12411,20 @@ func ParseAddUsersToEventResponse(rsp *http.Response) (*AddUsersToEventResponse,
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5427,22 +12437,22 @@ func ParseAddUsersToEventResponse(rsp *http.Response) (*AddUsersToEventResponse,
 	return response, nil
 }
 
-// ParseGetDummiesByEventIDResponse parses an HTTP response from a GetDummiesByEventIDWithResponse call
-func ParseGetDummiesByEventIDResponse(rsp *http.Response) (*GetDummiesByEventIDResponse, error) {
+// ParseGetExchangeRatesResponse parses an HTTP response from a GetExchangeRatesWithResponse call
+func ParseGetExchangeRatesResponse(rsp *http.Response) (*GetExchangeRatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDummiesByEventIDResponse{
+	response := &GetExchangeRatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserListResponse
+		var dest []ExchangeRateDTO
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5460,26 +12470,26 @@ func ParseGetDummiesByEventIDResponse(rsp *http.Response) (*GetDummiesByEventIDR
 	return response, nil
 }
 
-// ParseCreateDummyUserResponse parses an HTTP response from a CreateDummyUserWithResponse call
-func ParseCreateDummyUserResponse(rsp *http.Response) (*CreateDummyUserResponse, error) {
+// ParseJoinEventByInviteResponse parses an HTTP response from a JoinEventByInviteWithResponse call
+func ParseJoinEventByInviteResponse(rsp *http.Response) (*JoinEventByInviteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDummyUserResponse{
+	response := &JoinEventByInviteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest UserProfileDTO
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JoinEventResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
@@ -5488,6 +12498,27 @@ func ParseCreateDummyUserResponse(rsp *http.Response) (*CreateDummyUserResponse,
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5500,33 +12531,33 @@ func ParseCreateDummyUserResponse(rsp *http.Response) (*CreateDummyUserResponse,
 	return response, nil
 }
 
-// ParseRemoveUserFromEventResponse parses an HTTP response from a RemoveUserFromEventWithResponse call
-func ParseRemoveUserFromEventResponse(rsp *http.Response) (*RemoveUserFromEventResponse, error) {
+// ParseCreateCategoryResponse parses an HTTP response from a CreateCategoryWithResponse call
+func ParseCreateCategoryResponse(rsp *http.Response) (*CreateCategoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveUserFromEventResponse{
+	response := &CreateCategoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CategoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -5540,27 +12571,34 @@ func ParseRemoveUserFromEventResponse(rsp *http.Response) (*RemoveUserFromEventR
 	return response, nil
 }
 
-// ParseGetOptimizedDebtsByUserIDResponse parses an HTTP response from a GetOptimizedDebtsByUserIDWithResponse call
-func ParseGetOptimizedDebtsByUserIDResponse(rsp *http.Response) (*GetOptimizedDebtsByUserIDResponse, error) {
+// ParseDeleteCategoryResponse parses an HTTP response from a DeleteCategoryWithResponse call
+func ParseDeleteCategoryResponse(rsp *http.Response) (*DeleteCategoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOptimizedDebtsByUserIDResponse{
+	response := &DeleteCategoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OptimizedDebtListResponse
+		var dest SuccessResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5573,26 +12611,26 @@ func ParseGetOptimizedDebtsByUserIDResponse(rsp *http.Response) (*GetOptimizedDe
 	return response, nil
 }
 
-// ParseCreateCategoryResponse parses an HTTP response from a CreateCategoryWithResponse call
-func ParseCreateCategoryResponse(rsp *http.Response) (*CreateCategoryResponse, error) {
+// ParseUpdateCategoryResponse parses an HTTP response from a UpdateCategoryWithResponse call
+func ParseUpdateCategoryResponse(rsp *http.Response) (*UpdateCategoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCategoryResponse{
+	response := &UpdateCategoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest CategoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
@@ -5601,6 +12639,13 @@ func ParseCreateCategoryResponse(rsp *http.Response) (*CreateCategoryResponse, e
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5613,33 +12658,33 @@ func ParseCreateCategoryResponse(rsp *http.Response) (*CreateCategoryResponse, e
 	return response, nil
 }
 
-// ParseDeleteCategoryResponse parses an HTTP response from a DeleteCategoryWithResponse call
-func ParseDeleteCategoryResponse(rsp *http.Response) (*DeleteCategoryResponse, error) {
+// ParseSetExchangeRateResponse parses an HTTP response from a SetExchangeRateWithResponse call
+func ParseSetExchangeRateResponse(rsp *http.Response) (*SetExchangeRateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCategoryResponse{
+	response := &SetExchangeRateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
+		var dest ExchangeRateDTO
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -5653,22 +12698,22 @@ func ParseDeleteCategoryResponse(rsp *http.Response) (*DeleteCategoryResponse, e
 	return response, nil
 }
 
-// ParseUpdateCategoryResponse parses an HTTP response from a UpdateCategoryWithResponse call
-func ParseUpdateCategoryResponse(rsp *http.Response) (*UpdateCategoryResponse, error) {
+// ParseDeleteExchangeRateResponse parses an HTTP response from a DeleteExchangeRateWithResponse call
+func ParseDeleteExchangeRateResponse(rsp *http.Response) (*DeleteExchangeRateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateCategoryResponse{
+	response := &DeleteExchangeRateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CategoryResponse
+		var dest SuccessResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5681,13 +12726,6 @@ func ParseUpdateCategoryResponse(rsp *http.Response) (*UpdateCategoryResponse, e
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
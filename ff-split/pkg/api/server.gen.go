@@ -21,10 +21,16 @@ type ServerInterface interface {
 	GetCategoryByID(c *gin.Context, id int, params GetCategoryByIDParams)
 	// Получить список мероприятий
 	// (GET /api/v1/event)
-	GetEvents(c *gin.Context)
+	GetEvents(c *gin.Context, params GetEventsParams)
 	// Создать мероприятие
 	// (POST /api/v1/event)
 	CreateEvent(c *gin.Context)
+	// Получить корзину мероприятий
+	// (GET /api/v1/event/trash)
+	GetDeletedEvents(c *gin.Context)
+	// Восстановить мероприятие
+	// (POST /api/v1/event/trash/{id_deleted_event}/restore)
+	RestoreEvent(c *gin.Context, idDeletedEvent int64)
 	// Удалить мероприятие
 	// (DELETE /api/v1/event/{id_event})
 	DeleteEvent(c *gin.Context, idEvent int64)
@@ -49,15 +55,87 @@ type ServerInterface interface {
 	// Обновить активность
 	// (PUT /api/v1/event/{id_event}/activity/{id_activity})
 	UpdateActivity(c *gin.Context, idEvent int64, idActivity int)
+	// Получить аналитику расходов мероприятия
+	// (GET /api/v1/event/{id_event}/analytics)
+	GetEventAnalytics(c *gin.Context, idEvent int64, params GetEventAnalyticsParams)
+	// Отправить мероприятие в архив
+	// (POST /api/v1/event/{id_event}/archive)
+	ArchiveEvent(c *gin.Context, idEvent int64)
+	// Получить заявки мероприятия
+	// (GET /api/v1/event/{id_event}/claim)
+	GetDummyClaims(c *gin.Context, idEvent int64)
+	// Одобрить заявку на dummy-пользователя
+	// (POST /api/v1/event/{id_event}/claim/{id_claim}/approve)
+	ApproveDummyClaim(c *gin.Context, idEvent int64, idClaim int)
+	// Отклонить заявку на dummy-пользователя
+	// (POST /api/v1/event/{id_event}/claim/{id_claim}/reject)
+	RejectDummyClaim(c *gin.Context, idEvent int64, idClaim int)
+	// Закрыть мероприятие
+	// (POST /api/v1/event/{id_event}/close)
+	CloseEvent(c *gin.Context, idEvent int64)
 	// Получить долги мероприятия
 	// (GET /api/v1/event/{id_event}/debts)
 	GetDebtsByEventID(c *gin.Context, idEvent int64)
+	// Выгрузить мероприятие в файл
+	// (GET /api/v1/event/{id_event}/export)
+	ExportEvent(c *gin.Context, idEvent int64, params ExportEventParams)
+	// Получить ленту активности мероприятия
+	// (GET /api/v1/event/{id_event}/feed)
+	GetActivityFeed(c *gin.Context, idEvent int64, params GetActivityFeedParams)
+	// Импортировать транзакции из CSV
+	// (POST /api/v1/event/{id_event}/import)
+	ImportTransactions(c *gin.Context, idEvent int64, params ImportTransactionsParams)
+	// Получить приглашения мероприятия
+	// (GET /api/v1/event/{id_event}/invite)
+	GetEventInvites(c *gin.Context, idEvent int64)
+	// Создать приглашение в мероприятие
+	// (POST /api/v1/event/{id_event}/invite)
+	CreateEventInvite(c *gin.Context, idEvent int64)
+	// Отозвать приглашение
+	// (DELETE /api/v1/event/{id_event}/invite/{id_invite})
+	RevokeEventInvite(c *gin.Context, idEvent int64, idInvite int)
 	// Получить оптимизированные долги
 	// (GET /api/v1/event/{id_event}/optimized-debts)
 	GetOptimizedDebtsByEventID(c *gin.Context, idEvent int64)
 	// Оптимизировать долги
 	// (POST /api/v1/event/{id_event}/optimized-debts)
-	OptimizeDebts(c *gin.Context, idEvent int64)
+	OptimizeDebts(c *gin.Context, idEvent int64, params OptimizeDebtsParams)
+	// Передать владение мероприятием
+	// (POST /api/v1/event/{id_event}/owner)
+	TransferEventOwnership(c *gin.Context, idEvent int64)
+	// Получить регулярные транзакции мероприятия
+	// (GET /api/v1/event/{id_event}/recurring)
+	GetRecurringTransactions(c *gin.Context, idEvent int64)
+	// Создать регулярную транзакцию
+	// (POST /api/v1/event/{id_event}/recurring)
+	CreateRecurringTransaction(c *gin.Context, idEvent int64)
+	// Удалить регулярную транзакцию
+	// (DELETE /api/v1/event/{id_event}/recurring/{id_recurring})
+	DeleteRecurringTransaction(c *gin.Context, idEvent int64, idRecurring int)
+	// Изменить регулярную транзакцию
+	// (PUT /api/v1/event/{id_event}/recurring/{id_recurring})
+	UpdateRecurringTransaction(c *gin.Context, idEvent int64, idRecurring int)
+	// Приостановить регулярную транзакцию
+	// (POST /api/v1/event/{id_event}/recurring/{id_recurring}/pause)
+	PauseRecurringTransaction(c *gin.Context, idEvent int64, idRecurring int)
+	// Возобновить регулярную транзакцию
+	// (POST /api/v1/event/{id_event}/recurring/{id_recurring}/resume)
+	ResumeRecurringTransaction(c *gin.Context, idEvent int64, idRecurring int)
+	// Возобновить мероприятие
+	// (POST /api/v1/event/{id_event}/reopen)
+	ReopenEvent(c *gin.Context, idEvent int64)
+	// Начать расчеты по мероприятию
+	// (POST /api/v1/event/{id_event}/settle)
+	SettleEvent(c *gin.Context, idEvent int64)
+	// Получить погашения мероприятия
+	// (GET /api/v1/event/{id_event}/settlement)
+	GetSettlementsByEventID(c *gin.Context, idEvent int64)
+	// Зафиксировать погашение долга
+	// (POST /api/v1/event/{id_event}/settlement)
+	CreateSettlement(c *gin.Context, idEvent int64)
+	// Отменить погашение долга
+	// (DELETE /api/v1/event/{id_event}/settlement/{id_settlement})
+	DeleteSettlement(c *gin.Context, idEvent int64, idSettlement int)
 	// Получить задачи мероприятия
 	// (GET /api/v1/event/{id_event}/task)
 	GetTasksByEventID(c *gin.Context, idEvent int64)
@@ -75,7 +153,7 @@ type ServerInterface interface {
 	UpdateTask(c *gin.Context, idEvent int64, idTask int)
 	// Получить транзакции мероприятия
 	// (GET /api/v1/event/{id_event}/transaction)
-	GetTransactionsByEventID(c *gin.Context, idEvent int64)
+	GetTransactionsByEventID(c *gin.Context, idEvent int64, params GetTransactionsByEventIDParams)
 	// Создать транзакцию
 	// (POST /api/v1/event/{id_event}/transaction)
 	CreateTransaction(c *gin.Context, idEvent int64)
@@ -88,6 +166,39 @@ type ServerInterface interface {
 	// Обновить транзакцию
 	// (PUT /api/v1/event/{id_event}/transaction/{id_transaction})
 	UpdateTransaction(c *gin.Context, idEvent int64, idTransaction int)
+	// Прикрепить файл к транзакции
+	// (POST /api/v1/event/{id_event}/transaction/{id_transaction}/attachments)
+	AttachTransactionFile(c *gin.Context, idEvent int64, idTransaction int)
+	// Открепить файл от транзакции
+	// (DELETE /api/v1/event/{id_event}/transaction/{id_transaction}/attachments/{id_attachment})
+	DetachTransactionFile(c *gin.Context, idEvent int64, idTransaction int, idAttachment int)
+	// Получить ссылку на вложение транзакции
+	// (GET /api/v1/event/{id_event}/transaction/{id_transaction}/attachments/{id_attachment}/url)
+	GetTransactionAttachmentUrl(c *gin.Context, idEvent int64, idTransaction int, idAttachment int)
+	// Получить историю изменений транзакции
+	// (GET /api/v1/event/{id_event}/transaction/{id_transaction}/history)
+	GetTransactionHistory(c *gin.Context, idEvent int64, idTransaction int)
+	// Откатить транзакцию к ревизии
+	// (POST /api/v1/event/{id_event}/transaction/{id_transaction}/history/{id_revision}/revert)
+	RevertTransaction(c *gin.Context, idEvent int64, idTransaction int, idRevision int)
+	// Получить позиции чека
+	// (GET /api/v1/event/{id_event}/transaction/{id_transaction}/item)
+	GetTransactionItems(c *gin.Context, idEvent int64, idTransaction int)
+	// Добавить позицию чека
+	// (POST /api/v1/event/{id_event}/transaction/{id_transaction}/item)
+	CreateTransactionItem(c *gin.Context, idEvent int64, idTransaction int)
+	// Удалить позицию чека
+	// (DELETE /api/v1/event/{id_event}/transaction/{id_transaction}/item/{id_item})
+	DeleteTransactionItem(c *gin.Context, idEvent int64, idTransaction int, idItem int)
+	// Обновить позицию чека
+	// (PUT /api/v1/event/{id_event}/transaction/{id_transaction}/item/{id_item})
+	UpdateTransactionItem(c *gin.Context, idEvent int64, idTransaction int, idItem int)
+	// Восстановить транзакцию
+	// (POST /api/v1/event/{id_event}/transaction/{id_transaction}/restore)
+	RestoreTransaction(c *gin.Context, idEvent int64, idTransaction int)
+	// Получить корзину мероприятия
+	// (GET /api/v1/event/{id_event}/trash)
+	GetEventTrash(c *gin.Context, idEvent int64)
 	// Получить пользователей мероприятия
 	// (GET /api/v1/event/{id_event}/user)
 	GetUsersByEventID(c *gin.Context, idEvent int64)
@@ -103,9 +214,21 @@ type ServerInterface interface {
 	// Удалить пользователя из мероприятия
 	// (DELETE /api/v1/event/{id_event}/user/{id_user})
 	RemoveUserFromEvent(c *gin.Context, idEvent int64, idUser int64)
+	// Подать заявку на dummy-пользователя
+	// (POST /api/v1/event/{id_event}/user/{id_user}/claim)
+	CreateDummyClaim(c *gin.Context, idEvent int64, idUser int64)
 	// Получить оптимизированные долги пользователя
 	// (GET /api/v1/event/{id_event}/user/{id_user}/optimized-debts)
 	GetOptimizedDebtsByUserID(c *gin.Context, idEvent int64, idUser int64)
+	// Изменить роль участника мероприятия
+	// (PUT /api/v1/event/{id_event}/user/{id_user}/role)
+	UpdateEventUserRole(c *gin.Context, idEvent int64, idUser int64)
+	// Получить курсы валют
+	// (GET /api/v1/exchange-rate)
+	GetExchangeRates(c *gin.Context)
+	// Присоединиться к мероприятию по приглашению
+	// (POST /api/v1/invite/{token}/join)
+	JoinEventByInvite(c *gin.Context, token string)
 	// Создать категорию
 	// (POST /api/v1/manage/category)
 	CreateCategory(c *gin.Context, params CreateCategoryParams)
@@ -115,6 +238,12 @@ type ServerInterface interface {
 	// Обновить категорию
 	// (PUT /api/v1/manage/category/{id})
 	UpdateCategory(c *gin.Context, id int, params UpdateCategoryParams)
+	// Задать курс валют
+	// (PUT /api/v1/manage/exchange-rate)
+	SetExchangeRate(c *gin.Context)
+	// Удалить курс валют
+	// (DELETE /api/v1/manage/exchange-rate/{currency_from}/{currency_to})
+	DeleteExchangeRate(c *gin.Context, currencyFrom string, currencyTo string)
 	// Получить список иконок
 	// (GET /api/v1/manage/icons)
 	GetIcons(c *gin.Context)
@@ -232,8 +361,21 @@ func (siw *ServerInterfaceWrapper) GetCategoryByID(c *gin.Context) {
 // GetEvents operation middleware
 func (siw *ServerInterfaceWrapper) GetEvents(c *gin.Context) {
 
+	var err error
+
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsParams
+
+	// ------------- Optional query parameter "include_archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", c.Request.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_archived: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -241,7 +383,7 @@ func (siw *ServerInterfaceWrapper) GetEvents(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetEvents(c)
+	siw.Handler.GetEvents(c, params)
 }
 
 // CreateEvent operation middleware
@@ -259,6 +401,47 @@ func (siw *ServerInterfaceWrapper) CreateEvent(c *gin.Context) {
 	siw.Handler.CreateEvent(c)
 }
 
+// GetDeletedEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetDeletedEvents(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetDeletedEvents(c)
+}
+
+// RestoreEvent operation middleware
+func (siw *ServerInterfaceWrapper) RestoreEvent(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_deleted_event" -------------
+	var idDeletedEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_deleted_event", c.Param("id_deleted_event"), &idDeletedEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_deleted_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RestoreEvent(c, idDeletedEvent)
+}
+
 // DeleteEvent operation middleware
 func (siw *ServerInterfaceWrapper) DeleteEvent(c *gin.Context) {
 
@@ -494,8 +677,8 @@ func (siw *ServerInterfaceWrapper) UpdateActivity(c *gin.Context) {
 	siw.Handler.UpdateActivity(c, idEvent, idActivity)
 }
 
-// GetDebtsByEventID operation middleware
-func (siw *ServerInterfaceWrapper) GetDebtsByEventID(c *gin.Context) {
+// GetEventAnalytics operation middleware
+func (siw *ServerInterfaceWrapper) GetEventAnalytics(c *gin.Context) {
 
 	var err error
 
@@ -510,6 +693,41 @@ func (siw *ServerInterfaceWrapper) GetDebtsByEventID(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventAnalyticsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", c.Request.URL.Query(), &params.Interval)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter interval: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "top", c.Request.URL.Query(), &params.Top)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter top: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -517,11 +735,11 @@ func (siw *ServerInterfaceWrapper) GetDebtsByEventID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetDebtsByEventID(c, idEvent)
+	siw.Handler.GetEventAnalytics(c, idEvent, params)
 }
 
-// GetOptimizedDebtsByEventID operation middleware
-func (siw *ServerInterfaceWrapper) GetOptimizedDebtsByEventID(c *gin.Context) {
+// ArchiveEvent operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveEvent(c *gin.Context) {
 
 	var err error
 
@@ -543,11 +761,11 @@ func (siw *ServerInterfaceWrapper) GetOptimizedDebtsByEventID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetOptimizedDebtsByEventID(c, idEvent)
+	siw.Handler.ArchiveEvent(c, idEvent)
 }
 
-// OptimizeDebts operation middleware
-func (siw *ServerInterfaceWrapper) OptimizeDebts(c *gin.Context) {
+// GetDummyClaims operation middleware
+func (siw *ServerInterfaceWrapper) GetDummyClaims(c *gin.Context) {
 
 	var err error
 
@@ -569,11 +787,11 @@ func (siw *ServerInterfaceWrapper) OptimizeDebts(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.OptimizeDebts(c, idEvent)
+	siw.Handler.GetDummyClaims(c, idEvent)
 }
 
-// GetTasksByEventID operation middleware
-func (siw *ServerInterfaceWrapper) GetTasksByEventID(c *gin.Context) {
+// ApproveDummyClaim operation middleware
+func (siw *ServerInterfaceWrapper) ApproveDummyClaim(c *gin.Context) {
 
 	var err error
 
@@ -586,6 +804,15 @@ func (siw *ServerInterfaceWrapper) GetTasksByEventID(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "id_claim" -------------
+	var idClaim int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_claim", c.Param("id_claim"), &idClaim, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_claim: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -595,11 +822,11 @@ func (siw *ServerInterfaceWrapper) GetTasksByEventID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTasksByEventID(c, idEvent)
+	siw.Handler.ApproveDummyClaim(c, idEvent, idClaim)
 }
 
-// CreateTask operation middleware
-func (siw *ServerInterfaceWrapper) CreateTask(c *gin.Context) {
+// RejectDummyClaim operation middleware
+func (siw *ServerInterfaceWrapper) RejectDummyClaim(c *gin.Context) {
 
 	var err error
 
@@ -612,6 +839,15 @@ func (siw *ServerInterfaceWrapper) CreateTask(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "id_claim" -------------
+	var idClaim int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_claim", c.Param("id_claim"), &idClaim, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_claim: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -621,11 +857,11 @@ func (siw *ServerInterfaceWrapper) CreateTask(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateTask(c, idEvent)
+	siw.Handler.RejectDummyClaim(c, idEvent, idClaim)
 }
 
-// DeleteTask operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTask(c *gin.Context) {
+// CloseEvent operation middleware
+func (siw *ServerInterfaceWrapper) CloseEvent(c *gin.Context) {
 
 	var err error
 
@@ -638,12 +874,29 @@ func (siw *ServerInterfaceWrapper) DeleteTask(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "id_task" -------------
-	var idTask int
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id_task", c.Param("id_task"), &idTask, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CloseEvent(c, idEvent)
+}
+
+// GetDebtsByEventID operation middleware
+func (siw *ServerInterfaceWrapper) GetDebtsByEventID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_task: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -656,11 +909,11 @@ func (siw *ServerInterfaceWrapper) DeleteTask(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteTask(c, idEvent, idTask)
+	siw.Handler.GetDebtsByEventID(c, idEvent)
 }
 
-// GetTaskByID operation middleware
-func (siw *ServerInterfaceWrapper) GetTaskByID(c *gin.Context) {
+// ExportEvent operation middleware
+func (siw *ServerInterfaceWrapper) ExportEvent(c *gin.Context) {
 
 	var err error
 
@@ -673,17 +926,19 @@ func (siw *ServerInterfaceWrapper) GetTaskByID(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "id_task" -------------
-	var idTask int
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id_task", c.Param("id_task"), &idTask, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportEventParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_task: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -691,11 +946,11 @@ func (siw *ServerInterfaceWrapper) GetTaskByID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTaskByID(c, idEvent, idTask)
+	siw.Handler.ExportEvent(c, idEvent, params)
 }
 
-// UpdateTask operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTask(c *gin.Context) {
+// GetActivityFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetActivityFeed(c *gin.Context) {
 
 	var err error
 
@@ -708,16 +963,58 @@ func (siw *ServerInterfaceWrapper) UpdateTask(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "id_task" -------------
-	var idTask int
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id_task", c.Param("id_task"), &idTask, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetActivityFeedParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_task: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -726,11 +1023,11 @@ func (siw *ServerInterfaceWrapper) UpdateTask(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateTask(c, idEvent, idTask)
+	siw.Handler.GetActivityFeed(c, idEvent, params)
 }
 
-// GetTransactionsByEventID operation middleware
-func (siw *ServerInterfaceWrapper) GetTransactionsByEventID(c *gin.Context) {
+// ImportTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ImportTransactions(c *gin.Context) {
 
 	var err error
 
@@ -745,6 +1042,25 @@ func (siw *ServerInterfaceWrapper) GetTransactionsByEventID(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTransactionsParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -752,11 +1068,11 @@ func (siw *ServerInterfaceWrapper) GetTransactionsByEventID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTransactionsByEventID(c, idEvent)
+	siw.Handler.ImportTransactions(c, idEvent, params)
 }
 
-// CreateTransaction operation middleware
-func (siw *ServerInterfaceWrapper) CreateTransaction(c *gin.Context) {
+// GetEventInvites operation middleware
+func (siw *ServerInterfaceWrapper) GetEventInvites(c *gin.Context) {
 
 	var err error
 
@@ -778,11 +1094,11 @@ func (siw *ServerInterfaceWrapper) CreateTransaction(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateTransaction(c, idEvent)
+	siw.Handler.GetEventInvites(c, idEvent)
 }
 
-// DeleteTransaction operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTransaction(c *gin.Context) {
+// CreateEventInvite operation middleware
+func (siw *ServerInterfaceWrapper) CreateEventInvite(c *gin.Context) {
 
 	var err error
 
@@ -795,15 +1111,6 @@ func (siw *ServerInterfaceWrapper) DeleteTransaction(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "id_transaction" -------------
-	var idTransaction int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -813,11 +1120,11 @@ func (siw *ServerInterfaceWrapper) DeleteTransaction(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteTransaction(c, idEvent, idTransaction)
+	siw.Handler.CreateEventInvite(c, idEvent)
 }
 
-// GetTransactionByID operation middleware
-func (siw *ServerInterfaceWrapper) GetTransactionByID(c *gin.Context) {
+// RevokeEventInvite operation middleware
+func (siw *ServerInterfaceWrapper) RevokeEventInvite(c *gin.Context) {
 
 	var err error
 
@@ -830,12 +1137,12 @@ func (siw *ServerInterfaceWrapper) GetTransactionByID(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "id_transaction" -------------
-	var idTransaction int
+	// ------------- Path parameter "id_invite" -------------
+	var idInvite int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id_invite", c.Param("id_invite"), &idInvite, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_invite: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -848,11 +1155,11 @@ func (siw *ServerInterfaceWrapper) GetTransactionByID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTransactionByID(c, idEvent, idTransaction)
+	siw.Handler.RevokeEventInvite(c, idEvent, idInvite)
 }
 
-// UpdateTransaction operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTransaction(c *gin.Context) {
+// GetOptimizedDebtsByEventID operation middleware
+func (siw *ServerInterfaceWrapper) GetOptimizedDebtsByEventID(c *gin.Context) {
 
 	var err error
 
@@ -865,15 +1172,6 @@ func (siw *ServerInterfaceWrapper) UpdateTransaction(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "id_transaction" -------------
-	var idTransaction int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -883,11 +1181,11 @@ func (siw *ServerInterfaceWrapper) UpdateTransaction(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateTransaction(c, idEvent, idTransaction)
+	siw.Handler.GetOptimizedDebtsByEventID(c, idEvent)
 }
 
-// GetUsersByEventID operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersByEventID(c *gin.Context) {
+// OptimizeDebts operation middleware
+func (siw *ServerInterfaceWrapper) OptimizeDebts(c *gin.Context) {
 
 	var err error
 
@@ -902,6 +1200,17 @@ func (siw *ServerInterfaceWrapper) GetUsersByEventID(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OptimizeDebtsParams
+
+	// ------------- Optional query parameter "algorithm" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "algorithm", c.Request.URL.Query(), &params.Algorithm)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter algorithm: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -909,11 +1218,11 @@ func (siw *ServerInterfaceWrapper) GetUsersByEventID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUsersByEventID(c, idEvent)
+	siw.Handler.OptimizeDebts(c, idEvent, params)
 }
 
-// AddUsersToEvent operation middleware
-func (siw *ServerInterfaceWrapper) AddUsersToEvent(c *gin.Context) {
+// TransferEventOwnership operation middleware
+func (siw *ServerInterfaceWrapper) TransferEventOwnership(c *gin.Context) {
 
 	var err error
 
@@ -935,11 +1244,11 @@ func (siw *ServerInterfaceWrapper) AddUsersToEvent(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AddUsersToEvent(c, idEvent)
+	siw.Handler.TransferEventOwnership(c, idEvent)
 }
 
-// GetDummiesByEventID operation middleware
-func (siw *ServerInterfaceWrapper) GetDummiesByEventID(c *gin.Context) {
+// GetRecurringTransactions operation middleware
+func (siw *ServerInterfaceWrapper) GetRecurringTransactions(c *gin.Context) {
 
 	var err error
 
@@ -961,11 +1270,11 @@ func (siw *ServerInterfaceWrapper) GetDummiesByEventID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetDummiesByEventID(c, idEvent)
+	siw.Handler.GetRecurringTransactions(c, idEvent)
 }
 
-// CreateDummyUser operation middleware
-func (siw *ServerInterfaceWrapper) CreateDummyUser(c *gin.Context) {
+// CreateRecurringTransaction operation middleware
+func (siw *ServerInterfaceWrapper) CreateRecurringTransaction(c *gin.Context) {
 
 	var err error
 
@@ -987,11 +1296,11 @@ func (siw *ServerInterfaceWrapper) CreateDummyUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateDummyUser(c, idEvent)
+	siw.Handler.CreateRecurringTransaction(c, idEvent)
 }
 
-// RemoveUserFromEvent operation middleware
-func (siw *ServerInterfaceWrapper) RemoveUserFromEvent(c *gin.Context) {
+// DeleteRecurringTransaction operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRecurringTransaction(c *gin.Context) {
 
 	var err error
 
@@ -1004,12 +1313,12 @@ func (siw *ServerInterfaceWrapper) RemoveUserFromEvent(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "id_user" -------------
-	var idUser int64
+	// ------------- Path parameter "id_recurring" -------------
+	var idRecurring int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id_user", c.Param("id_user"), &idUser, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id_recurring", c.Param("id_recurring"), &idRecurring, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_user: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_recurring: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1022,11 +1331,11 @@ func (siw *ServerInterfaceWrapper) RemoveUserFromEvent(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RemoveUserFromEvent(c, idEvent, idUser)
+	siw.Handler.DeleteRecurringTransaction(c, idEvent, idRecurring)
 }
 
-// GetOptimizedDebtsByUserID operation middleware
-func (siw *ServerInterfaceWrapper) GetOptimizedDebtsByUserID(c *gin.Context) {
+// UpdateRecurringTransaction operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRecurringTransaction(c *gin.Context) {
 
 	var err error
 
@@ -1039,12 +1348,12 @@ func (siw *ServerInterfaceWrapper) GetOptimizedDebtsByUserID(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "id_user" -------------
-	var idUser int64
+	// ------------- Path parameter "id_recurring" -------------
+	var idRecurring int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id_user", c.Param("id_user"), &idUser, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id_recurring", c.Param("id_recurring"), &idRecurring, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_user: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_recurring: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1057,18 +1366,1346 @@ func (siw *ServerInterfaceWrapper) GetOptimizedDebtsByUserID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetOptimizedDebtsByUserID(c, idEvent, idUser)
+	siw.Handler.UpdateRecurringTransaction(c, idEvent, idRecurring)
 }
 
-// CreateCategory operation middleware
-func (siw *ServerInterfaceWrapper) CreateCategory(c *gin.Context) {
+// PauseRecurringTransaction operation middleware
+func (siw *ServerInterfaceWrapper) PauseRecurringTransaction(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateCategoryParams
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_recurring" -------------
+	var idRecurring int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_recurring", c.Param("id_recurring"), &idRecurring, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_recurring: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PauseRecurringTransaction(c, idEvent, idRecurring)
+}
+
+// ResumeRecurringTransaction operation middleware
+func (siw *ServerInterfaceWrapper) ResumeRecurringTransaction(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_recurring" -------------
+	var idRecurring int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_recurring", c.Param("id_recurring"), &idRecurring, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_recurring: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ResumeRecurringTransaction(c, idEvent, idRecurring)
+}
+
+// ReopenEvent operation middleware
+func (siw *ServerInterfaceWrapper) ReopenEvent(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReopenEvent(c, idEvent)
+}
+
+// SettleEvent operation middleware
+func (siw *ServerInterfaceWrapper) SettleEvent(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SettleEvent(c, idEvent)
+}
+
+// GetSettlementsByEventID operation middleware
+func (siw *ServerInterfaceWrapper) GetSettlementsByEventID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSettlementsByEventID(c, idEvent)
+}
+
+// CreateSettlement operation middleware
+func (siw *ServerInterfaceWrapper) CreateSettlement(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateSettlement(c, idEvent)
+}
+
+// DeleteSettlement operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSettlement(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_settlement" -------------
+	var idSettlement int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_settlement", c.Param("id_settlement"), &idSettlement, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_settlement: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSettlement(c, idEvent, idSettlement)
+}
+
+// GetTasksByEventID operation middleware
+func (siw *ServerInterfaceWrapper) GetTasksByEventID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTasksByEventID(c, idEvent)
+}
+
+// CreateTask operation middleware
+func (siw *ServerInterfaceWrapper) CreateTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTask(c, idEvent)
+}
+
+// DeleteTask operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_task" -------------
+	var idTask int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_task", c.Param("id_task"), &idTask, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_task: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTask(c, idEvent, idTask)
+}
+
+// GetTaskByID operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskByID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_task" -------------
+	var idTask int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_task", c.Param("id_task"), &idTask, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_task: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTaskByID(c, idEvent, idTask)
+}
+
+// UpdateTask operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_task" -------------
+	var idTask int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_task", c.Param("id_task"), &idTask, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_task: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateTask(c, idEvent, idTask)
+}
+
+// GetTransactionsByEventID operation middleware
+func (siw *ServerInterfaceWrapper) GetTransactionsByEventID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransactionsByEventIDParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_id", c.Request.URL.Query(), &params.CategoryId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "payer_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "payer_id", c.Request.URL.Query(), &params.PayerId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter payer_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "participant_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "participant_id", c.Request.URL.Query(), &params.ParticipantId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter participant_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "amount_min" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "amount_min", c.Request.URL.Query(), &params.AmountMin)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter amount_min: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "amount_max" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "amount_max", c.Request.URL.Query(), &params.AmountMax)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter amount_max: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTransactionsByEventID(c, idEvent, params)
+}
+
+// CreateTransaction operation middleware
+func (siw *ServerInterfaceWrapper) CreateTransaction(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTransaction(c, idEvent)
+}
+
+// DeleteTransaction operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTransaction(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTransaction(c, idEvent, idTransaction)
+}
+
+// GetTransactionByID operation middleware
+func (siw *ServerInterfaceWrapper) GetTransactionByID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTransactionByID(c, idEvent, idTransaction)
+}
+
+// UpdateTransaction operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTransaction(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateTransaction(c, idEvent, idTransaction)
+}
+
+// AttachTransactionFile operation middleware
+func (siw *ServerInterfaceWrapper) AttachTransactionFile(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AttachTransactionFile(c, idEvent, idTransaction)
+}
+
+// DetachTransactionFile operation middleware
+func (siw *ServerInterfaceWrapper) DetachTransactionFile(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_attachment" -------------
+	var idAttachment int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_attachment", c.Param("id_attachment"), &idAttachment, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_attachment: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DetachTransactionFile(c, idEvent, idTransaction, idAttachment)
+}
+
+// GetTransactionAttachmentUrl operation middleware
+func (siw *ServerInterfaceWrapper) GetTransactionAttachmentUrl(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_attachment" -------------
+	var idAttachment int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_attachment", c.Param("id_attachment"), &idAttachment, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_attachment: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTransactionAttachmentUrl(c, idEvent, idTransaction, idAttachment)
+}
+
+// GetTransactionHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetTransactionHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTransactionHistory(c, idEvent, idTransaction)
+}
+
+// RevertTransaction operation middleware
+func (siw *ServerInterfaceWrapper) RevertTransaction(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_revision" -------------
+	var idRevision int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_revision", c.Param("id_revision"), &idRevision, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_revision: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevertTransaction(c, idEvent, idTransaction, idRevision)
+}
+
+// GetTransactionItems operation middleware
+func (siw *ServerInterfaceWrapper) GetTransactionItems(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTransactionItems(c, idEvent, idTransaction)
+}
+
+// CreateTransactionItem operation middleware
+func (siw *ServerInterfaceWrapper) CreateTransactionItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTransactionItem(c, idEvent, idTransaction)
+}
+
+// DeleteTransactionItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTransactionItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_item" -------------
+	var idItem int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_item", c.Param("id_item"), &idItem, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_item: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTransactionItem(c, idEvent, idTransaction, idItem)
+}
+
+// UpdateTransactionItem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTransactionItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_item" -------------
+	var idItem int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_item", c.Param("id_item"), &idItem, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_item: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateTransactionItem(c, idEvent, idTransaction, idItem)
+}
+
+// RestoreTransaction operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTransaction(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_transaction" -------------
+	var idTransaction int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_transaction", c.Param("id_transaction"), &idTransaction, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_transaction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RestoreTransaction(c, idEvent, idTransaction)
+}
+
+// GetEventTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetEventTrash(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEventTrash(c, idEvent)
+}
+
+// GetUsersByEventID operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersByEventID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersByEventID(c, idEvent)
+}
+
+// AddUsersToEvent operation middleware
+func (siw *ServerInterfaceWrapper) AddUsersToEvent(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddUsersToEvent(c, idEvent)
+}
+
+// GetDummiesByEventID operation middleware
+func (siw *ServerInterfaceWrapper) GetDummiesByEventID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetDummiesByEventID(c, idEvent)
+}
+
+// CreateDummyUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateDummyUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateDummyUser(c, idEvent)
+}
+
+// RemoveUserFromEvent operation middleware
+func (siw *ServerInterfaceWrapper) RemoveUserFromEvent(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_user" -------------
+	var idUser int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_user", c.Param("id_user"), &idUser, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_user: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveUserFromEvent(c, idEvent, idUser)
+}
+
+// CreateDummyClaim operation middleware
+func (siw *ServerInterfaceWrapper) CreateDummyClaim(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_user" -------------
+	var idUser int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_user", c.Param("id_user"), &idUser, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_user: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateDummyClaim(c, idEvent, idUser)
+}
+
+// GetOptimizedDebtsByUserID operation middleware
+func (siw *ServerInterfaceWrapper) GetOptimizedDebtsByUserID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_user" -------------
+	var idUser int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_user", c.Param("id_user"), &idUser, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_user: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOptimizedDebtsByUserID(c, idEvent, idUser)
+}
+
+// UpdateEventUserRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEventUserRole(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id_event" -------------
+	var idEvent int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_event", c.Param("id_event"), &idEvent, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_event: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id_user" -------------
+	var idUser int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id_user", c.Param("id_user"), &idUser, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id_user: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateEventUserRole(c, idEvent, idUser)
+}
+
+// GetExchangeRates operation middleware
+func (siw *ServerInterfaceWrapper) GetExchangeRates(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetExchangeRates(c)
+}
+
+// JoinEventByInvite operation middleware
+func (siw *ServerInterfaceWrapper) JoinEventByInvite(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", c.Param("token"), &token, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.JoinEventByInvite(c, token)
+}
+
+// CreateCategory operation middleware
+func (siw *ServerInterfaceWrapper) CreateCategory(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateCategoryParams
 
 	// ------------- Required query parameter "category_type" -------------
 
@@ -1183,6 +2820,56 @@ func (siw *ServerInterfaceWrapper) UpdateCategory(c *gin.Context) {
 	siw.Handler.UpdateCategory(c, id, params)
 }
 
+// SetExchangeRate operation middleware
+func (siw *ServerInterfaceWrapper) SetExchangeRate(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetExchangeRate(c)
+}
+
+// DeleteExchangeRate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteExchangeRate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "currency_from" -------------
+	var currencyFrom string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "currency_from", c.Param("currency_from"), &currencyFrom, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter currency_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "currency_to" -------------
+	var currencyTo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "currency_to", c.Param("currency_to"), &currencyTo, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter currency_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteExchangeRate(c, currencyFrom, currencyTo)
+}
+
 // GetIcons operation middleware
 func (siw *ServerInterfaceWrapper) GetIcons(c *gin.Context) {
 
@@ -1398,6 +3085,8 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/api/v1/category/:id", wrapper.GetCategoryByID)
 	router.GET(options.BaseURL+"/api/v1/event", wrapper.GetEvents)
 	router.POST(options.BaseURL+"/api/v1/event", wrapper.CreateEvent)
+	router.GET(options.BaseURL+"/api/v1/event/trash", wrapper.GetDeletedEvents)
+	router.POST(options.BaseURL+"/api/v1/event/trash/:id_deleted_event/restore", wrapper.RestoreEvent)
 	router.DELETE(options.BaseURL+"/api/v1/event/:id_event", wrapper.DeleteEvent)
 	router.GET(options.BaseURL+"/api/v1/event/:id_event", wrapper.GetEventByID)
 	router.PUT(options.BaseURL+"/api/v1/event/:id_event", wrapper.UpdateEvent)
@@ -1406,9 +3095,33 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.DELETE(options.BaseURL+"/api/v1/event/:id_event/activity/:id_activity", wrapper.DeleteActivity)
 	router.GET(options.BaseURL+"/api/v1/event/:id_event/activity/:id_activity", wrapper.GetActivityByID)
 	router.PUT(options.BaseURL+"/api/v1/event/:id_event/activity/:id_activity", wrapper.UpdateActivity)
+	router.GET(options.BaseURL+"/api/v1/event/:id_event/analytics", wrapper.GetEventAnalytics)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/archive", wrapper.ArchiveEvent)
+	router.GET(options.BaseURL+"/api/v1/event/:id_event/claim", wrapper.GetDummyClaims)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/claim/:id_claim/approve", wrapper.ApproveDummyClaim)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/claim/:id_claim/reject", wrapper.RejectDummyClaim)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/close", wrapper.CloseEvent)
 	router.GET(options.BaseURL+"/api/v1/event/:id_event/debts", wrapper.GetDebtsByEventID)
+	router.GET(options.BaseURL+"/api/v1/event/:id_event/export", wrapper.ExportEvent)
+	router.GET(options.BaseURL+"/api/v1/event/:id_event/feed", wrapper.GetActivityFeed)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/import", wrapper.ImportTransactions)
+	router.GET(options.BaseURL+"/api/v1/event/:id_event/invite", wrapper.GetEventInvites)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/invite", wrapper.CreateEventInvite)
+	router.DELETE(options.BaseURL+"/api/v1/event/:id_event/invite/:id_invite", wrapper.RevokeEventInvite)
 	router.GET(options.BaseURL+"/api/v1/event/:id_event/optimized-debts", wrapper.GetOptimizedDebtsByEventID)
 	router.POST(options.BaseURL+"/api/v1/event/:id_event/optimized-debts", wrapper.OptimizeDebts)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/owner", wrapper.TransferEventOwnership)
+	router.GET(options.BaseURL+"/api/v1/event/:id_event/recurring", wrapper.GetRecurringTransactions)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/recurring", wrapper.CreateRecurringTransaction)
+	router.DELETE(options.BaseURL+"/api/v1/event/:id_event/recurring/:id_recurring", wrapper.DeleteRecurringTransaction)
+	router.PUT(options.BaseURL+"/api/v1/event/:id_event/recurring/:id_recurring", wrapper.UpdateRecurringTransaction)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/recurring/:id_recurring/pause", wrapper.PauseRecurringTransaction)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/recurring/:id_recurring/resume", wrapper.ResumeRecurringTransaction)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/reopen", wrapper.ReopenEvent)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/settle", wrapper.SettleEvent)
+	router.GET(options.BaseURL+"/api/v1/event/:id_event/settlement", wrapper.GetSettlementsByEventID)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/settlement", wrapper.CreateSettlement)
+	router.DELETE(options.BaseURL+"/api/v1/event/:id_event/settlement/:id_settlement", wrapper.DeleteSettlement)
 	router.GET(options.BaseURL+"/api/v1/event/:id_event/task", wrapper.GetTasksByEventID)
 	router.POST(options.BaseURL+"/api/v1/event/:id_event/task", wrapper.CreateTask)
 	router.DELETE(options.BaseURL+"/api/v1/event/:id_event/task/:id_task", wrapper.DeleteTask)
@@ -1419,15 +3132,32 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.DELETE(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction", wrapper.DeleteTransaction)
 	router.GET(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction", wrapper.GetTransactionByID)
 	router.PUT(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction", wrapper.UpdateTransaction)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction/attachments", wrapper.AttachTransactionFile)
+	router.DELETE(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction/attachments/:id_attachment", wrapper.DetachTransactionFile)
+	router.GET(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction/attachments/:id_attachment/url", wrapper.GetTransactionAttachmentUrl)
+	router.GET(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction/history", wrapper.GetTransactionHistory)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction/history/:id_revision/revert", wrapper.RevertTransaction)
+	router.GET(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction/item", wrapper.GetTransactionItems)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction/item", wrapper.CreateTransactionItem)
+	router.DELETE(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction/item/:id_item", wrapper.DeleteTransactionItem)
+	router.PUT(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction/item/:id_item", wrapper.UpdateTransactionItem)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/transaction/:id_transaction/restore", wrapper.RestoreTransaction)
+	router.GET(options.BaseURL+"/api/v1/event/:id_event/trash", wrapper.GetEventTrash)
 	router.GET(options.BaseURL+"/api/v1/event/:id_event/user", wrapper.GetUsersByEventID)
 	router.POST(options.BaseURL+"/api/v1/event/:id_event/user", wrapper.AddUsersToEvent)
 	router.GET(options.BaseURL+"/api/v1/event/:id_event/user/dummies", wrapper.GetDummiesByEventID)
 	router.POST(options.BaseURL+"/api/v1/event/:id_event/user/dummy", wrapper.CreateDummyUser)
 	router.DELETE(options.BaseURL+"/api/v1/event/:id_event/user/:id_user", wrapper.RemoveUserFromEvent)
+	router.POST(options.BaseURL+"/api/v1/event/:id_event/user/:id_user/claim", wrapper.CreateDummyClaim)
 	router.GET(options.BaseURL+"/api/v1/event/:id_event/user/:id_user/optimized-debts", wrapper.GetOptimizedDebtsByUserID)
+	router.PUT(options.BaseURL+"/api/v1/event/:id_event/user/:id_user/role", wrapper.UpdateEventUserRole)
+	router.GET(options.BaseURL+"/api/v1/exchange-rate", wrapper.GetExchangeRates)
+	router.POST(options.BaseURL+"/api/v1/invite/:token/join", wrapper.JoinEventByInvite)
 	router.POST(options.BaseURL+"/api/v1/manage/category", wrapper.CreateCategory)
 	router.DELETE(options.BaseURL+"/api/v1/manage/category/:id", wrapper.DeleteCategory)
 	router.PUT(options.BaseURL+"/api/v1/manage/category/:id", wrapper.UpdateCategory)
+	router.PUT(options.BaseURL+"/api/v1/manage/exchange-rate", wrapper.SetExchangeRate)
+	router.DELETE(options.BaseURL+"/api/v1/manage/exchange-rate/:currency_from/:currency_to", wrapper.DeleteExchangeRate)
 	router.GET(options.BaseURL+"/api/v1/manage/icons", wrapper.GetIcons)
 	router.POST(options.BaseURL+"/api/v1/manage/icons", wrapper.CreateIcon)
 	router.DELETE(options.BaseURL+"/api/v1/manage/icons/:id", wrapper.DeleteIcon)
@@ -18,83 +18,253 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xd3W4bR7J+lcHkXNIWfZJzEOhOjuKFFhvEiGXshS0oY7IlTUzOMDNDrRlDgCVZmwQ2",
-	"4uwiCwfBJl4nL0AxZkzLIvUK3W+0qOr55XTPDznin3mTiDSnu7q7vqqvqmu6H6oVs94wDWI4trr6ULUr",
-	"e6Su4Z9rFUff153WX3Tb+YzYDdOwCXzfsMwGsRyd4K80/iv3k+6QOv7xPxbZUVfV91aC5lfctle8hv1G",
-	"D0qq02oQdVXVLEtrqQfBF+a9L0jFgV8ET33ZJLYTl6RK7IqlNxzdNGIfVfoLvaA9dkjbtE97tKvQNj1j",
-	"R7RHO7RPB+wQ/lb9bm3H0o1d6FavmMa2Xo23uLGu0B49owPap2fhZ3XDIbvEgoebNrGED9N/0j47Zkfs",
-	"Ee3SPor0RoEWL+iAvmVP6Ws6oB3aZke0S9+yZ2pJ3TGtuubw9v//A0F3ByXVIl82dYtU1dU7kQ63Eucz",
-	"ZWlb0uEnTmFoGqqaQxy9TgTz8AOOsa3QnkI7OBvn7JmsZX8KoMEr2KJgxWZOD4TaXK3etollS7XZVR1b",
-	"MISX7hAG9EyuM7RL3yj0FSgP/G9AT2mbdvD7Pu2hRvlgTVUtAT7DqubLKtKzjzSH7JpWihmp8F/lMSNe",
-	"w/nMSPCUZOLHAryhCbX8Z9qmr2FtPKU7c5fpdzpgj2hPpHJDc4wtB2q4lTg02SzD02nTulExjfXNT1Hn",
-	"JbOQIP2lzYV0tJv4D7FufqU9eiFunBjNOkwp2SeGA51ZmmGDsYtYygD66+SeAzMSN5B1s2k4gs7/g2M8",
-	"p132iIPvLf2dtiMWzGzeq4XMl9Gs3+PTtmOZ9e38joP38gd+cxbtS45q2QKHRRbYAnME+c7wm1e0x45w",
-	"KTJKGFobGSixM9Cm12jL/y5RSJEOwcom26UquedkN0mepmSyROvNer0FTkBqigy9cl+KIljmPlh5eq5U",
-	"oakrCeQhxbZ4/YisyseWZVryCSLwz2nzEmljnTiaXktSP1jIC/YI3HFYBSXS61XANIqRKv9atapDR1pt",
-	"XXO0+GjsWnNXyFIGfHLRd/JpfUr7tA3OtUf77DHq9Dltg/rhjJMHWr1RQ7GhzUwmTTRNcU9pVkUK8RMd",
-	"0FcKHbBvaI+eug4qEGJfq+lVDX8sokzuZGRew6F5PCipdWLb2i4R0pUB0A/2LaceYPIH9DQsajciqm6g",
-	"sIpuNJpO6urjdATdCzUA7HwyzNEVZMc5tpiPd+AjnxCw8rbQmQCGdWJvAxDTWB/tgXOh/UTgg20Is7zY",
-	"qkeFLhVCOcelleJ5k1pIlzbKQ5RsVCVnyICOHUwCNMmeQcggsrKglbjemdQppBs5yFNGUURkcks+2zKY",
-	"3NNqmlERCfYP2qZvQSx2mCpUaOJnfP1kUskayKDxBS9sSW3smY4pnL/bt4EdgWs6ooNsHsgj/3ED+cAh",
-	"lqHVtptNGe2jXfaNS/igZ5GsO3qNSFrwhG3TN6BIklArfWmKitASu5dNndROFTjwogYgCC4DKUW24dOG",
-	"o9f1r0h1svEQuubtS0DiLARaIPgRevMefU17OBS+hn06AKM3O7HYQZpKJDMs0/vpdr6QKqZ1mVjDrT3N",
-	"IkIdTQ55e5cchE44JVwC3t8k2RDZy4JH4WQ3KxVi2/K1t/kPRLySHcGI2DE7VOA/9IJ22QlYwQH++YhH",
-	"VOG5vGeaNaIZMRvmdSIyXbdaRmVy6VZ2iDHhCaK5j8gORjGZpOumZt8Xqn/FIppDqtuak5CMx8G+pq+4",
-	"M4mqWpGpd8DKK9pmX4s93aVZ/qS8Q0ye0HMNSzct3WkJxvYC2SlyVFAIdpTemqM7NRE0n9M2WH76FvUL",
-	"w72UiZr0NpNQ3ZLNv6PZ97MbfU99M9l6+HFRm4IpE71UgPg+Ix9FIMOWdImSdCOzRgg1IPDPKVoY/DCH",
-	"MgYP5Uv5RB6U6KeUMv+CGbM2uhN2TM/pORhmMcvIs6swvo6UICofcOrInoDbw3wtBC/IZd8WGggnE6sQ",
-	"NE3LA7nmJydvRoOw9GmKsyTgJC5d7nq7p2ApOGfiGa/YyocJY/4UR3Yy6STtfSF5EkjP09PuHliDWBW+",
-	"C+ZqYkltGrpjCzfCQH/GYkhX2DH7GqRiR278NKCdIkmRG8j6YwnU3n3QG8RWGl6lRREywL4sAKW5CyVS",
-	"u0pma24gJtjtgIgzQldH2vaaRPSey6C99c3YU/ZtAQF8ZqQWbO1siG/la9fLmKFPWlQ/hBas6uzbtwwJ",
-	"OwgIk+mCb+8yTRi0d9Myd/Qaycxdh56JZysMN+1aBLlTaAdjUzS/XQDfWJ76R16qlXnTuZR9PztPo5j/",
-	"Tkl+52pwytEUoJtUmhBm3ALN4opwnWgWsdaazh7uw+CnG17jf/7rphrjLt/Tjpve8xMQ4DC+RpPbpa8V",
-	"3qQCP6JnMCK1xMs/McuC/xgIvOc4DfUAhNONHZPvQxuOVkFPyNdUvaEbN2rm35RNotXjZGrt5kYoQQJC",
-	"dHjspbBjBHGkPI3nIDroIZAtsCf0HEwHgp6d0AGmJ87RHSpuz1fvGncN+jJoXPGtA6/swy7YMwzP2GN2",
-	"TPuu+RnQPm27m/r4U/bUFXb1rnFFob8JJBR7Ly5Sj8t/yp4E32JDL6OZFXTnA3bEDrHhP7AO0fs3gZl8",
-	"g43w5F3E5EUnZkA7KB79g75ix1IF9aUSDi+IOdveoOLFkqFGQKpTHMwTBaZ+yO4HUxPammjzp+8a772n",
-	"0O8BXNA/ztlj7p653uLCPqdtcNpuIQFoRFchRrVh6oZjKy4uT9kx+w6i77asNVicBBSs3jU+//zzuwZg",
-	"zbT0r7BoYtX73d1mufx+RcNU47Zj3icGfkPch9SSWtMrxPUmLi4+2dgMhfs+TG41arqj3CLWvl4hytrN",
-	"DbWk7hPL5nC5drV8tQyPmQ1iaA1dXVXfv1q++r5aUhuas4dGYUVr6Cv711Y8Fwzf7RJHaLlA7TqoJN/S",
-	"NgfAYbiioIOgPIm76zeAzzPUuPC+BKrBBdIncFg4SxtVdVX9E3E+Coo5QVpLqxMHPemdPGV6Ovzgyyax",
-	"Wqrng4L9YpfMB9zfsZrEtV9a1spRLB08ONiCdjgHwGn933LZM3CEU32t0ajpFRzjyhc2jzLzdRUhGmhH",
-	"k8o7YmsAivB/BYoVLeoSyTPk69gzMAlByU47MOKYped+q1mva6CGKn2BFgeivR4a06iyxcdXUh1t18aC",
-	"nkB5tqDRYSVfeahXD/JpuqBE6zsFWEGcmoKpRJ/eczWcHSdoeOt6Cze6E3VcVsaA+g1YDtQbS9nkOh0n",
-	"D+8snhJ198d4RZ54uQFWH5Q/mCCsfhp2igqQXgXpxxt06X0A05yjPeb7v8sAcF6OXYQLExAzNDExGH/M",
-	"y/4uUV/jtYepxl8s/WI5ANkKeTri1mNuYWLXdiRVpZxIo4Xvu3sl4sopjHSjq/8RboR+7L4CYPH8/HWz",
-	"2ip26b3E/0E0UwnW9SCmdteK7jthef8tmqXozu+Am8dJKt3PtIubC49Q7c7cwA/T7W2f8w/Vh88bMnzN",
-	"5aZSpq4xKAybSiBC2/jXAcdHjTii1MpvOHle2Cvuz+M9MZisY6seTIZ4jpDBbJMQpsRcIj0rcpkUYrhc",
-	"JQc6jvlU4roPJk8exFLFCcRg7jDh6qhHHzJjolREFCDqrSdFhEcbxNR/PiGR7i5klFo4d0tkXCK1zoGN",
-	"RlNSWeDSJd8n5EJGDBG3G1VtWj5iFmhbeeq0DV/vdtc07JzmgLot7UQxdiJAda84XrniHQExTlgeP2AB",
-	"a2Vle/8xb7vmnzByvYUAWRy/KzxiJTVBIJzQ+XdsgoM4El6DcrU4dPxMzmQBOwYnF9/Qeqq4m2cZPB9P",
-	"IniruADOb/iQnQmnLeInAwn07nvBkkXyFu1l3mICeQsBcmS4zOJh8DvvQ66EhlgQURpjGkAtyRrXAmFy",
-	"7PhMOzEiBF84LTIFUieSaRF2VYaSIjkAV0BahJ7KjsaSEbTWpDMicwStTI5NnGORLcQSZhMjoklAGzPH",
-	"khVmPMeyKA5sRohrefrENZa5aS8zN++U9YnlbQri1f7LHaOmbbxzBwaScFicrFmHbhcuTxM73iA1RxOa",
-	"vfl3iN5gMuRjIm9YJmuofyLElRF0NenkDM94pQod097IYROLp8by4zpS9TlxutnJgil8duWSq740Gfkc",
-	"HekxO8SqaoEy++xwBBPsLTEq8Luptz8EwJeuI3syh0RBOJYhCz2aKfbewB+VK/iviuSwtZuafX/xTGzs",
-	"JIxUy+rP3fybzdApFRmYAp4IMtKmjd8PO869WQMLtAAbNeFDTya8SRM5zEOkVs+DF8eWGzOT35gJoUMA",
-	"tzQ3gJ/hj1x7MNE+RXsvk8adNG3lcEHmaM8lgqcp77WEZVnAPZZk7BRSbRo4ya6MFs3MRspsYSXV8ciK",
-	"VMNTvkTMpbE+CWbGrkJNQgzfF5l35zID/LA8HX643P94R81IbN9jDNoaur9n9CSG6LSNPOmMULZl8bIa",
-	"kgMW05Ibojmd/zdZBadX5d4YyZ/1iHcL7jFv9iMElQVIgsQP2Jx0LkR0NqhABX+Nrd2zZWpk4qkREYRG",
-	"TJgHP+UJk+BznryJRCBh/mQ6yJUz3Yg8c5RNEUJxylkVkUyLl13JB78iki2SgyaTyNvs5F5mEWBZ/Z0k",
-	"EyNcjyXaJkZUk/E2bqImE9rchM1CebPZYbblmWC2y6TOO26JhpM7xRFv78TvUStVEi7vyZzqwZuEFi7H",
-	"EzsOO7VyRX7E+NzvaYygJp4yu/cLyDM8PwSX8XsONaG7TtYDzdaqVVTMTXNRTuLwRjQlD5glmHwhXLge",
-	"L8g7DU5U5vWGy/xO0cANsJQO3E76mRgedtM80Ip7WfMYnijt5uY871xwYZYeKfU27Hn3Sxmu+x5JldFm",
-	"Z9qQSJKA3zCRaz8CVLd1m9/PM+/uyh/LlPYihu8fiavjetLiRQ/RWLqry9+OSMbSKFiGz/BHrtpNKZZ7",
-	"9HVWL/QZqZv7BDTwhmXWJ05ApXkd9+qveT6K9IUMraE9i8lnLmRSxbIX814ROho6RkfuBF+LHN+JC16Z",
-	"BBswIzsoMw7+5duYhb+NqWRwpvJkY10ztF0SuU0n96tJsYsPct6ew2mxd8vF/FyfUzydDm76mAqbznTR",
-	"SPw2j2VRz8TfdxrlrpEhqPt3CmXlzOPCnNf0ZIX58gahCXNuAa6nXCG0mPcGDXHtzEgu4KUSwa20uRDM",
-	"6xiWCJ4DUlCeNilY1kO801Yu9qrLGIxFr0DYUsQlad4lrAN6JorrN7CjMbGU6d5s6El8YXZKFBwewWJd",
-	"jxZdG081+NqPdGKGf+Ou4P18HnLCKlzSRWjQ9JSiOF+3hDWq/i3Ey7Bt8mFbVCWHdVxi+XIHakmKz4Mw",
-	"V/GzpCvn60WHiH5POXwJy7KAgUuyLhd0Gndwa3o36XIy0OccLzHMjk4n22rZ0dihWVmqdeGp9zTFLuD0",
-	"6/ACioPsy7XQM8B3yhPnO8uI9B1FeCwWzUzDcJuaPHCIZWi1nLvSnajQeOnaxnpitSY4OD8oOsOD/mD+",
-	"2TfwODtRNtavKvRf7JCX215kLUdQaAea7bnXZp3Tbgk/0z47wY3FvrfJyI657F2eBzxUdnau6NVgenmw",
-	"dp70goA7WRvrdupGXiSwHR6pkljxRx40amaVqKs7Ws0m4hxfU6/aibbRj9RT98SHo/SSajutGnwBj6rz",
-	"8o4CrKhAL89Bm3qRJcDvNtaXQeFEGMeIpiK6YJwaJxThoDEDlQZ8RsvmCjjijS/HY9oDCZNqEoSWI88b",
-	"yDNe4pJekio9s02y3stKt8t86ynQ24TStwzIsltGJbF8JsHfSkElload5PDNt1pGBZ3zJWU6/fbn8JUl",
-	"MQuKHpi+9H/FJ0Wlk57yQpMIhtA2qTQtvLH3zkP1OtEsYq01nT119c4WmHqbWPtiCrpO9knNbNSJ4Sj8",
-	"V2pJbVo1dVXdc5zG6spKzaxotT3TdlY/LH94DZmeK4EgC3uBb/x64SXesiwslgV2Fbg091Jiwaa2qEXB",
-	"a8VD7UWK/TK2KrM0nBuKCmPfhIg2LkXWnuJ3/QzJH7ruJ2ubwWmZ7aG5wKP0sjYzvEc5JFhomzJri0Gi",
-	"Z0gwHmwebB38NwAA//8zHil9jb4AAA==",
+	"H4sIAAAAAAAC/+y9a3PbRpoo/FdQnPdDXEVJzk72PXuc2g+ONZnV1E45ZTt7tmrs0sBky8KYBBgAlK1N",
+	"ucqS4jhZea05rmwlNWdmnMvU2fORlkyLulF/ofEXzi859TzdDTSAblwoSiQVfEkskmh0P/3cr5/XGk67",
+	"49jE9r3atc9rXmOVtE385/WGb61Z/vrHhDRvEa/j2B6Bzzuu0yGubxH8lcl+xf+yfNLGf/x/LlmpXav9",
+	"YiFafoGvvSAWDhd9Uq/56x1Su1YzXddch79t8thfbnRdz3FhuSbxGq7V8S3Hrl2r0T8FW8HTYIMOg6dG",
+	"sEGPaJ++DbaCl8HXtE8PjGAj2Aye0h49oYPgy2D7Q4MOg81gI9jC/27S3WCL9oNNg57QnkFP6VAsQk8U",
+	"C9B+Ldyg57uW/aD25Em95pLPupZLmrVrv5OhcC/8rXP/D6Thw2nEif/Z8vwLBuWTjO3cIp91ieendxID",
+	"dwr6f6WndBBsMPDQvkF79DDYpAO6S08AlPDvNMTqNavh2MtWM73i0qJBB/SQDukJPZSftWyfPCAuPNz1",
+	"iKt8mL6iJ3ixT2mfnuCWDgxY8ZQO6VHwgu7TId2lvWCT9ulRsFOr11Yct236bP3//wPF6xK3K7/wXiY8",
+	"c652XXv8TBBKYGiaPvGtNkmvQr/BM/YMOjDoLkLjONjRrRyCABacwxUVNzaFeNAx11uOiQ+bzaYFT5qt",
+	"TyRg+26X1BWwAdwItmkfGMaQvgm2YXvBTp0Te7AFmwe+AiDcpz26i4cbAJsYBpsGnuyU9nKOGWEF+6AY",
+	"/d6B346E5T26G2wCI6S93LvOQHctTt/hp0hs50cAhvKFHxqNruc7bWNO8XXwos7gv0/fsiuhPUDSQ3oE",
+	"KERP4Cz0uFavEbvbBtpji8GmXdP2gI4ce7nhEtMnzcSn3U5T8WmTtEj6U5d4vuPix23Svk/c5T84li3/",
+	"3SIrfg0Q6b7vLTsd32pb/8aWMb2H8g7gT+nV8Gf0TrJGbH/Z802/6y03Vk37gfRxuIl7Ciq53mx+6hHX",
+	"0zJpjiue4nZ+4JQ5pId6VoiSjr4Fngj/G9I3iPRHiGADZJShDMpFIYXYkTlouFcl+7TN1rpvNbwl2yfu",
+	"mtlSnOj/0B7dC/kap4Eh3aNDI3ga7AA2Ge/BOY1gix7jcZ9z+f3SaJrrVySUaprrtXrtESEP1YD3fbOx",
+	"2ia2rwU927uOhwVf0B49oEe0V2ecZA/Zy7vYrumusbIyt2K1iCeTaLdrNXO1jej1KnDeMH3ywHFzlI0G",
+	"+1UZZUMsXE7ZiJ7SAPNM4sA2lbLwL7RH9+kuwwAQTYcc6/eQVQ7oIBfIuHIkrO5lHk0HZXg6D6xLDcde",
+	"vHMTJaMGChm7PzdYaE97u0PspmU/gD2nFZ2207V9FUtCujymPQNEVbARPKND5Dq7MW3E6d5vSaqI3QVe",
+	"XKvXHs89cOb4h23HJuvzv4X/yt/MWe2O4zIEM/3V2rXaA8tf7d6fbzjtBWvN9GyytvCxZX/cch4trKzM",
+	"eZ2W5S8A/FzbbMHNtB17ARdHAHAK0etsKTga7+nsDMZlQ6NiHyXjlyjE6Rvap/uK1a4oLznc1RhvOyYa",
+	"veWG5gr/C2XKER0qD5KvRse3Xhe4onx7FrllqySq8wrOj2I3/kKlCLixaroPyKgIDkoNfcvF6SEdTCuC",
+	"6/A6vf3ReY12rWyuy6GswoJFct8vdzPf46aOaT94yhSdI7oH17Rr4MaYUjQEUoSNHwUvAX8M9gAd0lNA",
+	"o2CHmQvTepcrrtNeLm89MGi8w08Oaa+IsaDHmxC0yqd8Z4T9HeInb8EKYzZOsR3Kmr5OSUuzsEFB0wgw",
+	"MFu5QqOhsF4lMLqQOrXYbbfXb7RMq60kggZ8Y9r+CLDeB1MMrd4SnpJ6jVtCy6aK9F5FfohTlPe94HkZ",
+	"/wMcVifpvsN18SdzGc4ezaKl4ZP9Ir3oD72LBrod/p3hMzoa0cy6UgzMzGLUkV4urypPzQIddBLAJZ7T",
+	"Wsu/eK7ubYBVFkI1yUmzkCB80f314rcVbIH9hzfBeVtdtZPd4CumKkjnDbaKAY4Z9UpNYBMQI9gKNpJg",
+	"FIoIV6FB0nU6rrOGTgGXAJEr3QEJKWlFPgT8p0Qn9TQLCLd6L5Of5NiL8JMSPC3GpopzNnB5aC1F22o8",
+	"1CoecMnovafHpZlCUgkR71EB7Feu67h6OBH4Og86sTUWiW9arRxSRMoGRtKrFUMO3Ebu/q+HLtRF0zfT",
+	"p/Fa3QdKV/OQARdJmYH1hfDlDehJ8AVK62PaA8GKECePzXanhduGNQtZnCowpRHTaaoQ4k8gbsBz+xUd",
+	"0DeC/MJNrJktq2nij1UiggOj8B0m4PikXmsTzzMfEKVzboiS4GvGCblgkLfaj23VsnGzhmV3un7u7SM4",
+	"otcrMQAYR+h006Py/fVlYbKV9hHJTgJFeO8+E8CFlwW2kLNko+u6xG6oZMT/DLV8RNBIy+/pJWcKJ85P",
+	"AEtez8xYQcpNClCw2mTZI6X8eAKQnziW7Wug6TudZfK4Q2yvxMJ3nM6v2DPaVX2ld/evSA5wOcFGaEbP",
+	"ip/oQnwnkrAPEV1CHQFa5W7qMUqOiC+OPYk71zKOJXvN8tW+kYKmQCwAVEYTFOuXUgSjl0nKHpIp3QMf",
+	"PX7I+HBaaZywbk4edyyXeGqA/oCrHoLd3acHzOgoBU7dhhXACXaU+2ubjwGVVErw/6IDesyip4D5CVWM",
+	"3Y9yTddp5YZNERFvwQ+RqTwktl4DUJ4m9MhuBBvBNj1S+6bqNc3hfqCH/DyHGIAC+aJBKnpssNgPyJ2h",
+	"AhJHAJ/gRT4LSCr97NwcYHyrOVSbreJb+Jvi7D7BDgop+dIzWjV/JKzXXfR74F/Hawb9+TnE3+oGfAKA",
+	"B/9kn+nXyI2uFKadDMxXIwfEJMHPNtTgAcTGg41gR49G74lIwRACipx5PhcH1R+KDuFYbcu22mB8vj8G",
+	"otPebDaCIfKWxK9ywUZ85LcYwPeUIgosQ4t4aCvnRc6Rg/XpSaY5CVgoR8rToZWEDjSOsP1ZQ/NquGkJ",
+	"snwkTO0pHKOGbry3dPum8cHfvf/f6oYu7H/r04+uzBv0W04IffTMQRROIk+2akIlUcuCkslQhQ0Llm9S",
+	"jBok1C4RhCm8FZ7mgnbxstmC/AB/tZ23s5vSU9fDh5SRnXt63NNaoWbLtBtEgzRHcMpgQ3PGnBjP5Yk8",
+	"nzu9qQkCs5xy1f0tpKujSPfahRPAzvfRWbT1YYImuW4mP3cSbAfPlHujB7FrHGM6Y2FAnIPmP92kXa91",
+	"Vh3fUeLnp5+yFKgh3KlqJ5HfPJfd3WY/zRBZXG9JRXwRmxQGHSKfCosgeQP9rMytGeyE8oLuGsEGx41D",
+	"lZU41KzJYjvC5e88spGfmM22ZYdphrV6bc0ij4irzEMIz6gVzeUVN5kn49Nanny7UIBDefBrBmY8E0gC",
+	"3cWIF4dm3fCI77cs+wF8NcC0/U3h6nmOv9o23lMFZ5lGe4x8Y8iS6YLtK/W7dqPleKRpzLEfHAZPMbV3",
+	"iOmAKB5AQeizcI/wJeFvgy/g+jDH96kwS6/UDdNtrFprbMVdg/aCp8EzzGHt37Wl+2QHrNVr4kAYeoGt",
+	"wCXzNfS3esc1vVW91JPdOIob+CnOGgErFSCDugeWPxc85xKD+emHIXMNdoKX7GbkSCWzlpBegmd8XUVW",
+	"aKYvMDpApgovo2Ps0Eq0fMxSaG+ZOg8UF3nLkA+hihmHDsWTlORTepzEer6jWO1/c318t9BarukTDTEN",
+	"0dTgGdJGGCHG4hX0n4Sbjqsw8O2uEXwZ7mMEDQdMEpa9nBvChxThE6QUSZgf8lqcXkExnIxXxG4sDnEO",
+	"szxM0NstFTaUxoZxXQ8oxB/zl6VO+bcwPLgJOd3bPEN6H9xw+jTuhrcmC9SGtwZKeMt7XKvXOs0VJa/9",
+	"2CKt5g1ElTR+rMCXiu29pkPOBuHN9JgxwsyEoeiNNnmkWPJbRLHnUcgvYrZ0wLLTIh6rq1eTfTynfI/o",
+	"1BrwYOIQNb1Ws8gG3tLhWV+NfqY36D8dGrL2JDaTQCYGbxW+iBxohS+QGUnLmBiv9PrTfvAV9/eD7qm6",
+	"E0iz16zw6aexjH1Nxnm+rj+uRPXM1z/RgE7LAcd48HEdQJHtGe1SiRvtwrxEOs57cXcolHfhTnv0pG5w",
+	"dRX1UtCBIl3/VGDyHmLykCUEyWxnxbJXWs6jWr2GZvwjyyNKzsO2fYuw/2rTanhIC7yCnjrJDYmpl+uE",
+	"DEt5TvDkQ8ZhQPzQ3bgRzd2apbyWTXd92e2qzObXqCq+gcXpAYPdKVgG4EBlDmvYjUKN5K+47zgtYtpI",
+	"pqbVIs3s6CmrzAUPabAh50yAaaCmvJUQczKrMGQsAzrHv3N3I5eyIXSVOf7vcTcjPRXAgiq3GLBo35hj",
+	"bhHMdEWdnr4BNZ1ZSNKbgu0rmsjZo+LOdY6eziNNqN57aHU6Ocfnx4ETfC0hWHRH+UEtfjkRgkmQDxEi",
+	"2k09QTD80Hq2wQ84Uhr/bjwhXKMATKcHUe/6eyX5+orqNAWqjnOhk+WYC5PmFLwFYlwD5ICJZK7UKi3L",
+	"VkuooUj+Z34AphhHWAr+f6XDYTcSKP2zCPWiYNZmlH4PfDTYAsbPXC+MiZ8ip0DoS4eRfU4Pw1TAiIbu",
+	"Zdf/aBPmY7yOgbBYDn299sh0bXiT+oaxeQMXyO9QJIeqxJFgmhIWRExng6doZCsZiBb1WrLoKCMndskn",
+	"mux6x/a6bbWkTuef9LODeHVDqB/B13QQPGM/3Qc7D8ydM8X4MpI7onecUVPVrBQhVce1GkRjKJ/wKvu4",
+	"ebs1rfz0s65p+5a/rkkzOWKReJ6NMCzmddElwylcAElQTyGMnmjoKCflRGB4MZ2F02WhkDb8WO8ZukBC",
+	"NhifxqdOEMXPQtcVdZ6VOvUOpvevjOAh43KFgbMuYZZKsvzGseycePe5JROWTqwboeUO7goUhRBvoqzL",
+	"0MAeU1ceKRUuqnTRRrTUkcz00f6IJYwYVg82wUYDWG+yZEYMGnHdUBQ7QjBJ33jCsq2G7Dx44BLSBFrA",
+	"b+CLZtuxm97yQ9PtABZ1vVWXtMz7pCXSe1mcabltPuZuh7ZlL6O+thLHsoi05bPeIl63ldV06syh4LA3",
+	"ynK5sseb4jlt/WO95rjWAwvcf9GBi9G4KuSHHk/ldarphbShMGsMr64DbrzhujJ3wqj20ddlguuwOYJd",
+	"VQ0tC4Rz46HTUGYdxxwpdh31lsmuxMaANYT7dCjyGtcR2acsNiZyHoIX8eWn8v6LlWaexo45CHbiJ0vX",
+	"anZM17ewyoKDUG1LX1ihey5nyFa+z49xqzb2iblO3BF9ccxjT4/CCBbDSa1KfjytiDmCSkWPxMGCF8HX",
+	"xRmIugNXdm+NW4Q7DsnH8KzGg/hfjBOgq5q1zwx70HFCmjfoNxiMaDu2v9pal1L+4KFDZiUdo9DcCb6k",
+	"PfgzVibCoo6JvBT6lgOGOYmCF7E1Yh2+rJbo8YX/4Puo1WvrxHRb60rCZae37AdS7sps1zmNXNBkN5eb",
+	"6gSF1+nLOGYOVmjeV+ctVeU4xoEofuiLaP9pJEok5CkMnnOU7hLSZ3FAFZ3oJTZe1R66b3eCp2V9p5a+",
+	"Kd7rCHjBDoMnBMLe0bcYPhJPCo10IFVSpt+DHXfdrl0Aq+P9dllBXbQVDL4WSiIQe9/mqXs8U20gaqKK",
+	"l+N0zK5HmhrPuQz6nrINV1R2I0KmcppTTxms9HzT9XVUEut7ImIOaUAVPp/csKpM4h1zf+VVkkV4Hysp",
+	"lU4YQljXOyspQ+JcNFsPccUThTUQHacupIioHtbXok2CE55X0dqZ+dv4WVGOMy67am2GSVAmuRid5ZPX",
+	"muWBC6ahKWP4Lp7SpQk/1mMZaCGSChCxXC5RDdEzuD+DGZw7chJe2Ac3aoEbdb+Vuuy6ZI24vsZkuo3m",
+	"VJvY/sjBeoUxd5k8GvABsX2NC+pYcB3IVo9XVqe1yiKNuvYSpd8TV8xGcrtofM11rEkAdSDYkpRlYVTQ",
+	"vWJds7NjrHu5xfNx83u52GKhi+fMXqCR3BR6iLJvtlB9Gx2muYqKhAexI2SatRF7yVZEvPB3JZqpxFhX",
+	"IQUkekSrdlQcbxwcb0rZRmnC7wFr/lkR/qiEvmq66sKg7J6lg3PuInrB40vq0N6sS4oFf2Yon+S24/o3",
+	"3SZxNQkQp3gZYXUSn/WBKXKCTjILTGBJOVpreo0aqxpWa63JPlrj6IbO8y4kM2laGXSHuJbTXEbrRX0h",
+	"CNojOowdZzSTa9TOWkpw5jCf2MHKtym/3W00iJfRV89jP8gJT8F/cN/PaI+FP/qIKwkeE3qkEocQL1Hu",
+	"cN1uXNxsESiwpSdYADCkJ1K4e3BhE0bumN7D8p78MLV5VD9+yX4D3KPDOyRfYAPCjLajqf1Iz3Vcy3HV",
+	"aVevuTOVZdGguytvNd/ylbX838aKcQDr8gB10aPClOiWbWnAlKASfRU5+hayLuDH4xrslgPoCgHSwoOd",
+	"ItrDPe0VZeFGYYxQYkCsDeeopS+Xx5AcTzed0pP3zlQRM+bKkg4kX1xQ4sGYxy9oGkQwW5An34Z3k2kd",
+	"Sm75aMbXyNF9hvw8cec0Njbt/KL8WXZ4bDuyLS6KmMbQc1Q6/gAjpf2oA2F6OttBsr/m2fqS7tIj0flF",
+	"71QtOJmt3Py1eq3rtvTgkNPkogOzQUDhO3knunR/IVU/OjVy0UG4muA28ebw/RjA2fvjYKP9D+/audF5",
+	"ARkMNYJdCr+DGi1Y5K5drAV9dBWx2EMOZf6T5fmZI9VcHgDT1cnxKJWmqI+FZUUxGV6YYgDwSE1u2LZy",
+	"DZVo/zmQyNYgp31Acj3VwmisbYOyAFfeq67uPz5D9b7sZJ5+Ku7n07lvtQYabKubrL0XztHjo3jZsa+o",
+	"pu82cISbp71s1XQyA4UZcO7/+/Qb9i2wzr06fNHDcTHbtI8TbFlCARhLrO9BsEHf8NSNxB4R7a8U5SjR",
+	"5LlyIw7yqrqjfqnQEvW12v8p6fxnGZTAWzMta1occd4U1kmIxm9JuwP4lbSHYEuzh3mD/mdGq483/C1M",
+	"2IJwSDek4DUZopVVsWED7KitbpMso3qtNAxiqEA+65otgVvYEPwQz/actX/W6NwAFdZFmpUZhjwq2FZ3",
+	"0RCxk7GolYn8JZYYwnY5KKpShpivSBoKaxQj4jsjCcmFn6MXUJayrNSHS9/lAJW2RCo7a/WBAT3parOw",
+	"OtiGWGSwEbyMHtiK1sWbMSI0KAi3MEFfATRg+txnNKp8eVJX+YiGwZcsjoutzdhxEw3PWJgKtY4CQgE+",
+	"6hC3QWwfgN21Ld9TCojYPPHyo14LR/78rGmlLPojNyOSrJtwaimwjVq9xo8lhyTweOEF39P4ws7kxp9T",
+	"QX2cnvtkYwYZcfFBcYh7eWqftsaxQBhO0wBkZjvAmKGHw9O6Z+PmJe8jyg1M5DEn3LYc8JCxaOsreHUC",
+	"Ewoq9pLzRaXknEVxm7SaNWLznDE6DsNSrmZ6ZNsRz8Y421TS82/8rYzjDuh+/OntyeclXrSqy+1xKc0c",
+	"ntpVhNW1Mng07XBkV/BZ82bKqY5lVEQNbs+IejgOpc6DbCk9rxgUHECSmR0pErIUr59+BaxA902VBzCt",
+	"fxSqGkhk9oMMb/hOyahNT6rCKt7dVXDe5BPQ2ybY4HCRehzK/o8hPS7GCorGVVK7Li77rJUVXTtfNGbj",
+	"S3PSGqJiO8Q+8HKLdIEUwTb6Trnn9KnsYi5KBnIX4uK9ulLvUvWoYFUVmg7XqUXAoI+XexwYvCSX241K",
+	"ygpeqiU1Q2zjHw2xD+UmPdvseKuOP6rv97yiiVazllq9Log1hq/SGTiW5dgitx3Xz24snU5L1HUO1eQq",
+	"mj65Eit59pXhT04dYGnAT+fWTJfPvvpdcsOLbInEp9f5iuKAK8S9CaMsvFWrk5c/drZ53QnfU1iZyarn",
+	"kCO95fLzS9ovxoY0VfGq64QMuewYTGhbF55h+4nrQGCtcDJP4hnFyD7eHnsc2S580gkP7AKjPNOsmu+i",
+	"cqKCk/kLz/cusyhOqskZU1NqwYvoozXefLTk9GRdO7xmTg8OZotgqjL2uusFO8FGaJWgny54qSDlafWR",
+	"2ETfIIkFNlncA8tYt1KaOZOeTE3ml/iGHknfhcHEIT2YVhh0TKs5QusVhVPw8jRdGa1thrbhCoK4HhEZ",
+	"Q7y0yMHWTI0uZFTeBj7CaPMjYrrEvd4FQHxeu49/iWb4td/8jzu1erqFnbABwlxr1sCJtSXbN9iSTKM7",
+	"5K3gkXNhJAm/jM636vsdVo9h2SsO5xa+2cD7Ydy6xi/DuEPMdtrRf/2TJSkXPLIcegZv+9uLTZRhATje",
+	"2WUTtXS0feVCCez2DvKKv3n+rn3Xpj9Ei4fau9wEnyXdGMEXwRYMKADAYEp6T+j8UYOro2Dn2l17zqA/",
+	"KXaods6wLfFZkm+C7ehTXOiHeBI5M/XBDMOF3+GEKfGdShXERb6PwoARvFIVJBLL0kiScFfK40XptT1x",
+	"KNzHJm5SwClaBHb1Bg+zjfk0CWM9Ao00HKLHnr5r/+IXBv0jUCGv5xuwsVwCb+EnkDiFrVW/luw2Yjc7",
+	"jmX7niF4Lya4bBq0p1uNe4p0VHDtrv373//+rg205ri81eE18bu73atXf9kwsapiGWcv4yeEP1SD/uMN",
+	"wvVEThe/XbojZTaHZHIbGJZxm7hrVoMY1z9ZqtVra8RlzoPa+/NX56+yOkFimx2rdq32y/mr879ETuKv",
+	"IlNYMDvWwtr7C+FU92uf1x4opdkrRLtdRJKv2RTUaJwdJlPvIlE+S10bBtyioGNYXijCsjXcoYtQWmrW",
+	"rtV+TfwbbD9snnzHdM028VFH/p3G/6JMvrXgB591CU6r56AMPUQ8JBSxWt/tEs6/zFy/P1/lDizy5Mk9",
+	"WIdp9wjWv7t6VTA4nhpqdjotq4FnXPiDx5w45V4VMyGeqJJe5MtI3QEgwt+PcVu/cl3HzdxPQigGO8GO",
+	"3Hq/FzFx+G+Pya1uu22666GNy+pBkZkGG9nnq9d88wGao40Iee7BokkkX/jcaj4ph+kYKPginBDD+zEP",
+	"Vf5ENLRxkg/H8GArA8PXP1pfWszD8aXFDPxG1SZEb6uZidNpXeNnS0+ZuPtd6rp31NcNZPXB1Q8ukKz+",
+	"lBSKPAnoBOOtb3mnqVmn9pTsf1mAwDE+NxYRppsPnCJjNNDzhdSrMEOLH2838eZoOij3JuuiiSoatGyW",
+	"PhZOC5XJrklWzG7Lr11bMVseUdR7niexpYf550ouNegvl/TSoZdAcMKw6h5mTHnKjJPQCuDp1azASo04",
+	"tJ9C3RvolMbr4UybeP5HTnN9vFcfta16khQNT1Jo9/64351xvX9WQSleoTtkvP0ike4vLBsmeIpod8it",
+	"1r7Bd8T/kCs1Zo/Th5jLGKEOXVOkkOTzCz5MPi7J7bdS8441CRIoew55uG4vw7OrKgRRTZxPNp9lU+ZS",
+	"8mSRpcSEYmXCnPmngvC6DPqGlE9UgkOr0RJMjGWR3oSfP1ngTezg6BqmrrQ8lASiSmPCZslRhdUJM7Gl",
+	"WGsi1KXNDkrh5S22cyEt8myVOPpzaz9boUkaMXHYZZoV+f7Uc1dwRpA0IK2DDe5SlJvEcqnzywuWOqF/",
+	"M9gUnUIN4dILszp0e9b7Mi/ePNKAO2UiDZNZhLQ/c0zsVeo+BmcVqsC38F9PGH8CKlQmIPT5pnm+i5ZP",
+	"JVM1U8yFSTwNb1Ezh2lnCsnOPSUUUJl1ToAVvFYqOS946chOFPxgFceK4N3sMYKZI3uhlJUl9vo4HJ6q",
+	"t4VOT62HRO3lnE3qzhf5Ou+hEnYVkVdEXsRAKUHmna6mOF1obcHOCESeIu5PsVP3ZCT3NPirpsCKGEZ3",
+	"KqsMM+CzqlhexfISLC9iUGM0YxZw7iVvLjdqXCiVtML6Uhbznvya+NfZHizifbSOtH55tCF+tJLpCUqA",
+	"XjK2MNvqRvqGBnqEFwRphnheNnbFKhdTL2Wh0oL6CItpCYS8BCqJOMqEomjR6zPw7o+KK4uF0XqVSlLx",
+	"njIRQQUT0LGYInIfPxN/ZHs1uWNFWEfqjai8l5PgOXXd4ma0mRKJYJP2hyr5iOwNrayGsxxFBd7LkDeW",
+	"8IWW4B1j8IbSN0o9KcMCWL9oR+gMcYlC6obataq7iIpjVByjvKWTxTPO6FotyjGYa/WyqBVTYhldnbxl",
+	"lHLYVtZRxUhnlJGm3LXjMtxss7XuWw2vZIkOFEt+jX4kqZllsqixHvsk2GaRa1WloVSwjI20lPXZ6Ho/",
+	"VU7Ip8f1u7aqlf3TYIe+xfUOg6c8Va9PDxDu/cT25g36SvRjj1oayx08R5uxcddOiRwRqr8egv9ipU7h",
+	"sV/Ge1LD21irnSua+ghsaqPcUEYboCd15cBIaBvyZXpHiT68hbblOyNtSl0BEk1bLybBxC0viSdVB45m",
+	"oqVxNXgWw1XRw1F5zo66IuXv67W2+ZgP5r56NXtM9/nndIQwyWHnJ8Li5H2VU2CYHpF+imNNe8gENqH/",
+	"TRWvPFdLIoYaCvFTIIQSst8cKclqvTKyyUWOJmvrOBCjs4CQtzExvJ+VtBkWpM1DWX2R4jSAFl882A4b",
+	"pLOg3yHt6XPq43LoOjvYpUoIHT2/I9hMNFYYxm/n4nkNRyuG06yQUZo2KQa9QNkzfjGgx9OX0V5xwTGa",
+	"ARKKZuVtJPC2ZBZHo2Va7ZIGwQnbMKTI02M6lI4oLNxgh/fMgzswmt12e30uaxBp4YSPRVjqBuzZuyw8",
+	"LDpSuVQPAeYhPZxORjDbOkcMi/OUiwbDyAKkhh/gv54smJ2O62SqGn9Flv8GTVsxo5PtilvO8OW/i4EM",
+	"/EdZ5MYHaollBiERHs+zDkhvWNtZDkRMHom985iNKmN+Ab45DjJtURxYdml1hB0+wv6pcMY2+E6mJHQT",
+	"QYcNE02j/bchmvbkG5mQC1TeTbAFiGIoJUWvUl0SV3cp/JZxfhBnHHmKwBj4qUuwT18GO8VGykd0GPJK",
+	"eY/17P29MAT2RMOqCqfQ3cKtVcxunMwuusyK3VXsbiL2mcC/i2J4jpftmIq3wjjgY+kw4MJcBqzPPLqc",
+	"+VgBPnVGO9AER9qC021Dfu6UzRsIB6dwZ9gQ3VqvsTVBD+cb8yJ2XJT35+gFLzn/TEzWpcNoQz16HL5F",
+	"0crwBoDiUrmybnZ8q817Wt4iXrc1kl8r5oisY2eBpP3OxyzCD3Bs/y4bHKi/0coVVrnCJstqv42wemzl",
+	"S+EcrlFrlwSnyow+KPoQ3fcvX7ESnKqc90qCXlUiMEVurzyFQCKz2PzwbGIjj0XD+BLUxgcdYuOv9MSb",
+	"qGf1WzY+CP9WzcKsx7QKnLEjFIsh0zISAu+ujStzCdljL9Ilo8wbN27/C+zjX//59r+yCp23uOA7QCze",
+	"+tJAs/ENhjC/hMmNnyx+bMyxV8OKm9zXFmzwXRwGW4pUkl8hGC9c7dHkRvAHi2ZGsM3zzvhl2VGnuRKn",
+	"mnDX9y3bdNejbUvDwOQF1uzmvNMh9uN2iz3qzTkrK1aDNJ1Gt01sf97ruMRsequE+O3WPP6//Ct98thf",
+	"aHhrZZ9ME/bfOPajfraHmvc+OKMnkwRxilgpEJu1wodxYDj3J0rH3ay0njF2oZIuPi8AKJilrAUxnpvN",
+	"mFcIaZZky7HpCVqzcRhVoLLR7UKHh1lRx9cUU+SUQxXq6pnSwJDDIQiQDhg3cMO88JiOhpMf8JWQQCJZ",
+	"FLSHLf74/Au0n3FUAZ8otxFujA+8FgID8noPMBnQJo/95UbX9RwXbiOZHkT7BvtSnR4osp4/JqQ52eRA",
+	"7FEOR1TWkX8owj/YAjHMlgwTbzEfJTToazB+tdNymkTsTZnDxrqbR9suNKpLQIy1NVdMzvTXW0JE1RTn",
+	"LDSdUV1PoDpENEqmys08n9xM9dxeuKliJAtJUxKRajYXfpnygGfshY184fO3Ey/WvKdltS1fk7p5dWpy",
+	"N2W2lG3YyYcGyjliw13UjGQw/WmclS06FbaoQKOtcXTNyNKCpHlmGs+67L5GNejG7X+Zi6vmwZYYdmXw",
+	"Oa04RuiR5RHmE1dNCcUlMDxAT6RrxiNhSRNzzrKiIVBVDq/dtUPLmP8M4Sk//QbnJyF/fMYpkytZdYOH",
+	"zticczRrmRwPtplSH2ziYM8e2BqaOdqgSmXmdA3u2jDtCXnyZqxrBVZe/GA03fVlt2v/I45OUL8jeioM",
+	"F7AIwRCjG0PQDSXkGqR83fHHOGdmhz1W6GFLiAN3ZJ/GzJnYS+2Yia3S7+R4S4Q8nOIEYmHv9QGgCGsx",
+	"fUL7MWwCytaINn6zauHG4KIelKGrZTyDRX1xdYsM8LcI+28Gi/xrRHlYswoM5ZTPGu5Pi2A8iMzJKrHw",
+	"rFLtO+mGxTTpHs+XU7JWug/CRRJjXDrliDB7zfJJOVMePSMHYs48as+s7g6l3R49isdw1Zb+HIvH4Zhe",
+	"eENU61pn3/BiV/zvczlPeMC+h3HGbOjfafSstopuCc/pXaoCBnamcvETxR3Rg4pcx6+EliEGmWg5nhZv",
+	"13bIgt3K99XDclo+cB30/mBLdlgMhA7GSejA0OexRUm84PzCGEjwlOMWzyVmx2f6k3qwUvBy/q5NX8e2",
+	"G86eCb1EcoYHBHKesr9B9QWUfcc1i3jeMWh3ChVNmq3ESOaydMdlp5nkTCe2A13e3WsFs5nZoU5Vmshl",
+	"DZgk+t2dKrFWl7PbVzLvfJULP2H/zG6CB/mCkpWt2V9dmmhlRBbYKTfDGNc/4X7W/TC1Ts26FWnIa87D",
+	"SXFQbR6yJbYyQ2311CwxoQYPK36jhdQlqdDkl53BcUrzFYdlgpLm3Ag5aulU35hW2C+U4ZMyvW6KLV3O",
+	"9LXY8crZYZngDp5F4K4S3abLritOJ/qUN61p923kr1fTpTpDQZNL0WO/wA830bg6rrPIwZvgabjl43Cc",
+	"nHIZNNbi4S7DbMG0Z3+1zWzFfXShh532RJoDW6jPaznDlhyxPYl3B/8h8spjQQvaU5hzguqQp0yBsz2E",
+	"RmF/u5yzfz18eqrqBL6JuL0W40XY86KNtwFiXJ8xHOaySKGVgmzooGKkk6zyVGJRInt4tDRh55FN3Iww",
+	"7DfIgcTI4WQubmoQK+1rwV43OFN7x7N/kvXqRmr84wZjxW/pMTeyeK5H5JBLcTiMJq4QF9Wlm3A4b9Xq",
+	"XAKvlThYeKYJ9QstYqm9Ci82bCsYpe9VjqvZMSR/ihN82oScQaU0xEQ+kiWJq0pPFT2W2CvkHubxVZc0",
+	"ui7Gxcul+n7FixaG2BATt7oXbIGoC55y+0aVtFvCsLwldjapxIvzVNVUhytlXRaG+MybY+mTanOP8iJv",
+	"EbIXjr3JiK58a10OvfV4TpEIvcXytiDMBT8RpYIi0es92uOwekt7ddbGfBNPcsJzrliJhWjrN7gCBtuP",
+	"xRKjoAJDJNszpZkZhm94phdXQT+Mb3sbjUhso4tRPnFAhmWsnQGXQF8BbOpRIlD8/dpQnYoALoH2ozrW",
+	"hIJ3qq3oonjfx0kM8VhVWFeNmfpZZj7Eo2cphozliWlseanhvIXUEfww/KvMDCmZY88b9KcokWA/hiVq",
+	"ESJujfMvzfCpSXMvbbwsgvNMhcyK858Jj6eaPtOnMOgu3+ipszIi3WiZ70T9oeAoKa2N5SiqXhVjP1g9",
+	"+EOsBmlAD2KqVlRwHrbQidRF7mIaSmMV4rqjZojNpWRPU6isXZ1uZS1RSFupaxVPn8oseIGkg4mplwsd",
+	"s5vdP41Vt4aef9YcfhBmbMX0SzGhRvB4Pqcjzqk/gTdWeuSkWeRp4mJTY8IqTvSz4USvFbgwQZ7kEq/b",
+	"zmJKzDE/TA1CzGVGvBGj7O075Y7TreBrDjwhmmOeQKiK3oJcVa3HT5FYCseoON2kOd1uElsqLvez5HJJ",
+	"rnERHM7pEDuXkSWyVfUNjeSOn1jIT7BJD2IUH5g21I8cgPSPYEvBpmCT1QgkhLGSVQyrbq9VGc808q7x",
+	"dH31iO+3Sg53K8ajcGXLfjBvKKO2EItFtvQVv6WogAenJjBjM9hhZ2VhbgQdG/YaZ2O38RQVG5OzuXZ5",
+	"hjKf5Ka8m4q3VbxtsryNd3gT2liP9z7YDAcmKwutR+NybQ6OURtcn8bb+ENMY7Se17fD7Vy+0qHobCX7",
+	"NySBW42CrxhGoX4U6eEaeRlxETvILFr6W3rMh9xSi/eLAZG1J7pwDehxGcQw6Ftce49FOzVZYxFJXYJc",
+	"segwE8oQizag7e6QYEV8hKc09SUsjZvSZPmKd46bd9Yzusew5oZCF0hz2WB75rjstyp8V/JbuTK0p+Wx",
+	"xZQz/DT6s0z+m2pbA+W4H7A4oO8kZpds0N1gB+1S1mUHPhTHCbY0eXCT4cdaX74nb2eWOkakbiyW5zas",
+	"mNgZj7J3KRtMyFkT42dGvuk9PIuNGDbhL2EO3jG9h5fPEIRTlR7gzWBXlTNP19xvdiuFRiABKpfo8cdq",
+	"dMRUbfYeEMGF58gyCwlw7TJUEZvewwlZRezV2dNKORr0qnqYiqWcoQ2dROgKzpEnnPFv+Ecp6yD+TpVS",
+	"f9EsRKvO+2wjM6TIx1jDhEtVLpEKL4P18pWx5LCBegkNHGuHo5lrvJHUUHqFun82kPxH60uLFdmXVwe+",
+	"S4F8JwXyivgr4s83KzTkry5S+2sq7Xck4mcFY7Mu8qfAALk6GQNEl9BbGSEVR5ydvnGJfL4z2EVSdUFJ",
+	"32VsPN9Wme5FOL0AYkSQxLfJ22gO+FTR+HgXQKQDKArmgZft2INYI8z7piSejr5Cl9AbnCzIioOxQ10P",
+	"Jy1uahpDBTtjHGN6186aYwqbOeadG/tsQDftM3hgI4YScFKPRJXbQU3EVVwN5xx1UzBH9ZDzXt7KU1lG",
+	"r52m2jB98sBx15MTVQvckW6uK5bfCwhggf4h7Wle3zHXxzPNVbOZdONKLLPqxae77IkEzAHLHY1aUPJx",
+	"99rdu77VsDqm7Y/jDH+Wxh4y5MGyGR5SHgX1zbbTtf3ltmVrcM3p3m9JiGZ32/e1m+uxiP05bM98fObt",
+	"gTLyVp47yES4GA6gGXk9CMc3brHUUJCsWLcTtRzV7P2zvMm1qoc8xy0+YFBiy7fhOe2qjtskbuFlYa2b",
+	"+EQ17HdCw35LtShMzPvVdSSshvxWwZHcxo8j9Xgs2odfG35VdvUpHYa9VF0NJ9/MMLaDDBT8sepZWLGh",
+	"sTY7zC6BLtHBXvopfiz9nR3AFXWHON4c6w61LArRjO6jJ3iraN4Vj/pOWV8GP7afGYoB/ziFbQsvkfPz",
+	"x59Ha8NSbGccIWKNFybL/TY9EeNp5BVFVRZN/Fh5HxXjqBhHebMpm3WcNbxciHHwMPOl0jGmx866OhV2",
+	"VhWKrpjqZWGqyZD0xZiBC6bvm41VVp2T14z0EM92GvHnQ7XHLPgCb+KojnjPf9AXZLCHVdfvwp5/EEw2",
+	"VlbmVqwW8Vj0ieGY8Gx/wSJRbAD1Ie1Fk/7FhgbZk/5j21G8H8nhEBsRQg25utgUQsrw5ldsrjLc4mbU",
+	"uSqm8fKYOethyN4jQkEbwTY9CsMv4dbmFYHn63gzEnv82GqRSogpaPN6iMOT9xVGe1Eyib+x+xZdYEOK",
+	"EhPDpkF2scAwx0zaC2usjzP6WuBnYXCWK29yK3BNgPFg+joMhZNrCx1gIMAjAJZiMlGfiWzmMiWiNHka",
+	"dUH9B1f/+wVuVFCNPAcqQTw6YTSjLYHjki26jUOd8XOOegF+Gf2d7TqGKuGknhBtf6h0JdPBvAFN2UMt",
+	"IHpCuDEj7bQeF+6Q98XYUz8a9MyoD5zSR3HhfqDtJFGH/DeU089pP3q/4BOSbpx8xW7wlXg3/5/6FarJ",
+	"ZIvk0sl47YoRBs2Wd/1vEvJOWmD/uRLBWifllEhO9EUMuX2h7P0wgy1pZK6eFEk6nn6xQmmh67bK5V+n",
+	"Lkpty25gC8+YLTekB7ItB3/GrDnZoGUmo6q1d/AssYVgJ5ZkCeQdpt+Jtw3pYT1hRwrXUg9vc1c2QTkV",
+	"HdIefQcEx8RT6qXA2N5G2YjzuVnQkZHzqduqxNUkAjw5duarJG4DGmfh7FTw0LqCexazR2Y7fhLeS7DF",
+	"bqUQbzoHBrtqeb7jrpcsZGEdjwd0X5tcz9x6cODQFYZqssigRQEPVSp/4qwKfyOtK5Kq3iJE37Fe2L1w",
+	"wkgP1PdwaIjoFyfcextcKxrqHJbRVlQqB5MCUq+c+E/YIGz+RjjFXZt+F2zwnQ14lrw0KQA8KoI3q2t+",
+	"IGc4llmznc+T/4lfXRUdz2eeHFbZQfL4FSbQgh6orq4Kl1eRnXx2TwcSar0sjFrnxu3xC5esWR5+6pI1",
+	"4volZ6eoolV1gwV9WFM4kAFDUfvEBkDhOoPQjRq1jxugo2uDW4jDYIeehKBKiBvgtmidsKbAqAwjgLfj",
+	"qvBuCuwnYTFjbEXGwRXDWgAqlyadIGM4FcOD2ctq+lE/gkrgap+XtiVwqPLjVH6cdAQkrn1eihQDwScz",
+	"UgwU1DF+wWP5pF3SWRMXFiIVYJQc0iWftL1KTdZhIYCn/PQIcTUH4dVUqnClChcbIJEm6xGK676R51al",
+	"WAYrVmHL66L4YQ+KYIPvL1QhQx02rqXmV+IBMVUpOxouM+WFfa8l9IkPRmPEV8/I/UpgEm9xrxvyWSWt",
+	"Vvx8Nvl5xHXT/Dx4yRku7Z2TComfwj9KD6tIb3E8AiBV2Dj7AkC7osWONmNegjhXj1dJjpujV8xy5KNE",
+	"V3QJu++WZZIlKqbOk7mlKqoq5jaFivKkWWq6JqtSlCve//Pl/alWoxemIrsEwntkDJE8VUaCdqpmohmn",
+	"aBEoGm9GM6LosULI3GKbrnqDXEBkDPOOGM+daAntzy0apgP9lMfF0sw42QGI9meOPb9K3cU5lN96qyUz",
+	"6mSzlOkYJVrTqZLtkisGz6SkNzE9WR06w37Hd/AQl2Q6XnSiTMz6k4TYvUwNqVL2qkHp2XGugm3SRmAv",
+	"XY+4ZxmaqatHPSgxRPNTj7iXb4gmnKp8JFwJy6q74pTFnEtivKBLILZRwtCa12lavKZn5lxvNpHG7ji/",
+	"Wrvg0dfn1bOBn2hCvq2iM7LTFzdIhYJFRXzll6p4UJk4aXmmkGRDeXrBQrPbblvEO4N+ACusz41FS1hk",
+	"m6n0hDyYVpQ6PdpC7l2NSJXrGW7ZeKP4rB3wyoYyfeKBCtcBZS+BEhGeZUKpZPDqT1wHCr0X79xUoeNi",
+	"1uW9iDWKr5SIijWVaBOfzRZGYUvwN/yjdDaVki1hxKiYbnCLtJ01AsT0seu0L9zC0QZ9uoxLTqveMbIN",
+	"E7yI+YMrH+Z5HUX2Yl6GpKXyhD46E1potEyrnaEl/TGs/h+ITUUdPRMoow1W79OejG91I3gOi2Zz1xfG",
+	"nBH8B/6ODukJ9iONGggMmX+Agx3fArNFj1kYU8zpCmfrHcsFsmHyVV2eoczi5j2U0AO6y8ZywL9zdUOR",
+	"XiM1lBEnFgPoXt61szTFG3gLE511qRldmCsAL5Cpvz9elRaBrtEo6bf8AjkFpwYNvT813K+notDpi8tf",
+	"uNDIMQpSoiPDxrvwVpx/ob08rhN26WTjX1n0dD/C2pn0CQi1OzqI6NVTWBFHgVZSCDod32pb/0aac01y",
+	"3y/pyENs2WSRfUw5exoTk/1Yw4Wz+xd+TfybYruLsNuP0DafkoktU67MxyBXLuqYecvBs+iWh3T34rlv",
+	"Zb9rA5FjIM/RcwYkJuM6LZYlqqwy+DOcVnTyfcq2kb6nHo57l9tcJbrww0WjRovXDwPvP4yWS37J823o",
+	"iYQa8oj44DmPlYSPnSg9C6xkAT0K6CZ0pqS77riY0fhdqggrgNMUB2a/F1gTzwSthr/MiP5Lf0pa5zPv",
+	"LPkuxESePqpnlKM5Sx43Vk37AZlzTZ+U1AJ3gw2BhTG0PGSjzCGVfxddui+DTWUCKH/3LdMnXu2MtG9h",
+	"95W865LeiGZwyP9M1zXX81WiQzGlne7OHCqlcwfV9xSaFV3XJXbDIgmUsew1yycLn/vOQ2I/WfiDY9kZ",
+	"3jRF4lJP7WATbYTLGgxYDSLoIqwpwV/QPZDjwVecgNJy/DeOZaNk+mh9CY9VSIrjyYuUa4Qz9s9Tww/P",
+	"MJq6zEAJKA3cnJVEHDHFSOPefHnx8vB1+jbRJYqa4D7HoGHdEOIQ//scfzgMG6gPsBoQznPKfj9Dfq0P",
+	"Jg9sdfr0xXqJMsJO4BqShOJusMWlVIaLa/YGuMQJFfh4NqkiO1VzQ7nshPH0BKNvm7b5gCw0TJ884M2b",
+	"CyWWsHqXYAvefsgvaS/sjxlswYd0P8b08UpOaS/FoFnE4IbYQoo7J+uLYJnUW9GnhHz8sy5x1yNGLo62",
+	"jPw6i6Fn3bzY3R1Y5NwMKPGWCaWkRK/PrGyJ3/aOMpAwA4bUDOdvpElO1unYLVo5pA4+nFLZGmclc9bf",
+	"piiZLy1mkHjSJ1K6ccO0spQJOkYUdB1rcHPxOkp6R5ewn0thSj776Os0fpekYOYRrSh4BpSCq5NWCmZ6",
+	"vHbF5cbeueQMGkvKf6lmhAkDhTsEhmn2yH1iYUuFU5gzne3JvB33ZNbOKX4ivWJC1J7ynqowlsMPhyw8",
+	"C0d0n1Sa/3lQ0rc8lU9255Zz5qrIaOFz/uv15RXXaT+R/vadcmZBeWpilkCCoPJdsrEdl3LN1rPX852p",
+	"cfQW0tQF/aXysKdh/jd8SN9K1z+DQ9aSOvoZqM5qOPZZaihZBA4ycVhGC8iyQ1WkbQlfdBERNnjTSJE1",
+	"+QSzP3dPdzLJ49rIaTyv96mKBQ+DLY2/FG7hnPQQWHpCLsgQt1RT1QRMNMnLleZxrj7HOEomcVzD+Up7",
+	"GbMQn+kNHPGLJGLN1rDwGH5P2Pcm7+USet2ycbleQlhrnG30jfQSntBuLC2mUJoL7o/WlxZnDqezeXUS",
+	"KjtpqFRoPf7xkDmIfUYfcvIC1R7i8+XQU6DvXL1wfadyp/5MKTzlSC2shmGiPnnsE9c2W2XTPlNlk30o",
+	"m8xq5XNKh5FRhAVOuwD/4Ct4PHhmLC3OG/Q/sdBVW5KgqV8LNvj81T4kwNTxb3qCrschPREFECIZqM+C",
+	"WBvGysqc1YzAy4y146wehBxYS4tebhZKzLBNntTI7PlCHndaTpPUrq2YLY+oA1Rdq+ll8sbQUs/N9k9a",
+	"6fWa569D0QY+WpuVNohGsKHCS9EZXLoC/GxpsTIKL0TjGJFVxC+MqcYZOezw0YJlM/qMdxs5s64uMkq/",
+	"wJhRP6teSsk5Sijv015JmN+USKPZa++76qpxnt1II7zNaLNRgLK8dbuRmfuZIW+1RKXeTfCshGy+vW43",
+	"UDifk6czXH8GW4mqtSCRUj5DjUVnzCmqBXpOd04VGcLapNF1LX8dhcZHxHSJe73rr9au/e4esHqPuGtq",
+	"FXSRrJGW02kT2zfYr2r1Wtdt1a7VVn2/c21hoeU0zNaq4/nX/uHqP7yPmh7fgcILywv6xDgJ2ldnm+/w",
+	"Vv9cpGGlq1d7Ui+0omouU3y9WCFywVV1nIbphopD0IPohewqir4p1UcnuX/Y+prlW6T4mlGvnl4CFqb3",
+	"sPgyyQSbxMakHJuiK0aOnsTGmLFZeGO8nq7H7kOOocYD8Zq9vca0xFiZityUIFzFI77fIm0dPr5WFYvp",
+	"SkeCnWhdUTehWDNqpzPI7+fBeYA4M2vooVj0e7jEYAtl5dOMCR7RWi6wEJacUFe0ujoRnm9eQwpLBRus",
+	"r1MchKZtttZ9q6Hc16tgm+6h2N/XlqKyHsTBF6hkHQXb0dLkccdxfdW639FjAFjwVDlAih7wYr+3+Oo9",
+	"tLDZK+kRHdJ37Cpl0Fpt9qp7T/7fADvMdPJ0MAIA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -5,25 +5,150 @@ package api
 
 import (
 	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for ActivityType.
+const (
+	Custom              ActivityType = "custom"
+	DebtsOptimized      ActivityType = "debts_optimized"
+	EventRestored       ActivityType = "event_restored"
+	EventStatusChanged  ActivityType = "event_status_changed"
+	MemberJoined        ActivityType = "member_joined"
+	MemberLeft          ActivityType = "member_left"
+	TaskCreated         ActivityType = "task_created"
+	TaskDeleted         ActivityType = "task_deleted"
+	TaskUpdated         ActivityType = "task_updated"
+	TransactionCreated  ActivityType = "transaction_created"
+	TransactionDeleted  ActivityType = "transaction_deleted"
+	TransactionRestored ActivityType = "transaction_restored"
+	TransactionUpdated  ActivityType = "transaction_updated"
+)
+
+// Defines values for AnalyticsInterval.
+const (
+	Day  AnalyticsInterval = "day"
+	Week AnalyticsInterval = "week"
+)
+
 // Defines values for CategoryType.
 const (
 	Event       CategoryType = "event"
 	Transaction CategoryType = "transaction"
 )
 
+// Defines values for DummyClaimDTOStatus.
+const (
+	DummyClaimDTOStatusApproved DummyClaimDTOStatus = "approved"
+	DummyClaimDTOStatusPending  DummyClaimDTOStatus = "pending"
+	DummyClaimDTOStatusRejected DummyClaimDTOStatus = "rejected"
+)
+
+// Defines values for EventRole.
+const (
+	Admin  EventRole = "admin"
+	Member EventRole = "member"
+	Owner  EventRole = "owner"
+	Viewer EventRole = "viewer"
+)
+
+// Defines values for EventStatus.
+const (
+	Active   EventStatus = "active"
+	Archived EventStatus = "archived"
+	Closed   EventStatus = "closed"
+	Settling EventStatus = "settling"
+)
+
+// Defines values for ExportFormat.
+const (
+	Csv  ExportFormat = "csv"
+	Pdf  ExportFormat = "pdf"
+	Xlsx ExportFormat = "xlsx"
+)
+
+// Defines values for ImportFormat.
+const (
+	Finflow   ImportFormat = "finflow"
+	Splitwise ImportFormat = "splitwise"
+)
+
+// Defines values for ImportRowDTOStatus.
+const (
+	Error   ImportRowDTOStatus = "error"
+	Ok      ImportRowDTOStatus = "ok"
+	Skipped ImportRowDTOStatus = "skipped"
+)
+
+// Defines values for OptimizationAlgorithm.
+const (
+	Dinic              OptimizationAlgorithm = "dinic"
+	EdmondsKarp        OptimizationAlgorithm = "edmonds_karp"
+	Greedy             OptimizationAlgorithm = "greedy"
+	InteractiveMaxflow OptimizationAlgorithm = "interactive_maxflow"
+	MinTransfers       OptimizationAlgorithm = "min_transfers"
+	Pushrelabel        OptimizationAlgorithm = "pushrelabel"
+)
+
+// Defines values for OptimizedDebtDTOStatus.
+const (
+	OptimizedDebtDTOStatusPartial OptimizedDebtDTOStatus = "partial"
+	OptimizedDebtDTOStatusPending OptimizedDebtDTOStatus = "pending"
+	OptimizedDebtDTOStatusSettled OptimizedDebtDTOStatus = "settled"
+)
+
+// Defines values for RecurrenceFrequency.
+const (
+	Daily   RecurrenceFrequency = "daily"
+	Monthly RecurrenceFrequency = "monthly"
+	Weekly  RecurrenceFrequency = "weekly"
+	Yearly  RecurrenceFrequency = "yearly"
+)
+
+// Defines values for RevisionAction.
+const (
+	Created  RevisionAction = "created"
+	Deleted  RevisionAction = "deleted"
+	Restored RevisionAction = "restored"
+	Reverted RevisionAction = "reverted"
+	Updated  RevisionAction = "updated"
+)
+
+// Defines values for SortOrder.
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
 // Defines values for TransactionRequestType.
 const (
 	Amount  TransactionRequestType = "amount"
+	Equal   TransactionRequestType = "equal"
+	Items   TransactionRequestType = "items"
 	Percent TransactionRequestType = "percent"
 	Units   TransactionRequestType = "units"
 )
 
+// Defines values for TransactionSort.
+const (
+	TransactionSortAmount TransactionSort = "amount"
+	TransactionSortDate   TransactionSort = "date"
+)
+
+// ActivityFeedResponse defines model for ActivityFeedResponse.
+type ActivityFeedResponse struct {
+	Activities []ActivityResponse `json:"activities"`
+
+	// NextCursor Курсор следующей страницы; отсутствует на последней странице
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
 // ActivityListResponse defines model for ActivityListResponse.
 type ActivityListResponse struct {
 	Activities *[]ActivityResponse `json:"activities,omitempty"`
@@ -54,14 +179,35 @@ type ActivityResponse struct {
 
 	// IconId ID иконки
 	IconId *int `json:"icon_id,omitempty"`
+
+	// Payload Данные события, структура зависит от типа активности
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// Type Тип активности; custom - активность, созданная клиентом
+	Type *ActivityType `json:"type,omitempty"`
+
+	// UserId Внутренний ID автора активности
+	UserId *int64 `json:"user_id,omitempty"`
 }
 
+// ActivityType Тип активности; custom - активность, созданная клиентом
+type ActivityType string
+
 // AddUsersRequest defines model for AddUsersRequest.
 type AddUsersRequest struct {
 	// UserIds Список ID пользователей для добавления
 	UserIds []int64 `json:"user_ids"`
 }
 
+// AnalyticsInterval Шаг временного ряда (по умолчанию day)
+type AnalyticsInterval string
+
+// AttachmentRequest defines model for AttachmentRequest.
+type AttachmentRequest struct {
+	// ObjectId ID файла, загруженного в ff-files
+	ObjectId openapi_types.UUID `json:"object_id"`
+}
+
 // CategoryListResponse defines model for CategoryListResponse.
 type CategoryListResponse struct {
 	Categories *[]CategoryResponse `json:"categories,omitempty"`
@@ -87,13 +233,40 @@ type CategoryResponse struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// CategorySpendingDTO defines model for CategorySpendingDTO.
+type CategorySpendingDTO struct {
+	// Amount Сумма расходов
+	Amount money.Money `json:"amount"`
+
+	// CategoryId ID категории (отсутствует для транзакций без категории)
+	CategoryId *int `json:"category_id,omitempty"`
+
+	// CategoryName Название категории
+	CategoryName string `json:"category_name"`
+
+	// TransactionsCount Число транзакций
+	TransactionsCount int `json:"transactions_count"`
+}
+
 // CategoryType Тип категории
 type CategoryType string
 
+// ChargeDTO defines model for ChargeDTO.
+type ChargeDTO struct {
+	// Amount Сумма надбавки
+	Amount money.Money `json:"amount"`
+
+	// Id ID надбавки
+	Id *int `json:"id,omitempty"`
+
+	// Name Название надбавки
+	Name string `json:"name"`
+}
+
 // DebtDTO defines model for DebtDTO.
 type DebtDTO struct {
-	// Amount Размер долга
-	Amount *float64 `json:"amount,omitempty"`
+	// Amount Размер долга в базовой валюте мероприятия
+	Amount *money.Money `json:"amount,omitempty"`
 
 	// FromUserId Внутренний ID должника
 	FromUserId *int64 `json:"from_user_id,omitempty"`
@@ -113,6 +286,44 @@ type DebtListResponse struct {
 	Debts *[]DebtDTO `json:"debts,omitempty"`
 }
 
+// DummyClaimDTO defines model for DummyClaimDTO.
+type DummyClaimDTO struct {
+	// ClaimantUserId Внутренний ID заявителя
+	ClaimantUserId int64 `json:"claimant_user_id"`
+
+	// CreatedAt Время подачи
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// DummyName Имя dummy-пользователя
+	DummyName string `json:"dummy_name"`
+
+	// DummyUserId Внутренний ID dummy-пользователя (отсутствует после объединения)
+	DummyUserId *int64 `json:"dummy_user_id,omitempty"`
+
+	// EventId ID мероприятия
+	EventId int64 `json:"event_id"`
+
+	// Id ID заявки
+	Id int `json:"id"`
+
+	// ResolvedAt Время рассмотрения
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+
+	// ResolvedBy Внутренний ID участника, рассмотревшего заявку
+	ResolvedBy *int64 `json:"resolved_by,omitempty"`
+
+	// Status Статус заявки
+	Status DummyClaimDTOStatus `json:"status"`
+}
+
+// DummyClaimDTOStatus Статус заявки
+type DummyClaimDTOStatus string
+
+// DummyClaimListResponse defines model for DummyClaimListResponse.
+type DummyClaimListResponse struct {
+	Claims *[]DummyClaimDTO `json:"claims,omitempty"`
+}
+
 // DummyUserRequest defines model for DummyUserRequest.
 type DummyUserRequest struct {
 	// Nickname Никнейм dummy-пользователя
@@ -143,6 +354,76 @@ type ErrorResponseDetail struct {
 	Message string `json:"message"`
 }
 
+// EventAnalyticsResponse defines model for EventAnalyticsResponse.
+type EventAnalyticsResponse struct {
+	ByCategory []CategorySpendingDTO `json:"by_category"`
+	ByUser     []UserSpendingDTO     `json:"by_user"`
+
+	// Currency Базовая валюта мероприятия
+	Currency string `json:"currency"`
+
+	// EventId ID мероприятия
+	EventId int64 `json:"event_id"`
+
+	// Interval Шаг временного ряда (по умолчанию day)
+	Interval    AnalyticsInterval  `json:"interval"`
+	TimeSeries  []SpendingPointDTO `json:"time_series"`
+	TopExpenses []TopExpenseDTO    `json:"top_expenses"`
+
+	// Total Общая сумма расходов
+	Total money.Money `json:"total"`
+
+	// TransactionsCount Число транзакций
+	TransactionsCount int `json:"transactions_count"`
+}
+
+// EventInviteDTO defines model for EventInviteDTO.
+type EventInviteDTO struct {
+	// CreatedAt Время создания
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CreatedBy Внутренний ID создавшего приглашение участника
+	CreatedBy *int64 `json:"created_by,omitempty"`
+
+	// EventId ID мероприятия
+	EventId int64 `json:"event_id"`
+
+	// ExpiresAt Срок действия
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Id ID приглашения
+	Id int `json:"id"`
+
+	// MaxUses Лимит использований
+	MaxUses *int `json:"max_uses,omitempty"`
+
+	// Role Роль участника в мероприятии (заполняется в списке участников мероприятия)
+	Role EventRole `json:"role"`
+
+	// Token Код приглашения для ссылки
+	Token string `json:"token"`
+
+	// Uses Сколько раз приглашением уже воспользовались
+	Uses int `json:"uses"`
+}
+
+// EventInviteListResponse defines model for EventInviteListResponse.
+type EventInviteListResponse struct {
+	Invites *[]EventInviteDTO `json:"invites,omitempty"`
+}
+
+// EventInviteRequest defines model for EventInviteRequest.
+type EventInviteRequest struct {
+	// ExpiresAt Срок действия приглашения (бессрочно, если не задан)
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// MaxUses Сколько раз можно воспользоваться приглашением (без ограничения, если не задано)
+	MaxUses *int `json:"max_uses,omitempty"`
+
+	// Role Роль участника в мероприятии (заполняется в списке участников мероприятия)
+	Role *EventRole `json:"role,omitempty"`
+}
+
 // EventListResponse defines model for EventListResponse.
 type EventListResponse struct {
 	Events *[]EventResponse `json:"events,omitempty"`
@@ -162,22 +443,34 @@ type EventRequest struct {
 	// CategoryId ID категории
 	CategoryId *int `json:"category_id,omitempty"`
 
+	// Currency Базовая валюта мероприятия (ISO 4217, по умолчанию RUB). Задается только при создании
+	Currency *string `json:"currency,omitempty"`
+
 	// Description Описание мероприятия
 	Description *string          `json:"description,omitempty"`
 	Members     *EventMembersDTO `json:"members,omitempty"`
 
 	// Name Название мероприятия
 	Name string `json:"name"`
+
+	// OptimizationAlgorithm Алгоритм оптимизации долгов (по умолчанию dinic)
+	OptimizationAlgorithm *OptimizationAlgorithm `json:"optimization_algorithm,omitempty"`
 }
 
 // EventResponse defines model for EventResponse.
 type EventResponse struct {
-	// Balance Баланс мероприятия
-	Balance *int `json:"balance,omitempty"`
+	// Balance Баланс мероприятия в базовой валюте
+	Balance *money.Money `json:"balance,omitempty"`
 
 	// CategoryId ID категории
 	CategoryId *int `json:"category_id,omitempty"`
 
+	// Currency Базовая валюта мероприятия
+	Currency *string `json:"currency,omitempty"`
+
+	// DeletedAt Время удаления в корзину; только для удаленных мероприятий
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
 	// Description Описание мероприятия
 	Description *string `json:"description,omitempty"`
 
@@ -187,8 +480,76 @@ type EventResponse struct {
 	// Name Название мероприятия
 	Name *string `json:"name,omitempty"`
 
+	// OptimizationAlgorithm Алгоритм оптимизации долгов (по умолчанию dinic)
+	OptimizationAlgorithm *OptimizationAlgorithm `json:"optimization_algorithm,omitempty"`
+
 	// PhotoId UUID фото
 	PhotoId *string `json:"photo_id,omitempty"`
+
+	// Status Статус мероприятия: active - ведется, settling - идут расчеты (транзакции заморожены),
+	// closed - закрыто (план переводов зафиксирован), archived - в архиве
+	Status *EventStatus `json:"status,omitempty"`
+}
+
+// EventRole Роль участника в мероприятии (заполняется в списке участников мероприятия)
+type EventRole string
+
+// EventRoleRequest defines model for EventRoleRequest.
+type EventRoleRequest struct {
+	// Role Роль участника в мероприятии (заполняется в списке участников мероприятия)
+	Role EventRole `json:"role"`
+}
+
+// EventStatus Статус мероприятия: active - ведется, settling - идут расчеты (транзакции заморожены),
+// closed - закрыто (план переводов зафиксирован), archived - в архиве
+type EventStatus string
+
+// EventTrashResponse defines model for EventTrashResponse.
+type EventTrashResponse struct {
+	// Transactions Удаленные транзакции; окончательно удаляются после срока хранения
+	Transactions []TransactionResponse `json:"transactions"`
+}
+
+// ExchangeRateDTO defines model for ExchangeRateDTO.
+type ExchangeRateDTO struct {
+	// CurrencyFrom Исходная валюта
+	CurrencyFrom string `json:"currency_from"`
+
+	// CurrencyTo Целевая валюта
+	CurrencyTo string `json:"currency_to"`
+
+	// Rate Стоимость единицы исходной валюты в целевой валюте
+	Rate float64 `json:"rate"`
+
+	// UpdatedAt Время обновления курса
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ExchangeRateRequest defines model for ExchangeRateRequest.
+type ExchangeRateRequest struct {
+	// CurrencyFrom Исходная валюта
+	CurrencyFrom string `json:"currency_from"`
+
+	// CurrencyTo Целевая валюта
+	CurrencyTo string `json:"currency_to"`
+
+	// Rate Стоимость единицы исходной валюты в целевой валюте
+	Rate float64 `json:"rate"`
+}
+
+// ExportFormat Формат выгрузки (по умолчанию csv)
+type ExportFormat string
+
+// FieldChange defines model for FieldChange.
+type FieldChange struct {
+	// Field Поле снимка транзакции
+	Field string `json:"field"`
+
+	// New Значение после изменения; отсутствует, если поле очищено
+	New interface{} `json:"new,omitempty"`
+
+	// Old Значение до изменения; отсутствует, если поле не было заполнено
+	Old interface{} `json:"old,omitempty"`
 }
 
 // IconDTO defines model for IconDTO.
@@ -215,10 +576,137 @@ type IconRequest struct {
 	Name string `json:"name"`
 }
 
+// ImportFormat Формат файла (если не указан, определяется по заголовку)
+type ImportFormat string
+
+// ImportReportResponse defines model for ImportReportResponse.
+type ImportReportResponse struct {
+	// CreatedUsers Имена dummy-пользователей для несопоставленных имен
+	CreatedUsers []string `json:"created_users"`
+
+	// DryRun Пробный запуск без сохранения
+	DryRun bool `json:"dry_run"`
+
+	// Failed Число строк с ошибками
+	Failed int `json:"failed"`
+
+	// Format Формат файла (если не указан, определяется по заголовку)
+	Format ImportFormat `json:"format"`
+
+	// Imported Число созданных транзакций (при пробном запуске - которые будут созданы)
+	Imported int            `json:"imported"`
+	Rows     []ImportRowDTO `json:"rows"`
+
+	// Skipped Число пропущенных строк
+	Skipped int `json:"skipped"`
+}
+
+// ImportRowDTO defines model for ImportRowDTO.
+type ImportRowDTO struct {
+	// Amount Сумма в валюте транзакции
+	Amount money.Money `json:"amount"`
+
+	// Currency Валюта транзакции
+	Currency *string `json:"currency,omitempty"`
+
+	// Datetime Дата транзакции
+	Datetime *time.Time `json:"datetime,omitempty"`
+
+	// Error Причина ошибки
+	Error *string `json:"error,omitempty"`
+
+	// Line Номер первой строки транзакции в файле
+	Line int `json:"line"`
+
+	// Name Название транзакции
+	Name string `json:"name"`
+
+	// Status Результат импорта строки
+	Status ImportRowDTOStatus `json:"status"`
+
+	// TransactionId ID созданной транзакции
+	TransactionId *int `json:"transaction_id,omitempty"`
+
+	// Warning Предупреждение или причина пропуска
+	Warning *string `json:"warning,omitempty"`
+}
+
+// ImportRowDTOStatus Результат импорта строки
+type ImportRowDTOStatus string
+
+// ItemDTO defines model for ItemDTO.
+type ItemDTO struct {
+	// Consumers Внутренние ID пользователей, делящих позицию
+	Consumers *[]int64 `json:"consumers,omitempty"`
+
+	// Id ID позиции
+	Id *int `json:"id,omitempty"`
+
+	// Name Название позиции
+	Name *string `json:"name,omitempty"`
+
+	// Price Цена за единицу
+	Price *money.Money `json:"price,omitempty"`
+
+	// Quantity Количество
+	Quantity *float64 `json:"quantity,omitempty"`
+
+	// Total Стоимость позиции
+	Total *money.Money `json:"total,omitempty"`
+}
+
+// ItemListResponse defines model for ItemListResponse.
+type ItemListResponse struct {
+	Items *[]ItemDTO `json:"items,omitempty"`
+}
+
+// ItemRequest defines model for ItemRequest.
+type ItemRequest struct {
+	// Consumers Внутренние ID пользователей, делящих позицию поровну
+	Consumers []int64 `json:"consumers"`
+
+	// Name Название позиции
+	Name string `json:"name"`
+
+	// Price Цена за единицу
+	Price money.Money `json:"price"`
+
+	// Quantity Количество (по умолчанию 1)
+	Quantity *float64 `json:"quantity,omitempty"`
+}
+
+// JoinEventResponse defines model for JoinEventResponse.
+type JoinEventResponse struct {
+	// EventId ID мероприятия
+	EventId int64 `json:"event_id"`
+
+	// Role Роль участника в мероприятии (заполняется в списке участников мероприятия)
+	Role EventRole `json:"role"`
+
+	// UserId Внутренний ID присоединившегося пользователя
+	UserId int64 `json:"user_id"`
+}
+
+// OptimizationAlgorithm Алгоритм оптимизации долгов (по умолчанию dinic)
+type OptimizationAlgorithm string
+
+// OptimizationResultResponse defines model for OptimizationResultResponse.
+type OptimizationResultResponse struct {
+	// Algorithm Алгоритм оптимизации долгов (по умолчанию dinic)
+	Algorithm      *OptimizationAlgorithm `json:"algorithm,omitempty"`
+	OptimizedDebts *[]OptimizedDebtDTO    `json:"optimized_debts,omitempty"`
+
+	// OriginalTransfers Количество переводов до оптимизации
+	OriginalTransfers *int `json:"original_transfers,omitempty"`
+
+	// RemovedTransfers Количество переводов, убранных оптимизацией
+	RemovedTransfers *int `json:"removed_transfers,omitempty"`
+}
+
 // OptimizedDebtDTO defines model for OptimizedDebtDTO.
 type OptimizedDebtDTO struct {
-	// Amount Размер долга
-	Amount *float64 `json:"amount,omitempty"`
+	// Amount Размер долга в базовой валюте мероприятия
+	Amount *money.Money `json:"amount,omitempty"`
 
 	// EventId ID мероприятия
 	EventId *int64 `json:"event_id,omitempty"`
@@ -229,15 +717,144 @@ type OptimizedDebtDTO struct {
 	// Id ID оптимизированного долга
 	Id *int `json:"id,omitempty"`
 
+	// SettledAmount Погашенная часть долга
+	SettledAmount *money.Money `json:"settled_amount,omitempty"`
+
+	// Status Статус погашения долга
+	Status *OptimizedDebtDTOStatus `json:"status,omitempty"`
+
 	// ToUserId Внутренний ID кредитора
 	ToUserId *int64 `json:"to_user_id,omitempty"`
 }
 
+// OptimizedDebtDTOStatus Статус погашения долга
+type OptimizedDebtDTOStatus string
+
 // OptimizedDebtListResponse defines model for OptimizedDebtListResponse.
 type OptimizedDebtListResponse struct {
 	OptimizedDebts *[]OptimizedDebtDTO `json:"optimized_debts,omitempty"`
 }
 
+// PayerDTO defines model for PayerDTO.
+type PayerDTO struct {
+	// Amount Сумма, оплаченная пользователем
+	Amount money.Money `json:"amount"`
+
+	// UserId Внутренний ID плательщика
+	UserId int64 `json:"user_id"`
+}
+
+// RecurrenceFrequency Частота повторения. Для monthly в коротких месяцах используется последний день месяца
+type RecurrenceFrequency string
+
+// RecurringTransactionDTO defines model for RecurringTransactionDTO.
+type RecurringTransactionDTO struct {
+	// CreatedAt Время создания
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CreatedBy Внутренний ID создавшего участника
+	CreatedBy *int64 `json:"created_by,omitempty"`
+
+	// EndDate Последний момент, на который может выпасть повтор
+	EndDate *time.Time `json:"end_date,omitempty"`
+
+	// EventId ID мероприятия
+	EventId int64 `json:"event_id"`
+
+	// Frequency Частота повторения. Для monthly в коротких месяцах используется последний день месяца
+	Frequency RecurrenceFrequency `json:"frequency"`
+
+	// Id ID регулярной транзакции
+	Id int `json:"id"`
+
+	// Interval Повторять каждые interval периодов
+	Interval int `json:"interval"`
+
+	// NextRunAt Время следующего повтора (отсутствует, если повторы закончились)
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+
+	// Paused Регулярная транзакция приостановлена
+	Paused bool `json:"paused"`
+
+	// StartDate Время первого повтора
+	StartDate   time.Time          `json:"start_date"`
+	Transaction TransactionRequest `json:"transaction"`
+}
+
+// RecurringTransactionListResponse defines model for RecurringTransactionListResponse.
+type RecurringTransactionListResponse struct {
+	Recurring *[]RecurringTransactionDTO `json:"recurring,omitempty"`
+}
+
+// RecurringTransactionRequest defines model for RecurringTransactionRequest.
+type RecurringTransactionRequest struct {
+	// EndDate Последний момент, на который может выпасть повтор (бессрочно, если не задан)
+	EndDate *time.Time `json:"end_date,omitempty"`
+
+	// Frequency Частота повторения. Для monthly в коротких месяцах используется последний день месяца
+	Frequency RecurrenceFrequency `json:"frequency"`
+
+	// Interval Повторять каждые interval периодов (по умолчанию 1)
+	Interval *int `json:"interval,omitempty"`
+
+	// StartDate Время первого повтора
+	StartDate   time.Time          `json:"start_date"`
+	Transaction TransactionRequest `json:"transaction"`
+}
+
+// RevisionAction Изменение транзакции, после которого записана ревизия
+type RevisionAction string
+
+// SettlementDTO defines model for SettlementDTO.
+type SettlementDTO struct {
+	// Amount Сумма погашения в базовой валюте мероприятия
+	Amount money.Money `json:"amount"`
+
+	// Comment Комментарий
+	Comment *string `json:"comment,omitempty"`
+
+	// CreatedAt Время погашения
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// EventId ID мероприятия
+	EventId int64 `json:"event_id"`
+
+	// FromUserId Внутренний ID пользователя, вернувшего деньги
+	FromUserId int64 `json:"from_user_id"`
+
+	// Id ID погашения
+	Id int `json:"id"`
+
+	// OptimizedDebtId ID погашенного оптимизированного долга
+	OptimizedDebtId *int `json:"optimized_debt_id,omitempty"`
+
+	// ToUserId Внутренний ID пользователя, получившего деньги
+	ToUserId int64 `json:"to_user_id"`
+}
+
+// SettlementListResponse defines model for SettlementListResponse.
+type SettlementListResponse struct {
+	Settlements *[]SettlementDTO `json:"settlements,omitempty"`
+}
+
+// SettlementRequest defines model for SettlementRequest.
+type SettlementRequest struct {
+	// Amount Сумма погашения в базовой валюте мероприятия
+	Amount money.Money `json:"amount"`
+
+	// Comment Комментарий
+	Comment *string `json:"comment,omitempty"`
+
+	// FromUserId Внутренний ID пользователя, вернувшего деньги
+	FromUserId int64 `json:"from_user_id"`
+
+	// OptimizedDebtId ID погашаемого оптимизированного долга
+	OptimizedDebtId *int `json:"optimized_debt_id,omitempty"`
+
+	// ToUserId Внутренний ID пользователя, получившего деньги
+	ToUserId int64 `json:"to_user_id"`
+}
+
 // ShareDTO defines model for ShareDTO.
 type ShareDTO struct {
 	// Id ID доли
@@ -250,7 +867,22 @@ type ShareDTO struct {
 	UserId *int64 `json:"user_id,omitempty"`
 
 	// Value Размер доли
-	Value *float64 `json:"value,omitempty"`
+	Value *money.Money `json:"value,omitempty"`
+}
+
+// SortOrder Направление сортировки (по умолчанию desc)
+type SortOrder string
+
+// SpendingPointDTO defines model for SpendingPointDTO.
+type SpendingPointDTO struct {
+	// Amount Сумма расходов за период
+	Amount money.Money `json:"amount"`
+
+	// PeriodStart Начало периода
+	PeriodStart time.Time `json:"period_start"`
+
+	// TransactionsCount Число транзакций за период
+	TransactionsCount int `json:"transactions_count"`
 }
 
 // SuccessResponse defines model for SuccessResponse.
@@ -314,23 +946,95 @@ type TaskResponse struct {
 	Task *TaskDTO `json:"task,omitempty"`
 }
 
+// TopExpenseDTO defines model for TopExpenseDTO.
+type TopExpenseDTO struct {
+	// Amount Сумма в базовой валюте мероприятия
+	Amount money.Money `json:"amount"`
+
+	// CategoryId ID категории
+	CategoryId *int `json:"category_id,omitempty"`
+
+	// Datetime Дата и время транзакции
+	Datetime time.Time `json:"datetime"`
+
+	// Name Название транзакции
+	Name string `json:"name"`
+
+	// PayerId Внутренний ID плательщика
+	PayerId *int64 `json:"payer_id,omitempty"`
+
+	// TransactionId ID транзакции
+	TransactionId int `json:"transaction_id"`
+}
+
+// TransactionAttachment defines model for TransactionAttachment.
+type TransactionAttachment struct {
+	// CreatedAt Время прикрепления
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy Внутренний ID пользователя, прикрепившего файл
+	CreatedBy *int64 `json:"created_by,omitempty"`
+
+	// ExpiresAt Время истечения временной ссылки
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Id ID вложения
+	Id int `json:"id"`
+
+	// ObjectId ID файла в ff-files
+	ObjectId openapi_types.UUID `json:"object_id"`
+
+	// Url Временная ссылка на файл. Заполняется только при прикреплении файла и в запросе ссылки на вложение;
+	// отсутствует, если ff-files недоступен
+	Url *string `json:"url,omitempty"`
+}
+
+// TransactionHistoryResponse defines model for TransactionHistoryResponse.
+type TransactionHistoryResponse struct {
+	// Revisions Ревизии транзакции, начиная с последней
+	Revisions []TransactionRevision `json:"revisions"`
+}
+
 // TransactionListResponse defines model for TransactionListResponse.
 type TransactionListResponse struct {
+	// NextCursor Курсор следующей страницы; отсутствует на последней странице
+	NextCursor   *string                `json:"next_cursor,omitempty"`
 	Transactions *[]TransactionResponse `json:"transactions,omitempty"`
 }
 
 // TransactionRequest defines model for TransactionRequest.
 type TransactionRequest struct {
 	// Amount Общая сумма транзакции
-	Amount float64 `json:"amount"`
+	Amount money.Money `json:"amount"`
+
+	// Amounts Суммы участников (для типа amount)
+	Amounts *map[string]money.Money `json:"amounts,omitempty"`
+
+	// Charges Общие надбавки чека — налог, чаевые, сервисный сбор (для типа items)
+	Charges *[]ChargeDTO `json:"charges,omitempty"`
+
+	// Currency Валюта транзакции (ISO 4217). По умолчанию базовая валюта мероприятия
+	Currency *string `json:"currency,omitempty"`
+
+	// ExchangeRate Курс пересчета в базовую валюту мероприятия. Если не указан, берется из сохраненных курсов
+	ExchangeRate *float64 `json:"exchange_rate,omitempty"`
+
+	// ExcludePayer Для типа equal — не включать плательщика в раздел суммы
+	ExcludePayer *bool `json:"exclude_payer,omitempty"`
 
 	// FromUser Внутренний ID пользователя, который заплатил
 	FromUser int64 `json:"from_user"`
 
+	// Items Позиции чека (для типа items)
+	Items *[]ItemRequest `json:"items,omitempty"`
+
 	// Name Название транзакции
 	Name string `json:"name"`
 
-	// Portion Распределение долей
+	// Payers Плательщики и оплаченные ими суммы. Если не указаны, всю сумму оплатил from_user
+	Payers *[]PayerDTO `json:"payers,omitempty"`
+
+	// Portion Проценты или единицы долей участников (для типов percent и units)
 	Portion *map[string]float64 `json:"portion,omitempty"`
 
 	// TransactionCategoryId ID категории транзакции
@@ -348,8 +1052,17 @@ type TransactionRequestType string
 
 // TransactionResponse defines model for TransactionResponse.
 type TransactionResponse struct {
-	// Amount Сумма транзакции
-	Amount *float64 `json:"amount,omitempty"`
+	// Amount Сумма транзакции в валюте транзакции
+	Amount *money.Money `json:"amount,omitempty"`
+
+	// Attachments Прикрепленные файлы, например фото чеков
+	Attachments *[]TransactionAttachment `json:"attachments,omitempty"`
+
+	// Charges Общие надбавки чека
+	Charges *[]ChargeDTO `json:"charges,omitempty"`
+
+	// Currency Валюта транзакции
+	Currency *string `json:"currency,omitempty"`
 
 	// Datetime Дата и время транзакции
 	Datetime *time.Time `json:"datetime,omitempty"`
@@ -357,18 +1070,30 @@ type TransactionResponse struct {
 	// Debts Долги
 	Debts *[]DebtDTO `json:"debts,omitempty"`
 
+	// DeletedAt Время удаления в корзину; только для транзакций из корзины
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
 	// EventId ID мероприятия
 	EventId *int64 `json:"event_id,omitempty"`
 
+	// ExchangeRate Курс пересчета в базовую валюту мероприятия на момент ввода
+	ExchangeRate *float64 `json:"exchange_rate,omitempty"`
+
 	// FromUser Внутренний ID плательщика
 	FromUser *int64 `json:"from_user,omitempty"`
 
 	// Id ID транзакции
 	Id *int `json:"id,omitempty"`
 
+	// Items Позиции чека
+	Items *[]ItemDTO `json:"items,omitempty"`
+
 	// Name Название транзакции
 	Name *string `json:"name,omitempty"`
 
+	// Payers Плательщики и оплаченные ими суммы
+	Payers *[]PayerDTO `json:"payers,omitempty"`
+
 	// Shares Доли пользователей
 	Shares *[]ShareDTO `json:"shares,omitempty"`
 
@@ -379,6 +1104,40 @@ type TransactionResponse struct {
 	Type *string `json:"type,omitempty"`
 }
 
+// TransactionRevision defines model for TransactionRevision.
+type TransactionRevision struct {
+	// Action Изменение транзакции, после которого записана ревизия
+	Action RevisionAction `json:"action"`
+
+	// ActorId Внутренний ID автора изменения; отсутствует для изменений, сделанных сервисом
+	ActorId *int64 `json:"actor_id,omitempty"`
+
+	// CreatedAt Время изменения
+	CreatedAt time.Time `json:"created_at"`
+
+	// Diff Поля, измененные относительно предыдущей ревизии
+	Diff []FieldChange `json:"diff"`
+
+	// Id ID ревизии
+	Id int `json:"id"`
+
+	// RevertedFrom ID ревизии, к которой откатили транзакцию; только для action = reverted
+	RevertedFrom *int                `json:"reverted_from,omitempty"`
+	Snapshot     TransactionResponse `json:"snapshot"`
+
+	// TransactionId ID транзакции
+	TransactionId int `json:"transaction_id"`
+}
+
+// TransactionSort Поле сортировки транзакций (по умолчанию date)
+type TransactionSort string
+
+// TransferOwnershipRequest defines model for TransferOwnershipRequest.
+type TransferOwnershipRequest struct {
+	// UserId Внутренний ID участника, который станет владельцем
+	UserId int64 `json:"user_id"`
+}
+
 // UserListResponse defines model for UserListResponse.
 type UserListResponse struct {
 	Users *[]UserProfileDTO `json:"users,omitempty"`
@@ -398,10 +1157,28 @@ type UserProfileDTO struct {
 	// Photo UUID фото пользователя
 	Photo *string `json:"photo,omitempty"`
 
+	// Role Роль участника в мероприятии (заполняется в списке участников мероприятия)
+	Role *EventRole `json:"role,omitempty"`
+
 	// UserId Внутренний ID пользователя
 	UserId *int64 `json:"user_id,omitempty"`
 }
 
+// UserSpendingDTO defines model for UserSpendingDTO.
+type UserSpendingDTO struct {
+	// Consumed Сумма, приходящаяся на долю участника
+	Consumed money.Money `json:"consumed"`
+
+	// Net Разница между оплаченной и потребленной суммой
+	Net money.Money `json:"net"`
+
+	// Paid Сумма, оплаченная участником
+	Paid money.Money `json:"paid"`
+
+	// UserId Внутренний ID участника
+	UserId int64 `json:"user_id"`
+}
+
 // GetCategoriesParams defines parameters for GetCategories.
 type GetCategoriesParams struct {
 	// CategoryType Тип категории
@@ -414,6 +1191,99 @@ type GetCategoryByIDParams struct {
 	CategoryType CategoryType `form:"category_type" json:"category_type"`
 }
 
+// GetEventsParams defines parameters for GetEvents.
+type GetEventsParams struct {
+	// IncludeArchived Включить в список архивные мероприятия
+	IncludeArchived *bool `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+}
+
+// GetEventAnalyticsParams defines parameters for GetEventAnalytics.
+type GetEventAnalyticsParams struct {
+	// From Начало периода (включительно)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно)
+	To       *time.Time         `form:"to,omitempty" json:"to,omitempty"`
+	Interval *AnalyticsInterval `form:"interval,omitempty" json:"interval,omitempty"`
+
+	// Top Число крупнейших расходов
+	Top *int `form:"top,omitempty" json:"top,omitempty"`
+}
+
+// ExportEventParams defines parameters for ExportEvent.
+type ExportEventParams struct {
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetActivityFeedParams defines parameters for GetActivityFeed.
+type GetActivityFeedParams struct {
+	// Type Типы активностей; можно указать несколько
+	Type *[]ActivityType `form:"type,omitempty" json:"type,omitempty"`
+
+	// UserId Внутренний ID автора активности
+	UserId *int64 `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// From Начало периода (включительно)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно)
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Cursor Курсор следующей страницы из next_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Размер страницы
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ImportTransactionsParams defines parameters for ImportTransactions.
+type ImportTransactionsParams struct {
+	Format *ImportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// DryRun Только проверить файл, ничего не сохраняя
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// OptimizeDebtsParams defines parameters for OptimizeDebts.
+type OptimizeDebtsParams struct {
+	Algorithm *OptimizationAlgorithm `form:"algorithm,omitempty" json:"algorithm,omitempty"`
+}
+
+// GetTransactionsByEventIDParams defines parameters for GetTransactionsByEventID.
+type GetTransactionsByEventIDParams struct {
+	// From Начало периода (включительно)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно)
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// CategoryId ID категории транзакции
+	CategoryId *int `form:"category_id,omitempty" json:"category_id,omitempty"`
+
+	// PayerId Внутренний ID плательщика
+	PayerId *int64 `form:"payer_id,omitempty" json:"payer_id,omitempty"`
+
+	// ParticipantId Внутренний ID участника, на которого приходится доля
+	ParticipantId *int64 `form:"participant_id,omitempty" json:"participant_id,omitempty"`
+
+	// AmountMin Минимальная сумма (включительно)
+	AmountMin *float64 `form:"amount_min,omitempty" json:"amount_min,omitempty"`
+
+	// AmountMax Максимальная сумма (включительно)
+	AmountMax *float64 `form:"amount_max,omitempty" json:"amount_max,omitempty"`
+
+	// Q Подстрока названия транзакции без учета регистра
+	Q     *string          `form:"q,omitempty" json:"q,omitempty"`
+	Sort  *TransactionSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Order *SortOrder       `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор следующей страницы из next_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Размер страницы
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // CreateCategoryParams defines parameters for CreateCategory.
 type CreateCategoryParams struct {
 	// CategoryType Тип категории
@@ -450,6 +1320,21 @@ type CreateActivityJSONRequestBody = ActivityRequest
 // UpdateActivityJSONRequestBody defines body for UpdateActivity for application/json ContentType.
 type UpdateActivityJSONRequestBody = ActivityRequest
 
+// CreateEventInviteJSONRequestBody defines body for CreateEventInvite for application/json ContentType.
+type CreateEventInviteJSONRequestBody = EventInviteRequest
+
+// TransferEventOwnershipJSONRequestBody defines body for TransferEventOwnership for application/json ContentType.
+type TransferEventOwnershipJSONRequestBody = TransferOwnershipRequest
+
+// CreateRecurringTransactionJSONRequestBody defines body for CreateRecurringTransaction for application/json ContentType.
+type CreateRecurringTransactionJSONRequestBody = RecurringTransactionRequest
+
+// UpdateRecurringTransactionJSONRequestBody defines body for UpdateRecurringTransaction for application/json ContentType.
+type UpdateRecurringTransactionJSONRequestBody = RecurringTransactionRequest
+
+// CreateSettlementJSONRequestBody defines body for CreateSettlement for application/json ContentType.
+type CreateSettlementJSONRequestBody = SettlementRequest
+
 // CreateTaskJSONRequestBody defines body for CreateTask for application/json ContentType.
 type CreateTaskJSONRequestBody = TaskRequest
 
@@ -462,18 +1347,33 @@ type CreateTransactionJSONRequestBody = TransactionRequest
 // UpdateTransactionJSONRequestBody defines body for UpdateTransaction for application/json ContentType.
 type UpdateTransactionJSONRequestBody = TransactionRequest
 
+// AttachTransactionFileJSONRequestBody defines body for AttachTransactionFile for application/json ContentType.
+type AttachTransactionFileJSONRequestBody = AttachmentRequest
+
+// CreateTransactionItemJSONRequestBody defines body for CreateTransactionItem for application/json ContentType.
+type CreateTransactionItemJSONRequestBody = ItemRequest
+
+// UpdateTransactionItemJSONRequestBody defines body for UpdateTransactionItem for application/json ContentType.
+type UpdateTransactionItemJSONRequestBody = ItemRequest
+
 // AddUsersToEventJSONRequestBody defines body for AddUsersToEvent for application/json ContentType.
 type AddUsersToEventJSONRequestBody = AddUsersRequest
 
 // CreateDummyUserJSONRequestBody defines body for CreateDummyUser for application/json ContentType.
 type CreateDummyUserJSONRequestBody = DummyUserRequest
 
+// UpdateEventUserRoleJSONRequestBody defines body for UpdateEventUserRole for application/json ContentType.
+type UpdateEventUserRoleJSONRequestBody = EventRoleRequest
+
 // CreateCategoryJSONRequestBody defines body for CreateCategory for application/json ContentType.
 type CreateCategoryJSONRequestBody = CategoryRequest
 
 // UpdateCategoryJSONRequestBody defines body for UpdateCategory for application/json ContentType.
 type UpdateCategoryJSONRequestBody = CategoryRequest
 
+// SetExchangeRateJSONRequestBody defines body for SetExchangeRate for application/json ContentType.
+type SetExchangeRateJSONRequestBody = ExchangeRateRequest
+
 // CreateIconJSONRequestBody defines body for CreateIcon for application/json ContentType.
 type CreateIconJSONRequestBody = IconRequest
 
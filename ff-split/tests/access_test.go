@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// AccessSuite представляет suite для тестов проверки участия в мероприятии
+type AccessSuite struct {
+	BaseSuite
+}
+
+// TestAccessSuite запускает все тесты в AccessSuite
+func TestAccessSuite(t *testing.T) {
+	suite.Run(t, new(AccessSuite))
+}
+
+// prepareForeignEvent создает мероприятие, в котором состоит только второй пользователь,
+// и транзакцию в нем. Запросы выполняются от имени первого пользователя.
+func (s *AccessSuite) prepareForeignEvent() int64 {
+	icon := s.createTestIcon(TestIconID1, "Travel", TestRequestID)
+	category := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
+
+	s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	err := s.GetDB().Exec(`
+		INSERT INTO transactions (id, event_id, name, total_paid, payer_id, split_type)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, TestTransactionID1, event.ID, "Чужая транзакция", TestAmount1, user2.ID, 0).Error
+	s.NoError(err)
+
+	return event.ID
+}
+
+// TestGetEventByID_NotMember тестирует чтение чужого мероприятия
+func (s *AccessSuite) TestGetEventByID_NotMember() {
+	// Arrange - подготовка
+	eventID := s.prepareForeignEvent()
+
+	// Act - действие
+	resp, err := s.APIClient.GetEventByIDWithResponse(s.Ctx, eventID)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+	s.Require().NotNil(resp.JSON403, "должен быть возвращен объект ошибки")
+	s.Equal("forbidden", resp.JSON403.Error.Code)
+}
+
+// TestGetTransactionsByEventID_NotMember тестирует чтение транзакций чужого мероприятия
+func (s *AccessSuite) TestGetTransactionsByEventID_NotMember() {
+	// Arrange - подготовка
+	eventID := s.prepareForeignEvent()
+
+	// Act - действие
+	resp, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, eventID, nil)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+}
+
+// TestDeleteEvent_NotMember тестирует удаление чужого мероприятия
+func (s *AccessSuite) TestDeleteEvent_NotMember() {
+	// Arrange - подготовка
+	eventID := s.prepareForeignEvent()
+
+	// Act - действие
+	resp, err := s.APIClient.DeleteEventWithResponse(s.Ctx, eventID)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+
+	var count int64
+	err = s.GetDB().Table("events").Where("id = ?", eventID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(1), count, "мероприятие не должно быть удалено")
+}
+
+// TestDeleteTransaction_NotMember тестирует удаление транзакции чужого мероприятия
+func (s *AccessSuite) TestDeleteTransaction_NotMember() {
+	// Arrange - подготовка
+	eventID := s.prepareForeignEvent()
+
+	// Act - действие
+	resp, err := s.APIClient.DeleteTransactionWithResponse(s.Ctx, eventID, int(TestTransactionID1))
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+
+	var count int64
+	err = s.GetDB().Table("transactions").Where("id = ?", TestTransactionID1).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(1), count, "транзакция не должна быть удалена")
+}
+
+// TestAddUsersToEvent_NotMember тестирует попытку постороннего добавить себя в мероприятие
+func (s *AccessSuite) TestAddUsersToEvent_NotMember() {
+	// Arrange - подготовка
+	eventID := s.prepareForeignEvent()
+	reqBody := api.AddUsersToEventJSONRequestBody{
+		UserIds: []int64{TestUserID1},
+	}
+
+	// Act - действие
+	resp, err := s.APIClient.AddUsersToEventWithResponse(s.Ctx, eventID, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+
+	var count int64
+	err = s.GetDB().Table("user_event").Where("event_id = ?", eventID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(1), count, "состав мероприятия не должен измениться")
+}
+
+// TestMember_Allowed тестирует, что участник получает доступ к мероприятию
+func (s *AccessSuite) TestMember_Allowed() {
+	// Arrange - подготовка
+	eventID := s.prepareForeignEvent()
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	resp, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, eventID, nil)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(resp.JSON200)
+	s.Require().Len(*resp.JSON200.Transactions, 1)
+}
+
+// TestTransactionFromAnotherEvent тестирует обращение к транзакции чужого мероприятия
+// через мероприятие, в котором пользователь состоит
+func (s *AccessSuite) TestTransactionFromAnotherEvent() {
+	// Arrange - подготовка
+	s.prepareForeignEvent()
+	ownEvent := s.createTestEvent(TestEventID2, TestEventName2, "Свое мероприятие", nil)
+	s.addUserToEvent(TestUserID1, ownEvent.ID)
+
+	// Act - действие
+	getResp, err := s.APIClient.GetTransactionByIDWithResponse(s.Ctx, ownEvent.ID, int(TestTransactionID1))
+	s.Require().NoError(err, "запрос должен выполниться")
+	deleteResp, err := s.APIClient.DeleteTransactionWithResponse(s.Ctx, ownEvent.ID, int(TestTransactionID1))
+	s.Require().NoError(err, "запрос должен выполниться")
+
+	// Assert - проверка
+	s.Equal(404, getResp.StatusCode(), "чужая транзакция не должна быть найдена")
+	s.Equal(404, deleteResp.StatusCode(), "чужая транзакция не должна быть найдена")
+
+	var count int64
+	err = s.GetDB().Table("transactions").Where("id = ?", TestTransactionID1).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(1), count, "транзакция не должна быть удалена")
+}
+
+// TestEventNotFound тестирует обращение к несуществующему мероприятию
+func (s *AccessSuite) TestEventNotFound() {
+	// Arrange - подготовка
+	s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+
+	// Act - действие
+	resp, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, 999, nil)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(404, resp.StatusCode(), "должен быть статус 404")
+}
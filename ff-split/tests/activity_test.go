@@ -3,6 +3,7 @@ package tests
 import (
 	"testing"
 
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
 	"github.com/stretchr/testify/suite"
 )
@@ -122,6 +123,9 @@ func (s *ActivitySuite) TestGetActivityByID_NotFound() {
 	category := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
 	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
 
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+
 	nonExistentID := 999
 
 	// Act - действие
@@ -213,3 +217,132 @@ func (s *ActivitySuite) TestDeleteActivity_Success() {
 	s.Equal(int64(0), count, "активность должна быть удалена из БД")
 }
 
+
+// TestActivityFeed_RecordsTransactionChanges тестирует автоматическую запись изменений транзакций в ленту
+func (s *ActivitySuite) TestActivityFeed_RecordsTransactionChanges() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Activity", TestRequestID)
+	category := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	reqBody := api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин",
+		Amount:   money.FromFloat(TestAmount1),
+		FromUser: user1.ID,
+		Type:     api.TransactionRequestType("percent"),
+		Users:    []int64{user1.ID, user2.ID},
+	}
+	createResp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, reqBody)
+	s.Require().NoError(err)
+	s.Require().Equal(201, createResp.StatusCode())
+	transactionID := *createResp.JSON201.Id
+
+	reqBody.Name = "Ужин в ресторане"
+	updateResp, err := s.APIClient.UpdateTransactionWithResponse(s.Ctx, event.ID, transactionID, reqBody)
+	s.Require().NoError(err)
+	s.Require().Equal(200, updateResp.StatusCode())
+
+	deleteResp, err := s.APIClient.DeleteTransactionWithResponse(s.Ctx, event.ID, transactionID)
+	s.Require().NoError(err)
+	s.Require().Equal(200, deleteResp.StatusCode())
+
+	// Act - действие
+	resp, err := s.APIClient.GetActivityFeedWithResponse(s.Ctx, event.ID, &api.GetActivityFeedParams{})
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(resp.JSON200)
+	s.Require().Nil(resp.JSON200.NextCursor, "все активности помещаются на одну страницу")
+
+	activities := resp.JSON200.Activities
+	s.Require().Len(activities, 3, "должны быть записаны создание, изменение и удаление транзакции")
+	s.Equal(api.TransactionDeleted, *activities[0].Type)
+	s.Equal(api.TransactionUpdated, *activities[1].Type)
+	s.Equal(api.TransactionCreated, *activities[2].Type)
+
+	for _, activity := range activities {
+		s.Require().NotNil(activity.UserId, "автор активности должен быть указан")
+		s.Equal(user1.ID, *activity.UserId)
+		s.Require().NotNil(activity.Payload)
+		s.Equal(float64(transactionID), (*activity.Payload)["transaction_id"])
+	}
+	s.Equal("Ужин в ресторане", (*activities[1].Payload)["name"])
+}
+
+// TestActivityFeed_FilterAndPagination тестирует фильтрацию и постраничную выдачу ленты
+func (s *ActivitySuite) TestActivityFeed_FilterAndPagination() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Activity", TestRequestID)
+	category := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	activityTypes := []string{"task_created", "member_joined", "task_updated", "task_deleted", "member_left"}
+	for i, activityType := range activityTypes {
+		author := user1.ID
+		if i%2 == 1 {
+			author = user2.ID
+		}
+		err := s.GetDB().Exec(`
+			INSERT INTO activities (id, event_id, user_id, type, payload)
+			VALUES ($1, $2, $3, $4, '{}')
+		`, i+1, event.ID, author, activityType).Error
+		s.Require().NoError(err)
+	}
+
+	s.Run("постраничная выдача", func() {
+		limit := 2
+		firstPage, err := s.APIClient.GetActivityFeedWithResponse(s.Ctx, event.ID, &api.GetActivityFeedParams{Limit: &limit})
+		s.Require().NoError(err)
+		s.Require().Equal(200, firstPage.StatusCode())
+		s.Require().Len(firstPage.JSON200.Activities, 2)
+		s.Equal(5, *firstPage.JSON200.Activities[0].ActivityId, "сначала идут новые активности")
+		s.Require().NotNil(firstPage.JSON200.NextCursor)
+
+		var ids []int
+		cursor := firstPage.JSON200.NextCursor
+		for cursor != nil {
+			page, err := s.APIClient.GetActivityFeedWithResponse(s.Ctx, event.ID, &api.GetActivityFeedParams{Limit: &limit, Cursor: cursor})
+			s.Require().NoError(err)
+			s.Require().Equal(200, page.StatusCode())
+			for _, activity := range page.JSON200.Activities {
+				ids = append(ids, *activity.ActivityId)
+			}
+			cursor = page.JSON200.NextCursor
+		}
+		s.Equal([]int{3, 2, 1}, ids)
+	})
+
+	s.Run("фильтр по типу и автору", func() {
+		types := []api.ActivityType{api.TaskCreated, api.TaskUpdated, api.TaskDeleted}
+		resp, err := s.APIClient.GetActivityFeedWithResponse(s.Ctx, event.ID, &api.GetActivityFeedParams{
+			Type:   &types,
+			UserId: &user1.ID,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(200, resp.StatusCode())
+		s.Require().Len(resp.JSON200.Activities, 2)
+		s.Equal(api.TaskUpdated, *resp.JSON200.Activities[0].Type)
+		s.Equal(api.TaskCreated, *resp.JSON200.Activities[1].Type)
+		for _, activity := range resp.JSON200.Activities {
+			s.Equal(user1.ID, *activity.UserId)
+		}
+	})
+
+	s.Run("некорректный курсор", func() {
+		cursor := "abc"
+		resp, err := s.APIClient.GetActivityFeedWithResponse(s.Ctx, event.ID, &api.GetActivityFeedParams{Cursor: &cursor})
+		s.Require().NoError(err)
+		s.Equal(400, resp.StatusCode())
+	})
+}
@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// AnalyticsSuite представляет suite для тестов аналитики расходов
+type AnalyticsSuite struct {
+	BaseSuite
+}
+
+// TestAnalyticsSuite запускает все тесты в AnalyticsSuite
+func TestAnalyticsSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsSuite))
+}
+
+// prepareEvent создает мероприятие с двумя участниками и категорией транзакций
+func (s *AnalyticsSuite) prepareEvent() (int64, int) {
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	category := s.createTestTransactionCategory(TestCategoryID1, "Еда", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", nil)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	return event.ID, category.ID
+}
+
+// createTransaction создает транзакцию через API и переносит ее на дату datetime
+func (s *AnalyticsSuite) createTransaction(eventID int64, req api.CreateTransactionJSONRequestBody, datetime time.Time) int {
+	resp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, eventID, req)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, resp.StatusCode(), "должен быть статус 201")
+	s.Require().NotNil(resp.JSON201)
+	s.Require().NotNil(resp.JSON201.Id)
+
+	err = s.GetDB().Exec("UPDATE transactions SET datetime = ? WHERE id = ?", datetime, *resp.JSON201.Id).Error
+	s.Require().NoError(err)
+	return *resp.JSON201.Id
+}
+
+// TestGetEventAnalytics тестирует агрегаты по категориям, участникам, периодам и крупнейшие расходы
+func (s *AnalyticsSuite) TestGetEventAnalytics() {
+	// Arrange - подготовка
+	eventID, categoryID := s.prepareEvent()
+	day1 := time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC)
+	day3 := day1.AddDate(0, 0, 2)
+
+	// 300 RUB: платит первый, делится поровну
+	s.createTransaction(eventID, api.CreateTransactionJSONRequestBody{
+		Name:                  "Ужин",
+		Amount:                money.FromFloat(300),
+		FromUser:              TestUserID1,
+		Type:                  api.Equal,
+		Users:                 []int64{TestUserID1, TestUserID2},
+		TransactionCategoryId: &categoryID,
+	}, day1)
+	// 50 USD по курсу 2: платит второй, потребляет только первый
+	currency := "USD"
+	rate := 2.0
+	taxiID := s.createTransaction(eventID, api.CreateTransactionJSONRequestBody{
+		Name:         "Такси",
+		Amount:       money.FromFloat(50),
+		FromUser:     TestUserID2,
+		Type:         api.Amount,
+		Amounts:      &map[string]money.Money{"1": money.FromFloat(50)},
+		Users:        []int64{TestUserID1},
+		Currency:     &currency,
+		ExchangeRate: &rate,
+	}, day3)
+
+	// Act - действие
+	resp, err := s.APIClient.GetEventAnalyticsWithResponse(s.Ctx, eventID, &api.GetEventAnalyticsParams{})
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(resp.JSON200)
+	analytics := resp.JSON200
+
+	s.Equal(money.FromFloat(400), analytics.Total, "сумма в валюте мероприятия")
+	s.Equal(2, analytics.TransactionsCount)
+	s.Equal(api.Day, analytics.Interval)
+
+	s.Require().Len(analytics.ByCategory, 2)
+	s.Equal(money.FromFloat(300), analytics.ByCategory[0].Amount)
+	s.Equal("Еда", analytics.ByCategory[0].CategoryName)
+	s.Nil(analytics.ByCategory[1].CategoryId, "транзакция без категории")
+	s.Equal(money.FromFloat(100), analytics.ByCategory[1].Amount)
+
+	s.Require().Len(analytics.ByUser, 2)
+	s.Equal(api.UserSpendingDTO{UserId: TestUserID1, Paid: money.FromFloat(300), Consumed: money.FromFloat(250), Net: money.FromFloat(50)}, analytics.ByUser[0])
+	s.Equal(api.UserSpendingDTO{UserId: TestUserID2, Paid: money.FromFloat(100), Consumed: money.FromFloat(150), Net: money.FromFloat(-50)}, analytics.ByUser[1])
+
+	s.Require().Len(analytics.TimeSeries, 3, "пустой день между расходами")
+	s.Equal(money.FromFloat(300), analytics.TimeSeries[0].Amount)
+	s.Equal(money.FromFloat(0), analytics.TimeSeries[1].Amount)
+	s.Equal(money.FromFloat(100), analytics.TimeSeries[2].Amount)
+
+	s.Require().Len(analytics.TopExpenses, 2)
+	s.Equal("Ужин", analytics.TopExpenses[0].Name)
+	s.Equal(taxiID, analytics.TopExpenses[1].TransactionId)
+	s.Equal(money.FromFloat(100), analytics.TopExpenses[1].Amount)
+}
+
+// TestGetEventAnalytics_Filters тестирует отбор по периоду, недельный ряд и ограничение крупнейших расходов
+func (s *AnalyticsSuite) TestGetEventAnalytics_Filters() {
+	// Arrange - подготовка
+	eventID, _ := s.prepareEvent()
+	monday := time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC)
+	for i, amount := range []float64{100, 200, 400} {
+		s.createTransaction(eventID, api.CreateTransactionJSONRequestBody{
+			Name:     "Покупка",
+			Amount:   money.FromFloat(amount),
+			FromUser: TestUserID1,
+			Type:     api.Equal,
+			Users:    []int64{TestUserID1, TestUserID2},
+		}, monday.AddDate(0, 0, 7*i))
+	}
+	from := monday.AddDate(0, 0, 1)
+	interval := api.Week
+	top := 1
+
+	// Act - действие
+	resp, err := s.APIClient.GetEventAnalyticsWithResponse(s.Ctx, eventID, &api.GetEventAnalyticsParams{
+		From:     &from,
+		Interval: &interval,
+		Top:      &top,
+	})
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(resp.JSON200)
+	s.Equal(money.FromFloat(600), resp.JSON200.Total, "первая транзакция не входит в период")
+	s.Require().Len(resp.JSON200.TimeSeries, 2)
+	s.Equal(time.Weekday(time.Monday), resp.JSON200.TimeSeries[0].PeriodStart.Weekday())
+	s.Require().Len(resp.JSON200.TopExpenses, 1)
+	s.Equal(money.FromFloat(400), resp.JSON200.TopExpenses[0].Amount)
+}
+
+// TestGetEventAnalytics_InvalidInterval тестирует ошибку при неизвестном шаге ряда
+func (s *AnalyticsSuite) TestGetEventAnalytics_InvalidInterval() {
+	// Arrange - подготовка
+	eventID, _ := s.prepareEvent()
+	interval := api.AnalyticsInterval("month")
+
+	// Act - действие
+	resp, err := s.APIClient.GetEventAnalyticsWithResponse(s.Ctx, eventID, &api.GetEventAnalyticsParams{Interval: &interval})
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Equal(400, resp.StatusCode(), "должен быть статус 400")
+}
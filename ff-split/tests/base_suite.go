@@ -6,7 +6,9 @@ import (
 	"net/http/httptest"
 
 	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-auth/pkg/auth"
 	"github.com/ivasnev/FinFlow/ff-split/internal/api/handler"
+	"github.com/ivasnev/FinFlow/ff-split/internal/api/middleware"
 	"github.com/ivasnev/FinFlow/ff-split/internal/common/config"
 	"github.com/ivasnev/FinFlow/ff-split/internal/container"
 	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
@@ -31,11 +33,15 @@ type BaseSuite struct {
 	APIClient   api.ClientWithResponsesInterface
 
 	// Мок-серверы для внешних зависимостей
-	FFIDMockServer *mockserver.MockServer
+	FFIDMockServer    *mockserver.MockServer
+	FFFilesMockServer *mockserver.MockServer
 
 	// Провайдеры для детерминированности
 	TimeProvider *testTime.ConstantProvider
 	UUIDProvider *testUUID.ConstantProvider
+
+	// AuthUserID - внешний ID пользователя, от имени которого выполняются запросы
+	AuthUserID int64
 }
 
 // SetupSuite выполняется один раз перед всеми тестами в suite
@@ -50,8 +56,9 @@ func (s *BaseSuite) SetupSuite() {
 	// 3. Настройка тестовой БД с использованием testcontainers
 	s.DBContainer = setupTestDB(s.T())
 
-	// 4. Настройка мок-сервера для ff-id
+	// 4. Настройка мок-серверов для ff-id и ff-files
 	s.FFIDMockServer = setupMockServer(s.T())
+	s.FFFilesMockServer = setupMockServer(s.T())
 
 	// 5. Создаем конфигурацию для тестов
 	s.Config = &config.Config{}
@@ -62,6 +69,8 @@ func (s *BaseSuite) SetupSuite() {
 	s.Config.Postgres.DBName = "ff_split_test"
 	// Настраиваем ff-id клиент на мок-сервер
 	s.Config.IDService.BaseURL = s.FFIDMockServer.GetBaseURL()
+	// Настраиваем ff-files клиент на мок-сервер
+	s.Config.FileService.BaseURL = s.FFFilesMockServer.GetBaseURL()
 
 	// 6. Создаем HTTP клиент для тестов
 	s.HTTPClient = &http.Client{}
@@ -84,18 +93,25 @@ func (s *BaseSuite) SetupSuite() {
 		s.Container.TaskService,
 		s.Container.CategoryService,
 		s.Container.IconService,
+		s.Container.ExchangeRateService,
+		s.Container.InviteService,
+		s.Container.DummyClaimService,
+		s.Container.RecurringService,
+		s.Container.AnalyticsService,
+		s.Container.ExportService,
+		s.Container.ImportService,
 	)
 
-	// 10. Тестовый middleware для установки user_id
+	// 10. Тестовый middleware для установки данных пользователя
 	testAuthMiddleware := func(c *gin.Context) {
-		// Устанавливаем тестовый user_id для всех запросов
-		c.Set("user_id", TestUserID1)
+		// Устанавливаем данные текущего тестового пользователя для всех запросов
+		c.Set(string(auth.UserContextKey()), auth.UserData{UserID: s.AuthUserID})
 		c.Next()
 	}
 
-	// 11. Регистрируем роуты с тестовым middleware
+	// 11. Регистрируем роуты с тестовым middleware и проверкой участия в мероприятии
 	v1 := router.Group("/api/v1")
-	v1.Use(testAuthMiddleware)
+	v1.Use(testAuthMiddleware, middleware.EventAccessMiddleware(s.Container.EventService))
 	api.RegisterHandlers(v1, s.Container.ServerHandler)
 
 	// 12. Создаем тестовый HTTP сервер
@@ -114,10 +130,13 @@ func (s *BaseSuite) TearDownSuite() {
 		s.Server.Close()
 	}
 
-	// Закрытие мок-сервера
+	// Закрытие мок-серверов
 	if s.FFIDMockServer != nil {
 		s.FFIDMockServer.Stop()
 	}
+	if s.FFFilesMockServer != nil {
+		s.FFFilesMockServer.Stop()
+	}
 
 	// Закрытие тестовой БД
 	if s.DBContainer != nil {
@@ -132,16 +151,22 @@ func (s *BaseSuite) TearDownSuite() {
 
 // SetupTest выполняется перед каждым тестом
 func (s *BaseSuite) SetupTest() {
+	// По умолчанию запросы выполняются от имени первого тестового пользователя
+	s.AuthUserID = TestUserID1
+
 	// Очищаем данные перед каждым тестом для гарантированной чистоты
 	s.cleanupDatabase()
 }
 
 // TearDownTest выполняется после каждого теста
 func (s *BaseSuite) TearDownTest() {
-	// Очищаем мок-сервер и проверяем, что все ожидаемые вызовы были сделаны
+	// Очищаем мок-серверы и проверяем, что все ожидаемые вызовы были сделаны
 	if s.FFIDMockServer != nil {
 		s.FFIDMockServer.Clear(s.T())
 	}
+	if s.FFFilesMockServer != nil {
+		s.FFFilesMockServer.Clear(s.T())
+	}
 
 	// Очищаем данные после каждого теста
 	s.cleanupDatabase()
@@ -151,9 +176,20 @@ func (s *BaseSuite) TearDownTest() {
 func (s *BaseSuite) cleanupDatabase() {
 	if s.DBContainer != nil && s.DBContainer.DB != nil {
 		// Выполняем очистку в правильном порядке из-за внешних ключей
+		s.DBContainer.DB.Exec("TRUNCATE TABLE recurring_occurrences CASCADE")
+		s.DBContainer.DB.Exec("TRUNCATE TABLE recurring_transactions CASCADE")
+		s.DBContainer.DB.Exec("TRUNCATE TABLE dummy_claims CASCADE")
+		s.DBContainer.DB.Exec("TRUNCATE TABLE event_invites CASCADE")
+		s.DBContainer.DB.Exec("TRUNCATE TABLE settlements CASCADE")
 		s.DBContainer.DB.Exec("TRUNCATE TABLE optimized_debts CASCADE")
+		s.DBContainer.DB.Exec("TRUNCATE TABLE transaction_revisions CASCADE")
+		s.DBContainer.DB.Exec("TRUNCATE TABLE transaction_attachments CASCADE")
 		s.DBContainer.DB.Exec("TRUNCATE TABLE debts CASCADE")
 		s.DBContainer.DB.Exec("TRUNCATE TABLE transaction_shares CASCADE")
+		s.DBContainer.DB.Exec("TRUNCATE TABLE transaction_payers CASCADE")
+		s.DBContainer.DB.Exec("TRUNCATE TABLE transaction_item_consumers CASCADE")
+		s.DBContainer.DB.Exec("TRUNCATE TABLE transaction_items CASCADE")
+		s.DBContainer.DB.Exec("TRUNCATE TABLE transaction_charges CASCADE")
 		s.DBContainer.DB.Exec("TRUNCATE TABLE transactions CASCADE")
 		s.DBContainer.DB.Exec("TRUNCATE TABLE tasks CASCADE")
 		s.DBContainer.DB.Exec("TRUNCATE TABLE activities CASCADE")
@@ -163,12 +199,17 @@ func (s *BaseSuite) cleanupDatabase() {
 		s.DBContainer.DB.Exec("TRUNCATE TABLE event_categories CASCADE")
 		s.DBContainer.DB.Exec("TRUNCATE TABLE icons CASCADE")
 		s.DBContainer.DB.Exec("TRUNCATE TABLE users CASCADE")
+		s.DBContainer.DB.Exec("TRUNCATE TABLE exchange_rates CASCADE")
 
 		// Сбрасываем последовательности
 		s.DBContainer.DB.Exec("ALTER SEQUENCE users_id_seq RESTART WITH 1")
 		s.DBContainer.DB.Exec("ALTER SEQUENCE events_id_seq RESTART WITH 1")
 		s.DBContainer.DB.Exec("ALTER SEQUENCE transactions_id_seq RESTART WITH 1")
 		s.DBContainer.DB.Exec("ALTER SEQUENCE transaction_shares_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE transaction_payers_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE transaction_item_consumers_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE transaction_items_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE transaction_charges_id_seq RESTART WITH 1")
 		s.DBContainer.DB.Exec("ALTER SEQUENCE debts_id_seq RESTART WITH 1")
 		s.DBContainer.DB.Exec("ALTER SEQUENCE tasks_id_seq RESTART WITH 1")
 		s.DBContainer.DB.Exec("ALTER SEQUENCE activities_id_seq RESTART WITH 1")
@@ -176,6 +217,14 @@ func (s *BaseSuite) cleanupDatabase() {
 		s.DBContainer.DB.Exec("ALTER SEQUENCE event_categories_id_seq RESTART WITH 1")
 		s.DBContainer.DB.Exec("ALTER SEQUENCE transaction_categories_id_seq RESTART WITH 1")
 		s.DBContainer.DB.Exec("ALTER SEQUENCE optimized_debts_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE exchange_rates_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE settlements_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE event_invites_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE dummy_claims_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE recurring_transactions_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE recurring_occurrences_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE transaction_revisions_id_seq RESTART WITH 1")
+		s.DBContainer.DB.Exec("ALTER SEQUENCE transaction_attachments_id_seq RESTART WITH 1")
 	}
 }
 
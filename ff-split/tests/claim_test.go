@@ -0,0 +1,220 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// testDummyUserID - внутренний ID dummy-пользователя в тестах заявок
+const testDummyUserID int64 = 3
+
+// ClaimSuite представляет suite для тестов заявок на dummy-пользователей
+type ClaimSuite struct {
+	BaseSuite
+}
+
+// TestClaimSuite запускает все тесты в ClaimSuite
+func TestClaimSuite(t *testing.T) {
+	suite.Run(t, new(ClaimSuite))
+}
+
+// prepareEvent создает мероприятие, где первый пользователь - владелец,
+// второй - участник, а третий - dummy-пользователь
+func (s *ClaimSuite) prepareEvent() int64 {
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", nil)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	dummy := s.createTestDummyUser(testDummyUserID, "vasya", "Вася")
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
+	s.addUserToEventWithRole(user2.ID, event.ID, models.EventRoleMember)
+	s.addUserToEventWithRole(dummy.ID, event.ID, models.EventRoleMember)
+
+	return event.ID
+}
+
+// exec выполняет SQL-запрос подготовки данных
+func (s *ClaimSuite) exec(query string, args ...interface{}) {
+	s.Require().NoError(s.GetDB().Exec(query, args...).Error)
+}
+
+// createPendingClaim создает нерассмотренную заявку второго пользователя на dummy-пользователя
+func (s *ClaimSuite) createPendingClaim(eventID int64) {
+	s.exec(`
+		INSERT INTO dummy_claims (event_id, dummy_user_id, dummy_name, claimant_user_id)
+		VALUES ($1, $2, $3, $4)
+	`, eventID, testDummyUserID, "Вася", TestUserID2)
+}
+
+// TestApproveClaim_MergesDummy тестирует подачу и одобрение заявки с переносом данных dummy-пользователя
+func (s *ClaimSuite) TestApproveClaim_MergesDummy() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	// Транзакция 1: платит владелец, доли есть и у заявителя, и у dummy - доли и долги должны сложиться
+	s.exec(`INSERT INTO transactions (id, event_id, name, total_paid, payer_id) VALUES (1, $1, 'Ужин', 300, $2)`, eventID, TestUserID1)
+	s.exec(`INSERT INTO transaction_shares (transaction_id, user_id, value) VALUES (1, $1, 100), (1, $2, 100), (1, $3, 100)`,
+		TestUserID1, TestUserID2, testDummyUserID)
+	s.exec(`INSERT INTO debts (transaction_id, from_user_id, to_user_id, amount) VALUES (1, $1, $2, 100), (1, $3, $2, 100)`,
+		TestUserID2, TestUserID1, testDummyUserID)
+	// Транзакция 2: платит dummy за заявителя - долг между ними после объединения исчезает
+	s.exec(`INSERT INTO transactions (id, event_id, name, total_paid, payer_id) VALUES (2, $1, 'Такси', 100, $2)`, eventID, testDummyUserID)
+	s.exec(`INSERT INTO transaction_shares (transaction_id, user_id, value) VALUES (2, $1, 50), (2, $2, 50)`, TestUserID2, testDummyUserID)
+	s.exec(`INSERT INTO debts (transaction_id, from_user_id, to_user_id, amount) VALUES (2, $1, $2, 50)`, TestUserID2, testDummyUserID)
+	s.exec(`INSERT INTO tasks (user_id, event_id, title) VALUES ($1, $2, 'Купить продукты')`, testDummyUserID, eventID)
+	// Шаблон регулярной транзакции, где платит dummy - после объединения платить должен заявитель
+	s.exec(`INSERT INTO recurring_transactions (event_id, frequency, start_date, body, created_by) VALUES ($1, 'daily', now(), $2, $3)`,
+		eventID, fmt.Sprintf(`{"name": "Интернет", "type": "equal", "amount": 100, "from_user": %d, "users": [%d, %d]}`,
+			testDummyUserID, TestUserID2, testDummyUserID), testDummyUserID)
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	createResp, err := s.APIClient.CreateDummyClaimWithResponse(s.Ctx, eventID, testDummyUserID)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, createResp.StatusCode(), "должен быть статус 201")
+	s.Require().NotNil(createResp.JSON201)
+	s.AuthUserID = TestUserID1
+	listResp, err := s.APIClient.GetDummyClaimsWithResponse(s.Ctx, eventID)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	approveResp, err := s.APIClient.ApproveDummyClaimWithResponse(s.Ctx, eventID, createResp.JSON201.Id)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+
+	// Assert - проверка
+	s.Require().Equal(200, listResp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(listResp.JSON200)
+	s.Require().NotNil(listResp.JSON200.Claims)
+	s.Require().Len(*listResp.JSON200.Claims, 1)
+	s.Equal(TestUserID2, (*listResp.JSON200.Claims)[0].ClaimantUserId)
+
+	s.Require().Equal(200, approveResp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(approveResp.JSON200)
+	s.Equal(api.DummyClaimDTOStatusApproved, approveResp.JSON200.Status)
+
+	var share float64
+	err = s.GetDB().Table("transaction_shares").Select("value").
+		Where("transaction_id = 1 AND user_id = ?", TestUserID2).Scan(&share).Error
+	s.NoError(err)
+	s.Equal(200.0, share, "доли заявителя и dummy-пользователя должны сложиться")
+
+	var debt float64
+	err = s.GetDB().Table("debts").Select("amount").
+		Where("transaction_id = 1 AND from_user_id = ? AND to_user_id = ?", TestUserID2, TestUserID1).Scan(&debt).Error
+	s.NoError(err)
+	s.Equal(200.0, debt, "долги заявителя и dummy-пользователя должны сложиться")
+
+	var payerID int64
+	err = s.GetDB().Table("transactions").Select("payer_id").Where("id = 2").Scan(&payerID).Error
+	s.NoError(err)
+	s.Equal(TestUserID2, payerID, "плательщиком должен стать заявитель")
+
+	var count int64
+	err = s.GetDB().Table("debts").Where("transaction_id = 2").Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(0), count, "долг заявителя самому себе должен быть удален")
+
+	err = s.GetDB().Table("tasks").Where("user_id = ?", TestUserID2).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(1), count, "задача должна перейти заявителю")
+
+	err = s.GetDB().Table("users").Where("id = ?", testDummyUserID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(0), count, "dummy-пользователь должен быть удален")
+
+	err = s.GetDB().Table("user_event").Where("event_id = ?", eventID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(2), count, "в мероприятии должны остаться только реальные участники")
+
+	var recurring struct {
+		Body      string
+		CreatedBy int64
+	}
+	err = s.GetDB().Table("recurring_transactions").Select("body, created_by").Where("event_id = ?", eventID).Scan(&recurring).Error
+	s.NoError(err)
+	s.Equal(TestUserID2, recurring.CreatedBy, "автором шаблона должен стать заявитель")
+	var body struct {
+		FromUser int64   `json:"from_user"`
+		Users    []int64 `json:"users"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(recurring.Body), &body))
+	s.Equal(TestUserID2, body.FromUser, "плательщиком в шаблоне должен стать заявитель")
+	s.Equal([]int64{TestUserID2}, body.Users, "dummy-пользователь должен исчезнуть из участников шаблона")
+
+	var outdated bool
+	err = s.GetDB().Table("events").Select("optimized_debts_outdated").Where("id = ?", eventID).Scan(&outdated).Error
+	s.NoError(err)
+	s.True(outdated, "план переводов мероприятия должен устареть после переноса долгов")
+}
+
+// TestApproveClaim_ByClaimant тестирует запрет на одобрение собственной заявки не владельцем
+func (s *ClaimSuite) TestApproveClaim_ByClaimant() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	s.exec("UPDATE user_event SET role = ? WHERE user_id = ?", string(models.EventRoleAdmin), TestUserID2)
+	s.createPendingClaim(eventID)
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	resp, err := s.APIClient.ApproveDummyClaimWithResponse(s.Ctx, eventID, 1)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+
+	var count int64
+	err = s.GetDB().Table("users").Where("id = ?", testDummyUserID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(1), count, "dummy-пользователь должен остаться")
+}
+
+// TestRejectClaim_Success тестирует отклонение заявки владельцем
+func (s *ClaimSuite) TestRejectClaim_Success() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	s.createPendingClaim(eventID)
+
+	// Act - действие
+	resp, err := s.APIClient.RejectDummyClaimWithResponse(s.Ctx, eventID, 1)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(resp.JSON200)
+	s.Equal(api.DummyClaimDTOStatusRejected, resp.JSON200.Status)
+
+	var count int64
+	err = s.GetDB().Table("users").Where("id = ?", testDummyUserID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(1), count, "dummy-пользователь должен остаться")
+}
+
+// TestCreateClaim_Duplicate тестирует запрет на повторную заявку на того же dummy-пользователя
+func (s *ClaimSuite) TestCreateClaim_Duplicate() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	s.createPendingClaim(eventID)
+
+	// Act - действие
+	resp, err := s.APIClient.CreateDummyClaimWithResponse(s.Ctx, eventID, testDummyUserID)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(409, resp.StatusCode(), "должен быть статус 409")
+}
+
+// TestCreateClaim_NotDummy тестирует запрет на заявку на реального пользователя
+func (s *ClaimSuite) TestCreateClaim_NotDummy() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	resp, err := s.APIClient.CreateDummyClaimWithResponse(s.Ctx, eventID, TestUserID1)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(404, resp.StatusCode(), "должен быть статус 404")
+}
@@ -1,5 +1,7 @@
 package tests
 
+import "time"
+
 // Константы для детерминированных тестов
 const (
 	// User IDs
@@ -15,6 +17,7 @@ const (
 	// Transaction IDs
 	TestTransactionID1 = int64(1)
 	TestTransactionID2 = int64(2)
+	TestTransactionID3 = int64(3)
 
 	// Category IDs
 	TestCategoryID1 = int(1)
@@ -56,5 +59,11 @@ const (
 	TestAmount1 = 1000.00
 	TestAmount2 = 500.50
 	TestAmount3 = 250.25
+
+	// Срок хранения записей в корзине
+	TestTrashRetention = 30 * 24 * time.Hour
+
+	// ID файла в ff-files из samples/ff_files_service
+	TestFileObjectID = "3f2b8c1e-0d4a-4c5e-9a7b-1c2d3e4f5a6b"
 )
 
@@ -5,29 +5,44 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-split/internal/adapters/fffiles"
 	"github.com/ivasnev/FinFlow/ff-split/internal/adapters/ffid"
 	"github.com/ivasnev/FinFlow/ff-split/internal/common/config"
 	"github.com/ivasnev/FinFlow/ff-split/internal/container"
 	activity_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/activity"
 	category_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/category"
 	event_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/event"
+	exchange_rate_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/exchange_rate"
+	settlement_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/settlement"
 	icon_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/icon"
+	invite_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/invite"
+	claim_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/claim"
+	recurring_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/recurring"
+	analytics_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/analytics"
 	task_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/task"
 	transaction_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/transaction"
 	user_repository "github.com/ivasnev/FinFlow/ff-split/internal/repository/postgres/user"
 	activity_service "github.com/ivasnev/FinFlow/ff-split/internal/service/activity"
 	category_service "github.com/ivasnev/FinFlow/ff-split/internal/service/category"
+	currency_service "github.com/ivasnev/FinFlow/ff-split/internal/service/currency"
 	event_service "github.com/ivasnev/FinFlow/ff-split/internal/service/event"
 	icon_service "github.com/ivasnev/FinFlow/ff-split/internal/service/icon"
+	invite_service "github.com/ivasnev/FinFlow/ff-split/internal/service/invite"
+	claim_service "github.com/ivasnev/FinFlow/ff-split/internal/service/claim"
+	recurring_service "github.com/ivasnev/FinFlow/ff-split/internal/service/recurring"
+	analytics_service "github.com/ivasnev/FinFlow/ff-split/internal/service/analytics"
+	export_service "github.com/ivasnev/FinFlow/ff-split/internal/service/export"
+	import_service "github.com/ivasnev/FinFlow/ff-split/internal/service/importer"
 	task_service "github.com/ivasnev/FinFlow/ff-split/internal/service/task"
 	transaction_service "github.com/ivasnev/FinFlow/ff-split/internal/service/transaction"
+	trash_service "github.com/ivasnev/FinFlow/ff-split/internal/service/trash"
 	user_service "github.com/ivasnev/FinFlow/ff-split/internal/service/user"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
 
 // createTestContainer создает тестовый контейнер с роутером для HTTP сервера
-func createTestContainer(t *testing.T, cfg *config.Config, router *gin.Engine, db *gorm.DB, httpClient *http.Client) (*container.Container, error) {
+func createTestContainer(t testing.TB, cfg *config.Config, router *gin.Engine, db *gorm.DB, httpClient *http.Client) (*container.Container, error) {
 	c := &container.Container{
 		Config: cfg,
 		Router: router,
@@ -42,19 +57,37 @@ func createTestContainer(t *testing.T, cfg *config.Config, router *gin.Engine, d
 	c.IconRepository = icon_repository.NewIconRepository(c.DB)
 	c.TaskRepository = task_repository.NewTaskRepository(c.DB)
 	c.TransactionRepository = transaction_repository.NewTransactionRepository(c.DB)
+	c.ExchangeRateRepository = exchange_rate_repository.NewExchangeRateRepository(c.DB)
+	c.SettlementRepository = settlement_repository.NewSettlementRepository(c.DB)
+	c.InviteRepository = invite_repository.NewInviteRepository(c.DB)
+	c.DummyClaimRepository = claim_repository.NewDummyClaimRepository(c.DB)
+	c.RecurringRepository = recurring_repository.NewRecurringRepository(c.DB)
+	c.AnalyticsRepository = analytics_repository.NewAnalyticsRepository(c.DB)
 
 	// Создаем реальный HTTP адаптер для ff-id (будет использовать MockServer)
 	idAdapter, err := ffid.NewAdapter(cfg.IDService.BaseURL, httpClient)
 	require.NoError(t, err, "не удалось создать ff-id адаптер")
 
+	// Создаем реальный HTTP адаптер для ff-files (будет использовать MockServer)
+	filesAdapter, err := fffiles.NewAdapter(cfg.FileService.BaseURL, httpClient)
+	require.NoError(t, err, "не удалось создать ff-files адаптер")
+
 	// Инициализируем сервисы с реальным HTTP адаптером
-	c.UserService = user_service.NewUserService(c.UserRepository, idAdapter)
-	c.CategoryService = category_service.NewCategoryService(c.CategoryRepository)
-	c.EventService = event_service.NewEventService(c.EventRepository, c.DB, c.UserService, c.CategoryService)
 	c.ActivityService = activity_service.NewActivityService(c.ActivityRepository)
+	c.UserService = user_service.NewUserService(c.UserRepository, idAdapter, c.ActivityService)
+	c.CategoryService = category_service.NewCategoryService(c.CategoryRepository)
+	c.EventService = event_service.NewEventService(c.EventRepository, c.DB, c.UserService, c.CategoryService, c.ActivityService)
 	c.IconService = icon_service.NewIconService(c.IconRepository)
-	c.TaskService = task_service.NewTaskService(c.TaskRepository, c.UserService)
-	c.TransactionService = transaction_service.NewTransactionService(c.DB, c.TransactionRepository, c.UserService, c.EventService)
+	c.TaskService = task_service.NewTaskService(c.TaskRepository, c.UserService, c.ActivityService)
+	c.ExchangeRateService = currency_service.NewExchangeRateService(c.ExchangeRateRepository)
+	c.TransactionService = transaction_service.NewTransactionService(c.DB, c.TransactionRepository, c.SettlementRepository, c.UserService, c.EventService, c.ExchangeRateService, c.ActivityService, filesAdapter)
+	c.InviteService = invite_service.NewInviteService(c.DB, c.InviteRepository, c.UserService, c.EventService)
+	c.RecurringService = recurring_service.NewRecurringService(c.DB, c.RecurringRepository, c.TransactionService)
+	c.DummyClaimService = claim_service.NewDummyClaimService(c.DB, c.DummyClaimRepository, c.UserService, c.RecurringService)
+	c.AnalyticsService = analytics_service.NewAnalyticsService(c.AnalyticsRepository, c.EventService)
+	c.ExportService = export_service.NewExportService(c.EventService, c.UserService, c.TransactionService, c.AnalyticsService, c.CategoryService)
+	c.ImportService = import_service.NewImportService(c.DB, c.TransactionService, c.UserService, c.CategoryService)
+	c.TrashService = trash_service.NewTrashService(c.TransactionRepository, c.EventRepository, filesAdapter, TestTrashRetention)
 
 	return c, nil
 }
@@ -3,6 +3,7 @@ package tests
 import (
 	"testing"
 
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
 	"github.com/stretchr/testify/suite"
 )
@@ -62,6 +63,65 @@ func (s *EventSuite) TestCreateEvent_Success() {
 	err = s.GetDB().Table("user_event").Where("event_id = ?", resp.JSON201.Id).Count(&userEventCount).Error
 	s.NoError(err)
 	s.Equal(int64(2), userEventCount, "должно быть добавлено 2 пользователя")
+
+	// Проверяем, что создатель стал владельцем мероприятия
+	var role string
+	err = s.GetDB().Table("user_event").Select("role").
+		Where("event_id = ? AND user_id = ?", resp.JSON201.Id, user1.ID).
+		Scan(&role).Error
+	s.NoError(err)
+	s.Equal(string(models.EventRoleOwner), role, "создатель должен стать владельцем")
+}
+
+// TestCreateEvent_OptimizationAlgorithm тестирует создание мероприятия с выбранным алгоритмом оптимизации
+func (s *EventSuite) TestCreateEvent_OptimizationAlgorithm() {
+	// Arrange - подготовка
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+
+	algorithm := api.Greedy
+	reqBody := api.CreateEventJSONRequestBody{
+		Name:                  TestEventName1,
+		OptimizationAlgorithm: &algorithm,
+		Members: &api.EventMembersDTO{
+			UserIds: &[]int64{*user1.UserID},
+		},
+	}
+
+	// Act - действие
+	resp, err := s.APIClient.CreateEventWithResponse(s.Ctx, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, resp.StatusCode(), "должен быть статус 201")
+	s.Require().NotNil(resp.JSON201.OptimizationAlgorithm)
+	s.Equal(api.Greedy, *resp.JSON201.OptimizationAlgorithm)
+
+	var stored string
+	err = s.GetDB().Table("events").Where("id = ?", resp.JSON201.Id).Select("optimization_algorithm").Scan(&stored).Error
+	s.NoError(err)
+	s.Equal("greedy", stored)
+}
+
+// TestCreateEvent_UnknownOptimizationAlgorithm тестирует создание мероприятия с неизвестным алгоритмом
+func (s *EventSuite) TestCreateEvent_UnknownOptimizationAlgorithm() {
+	// Arrange - подготовка
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+
+	algorithm := api.OptimizationAlgorithm("simplex")
+	reqBody := api.CreateEventJSONRequestBody{
+		Name:                  TestEventName1,
+		OptimizationAlgorithm: &algorithm,
+		Members: &api.EventMembersDTO{
+			UserIds: &[]int64{*user1.UserID},
+		},
+	}
+
+	// Act - действие
+	resp, err := s.APIClient.CreateEventWithResponse(s.Ctx, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Equal(400, resp.StatusCode(), "должен быть статус 400")
 }
 
 // TestCreateEvent_WithDummyUsers тестирует создание мероприятия с dummy-пользователями
@@ -176,7 +236,7 @@ func (s *EventSuite) TestGetEvents_Success() {
 	s.addUserToEvent(user1.ID, event2.ID)
 
 	// Act - действие
-	resp, err := s.APIClient.GetEventsWithResponse(s.Ctx)
+	resp, err := s.APIClient.GetEventsWithResponse(s.Ctx, nil)
 
 	// Assert - проверка
 	s.Require().NoError(err, "запрос должен выполниться успешно")
@@ -203,6 +263,9 @@ func (s *EventSuite) TestUpdateEvent_Success() {
 	category := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
 	event := s.createTestEvent(TestEventID1, TestEventName1, "Старое описание", &category.ID)
 
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
+
 	// Подготавливаем запрос на обновление
 	newName := "Обновленное мероприятие"
 	newDescription := "Новое описание"
@@ -258,6 +321,9 @@ func (s *EventSuite) TestDeleteEvent_Success() {
 	category := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
 	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
 
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
+
 	// Act - действие
 	resp, err := s.APIClient.DeleteEventWithResponse(s.Ctx, event.ID)
 
@@ -266,11 +332,15 @@ func (s *EventSuite) TestDeleteEvent_Success() {
 	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
 	s.Require().NotNil(resp.JSON200, "должен быть возвращен объект успеха")
 
-	// Проверяем, что мероприятие удалено из БД
+	// Проверяем, что мероприятие перенесено в корзину
 	var count int64
-	err = s.GetDB().Table("events").Where("id = ?", event.ID).Count(&count).Error
+	err = s.GetDB().Table("events").Where("id = ? AND deleted_at IS NULL", event.ID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(0), count, "мероприятие должно быть удалено")
+
+	err = s.GetDB().Table("events").Where("id = ? AND deleted_at IS NOT NULL", event.ID).Count(&count).Error
 	s.NoError(err)
-	s.Equal(int64(0), count, "мероприятие должно быть удалено из БД")
+	s.Equal(int64(1), count, "мероприятие должно остаться в корзине")
 }
 
 // TestDeleteEvent_NotFound тестирует удаление несуществующего мероприятия
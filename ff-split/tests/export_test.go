@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xuri/excelize/v2"
+)
+
+// ExportSuite представляет suite для тестов выгрузки мероприятий
+type ExportSuite struct {
+	BaseSuite
+}
+
+// TestExportSuite запускает все тесты в ExportSuite
+func TestExportSuite(t *testing.T) {
+	suite.Run(t, new(ExportSuite))
+}
+
+// prepareEvent создает мероприятие с двумя участниками и одной транзакцией
+func (s *ExportSuite) prepareEvent() int64 {
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", nil)
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	resp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин",
+		Amount:   money.FromFloat(300),
+		FromUser: TestUserID1,
+		Type:     api.Equal,
+		Users:    []int64{TestUserID1, TestUserID2},
+	})
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, resp.StatusCode(), "должен быть статус 201")
+
+	return event.ID
+}
+
+// TestExportEvent_CSV тестирует выгрузку в CSV с именами участников
+func (s *ExportSuite) TestExportEvent_CSV() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+
+	// Act - действие
+	resp, err := s.APIClient.ExportEventWithResponse(s.Ctx, eventID, &api.ExportEventParams{})
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Equal("text/csv; charset=utf-8", resp.HTTPResponse.Header.Get("Content-Type"))
+	s.Contains(resp.HTTPResponse.Header.Get("Content-Disposition"), fmt.Sprintf("event-%d.csv", eventID))
+
+	content := string(resp.Body)
+	s.Contains(content, "Мероприятие,"+TestEventName1)
+	s.Contains(content, "Итоги по участникам")
+	s.Contains(content, "План переводов")
+	s.Contains(content, "Долги")
+	s.Contains(content, ",Ужин,,"+TestName1+",300.00,RUB,1,300.00,"+TestName2+",150.00")
+	s.Contains(content, "Всего,300.00,300.00,0.00")
+	s.Contains(content, "\n"+TestName2+","+TestName1+",150.00,0.00,Не погашен", "устаревший план должен попасть в выгрузку пересчитанным")
+
+	var count int64
+	s.Require().NoError(s.GetDB().Table("optimized_debts").Where("event_id = ?", eventID).Count(&count).Error)
+	s.Equal(int64(0), count, "выгрузка не должна сохранять план переводов")
+}
+
+// TestExportEvent_XLSX тестирует выгрузку в XLSX
+func (s *ExportSuite) TestExportEvent_XLSX() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	format := api.Xlsx
+
+	// Act - действие
+	resp, err := s.APIClient.ExportEventWithResponse(s.Ctx, eventID, &api.ExportEventParams{Format: &format})
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+
+	workbook, err := excelize.OpenReader(bytes.NewReader(resp.Body))
+	s.Require().NoError(err, "файл должен открываться как XLSX")
+	defer workbook.Close()
+
+	rows, err := workbook.GetRows("Итоги по участникам")
+	s.Require().NoError(err)
+	s.Require().Len(rows, 4, "заголовок, два участника и итог")
+	s.Equal(TestName1, rows[1][0])
+}
+
+// TestExportEvent_PDF тестирует выгрузку печатной сводки в PDF
+func (s *ExportSuite) TestExportEvent_PDF() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	format := api.Pdf
+
+	// Act - действие
+	resp, err := s.APIClient.ExportEventWithResponse(s.Ctx, eventID, &api.ExportEventParams{Format: &format})
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Equal("application/pdf", resp.HTTPResponse.Header.Get("Content-Type"))
+	s.True(bytes.HasPrefix(resp.Body, []byte("%PDF-")))
+}
+
+// TestExportEvent_UnknownFormat тестирует ошибку при неподдерживаемом формате
+func (s *ExportSuite) TestExportEvent_UnknownFormat() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	format := api.ExportFormat("docx")
+
+	// Act - действие
+	resp, err := s.APIClient.ExportEventWithResponse(s.Ctx, eventID, &api.ExportEventParams{Format: &format})
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Equal(400, resp.StatusCode(), "должен быть статус 400")
+}
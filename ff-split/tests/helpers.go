@@ -128,3 +128,11 @@ func (s *BaseSuite) addUserToEvent(userID int64, eventID int64) {
 	s.NoError(err, "не удалось добавить пользователя к мероприятию")
 }
 
+// addUserToEventWithRole добавляет пользователя к мероприятию с указанной ролью
+func (s *BaseSuite) addUserToEventWithRole(userID int64, eventID int64, role models.EventRole) {
+	err := s.GetDB().Exec(`
+		INSERT INTO user_event (user_id, event_id, role)
+		VALUES ($1, $2, $3)
+	`, userID, eventID, string(role)).Error
+	s.NoError(err, "не удалось добавить пользователя к мероприятию")
+}
@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// ImportSuite представляет suite для тестов импорта транзакций
+type ImportSuite struct {
+	BaseSuite
+}
+
+// TestImportSuite запускает все тесты в ImportSuite
+func TestImportSuite(t *testing.T) {
+	suite.Run(t, new(ImportSuite))
+}
+
+// splitwiseExport - выгрузка Splitwise, где Guest не является участником мероприятия
+const splitwiseExport = `Date,Description,Category,Cost,Currency,User One,User Two,Guest
+
+2025-03-01,Dinner,Dining out,90.00,RUB,60.00,-30.00,-30.00
+2025-03-02,Payment,Payment,30.00,RUB,-30.00,30.00,0.00
+2025-03-03,Broken,General,abc,RUB,1.00,-1.00,0.00
+
+2025-03-04,Total balance,,,RUB,30.00,0.00,-30.00
+`
+
+// prepareEvent создает мероприятие, где первый пользователь - владелец, а второй - участник
+func (s *ImportSuite) prepareEvent(eventID int64) int64 {
+	event := s.createTestEvent(eventID, TestEventName1, "Описание", nil)
+	s.addUserToEventWithRole(TestUserID1, event.ID, models.EventRoleOwner)
+	s.addUserToEventWithRole(TestUserID2, event.ID, models.EventRoleMember)
+	return event.ID
+}
+
+// createUsers создает пользователей и сдвигает последовательность для dummy-пользователей
+func (s *ImportSuite) createUsers() {
+	s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+
+	// Пользователи созданы с явными ID - сдвигаем последовательность для новых пользователей
+	err := s.GetDB().Exec("SELECT setval('users_id_seq', (SELECT max(id) FROM users))").Error
+	s.Require().NoError(err)
+}
+
+// importCSV отправляет файл на импорт
+func (s *ImportSuite) importCSV(eventID int64, data string, dryRun bool) *api.ImportReportResponse {
+	resp, err := s.APIClient.ImportTransactionsWithBodyWithResponse(s.Ctx, eventID,
+		&api.ImportTransactionsParams{DryRun: &dryRun}, "text/csv", strings.NewReader(data))
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200: %s", string(resp.Body))
+	s.Require().NotNil(resp.JSON200)
+	return resp.JSON200
+}
+
+// count возвращает число строк таблицы, удовлетворяющих условию
+func (s *ImportSuite) count(table, query string, args ...interface{}) int64 {
+	var count int64
+	err := s.GetDB().Table(table).Where(query, args...).Count(&count).Error
+	s.Require().NoError(err)
+	return count
+}
+
+// TestImport_DryRun тестирует, что пробный запуск ничего не сохраняет
+func (s *ImportSuite) TestImport_DryRun() {
+	// Arrange - подготовка
+	s.createUsers()
+	eventID := s.prepareEvent(TestEventID1)
+
+	// Act - действие
+	report := s.importCSV(eventID, splitwiseExport, true)
+
+	// Assert - проверка
+	s.True(report.DryRun)
+	s.Equal(api.Splitwise, report.Format)
+	s.Equal([]string{"Guest"}, report.CreatedUsers)
+	s.Equal(1, report.Imported)
+	s.Equal(1, report.Failed)
+	s.Equal(1, report.Skipped)
+	s.Require().Len(report.Rows, 3)
+	s.Equal(api.Ok, report.Rows[0].Status)
+	s.Nil(report.Rows[0].TransactionId)
+	s.Equal(api.Error, report.Rows[2].Status)
+	s.Require().NotNil(report.Rows[2].Error)
+
+	s.Equal(int64(0), s.count("transactions", "event_id = ?", eventID), "транзакции не создаются")
+	s.Equal(int64(0), s.count("users", "is_dummy = ?", true), "dummy-пользователи не создаются")
+}
+
+// TestImport_Splitwise тестирует создание транзакций, dummy-пользователей и долгов
+func (s *ImportSuite) TestImport_Splitwise() {
+	// Arrange - подготовка
+	s.createUsers()
+	eventID := s.prepareEvent(TestEventID1)
+
+	// Act - действие
+	report := s.importCSV(eventID, splitwiseExport, false)
+
+	// Assert - проверка
+	s.False(report.DryRun)
+	s.Equal(1, report.Imported)
+	s.Require().NotNil(report.Rows[0].TransactionId)
+
+	s.Equal(int64(1), s.count("users", "is_dummy = ? AND name_cashed = ?", true, "Guest"))
+	s.Equal(int64(3), s.count("user_event", "event_id = ?", eventID), "dummy-пользователь добавлен в мероприятие")
+
+	txResp, err := s.APIClient.GetTransactionByIDWithResponse(s.Ctx, eventID, *report.Rows[0].TransactionId)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, txResp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(txResp.JSON200)
+	s.Require().NotNil(txResp.JSON200.Datetime)
+	s.Equal("2025-03-01", txResp.JSON200.Datetime.Format("2006-01-02"), "дата берется из файла")
+
+	s.Equal(int64(2), s.count("debts", "transaction_id = ? AND to_user_id = ?", *report.Rows[0].TransactionId, TestUserID1),
+		"оба участника должны плательщику")
+}
+
+// TestImport_FailedRowsKeepNoDummyUsers тестирует, что dummy-пользователи неимпортированных строк не сохраняются
+func (s *ImportSuite) TestImport_FailedRowsKeepNoDummyUsers() {
+	// Arrange - подготовка
+	s.createUsers()
+	eventID := s.prepareEvent(TestEventID1)
+
+	// В закрытое мероприятие транзакции не добавляются
+	err := s.GetDB().Exec("UPDATE events SET status = ? WHERE id = ?", models.EventStatusClosed, eventID).Error
+	s.Require().NoError(err)
+
+	// Act - действие
+	report := s.importCSV(eventID, splitwiseExport, false)
+
+	// Assert - проверка
+	s.Equal(0, report.Imported)
+	s.Equal(2, report.Failed)
+	s.Empty(report.CreatedUsers)
+	s.Equal(api.Error, report.Rows[0].Status)
+
+	s.Equal(int64(0), s.count("users", "is_dummy = ?", true), "dummy-пользователи откатываются вместе со строкой")
+	s.Equal(int64(2), s.count("user_event", "event_id = ?", eventID), "участники мероприятия не меняются")
+}
+
+// TestImport_FinFlowRoundTrip тестирует импорт собственной выгрузки в другое мероприятие
+func (s *ImportSuite) TestImport_FinFlowRoundTrip() {
+	// Arrange - подготовка
+	s.createUsers()
+	sourceID := s.prepareEvent(TestEventID1)
+	targetID := s.prepareEvent(TestEventID2)
+
+	createResp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, sourceID, api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин",
+		Amount:   money.FromFloat(300),
+		FromUser: TestUserID1,
+		Type:     api.Equal,
+		Users:    []int64{TestUserID1, TestUserID2},
+	})
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, createResp.StatusCode(), "должен быть статус 201")
+
+	exportResp, err := s.APIClient.ExportEventWithResponse(s.Ctx, sourceID, &api.ExportEventParams{})
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, exportResp.StatusCode(), "должен быть статус 200")
+
+	// Act - действие
+	report := s.importCSV(targetID, string(exportResp.Body), false)
+
+	// Assert - проверка
+	s.Equal(api.Finflow, report.Format)
+	s.Empty(report.CreatedUsers, "все имена сопоставлены с участниками")
+	s.Equal(1, report.Imported)
+
+	analyticsResp, err := s.APIClient.GetEventAnalyticsWithResponse(s.Ctx, targetID, &api.GetEventAnalyticsParams{})
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().NotNil(analyticsResp.JSON200)
+	s.Equal(money.FromFloat(300), analyticsResp.JSON200.Total)
+	s.Require().Len(analyticsResp.JSON200.ByUser, 2)
+	s.Equal(money.FromFloat(150), analyticsResp.JSON200.ByUser[0].Net)
+}
@@ -0,0 +1,193 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// InviteSuite представляет suite для тестов приглашений в мероприятия
+type InviteSuite struct {
+	BaseSuite
+}
+
+// TestInviteSuite запускает все тесты в InviteSuite
+func TestInviteSuite(t *testing.T) {
+	suite.Run(t, new(InviteSuite))
+}
+
+// prepareEvent создает мероприятие, где первый пользователь - владелец.
+// Второй пользователь в БД не создается: он появится при синхронизации с ff-id.
+func (s *InviteSuite) prepareEvent() int64 {
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", nil)
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
+
+	// Пользователи созданы с явными ID - сдвигаем последовательность для новых пользователей
+	err := s.GetDB().Exec("SELECT setval('users_id_seq', (SELECT max(id) FROM users))").Error
+	s.Require().NoError(err)
+
+	return event.ID
+}
+
+// createTestInvite создает приглашение напрямую в БД
+func (s *InviteSuite) createTestInvite(eventID int64, token string, maxUses *int, uses int) {
+	err := s.GetDB().Exec(`
+		INSERT INTO event_invites (event_id, token, role, max_uses, uses)
+		VALUES ($1, $2, $3, $4, $5)
+	`, eventID, token, string(models.EventRoleMember), maxUses, uses).Error
+	s.Require().NoError(err, "не удалось создать тестовое приглашение")
+}
+
+// expectUser2Sync настраивает ответ ff-id для синхронизации второго пользователя
+func (s *InviteSuite) expectUser2Sync() {
+	s.FFIDMockServer.
+		Expect(http.MethodGet, "/api/v1/internal/users").
+		Return("ff_id_service/get_user_by_id_2_success.json")
+}
+
+// TestCreateInvite_AndJoin тестирует создание приглашения и присоединение по нему
+func (s *InviteSuite) TestCreateInvite_AndJoin() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	role := api.Viewer
+	maxUses := 1
+	expiresAt := time.Now().Add(24 * time.Hour)
+	createResp, err := s.APIClient.CreateEventInviteWithResponse(s.Ctx, eventID, api.CreateEventInviteJSONRequestBody{
+		Role:      &role,
+		MaxUses:   &maxUses,
+		ExpiresAt: &expiresAt,
+	})
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, createResp.StatusCode(), "должен быть статус 201")
+	s.Require().NotNil(createResp.JSON201)
+	s.Require().NotEmpty(createResp.JSON201.Token, "код приглашения должен быть заполнен")
+	s.expectUser2Sync()
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	resp, err := s.APIClient.JoinEventByInviteWithResponse(s.Ctx, createResp.JSON201.Token)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(resp.JSON200)
+	s.Equal(eventID, resp.JSON200.EventId)
+	s.Equal(api.Viewer, resp.JSON200.Role)
+
+	var nickname string
+	err = s.GetDB().Table("users").Select("nickname_cashed").Where("user_id = ?", TestUserID2).Scan(&nickname).Error
+	s.NoError(err)
+	s.Equal(TestNickname2, nickname, "профиль пользователя должен быть сохранен")
+	s.Equal(string(models.EventRoleViewer), s.getRole(resp.JSON200.UserId, eventID))
+
+	var uses int
+	err = s.GetDB().Table("event_invites").Select("uses").Where("id = ?", createResp.JSON201.Id).Scan(&uses).Error
+	s.NoError(err)
+	s.Equal(1, uses, "использование приглашения должно быть учтено")
+}
+
+// TestJoin_Exhausted тестирует отказ в присоединении по исчерпанному приглашению
+func (s *InviteSuite) TestJoin_Exhausted() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	maxUses := 1
+	s.createTestInvite(eventID, "exhausted", &maxUses, 1)
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	resp, err := s.APIClient.JoinEventByInviteWithResponse(s.Ctx, "exhausted")
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(400, resp.StatusCode(), "должен быть статус 400")
+
+	var count int64
+	err = s.GetDB().Table("user_event").Where("event_id = ?", eventID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(1), count, "пользователь не должен быть добавлен")
+}
+
+// TestJoin_AlreadyMember тестирует повторное присоединение участника
+func (s *InviteSuite) TestJoin_AlreadyMember() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	s.createTestInvite(eventID, "member-invite", nil, 0)
+	s.FFIDMockServer.
+		Expect(http.MethodGet, "/api/v1/internal/users").
+		Return("ff_id_service/get_user_by_id_success.json")
+
+	// Act - действие
+	resp, err := s.APIClient.JoinEventByInviteWithResponse(s.Ctx, "member-invite")
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(409, resp.StatusCode(), "должен быть статус 409")
+
+	var uses int
+	err = s.GetDB().Table("event_invites").Select("uses").Where("token = ?", "member-invite").Scan(&uses).Error
+	s.NoError(err)
+	s.Equal(0, uses, "неудачное присоединение не расходует приглашение")
+	s.Equal(string(models.EventRoleOwner), s.getRole(TestUserID1, eventID), "роль владельца не должна измениться")
+}
+
+// TestRevokeInvite_Success тестирует отзыв приглашения владельцем
+func (s *InviteSuite) TestRevokeInvite_Success() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	s.createTestInvite(eventID, "revoked", nil, 0)
+	s.createTestInvite(eventID, "kept", nil, 0)
+
+	// Act - действие
+	revokeResp, err := s.APIClient.RevokeEventInviteWithResponse(s.Ctx, eventID, 1)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	listResp, err := s.APIClient.GetEventInvitesWithResponse(s.Ctx, eventID)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.AuthUserID = TestUserID2
+	joinResp, err := s.APIClient.JoinEventByInviteWithResponse(s.Ctx, "revoked")
+	s.Require().NoError(err, "запрос должен выполниться")
+
+	// Assert - проверка
+	s.Require().Equal(200, revokeResp.StatusCode(), "должен быть статус 200")
+	s.Require().Equal(200, listResp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(listResp.JSON200)
+	s.Require().NotNil(listResp.JSON200.Invites)
+	s.Require().Len(*listResp.JSON200.Invites, 1, "в списке остается только действующее приглашение")
+	s.Equal("kept", (*listResp.JSON200.Invites)[0].Token)
+	s.Equal(400, joinResp.StatusCode(), "по отозванному приглашению присоединиться нельзя")
+}
+
+// TestCreateInvite_ByMember тестирует запрет на создание приглашения обычным участником
+func (s *InviteSuite) TestCreateInvite_ByMember() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user2.ID, eventID)
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	resp, err := s.APIClient.CreateEventInviteWithResponse(s.Ctx, eventID, api.CreateEventInviteJSONRequestBody{})
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+
+	var count int64
+	err = s.GetDB().Table("event_invites").Where("event_id = ?", eventID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(0), count, "приглашение не должно быть создано")
+}
+
+// getRole возвращает роль пользователя в мероприятии
+func (s *InviteSuite) getRole(userID, eventID int64) string {
+	var role string
+	err := s.GetDB().Table("user_event").Select("role").
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		Scan(&role).Error
+	s.NoError(err)
+	return role
+}
@@ -0,0 +1,186 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// LifecycleSuite представляет suite для тестов жизненного цикла мероприятия
+type LifecycleSuite struct {
+	BaseSuite
+}
+
+// TestLifecycleSuite запускает все тесты в LifecycleSuite
+func TestLifecycleSuite(t *testing.T) {
+	suite.Run(t, new(LifecycleSuite))
+}
+
+// prepareEvent создает мероприятие, где первый пользователь - владелец,
+// а второй - администратор
+func (s *LifecycleSuite) prepareEvent() int64 {
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", nil)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
+	s.addUserToEventWithRole(user2.ID, event.ID, models.EventRoleAdmin)
+
+	return event.ID
+}
+
+// setStatus устанавливает статус мероприятия напрямую в БД
+func (s *LifecycleSuite) setStatus(eventID int64, status string) {
+	err := s.GetDB().Exec("UPDATE events SET status = ? WHERE id = ?", status, eventID).Error
+	s.Require().NoError(err)
+}
+
+// getStatus возвращает статус мероприятия из БД
+func (s *LifecycleSuite) getStatus(eventID int64) string {
+	var status string
+	err := s.GetDB().Table("events").Select("status").Where("id = ?", eventID).Scan(&status).Error
+	s.Require().NoError(err)
+	return status
+}
+
+// newTransaction возвращает запрос на создание транзакции между участниками
+func (s *LifecycleSuite) newTransaction() api.CreateTransactionJSONRequestBody {
+	return api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин",
+		Amount:   money.FromFloat(TestAmount1),
+		FromUser: TestUserID1,
+		Type:     api.Equal,
+		Users:    []int64{TestUserID1, TestUserID2},
+	}
+}
+
+// TestSettle_FreezesTransactions тестирует запрет на создание транзакций на этапе расчетов
+func (s *LifecycleSuite) TestSettle_FreezesTransactions() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+
+	// Act - действие
+	settleResp, err := s.APIClient.SettleEventWithResponse(s.Ctx, eventID)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	createResp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, eventID, s.newTransaction())
+	s.Require().NoError(err, "запрос должен выполниться")
+
+	// Assert - проверка
+	s.Require().Equal(200, settleResp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(settleResp.JSON200)
+	s.Equal(api.Settling, *settleResp.JSON200.Status)
+	s.Equal(400, createResp.StatusCode(), "транзакции на этапе расчетов не принимаются")
+
+	var count int64
+	err = s.GetDB().Table("transactions").Where("event_id = ?", eventID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(0), count, "транзакция не должна быть создана")
+}
+
+// TestClose_ReturnsFrozenPlan тестирует закрытие мероприятия с фиксацией плана переводов
+func (s *LifecycleSuite) TestClose_ReturnsFrozenPlan() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	createResp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, eventID, s.newTransaction())
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, createResp.StatusCode(), "должен быть статус 200")
+
+	// Act - действие
+	resp, err := s.APIClient.CloseEventWithResponse(s.Ctx, eventID)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(resp.JSON200)
+	s.Require().NotNil(resp.JSON200.OptimizedDebts)
+	s.Require().Len(*resp.JSON200.OptimizedDebts, 1, "план должен содержать один перевод")
+	s.Equal(models.EventStatusClosed, s.getStatus(eventID))
+
+	optimizeResp, err := s.APIClient.OptimizeDebtsWithResponse(s.Ctx, eventID, nil)
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Equal(400, optimizeResp.StatusCode(), "план закрытого мероприятия не пересчитывается")
+}
+
+// TestArchive_HiddenFromList тестирует скрытие архивных мероприятий из списка
+func (s *LifecycleSuite) TestArchive_HiddenFromList() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	s.setStatus(eventID, models.EventStatusClosed)
+
+	// Act - действие
+	archiveResp, err := s.APIClient.ArchiveEventWithResponse(s.Ctx, eventID)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	listResp, err := s.APIClient.GetEventsWithResponse(s.Ctx, nil)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	includeArchived := true
+	fullListResp, err := s.APIClient.GetEventsWithResponse(s.Ctx, &api.GetEventsParams{IncludeArchived: &includeArchived})
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+
+	// Assert - проверка
+	s.Require().Equal(200, archiveResp.StatusCode(), "должен быть статус 200")
+	s.Equal(models.EventStatusArchived, s.getStatus(eventID))
+
+	s.Require().Equal(200, listResp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(listResp.JSON200)
+	if listResp.JSON200.Events != nil {
+		s.Empty(*listResp.JSON200.Events, "архивное мероприятие должно быть скрыто")
+	}
+
+	s.Require().Equal(200, fullListResp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(fullListResp.JSON200)
+	s.Require().NotNil(fullListResp.JSON200.Events)
+	s.Require().Len(*fullListResp.JSON200.Events, 1)
+	s.Equal(api.Archived, *(*fullListResp.JSON200.Events)[0].Status)
+}
+
+// TestArchive_FromActive тестирует запрет на архивирование незакрытого мероприятия
+func (s *LifecycleSuite) TestArchive_FromActive() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+
+	// Act - действие
+	resp, err := s.APIClient.ArchiveEventWithResponse(s.Ctx, eventID)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(400, resp.StatusCode(), "должен быть статус 400")
+	s.Equal(models.EventStatusActive, s.getStatus(eventID))
+}
+
+// TestReopen_ByOwner тестирует возобновление закрытого мероприятия владельцем
+func (s *LifecycleSuite) TestReopen_ByOwner() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	s.setStatus(eventID, models.EventStatusClosed)
+
+	// Act - действие
+	resp, err := s.APIClient.ReopenEventWithResponse(s.Ctx, eventID)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Equal(models.EventStatusActive, s.getStatus(eventID))
+
+	createResp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, eventID, s.newTransaction())
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Equal(200, createResp.StatusCode(), "возобновленное мероприятие принимает транзакции")
+}
+
+// TestReopen_ByAdmin тестирует запрет на возобновление мероприятия администратором
+func (s *LifecycleSuite) TestReopen_ByAdmin() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	s.setStatus(eventID, models.EventStatusClosed)
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	resp, err := s.APIClient.ReopenEventWithResponse(s.Ctx, eventID)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+	s.Equal(models.EventStatusClosed, s.getStatus(eventID))
+}
@@ -139,3 +139,242 @@ create index idx_optimized_debts_event_id on optimized_debts (event_id);
 create index idx_optimized_debts_from_user_id on optimized_debts (from_user_id);
 create index idx_optimized_debts_to_user_id on optimized_debts (to_user_id);
 
+
+-- Суммы, оплаченные пользователями в транзакции (несколько плательщиков)
+create table transaction_payers
+(
+    id             serial primary key,                               -- ID записи
+    transaction_id integer references transactions on delete cascade,-- Транзакция
+    user_id        bigint references users (id),                     -- Плательщик
+    amount         numeric(10, 2) not null,                          -- Оплаченная сумма
+    constraint uniq_tx_payer unique (transaction_id, user_id)        -- Один пользователь — одна оплата в одной транзакции
+);
+
+create index idx_transaction_payers_tx_id on transaction_payers (transaction_id);
+
+-- Позиции чека (построчное деление транзакции)
+create table transaction_items
+(
+    id             serial primary key,                               -- ID позиции
+    transaction_id integer references transactions on delete cascade,-- Транзакция
+    name           varchar(255)   not null,                          -- Название позиции
+    price          numeric(10, 2) not null,                          -- Цена за единицу
+    quantity       numeric(10, 3) not null default 1                 -- Количество
+);
+
+create index idx_transaction_items_tx_id on transaction_items (transaction_id);
+
+-- Потребители позиции чека (стоимость позиции делится между ними поровну)
+create table transaction_item_consumers
+(
+    id      serial primary key,                                      -- ID записи
+    item_id integer references transaction_items on delete cascade,  -- Позиция чека
+    user_id bigint references users (id),                            -- Пользователь-потребитель
+    constraint uniq_item_consumer unique (item_id, user_id)
+);
+
+create index idx_transaction_item_consumers_item_id on transaction_item_consumers (item_id);
+
+-- Общие надбавки чека (налог, чаевые, сервисный сбор), делятся пропорционально позициям
+create table transaction_charges
+(
+    id             serial primary key,                               -- ID надбавки
+    transaction_id integer references transactions on delete cascade,-- Транзакция
+    name           varchar(255)   not null,                          -- Название надбавки
+    amount         numeric(10, 2) not null                           -- Сумма надбавки
+);
+
+create index idx_transaction_charges_tx_id on transaction_charges (transaction_id);
+
+-- Базовая валюта мероприятия
+alter table events
+    add column currency varchar(3) not null default 'RUB';
+
+-- Валюта транзакции и курс к базовой валюте мероприятия на момент ввода
+alter table transactions
+    add column currency      varchar(3)     not null default 'RUB',
+    add column exchange_rate numeric(18, 8) not null default 1;
+
+-- Курсы валют, которые задаются вручную
+create table exchange_rates
+(
+    id            serial primary key,                  -- ID курса
+    currency_from varchar(3)     not null,             -- Исходная валюта
+    currency_to   varchar(3)     not null,             -- Целевая валюта
+    rate          numeric(18, 8) not null,             -- Сколько единиц currency_to стоит одна единица currency_from
+    updated_at    timestamp default CURRENT_TIMESTAMP, -- Время обновления курса
+    constraint uniq_exchange_rate unique (currency_from, currency_to)
+);
+
+-- Погашенная часть оптимизированного долга
+alter table optimized_debts
+    add column settled_amount numeric(10, 2) not null default 0,
+    add column status         varchar(16)    not null default 'pending';
+
+-- Погашения долгов: from_user_id вернул to_user_id сумму amount в базовой валюте мероприятия
+create table settlements
+(
+    id                serial primary key,                                     -- ID погашения
+    event_id          bigint         not null references events on delete cascade, -- Событие
+    from_user_id      bigint         not null references users (id),           -- Кто вернул деньги
+    to_user_id        bigint         not null references users (id),           -- Кому вернули деньги
+    amount            numeric(10, 2) not null check (amount > 0),              -- Сумма погашения
+    optimized_debt_id integer references optimized_debts on delete set null,   -- Погашаемый оптимизированный долг
+    comment           text,                                                    -- Комментарий
+    created_at        timestamp default CURRENT_TIMESTAMP                      -- Время создания
+);
+
+create index idx_settlements_event_id on settlements (event_id);
+create index idx_settlements_optimized_debt_id on settlements (optimized_debt_id);
+
+-- Признак устаревших оптимизированных долгов: выставляется при любом изменении долгов мероприятия
+alter table events
+    add column optimized_debts_outdated boolean not null default true;
+
+-- Алгоритм оптимизации долгов мероприятия (имя из реестра оптимизаторов ff-common)
+alter table events
+    add column optimization_algorithm varchar(32) not null default 'dinic';
+
+-- Роль участника в мероприятии: owner, admin, member, viewer
+alter table user_event
+    add column role varchar(16) not null default 'member'
+        check (role in ('owner', 'admin', 'member', 'viewer'));
+
+-- У мероприятия не больше одного владельца
+create unique index uniq_user_event_owner on user_event (event_id) where role = 'owner';
+
+-- Жизненный цикл мероприятия: active -> settling -> closed -> archived
+alter table events
+    drop constraint if exists events_status_check;
+
+alter table events
+    add constraint events_status_check
+        check (status in ('active', 'settling', 'closed', 'archived'));
+
+-- Приглашения в мероприятие по ссылке: присоединившийся получает роль role
+create table event_invites
+(
+    id         serial primary key,                                         -- ID приглашения
+    event_id   bigint      not null references events on delete cascade,   -- Событие
+    token      varchar(64) not null unique,                                -- Код приглашения
+    role       varchar(16) not null default 'member'
+        check (role in ('admin', 'member', 'viewer')),                      -- Роль присоединившегося
+    max_uses   integer check (max_uses > 0),                               -- Лимит использований
+    uses       integer     not null default 0,                             -- Число использований
+    expires_at timestamp,                                                  -- Срок действия
+    created_by bigint references users (id) on delete set null,           -- Кто создал приглашение
+    revoked_at timestamp,                                                  -- Время отзыва
+    created_at timestamp default CURRENT_TIMESTAMP                         -- Время создания
+);
+
+create index idx_event_invites_event_id on event_invites (event_id);
+
+-- Заявки участников на dummy-пользователей: после одобрения dummy объединяется с заявителем
+create table dummy_claims
+(
+    id               serial primary key,                                       -- ID заявки
+    event_id         bigint       not null references events on delete cascade, -- Событие
+    dummy_user_id    bigint references users (id) on delete set null,          -- Dummy-пользователь
+    dummy_name       varchar(100) not null,                                    -- Имя dummy-пользователя
+    claimant_user_id bigint       not null references users (id) on delete cascade, -- Заявитель
+    status           varchar(16)  not null default 'pending'
+        check (status in ('pending', 'approved', 'rejected')),                 -- Статус заявки
+    resolved_by      bigint references users (id) on delete set null,          -- Кто принял решение
+    resolved_at      timestamp,                                                -- Время решения
+    created_at       timestamp default CURRENT_TIMESTAMP                       -- Время подачи
+);
+
+create index idx_dummy_claims_event_id on dummy_claims (event_id);
+-- На одного dummy-пользователя может быть только одна нерассмотренная заявка
+create unique index uniq_dummy_claims_pending on dummy_claims (dummy_user_id) where status = 'pending';
+
+-- Шаблоны регулярных транзакций: повторы выпадают на start_date + k * interval_count периодов frequency
+create table recurring_transactions
+(
+    id          serial primary key,                                       -- ID шаблона
+    event_id    bigint      not null references events on delete cascade, -- Событие
+    frequency   varchar(16) not null
+        check (frequency in ('daily', 'weekly', 'monthly', 'yearly')),    -- Частота
+    interval_count integer  not null default 1 check (interval_count > 0), -- Каждые interval_count периодов
+    start_date  timestamp   not null,                                     -- Первый повтор
+    end_date    timestamp,                                                -- Последний возможный повтор
+    body        jsonb       not null,                                     -- Тело запроса на создание транзакции
+    paused      boolean     not null default false,                       -- Шаблон приостановлен
+    next_index  integer     not null default 0,                           -- Номер следующего повтора
+    next_run_at timestamp,                                                -- Время следующего повтора
+    created_by  bigint references users (id) on delete set null,         -- Кто создал шаблон
+    created_at  timestamp default CURRENT_TIMESTAMP,                      -- Время создания
+    updated_at  timestamp default CURRENT_TIMESTAMP                       -- Время обновления
+);
+
+create index idx_recurring_transactions_event_id on recurring_transactions (event_id);
+create index idx_recurring_transactions_next_run_at on recurring_transactions (next_run_at) where not paused;
+
+-- Созданные повторы регулярных транзакций
+create table recurring_occurrences
+(
+    id             serial primary key,                                                    -- ID повтора
+    recurring_id   integer   not null references recurring_transactions on delete cascade, -- Шаблон
+    scheduled_at   timestamp not null,                                                    -- Время повтора по расписанию
+    transaction_id integer references transactions on delete set null,                   -- Созданная транзакция
+    error          text,                                                                  -- Ошибка создания транзакции
+    created_at     timestamp default CURRENT_TIMESTAMP,                                   -- Время создания
+    constraint uniq_recurring_occurrence unique (recurring_id, scheduled_at)               -- Повтор создается один раз
+);
+
+-- Лента активности: тип события и его данные в JSON. Автор события хранится в user_id.
+-- Активности, которые записывает сам ff-split, создаются без иконки.
+alter table activities
+    add column type    varchar(32) not null default 'custom',
+    add column payload jsonb;
+
+create index idx_activities_event_feed on activities (event_id, id desc);
+
+-- Мягкое удаление транзакций и мероприятий: удаленные записи попадают в корзину
+-- и окончательно удаляются фоновой очисткой после срока хранения
+alter table transactions
+    add column deleted_at timestamp;
+
+alter table events
+    add column deleted_at timestamp;
+
+create index idx_transactions_deleted_at on transactions (deleted_at) where deleted_at is not null;
+create index idx_events_deleted_at on events (deleted_at) where deleted_at is not null;
+
+-- История изменений транзакций: неизменяемые ревизии с полным снимком транзакции
+-- и списком измененных полей относительно предыдущей ревизии
+create table transaction_revisions
+(
+    id             serial primary key,                                             -- ID ревизии
+    transaction_id integer     not null references transactions on delete cascade, -- Транзакция
+    event_id       bigint      not null,                                           -- Мероприятие транзакции
+    action         varchar(16) not null,                                           -- created | updated | deleted | restored | reverted
+    actor_id       bigint references users (id) on delete set null,                -- Автор изменения (внутренний ID)
+    reverted_from  integer references transaction_revisions (id),                  -- Ревизия, к которой откатили транзакцию
+    snapshot       jsonb       not null,                                           -- Транзакция с долями, оплатами, позициями и долгами
+    diff           jsonb,                                                          -- Измененные поля
+    created_at     timestamp default CURRENT_TIMESTAMP                             -- Время изменения
+);
+
+create index idx_transaction_revisions_tx on transaction_revisions (transaction_id, id);
+
+-- Вложения транзакций: фотографии чеков хранятся в ff-files, здесь только ID объектов
+create table transaction_attachments
+(
+    id             serial primary key,                                             -- ID вложения
+    transaction_id integer not null references transactions on delete cascade,     -- Транзакция
+    object_id      uuid    not null,                                               -- ID файла в ff-files
+    created_by     bigint references users (id) on delete set null,                -- Кто прикрепил файл (внутренний ID)
+    created_at     timestamp default CURRENT_TIMESTAMP,                             -- Время прикрепления
+    unique (transaction_id, object_id)
+);
+
+-- Версия долгов мероприятия: увеличивается вместе с признаком optimized_debts_outdated.
+-- План переводов сохраняется, только если версия не изменилась с момента чтения долгов
+alter table events
+    add column debts_version bigint not null default 0;
+
+-- История изменений переживает очистку корзины: ревизии не удаляются вместе с транзакцией
+-- и удаляются только при очистке всего мероприятия
+alter table transaction_revisions
+    drop constraint if exists transaction_revisions_transaction_id_fkey;
@@ -0,0 +1,191 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// RecurringSuite представляет suite для тестов регулярных транзакций
+type RecurringSuite struct {
+	BaseSuite
+}
+
+// TestRecurringSuite запускает все тесты в RecurringSuite
+func TestRecurringSuite(t *testing.T) {
+	suite.Run(t, new(RecurringSuite))
+}
+
+// prepareEvent создает мероприятие, где первый пользователь - владелец, а второй - наблюдатель
+func (s *RecurringSuite) prepareEvent() int64 {
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", nil)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
+	s.addUserToEventWithRole(user2.ID, event.ID, models.EventRoleViewer)
+
+	return event.ID
+}
+
+// newRecurringRequest возвращает запрос на ежедневную транзакцию, начиная с start
+func (s *RecurringSuite) newRecurringRequest(start time.Time) api.CreateRecurringTransactionJSONRequestBody {
+	return api.CreateRecurringTransactionJSONRequestBody{
+		Frequency: api.Daily,
+		StartDate: start,
+		Transaction: api.TransactionRequest{
+			Name:     "Интернет",
+			Amount:   money.FromFloat(TestAmount1),
+			FromUser: TestUserID1,
+			Type:     api.Equal,
+			Users:    []int64{TestUserID1, TestUserID2},
+		},
+	}
+}
+
+// createRecurring создает регулярную транзакцию через API и возвращает ее ID
+func (s *RecurringSuite) createRecurring(eventID int64, start time.Time) int {
+	resp, err := s.APIClient.CreateRecurringTransactionWithResponse(s.Ctx, eventID, s.newRecurringRequest(start))
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, resp.StatusCode(), "должен быть статус 201")
+	s.Require().NotNil(resp.JSON201)
+	return resp.JSON201.Id
+}
+
+// countTransactions возвращает число транзакций мероприятия
+func (s *RecurringSuite) countTransactions(eventID int64) int64 {
+	var count int64
+	err := s.GetDB().Table("transactions").Where("event_id = ?", eventID).Count(&count).Error
+	s.Require().NoError(err)
+	return count
+}
+
+// TestProcessDue_Idempotent тестирует создание наступивших повторов и отсутствие дублей при повторной обработке
+func (s *RecurringSuite) TestProcessDue_Idempotent() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	start := time.Now().Add(time.Hour).Truncate(time.Second)
+	s.createRecurring(eventID, start)
+	now := start.AddDate(0, 0, 2)
+
+	// Act - действие
+	created, err := s.Container.RecurringService.ProcessDue(context.Background(), now)
+	s.Require().NoError(err)
+	// Повторная обработка, как после перезапуска сервиса
+	createdAgain, err := s.Container.RecurringService.ProcessDue(context.Background(), now)
+	s.Require().NoError(err)
+
+	// Assert - проверка
+	s.Equal(3, created, "должны быть созданы повторы за три дня")
+	s.Equal(0, createdAgain, "повторная обработка не создает дублей")
+	s.Equal(int64(3), s.countTransactions(eventID))
+
+	var occurrences int64
+	err = s.GetDB().Table("recurring_occurrences").Where("transaction_id IS NOT NULL").Count(&occurrences).Error
+	s.NoError(err)
+	s.Equal(int64(3), occurrences, "каждый повтор должен ссылаться на транзакцию")
+
+	var datetimes []time.Time
+	err = s.GetDB().Table("transactions").Where("event_id = ?", eventID).Order("datetime").Pluck("datetime", &datetimes).Error
+	s.NoError(err)
+	s.Require().Len(datetimes, 3)
+	for i, datetime := range datetimes {
+		s.True(datetime.Equal(start.AddDate(0, 0, i)), "транзакция должна быть датирована временем повтора")
+	}
+
+	listResp, err := s.APIClient.GetRecurringTransactionsWithResponse(s.Ctx, eventID)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, listResp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(listResp.JSON200)
+	s.Require().NotNil(listResp.JSON200.Recurring)
+	s.Require().Len(*listResp.JSON200.Recurring, 1)
+	recurring := (*listResp.JSON200.Recurring)[0]
+	s.Require().NotNil(recurring.NextRunAt)
+	s.True(recurring.NextRunAt.Equal(start.AddDate(0, 0, 3)), "следующий повтор - четвертый день")
+	s.Equal("Интернет", recurring.Transaction.Name)
+}
+
+// TestPause_SkipsOccurrences тестирует, что приостановленный шаблон не создает транзакций
+func (s *RecurringSuite) TestPause_SkipsOccurrences() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	start := time.Now().Add(time.Hour).Truncate(time.Second)
+	id := s.createRecurring(eventID, start)
+
+	// Act - действие
+	pauseResp, err := s.APIClient.PauseRecurringTransactionWithResponse(s.Ctx, eventID, id)
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	created, err := s.Container.RecurringService.ProcessDue(context.Background(), start.AddDate(0, 0, 1))
+	s.Require().NoError(err)
+
+	// Assert - проверка
+	s.Require().Equal(200, pauseResp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(pauseResp.JSON200)
+	s.True(pauseResp.JSON200.Paused)
+	s.Equal(0, created, "приостановленный шаблон не создает транзакций")
+	s.Equal(int64(0), s.countTransactions(eventID))
+}
+
+// TestUpdateRecurring_Success тестирует изменение расписания и транзакции шаблона
+func (s *RecurringSuite) TestUpdateRecurring_Success() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	start := time.Now().Add(time.Hour).Truncate(time.Second)
+	id := s.createRecurring(eventID, start)
+	request := s.newRecurringRequest(start)
+	request.Frequency = api.Monthly
+	request.Transaction.Name = "Аренда"
+
+	// Act - действие
+	resp, err := s.APIClient.UpdateRecurringTransactionWithResponse(s.Ctx, eventID, id, request)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(resp.JSON200)
+	s.Equal(api.Monthly, resp.JSON200.Frequency)
+	s.Equal("Аренда", resp.JSON200.Transaction.Name)
+
+	var frequency string
+	err = s.GetDB().Table("recurring_transactions").Select("frequency").Where("id = ?", id).Scan(&frequency).Error
+	s.NoError(err)
+	s.Equal(models.RecurrenceMonthly, frequency)
+}
+
+// TestDeleteRecurring_Success тестирует удаление шаблона
+func (s *RecurringSuite) TestDeleteRecurring_Success() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	id := s.createRecurring(eventID, time.Now().Add(time.Hour))
+
+	// Act - действие
+	resp, err := s.APIClient.DeleteRecurringTransactionWithResponse(s.Ctx, eventID, id)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+
+	var count int64
+	err = s.GetDB().Table("recurring_transactions").Where("event_id = ?", eventID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(0), count, "шаблон должен быть удален")
+}
+
+// TestCreateRecurring_ByViewer тестирует запрет на создание шаблона наблюдателем
+func (s *RecurringSuite) TestCreateRecurring_ByViewer() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent()
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	resp, err := s.APIClient.CreateRecurringTransactionWithResponse(s.Ctx, eventID, s.newRecurringRequest(time.Now()))
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+}
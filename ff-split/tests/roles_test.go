@@ -0,0 +1,211 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// RolesSuite представляет suite для тестов ролей участников мероприятия
+type RolesSuite struct {
+	BaseSuite
+}
+
+// TestRolesSuite запускает все тесты в RolesSuite
+func TestRolesSuite(t *testing.T) {
+	suite.Run(t, new(RolesSuite))
+}
+
+// prepareEvent создает мероприятие, где первый пользователь - владелец,
+// второй - с указанной ролью, а третий - обычный участник
+func (s *RolesSuite) prepareEvent(secondRole models.EventRole) int64 {
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", nil)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	user3 := s.createTestUser(TestUserID3, TestUserID3, TestNickname3, TestName3)
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
+	s.addUserToEventWithRole(user2.ID, event.ID, secondRole)
+	s.addUserToEvent(user3.ID, event.ID)
+
+	return event.ID
+}
+
+// getRole возвращает роль пользователя в мероприятии
+func (s *RolesSuite) getRole(userID, eventID int64) string {
+	var role string
+	err := s.GetDB().Table("user_event").Select("role").
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		Scan(&role).Error
+	s.NoError(err)
+	return role
+}
+
+// TestViewer_CannotCreateTransaction тестирует запрет на создание транзакции наблюдателем
+func (s *RolesSuite) TestViewer_CannotCreateTransaction() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent(models.EventRoleViewer)
+	s.AuthUserID = TestUserID2
+	reqBody := api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин",
+		Amount:   money.FromFloat(TestAmount1),
+		FromUser: TestUserID2,
+		Type:     api.Equal,
+		Users:    []int64{TestUserID1, TestUserID2},
+	}
+
+	// Act - действие
+	resp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, eventID, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+
+	var count int64
+	err = s.GetDB().Table("transactions").Where("event_id = ?", eventID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(0), count, "транзакция не должна быть создана")
+}
+
+// TestViewer_CanRead тестирует, что наблюдатель может читать данные мероприятия
+func (s *RolesSuite) TestViewer_CanRead() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent(models.EventRoleViewer)
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	resp, err := s.APIClient.GetUsersByEventIDWithResponse(s.Ctx, eventID)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Require().NotNil(resp.JSON200)
+	s.Require().Len(*resp.JSON200.Users, 3)
+
+	roles := make(map[int64]api.EventRole)
+	for _, user := range *resp.JSON200.Users {
+		s.Require().NotNil(user.Role, "роль должна быть заполнена")
+		roles[*user.InternalId] = *user.Role
+	}
+	s.Equal(api.Owner, roles[TestUserID1])
+	s.Equal(api.Viewer, roles[TestUserID2])
+	s.Equal(api.Member, roles[TestUserID3])
+}
+
+// TestMember_CannotDeleteEvent тестирует запрет на удаление мероприятия участником
+func (s *RolesSuite) TestMember_CannotDeleteEvent() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent(models.EventRoleMember)
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	resp, err := s.APIClient.DeleteEventWithResponse(s.Ctx, eventID)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+
+	var count int64
+	err = s.GetDB().Table("events").Where("id = ?", eventID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(1), count, "мероприятие не должно быть удалено")
+}
+
+// TestAdmin_RemovesMember тестирует удаление участника администратором
+func (s *RolesSuite) TestAdmin_RemovesMember() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent(models.EventRoleAdmin)
+	s.AuthUserID = TestUserID2
+
+	// Act - действие
+	memberResp, err := s.APIClient.RemoveUserFromEventWithResponse(s.Ctx, eventID, TestUserID3)
+	s.Require().NoError(err, "запрос должен выполниться")
+	ownerResp, err := s.APIClient.RemoveUserFromEventWithResponse(s.Ctx, eventID, TestUserID1)
+	s.Require().NoError(err, "запрос должен выполниться")
+
+	// Assert - проверка
+	s.Equal(200, memberResp.StatusCode(), "администратор может удалить участника")
+	s.Equal(400, ownerResp.StatusCode(), "владельца удалить нельзя")
+	s.Equal(string(models.EventRoleOwner), s.getRole(TestUserID1, eventID))
+}
+
+// TestChangeRole_Success тестирует смену роли владельцем
+func (s *RolesSuite) TestChangeRole_Success() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent(models.EventRoleMember)
+	reqBody := api.UpdateEventUserRoleJSONRequestBody{Role: api.Viewer}
+
+	// Act - действие
+	resp, err := s.APIClient.UpdateEventUserRoleWithResponse(s.Ctx, eventID, TestUserID2, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Equal(string(models.EventRoleViewer), s.getRole(TestUserID2, eventID))
+}
+
+// TestChangeRole_ByAdmin тестирует запрет на смену ролей администратором
+func (s *RolesSuite) TestChangeRole_ByAdmin() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent(models.EventRoleAdmin)
+	s.AuthUserID = TestUserID2
+	reqBody := api.UpdateEventUserRoleJSONRequestBody{Role: api.Admin}
+
+	// Act - действие
+	resp, err := s.APIClient.UpdateEventUserRoleWithResponse(s.Ctx, eventID, TestUserID3, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+	s.Equal(string(models.EventRoleMember), s.getRole(TestUserID3, eventID))
+}
+
+// TestChangeRole_ToOwner тестирует запрет на назначение владельца сменой роли
+func (s *RolesSuite) TestChangeRole_ToOwner() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent(models.EventRoleMember)
+	reqBody := api.UpdateEventUserRoleJSONRequestBody{Role: api.Owner}
+
+	// Act - действие
+	resp, err := s.APIClient.UpdateEventUserRoleWithResponse(s.Ctx, eventID, TestUserID2, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(400, resp.StatusCode(), "должен быть статус 400")
+	s.Equal(string(models.EventRoleMember), s.getRole(TestUserID2, eventID))
+}
+
+// TestTransferOwnership_Success тестирует передачу владения
+func (s *RolesSuite) TestTransferOwnership_Success() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent(models.EventRoleMember)
+	reqBody := api.TransferEventOwnershipJSONRequestBody{UserId: TestUserID2}
+
+	// Act - действие
+	resp, err := s.APIClient.TransferEventOwnershipWithResponse(s.Ctx, eventID, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+	s.Equal(string(models.EventRoleOwner), s.getRole(TestUserID2, eventID), "новый владелец")
+	s.Equal(string(models.EventRoleAdmin), s.getRole(TestUserID1, eventID), "прежний владелец становится администратором")
+}
+
+// TestTransferOwnership_NotOwner тестирует запрет на передачу владения не владельцем
+func (s *RolesSuite) TestTransferOwnership_NotOwner() {
+	// Arrange - подготовка
+	eventID := s.prepareEvent(models.EventRoleAdmin)
+	s.AuthUserID = TestUserID2
+	reqBody := api.TransferEventOwnershipJSONRequestBody{UserId: TestUserID2}
+
+	// Act - действие
+	resp, err := s.APIClient.TransferEventOwnershipWithResponse(s.Ctx, eventID, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться")
+	s.Require().Equal(403, resp.StatusCode(), "должен быть статус 403")
+	s.Equal(string(models.EventRoleOwner), s.getRole(TestUserID1, eventID))
+}
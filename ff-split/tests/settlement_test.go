@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// SettlementSuite представляет suite для тестов погашения долгов
+type SettlementSuite struct {
+	BaseSuite
+}
+
+// TestSettlementSuite запускает все тесты в SettlementSuite
+func TestSettlementSuite(t *testing.T) {
+	suite.Run(t, new(SettlementSuite))
+}
+
+// prepareDebt создает мероприятие с долгом user2 перед user1 и возвращает их идентификаторы
+func (s *SettlementSuite) prepareDebt() (eventID, creditorID, debtorID int64) {
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	err := s.GetDB().Exec(`
+		INSERT INTO transactions (id, event_id, name, total_paid, payer_id, split_type)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, TestTransactionID1, event.ID, "Транзакция", TestAmount1, user1.ID, 0).Error
+	s.Require().NoError(err)
+
+	err = s.GetDB().Exec(`
+		INSERT INTO debts (transaction_id, from_user_id, to_user_id, amount)
+		VALUES ($1, $2, $3, $4)
+	`, TestTransactionID1, user2.ID, user1.ID, TestAmount2).Error
+	s.Require().NoError(err)
+
+	return event.ID, user1.ID, user2.ID
+}
+
+// TestCreateSettlement_PartialSettlesOptimizedDebt тестирует частичное погашение оптимизированного долга
+func (s *SettlementSuite) TestCreateSettlement_PartialSettlesOptimizedDebt() {
+	// Arrange - подготовка
+	eventID, creditorID, debtorID := s.prepareDebt()
+
+	optimizeResp, err := s.APIClient.OptimizeDebtsWithResponse(s.Ctx, eventID, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(200, optimizeResp.StatusCode())
+	s.Require().Len(*optimizeResp.JSON200.OptimizedDebts, 1)
+
+	// Act - действие
+	resp, err := s.APIClient.CreateSettlementWithResponse(s.Ctx, eventID, api.CreateSettlementJSONRequestBody{
+		FromUserId: debtorID,
+		ToUserId:   creditorID,
+		Amount:     money.FromFloat(TestAmount3),
+	})
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, resp.StatusCode(), "должен быть статус 201")
+	s.Require().NotNil(resp.JSON201)
+	s.Require().NotNil(resp.JSON201.OptimizedDebtId, "погашение должно быть связано с оптимизированным долгом")
+
+	debtsResp, err := s.APIClient.GetOptimizedDebtsByEventIDWithResponse(s.Ctx, eventID)
+	s.Require().NoError(err)
+	s.Require().Equal(200, debtsResp.StatusCode())
+	s.Require().Len(*debtsResp.JSON200.OptimizedDebts, 1)
+	debt := (*debtsResp.JSON200.OptimizedDebts)[0]
+	s.Equal(money.FromFloat(TestAmount3), *debt.SettledAmount)
+	s.Equal(api.OptimizedDebtDTOStatus("partial"), *debt.Status)
+
+	// Повторная оптимизация учитывает погашение
+	optimizeResp, err = s.APIClient.OptimizeDebtsWithResponse(s.Ctx, eventID, nil)
+	s.Require().NoError(err)
+	s.Require().Len(*optimizeResp.JSON200.OptimizedDebts, 1)
+	s.Equal(money.FromFloat(TestAmount2-TestAmount3), *(*optimizeResp.JSON200.OptimizedDebts)[0].Amount)
+}
+
+// TestCreateSettlement_FullRepaymentClearsDebts тестирует полное погашение долга
+func (s *SettlementSuite) TestCreateSettlement_FullRepaymentClearsDebts() {
+	// Arrange - подготовка
+	eventID, creditorID, debtorID := s.prepareDebt()
+
+	// Act - действие
+	resp, err := s.APIClient.CreateSettlementWithResponse(s.Ctx, eventID, api.CreateSettlementJSONRequestBody{
+		FromUserId: debtorID,
+		ToUserId:   creditorID,
+		Amount:     money.FromFloat(TestAmount2),
+	})
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Require().Equal(201, resp.StatusCode())
+
+	debtsResp, err := s.APIClient.GetDebtsByEventIDWithResponse(s.Ctx, eventID)
+	s.Require().NoError(err)
+	s.Require().Len(*debtsResp.JSON200.Debts, 1, "погашения не входят в список долгов")
+
+	settlementsResp, err := s.APIClient.GetSettlementsByEventIDWithResponse(s.Ctx, eventID)
+	s.Require().NoError(err)
+	s.Require().Len(*settlementsResp.JSON200.Settlements, 1)
+
+	optimizeResp, err := s.APIClient.OptimizeDebtsWithResponse(s.Ctx, eventID, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(200, optimizeResp.StatusCode())
+	s.Empty(*optimizeResp.JSON200.OptimizedDebts, "после полного погашения долгов не остается")
+}
+
+// TestCreateSettlement_SelfRepayment тестирует погашение самому себе
+func (s *SettlementSuite) TestCreateSettlement_SelfRepayment() {
+	// Arrange - подготовка
+	eventID, creditorID, _ := s.prepareDebt()
+
+	// Act - действие
+	resp, err := s.APIClient.CreateSettlementWithResponse(s.Ctx, eventID, api.CreateSettlementJSONRequestBody{
+		FromUserId: creditorID,
+		ToUserId:   creditorID,
+		Amount:     money.FromFloat(TestAmount3),
+	})
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Equal(400, resp.StatusCode(), "должен быть статус 400")
+}
+
+// TestDeleteSettlement_Success тестирует отмену погашения
+func (s *SettlementSuite) TestDeleteSettlement_Success() {
+	// Arrange - подготовка
+	eventID, creditorID, debtorID := s.prepareDebt()
+
+	createResp, err := s.APIClient.CreateSettlementWithResponse(s.Ctx, eventID, api.CreateSettlementJSONRequestBody{
+		FromUserId: debtorID,
+		ToUserId:   creditorID,
+		Amount:     money.FromFloat(TestAmount3),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(201, createResp.StatusCode())
+
+	// Act - действие
+	resp, err := s.APIClient.DeleteSettlementWithResponse(s.Ctx, eventID, createResp.JSON201.Id)
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Require().Equal(200, resp.StatusCode())
+
+	var count int64
+	err = s.GetDB().Table("settlements").Where("event_id = ?", eventID).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(0), count, "погашение должно быть удалено")
+}
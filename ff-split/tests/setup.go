@@ -27,7 +27,7 @@ type TestDBContainer struct {
 }
 
 // setupTestDB создает тестовую базу данных с использованием testcontainers
-func setupTestDB(t *testing.T) *TestDBContainer {
+func setupTestDB(t testing.TB) *TestDBContainer {
 	ctx := context.Background()
 
 	// Создаем PostgreSQL контейнер
@@ -63,7 +63,7 @@ func setupTestDB(t *testing.T) *TestDBContainer {
 }
 
 // teardownTestDB останавливает и удаляет тестовый контейнер
-func teardownTestDB(t *testing.T, container *TestDBContainer) {
+func teardownTestDB(t testing.TB, container *TestDBContainer) {
 	ctx := context.Background()
 	if container != nil && container.Container != nil {
 		// Очищаем данные из таблиц перед остановкой контейнера
@@ -128,6 +128,9 @@ func (s *TaskSuite) TestGetTaskByID_NotFound() {
 	category := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
 	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
 
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+
 	nonExistentID := 999
 
 	// Act - действие
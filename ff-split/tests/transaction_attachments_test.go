@@ -0,0 +1,279 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// TransactionAttachmentsSuite представляет suite для тестов вложений транзакций
+type TransactionAttachmentsSuite struct {
+	BaseSuite
+}
+
+// TestTransactionAttachmentsSuite запускает все тесты в TransactionAttachmentsSuite
+func TestTransactionAttachmentsSuite(t *testing.T) {
+	suite.Run(t, new(TransactionAttachmentsSuite))
+}
+
+const (
+	fileMetadataURL = "/api/v1/files/" + TestFileObjectID + "/metadata"
+	fileTempURL     = "/api/v1/files/" + TestFileObjectID + "/temporary-url"
+	fileURL         = "/api/v1/files/" + TestFileObjectID
+	fileDownloadURL = "http://ff-files.local/files/" + TestFileObjectID + "?token=temporary"
+)
+
+// createTransaction создает в мероприятии транзакцию на одного участника и возвращает ее ID
+func (s *TransactionAttachmentsSuite) createTransaction(eventID, userID int64) int {
+	resp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, eventID, api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин",
+		Amount:   money.FromFloat(TestAmount1),
+		FromUser: userID,
+		Type:     api.Equal,
+		Users:    []int64{userID},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(201, resp.StatusCode())
+	return *resp.JSON201.Id
+}
+
+// TestAttachments_AttachAndDetach тестирует прикрепление файла, ссылку в транзакции и открепление
+func (s *TransactionAttachmentsSuite) TestAttachments_AttachAndDetach() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+	transactionID := s.createTransaction(event.ID, user1.ID)
+
+	s.FFFilesMockServer.
+		Expect(http.MethodGet, fileMetadataURL).
+		Return("ff_files_service/get_file_metadata_success.json")
+	s.FFFilesMockServer.
+		Expect(http.MethodPost, fileTempURL).
+		Return("ff_files_service/generate_temporary_url_success.json")
+
+	// Act - действие: прикрепляем файл
+	attachResp, err := s.APIClient.AttachTransactionFileWithResponse(s.Ctx, event.ID, transactionID, api.AttachTransactionFileJSONRequestBody{
+		ObjectId: uuid.MustParse(TestFileObjectID),
+	})
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Require().Equal(201, attachResp.StatusCode())
+	attachment := attachResp.JSON201
+	s.Equal(TestFileObjectID, attachment.ObjectId.String())
+	s.Require().NotNil(attachment.Url)
+	s.Equal(fileDownloadURL, *attachment.Url)
+	s.Require().NotNil(attachment.CreatedBy)
+	s.Equal(user1.ID, *attachment.CreatedBy)
+
+	// Act - действие: вложение возвращается вместе с транзакцией без ссылки
+	getResp, err := s.APIClient.GetTransactionByIDWithResponse(s.Ctx, event.ID, transactionID)
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Require().Equal(200, getResp.StatusCode())
+	s.Require().NotNil(getResp.JSON200.Attachments)
+	s.Require().Len(*getResp.JSON200.Attachments, 1)
+	s.Equal(attachment.Id, (*getResp.JSON200.Attachments)[0].Id)
+	s.Nil((*getResp.JSON200.Attachments)[0].Url, "ссылка запрашивается отдельно для каждого вложения")
+
+	// Act - действие: запрашиваем ссылку на вложение
+	s.FFFilesMockServer.
+		Expect(http.MethodPost, fileTempURL).
+		Return("ff_files_service/generate_temporary_url_success.json")
+
+	urlResp, err := s.APIClient.GetTransactionAttachmentUrlWithResponse(s.Ctx, event.ID, transactionID, attachment.Id)
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Require().Equal(200, urlResp.StatusCode())
+	s.Require().NotNil(urlResp.JSON200.Url)
+	s.Equal(fileDownloadURL, *urlResp.JSON200.Url)
+
+	// Act - действие: открепляем файл
+	s.FFFilesMockServer.
+		Expect(http.MethodGet, fileMetadataURL).
+		Return("ff_files_service/get_file_metadata_success.json")
+	s.FFFilesMockServer.
+		Expect(http.MethodDelete, fileURL).
+		Return("ff_files_service/delete_file_success.json")
+
+	detachResp, err := s.APIClient.DetachTransactionFileWithResponse(s.Ctx, event.ID, transactionID, attachment.Id)
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Require().Equal(200, detachResp.StatusCode())
+
+	var count int64
+	s.Require().NoError(s.GetDB().Table("transaction_attachments").Where("transaction_id = ?", transactionID).Count(&count).Error)
+	s.Equal(int64(0), count, "вложение должно быть удалено")
+}
+
+// TestAttachments_ForeignFile тестирует запрет на прикрепление файла, загруженного другим пользователем
+func (s *TransactionAttachmentsSuite) TestAttachments_ForeignFile() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+	transactionID := s.createTransaction(event.ID, user1.ID)
+
+	s.FFFilesMockServer.
+		Expect(http.MethodGet, fileMetadataURL).
+		Return("ff_files_service/get_other_file_metadata_success.json")
+
+	// Act - действие
+	resp, err := s.APIClient.AttachTransactionFileWithResponse(s.Ctx, event.ID, transactionID, api.AttachTransactionFileJSONRequestBody{
+		ObjectId: uuid.MustParse(TestFileObjectID),
+	})
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Equal(403, resp.StatusCode())
+}
+
+// TestAttachments_DetachForeignFile тестирует открепление чужого файла без удаления из ff-files
+func (s *TransactionAttachmentsSuite) TestAttachments_DetachForeignFile() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+	transactionID := s.createTransaction(event.ID, user1.ID)
+
+	var attachmentID int
+	err := s.GetDB().Raw(`
+		INSERT INTO transaction_attachments (transaction_id, object_id, created_by) VALUES ($1, $2, $3) RETURNING id
+	`, transactionID, TestFileObjectID, user2.ID).Scan(&attachmentID).Error
+	s.Require().NoError(err)
+
+	// Файл загрузил второй пользователь: DELETE в ff-files не ожидается
+	s.FFFilesMockServer.
+		Expect(http.MethodGet, fileMetadataURL).
+		Return("ff_files_service/get_other_file_metadata_success.json")
+
+	// Act - действие
+	resp, err := s.APIClient.DetachTransactionFileWithResponse(s.Ctx, event.ID, transactionID, attachmentID)
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Require().Equal(200, resp.StatusCode())
+
+	var count int64
+	s.Require().NoError(s.GetDB().Table("transaction_attachments").Where("transaction_id = ?", transactionID).Count(&count).Error)
+	s.Equal(int64(0), count, "вложение должно быть откреплено")
+}
+
+// TestAttachments_FileNotFound тестирует прикрепление файла, которого нет в ff-files
+func (s *TransactionAttachmentsSuite) TestAttachments_FileNotFound() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+	transactionID := s.createTransaction(event.ID, user1.ID)
+
+	s.FFFilesMockServer.
+		Expect(http.MethodGet, fileMetadataURL).
+		HTTPCode(http.StatusNotFound).
+		Return("ff_files_service/file_not_found.json")
+
+	// Act - действие
+	resp, err := s.APIClient.AttachTransactionFileWithResponse(s.Ctx, event.ID, transactionID, api.AttachTransactionFileJSONRequestBody{
+		ObjectId: uuid.MustParse(TestFileObjectID),
+	})
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Equal(404, resp.StatusCode())
+}
+
+// TestAttachments_AlreadyAttached тестирует повторное прикрепление того же файла
+func (s *TransactionAttachmentsSuite) TestAttachments_AlreadyAttached() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+	transactionID := s.createTransaction(event.ID, user1.ID)
+
+	err := s.GetDB().Exec(`
+		INSERT INTO transaction_attachments (transaction_id, object_id, created_by) VALUES ($1, $2, $3)
+	`, transactionID, TestFileObjectID, user1.ID).Error
+	s.Require().NoError(err)
+
+	s.FFFilesMockServer.
+		Expect(http.MethodGet, fileMetadataURL).
+		Return("ff_files_service/get_file_metadata_success.json")
+
+	// Act - действие
+	resp, err := s.APIClient.AttachTransactionFileWithResponse(s.Ctx, event.ID, transactionID, api.AttachTransactionFileJSONRequestBody{
+		ObjectId: uuid.MustParse(TestFileObjectID),
+	})
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Equal(409, resp.StatusCode())
+}
+
+// TestAttachments_PurgeDeletesFiles тестирует удаление файлов из ff-files при очистке корзины
+func (s *TransactionAttachmentsSuite) TestAttachments_PurgeDeletesFiles() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+	transactionID := s.createTransaction(event.ID, user1.ID)
+
+	err := s.GetDB().Exec(`
+		INSERT INTO transaction_attachments (transaction_id, object_id, created_by) VALUES ($1, $2, $3)
+	`, transactionID, TestFileObjectID, user1.ID).Error
+	s.Require().NoError(err)
+
+	// Удаленная транзакция хранит вложения, пока лежит в корзине
+	deleteResp, err := s.APIClient.DeleteTransactionWithResponse(s.Ctx, event.ID, transactionID)
+	s.Require().NoError(err)
+	s.Require().Equal(200, deleteResp.StatusCode())
+
+	err = s.GetDB().Exec(`
+		UPDATE transactions SET deleted_at = now() - $1 * interval '1 hour' WHERE id = $2
+	`, int(TestTrashRetention.Hours())+1, transactionID).Error
+	s.Require().NoError(err)
+
+	s.FFFilesMockServer.
+		Expect(http.MethodDelete, fileURL).
+		Return("ff_files_service/delete_file_success.json")
+
+	// Act - действие
+	result, err := s.Container.TrashService.PurgeExpired(s.Ctx, time.Now())
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Equal(int64(1), result.Transactions)
+	s.Equal(1, result.Files)
+
+	var count int64
+	s.Require().NoError(s.GetDB().Table("transaction_attachments").Count(&count).Error)
+	s.Equal(int64(0), count, "вложения должны быть удалены вместе с транзакцией")
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// TransactionHistorySuite представляет suite для тестов истории изменений транзакций
+type TransactionHistorySuite struct {
+	BaseSuite
+}
+
+// TestTransactionHistorySuite запускает все тесты в TransactionHistorySuite
+func TestTransactionHistorySuite(t *testing.T) {
+	suite.Run(t, new(TransactionHistorySuite))
+}
+
+// TestTransactionHistory_UpdateAndRevert тестирует историю изменений транзакции и откат к ревизии
+func (s *TransactionHistorySuite) TestTransactionHistory_UpdateAndRevert() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	request := api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин",
+		Amount:   money.FromFloat(1500),
+		FromUser: user1.ID,
+		Type:     api.Equal,
+		Users:    []int64{user1.ID, user2.ID},
+	}
+	createResp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, request)
+	s.Require().NoError(err)
+	s.Require().Equal(201, createResp.StatusCode())
+	transactionID := *createResp.JSON201.Id
+
+	request.Amount = money.FromFloat(15000)
+	updateResp, err := s.APIClient.UpdateTransactionWithResponse(s.Ctx, event.ID, transactionID, request)
+	s.Require().NoError(err)
+	s.Require().Equal(200, updateResp.StatusCode())
+
+	// Act - действие: история после создания и изменения
+	historyResp, err := s.APIClient.GetTransactionHistoryWithResponse(s.Ctx, event.ID, transactionID)
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Require().Equal(200, historyResp.StatusCode())
+	revisions := historyResp.JSON200.Revisions
+	s.Require().Len(revisions, 2)
+
+	updated, created := revisions[0], revisions[1]
+	s.Equal(api.Updated, updated.Action)
+	s.Equal(api.Created, created.Action)
+	s.Require().NotNil(updated.ActorId, "должен быть указан автор изменения")
+	s.Equal(user1.ID, *updated.ActorId)
+	s.Equal(money.FromFloat(15000), *updated.Snapshot.Amount)
+	s.Equal(money.FromFloat(1500), *created.Snapshot.Amount)
+
+	fields := map[string]api.FieldChange{}
+	for _, change := range updated.Diff {
+		fields[change.Field] = change
+	}
+	s.Require().Contains(fields, "amount")
+	s.Equal(1500.0, fields["amount"].Old)
+	s.Equal(15000.0, fields["amount"].New)
+	s.Contains(fields, "shares")
+	s.Contains(fields, "debts")
+	s.NotContains(fields, "name", "неизмененные поля не должны попадать в изменения")
+
+	// Act - действие: откат к первой ревизии
+	revertResp, err := s.APIClient.RevertTransactionWithResponse(s.Ctx, event.ID, transactionID, created.Id)
+
+	// Assert - проверка: сумма, доли и долги вернулись
+	s.Require().NoError(err)
+	s.Require().Equal(200, revertResp.StatusCode())
+	s.Equal(money.FromFloat(1500), *revertResp.JSON200.Amount)
+
+	debtsResp, err := s.APIClient.GetDebtsByEventIDWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Require().Len(*debtsResp.JSON200.Debts, 1)
+	s.Equal(money.FromFloat(750), *(*debtsResp.JSON200.Debts)[0].Amount)
+
+	historyResp, err = s.APIClient.GetTransactionHistoryWithResponse(s.Ctx, event.ID, transactionID)
+	s.Require().NoError(err)
+	s.Require().Len(historyResp.JSON200.Revisions, 3)
+	reverted := historyResp.JSON200.Revisions[0]
+	s.Equal(api.Reverted, reverted.Action)
+	s.Require().NotNil(reverted.RevertedFrom)
+	s.Equal(created.Id, *reverted.RevertedFrom)
+}
+
+// TestTransactionHistory_DeleteAndRestore тестирует ревизии удаления и восстановления транзакции
+func (s *TransactionHistorySuite) TestTransactionHistory_DeleteAndRestore() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+
+	createResp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, api.CreateTransactionJSONRequestBody{
+		Name:     "Такси",
+		Amount:   money.FromFloat(TestAmount1),
+		FromUser: user1.ID,
+		Type:     api.Equal,
+		Users:    []int64{user1.ID},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(201, createResp.StatusCode())
+	transactionID := *createResp.JSON201.Id
+
+	deleteResp, err := s.APIClient.DeleteTransactionWithResponse(s.Ctx, event.ID, transactionID)
+	s.Require().NoError(err)
+	s.Require().Equal(200, deleteResp.StatusCode())
+
+	// Act - действие: история удаленной транзакции
+	historyResp, err := s.APIClient.GetTransactionHistoryWithResponse(s.Ctx, event.ID, transactionID)
+
+	// Assert - проверка: история доступна и для транзакции из корзины
+	s.Require().NoError(err)
+	s.Require().Equal(200, historyResp.StatusCode())
+	s.Require().Len(historyResp.JSON200.Revisions, 2)
+	s.Equal(api.Deleted, historyResp.JSON200.Revisions[0].Action)
+	s.Empty(historyResp.JSON200.Revisions[0].Diff, "удаление не меняет поля транзакции")
+
+	// Откатить транзакцию из корзины нельзя, сначала ее нужно восстановить
+	revertResp, err := s.APIClient.RevertTransactionWithResponse(s.Ctx, event.ID, transactionID, historyResp.JSON200.Revisions[1].Id)
+	s.Require().NoError(err)
+	s.Equal(404, revertResp.StatusCode())
+
+	restoreResp, err := s.APIClient.RestoreTransactionWithResponse(s.Ctx, event.ID, transactionID)
+	s.Require().NoError(err)
+	s.Require().Equal(200, restoreResp.StatusCode())
+
+	historyResp, err = s.APIClient.GetTransactionHistoryWithResponse(s.Ctx, event.ID, transactionID)
+	s.Require().NoError(err)
+	s.Require().Len(historyResp.JSON200.Revisions, 3)
+	s.Equal(api.Restored, historyResp.JSON200.Revisions[0].Action)
+}
+
+// TestTransactionHistory_NotFound тестирует историю транзакции другого мероприятия
+func (s *TransactionHistorySuite) TestTransactionHistory_NotFound() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+
+	// Act - действие
+	resp, err := s.APIClient.GetTransactionHistoryWithResponse(s.Ctx, event.ID, int(TestTransactionID1))
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Equal(404, resp.StatusCode())
+}
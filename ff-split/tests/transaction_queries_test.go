@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/config"
+	"github.com/ivasnev/FinFlow/ff-split/internal/service"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// queryCounter считает SQL-запросы, выполненные через gorm
+type queryCounter struct {
+	count atomic.Int64
+}
+
+// register подключает счетчик ко всем видам запросов gorm
+func (c *queryCounter) register(db *gorm.DB) error {
+	inc := func(*gorm.DB) { c.count.Add(1) }
+	callbacks := db.Callback()
+
+	if err := callbacks.Query().After("gorm:query").Register("tests:count_query", inc); err != nil {
+		return err
+	}
+	if err := callbacks.Row().After("gorm:row").Register("tests:count_row", inc); err != nil {
+		return err
+	}
+	if err := callbacks.Raw().After("gorm:raw").Register("tests:count_raw", inc); err != nil {
+		return err
+	}
+	if err := callbacks.Create().After("gorm:create").Register("tests:count_create", inc); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register("tests:count_update", inc); err != nil {
+		return err
+	}
+	return callbacks.Delete().After("gorm:delete").Register("tests:count_delete", inc)
+}
+
+// TransactionQueriesSuite проверяет число SQL-запросов при чтении транзакций
+type TransactionQueriesSuite struct {
+	BaseSuite
+	queries queryCounter
+}
+
+// TestTransactionQueriesSuite запускает все тесты в TransactionQueriesSuite
+func TestTransactionQueriesSuite(t *testing.T) {
+	suite.Run(t, new(TransactionQueriesSuite))
+}
+
+// SetupSuite подключает счетчик запросов к тестовой БД
+func (s *TransactionQueriesSuite) SetupSuite() {
+	s.BaseSuite.SetupSuite()
+	s.Require().NoError(s.queries.register(s.GetDB()), "не удалось подключить счетчик запросов")
+}
+
+// TestGetTransactionsByEventID_ConstantQueryCount проверяет, что число запросов к БД
+// при получении списка транзакций не растет вместе с количеством транзакций
+func (s *TransactionQueriesSuite) TestGetTransactionsByEventID_ConstantQueryCount() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	limit := 100
+	params := &api.GetTransactionsByEventIDParams{Limit: &limit}
+
+	var baseline int64
+	seeded := 0
+	for _, size := range []int{10, 50, 100} {
+		seedTransactions(s.T(), s.GetDB(), event.ID, user1.ID, user2.ID, seeded+1, size)
+		seeded = size
+
+		// Act - действие
+		s.queries.count.Store(0)
+		resp, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID, params)
+		queries := s.queries.count.Load()
+
+		// Assert - проверка
+		s.Require().NoError(err, "запрос должен выполниться успешно")
+		s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
+		s.Require().Len(*resp.JSON200.Transactions, size)
+
+		if baseline == 0 {
+			baseline = queries
+			continue
+		}
+		s.Equal(baseline, queries, "число запросов не должно зависеть от количества транзакций (%d)", size)
+	}
+}
+
+// BenchmarkListTransactions измеряет получение страницы транзакций мероприятия разного размера
+func BenchmarkListTransactions(b *testing.B) {
+	testDB := setupTestDB(b)
+	defer teardownTestDB(b, testDB)
+
+	var queries queryCounter
+	require.NoError(b, queries.register(testDB.DB), "не удалось подключить счетчик запросов")
+
+	c, err := createTestContainer(b, &config.Config{}, gin.New(), testDB.DB, &http.Client{})
+	require.NoError(b, err, "не удалось создать тестовый контейнер")
+
+	var payerID, debtorID, eventID int64
+	require.NoError(b, testDB.DB.Raw(`
+		INSERT INTO users (user_id, nickname_cashed, name_cashed) VALUES ($1, $2, $3) RETURNING id
+	`, TestUserID1, TestNickname1, TestName1).Scan(&payerID).Error)
+	require.NoError(b, testDB.DB.Raw(`
+		INSERT INTO users (user_id, nickname_cashed, name_cashed) VALUES ($1, $2, $3) RETURNING id
+	`, TestUserID2, TestNickname2, TestName2).Scan(&debtorID).Error)
+	require.NoError(b, testDB.DB.Raw(`
+		INSERT INTO events (name, description, status) VALUES ($1, 'Описание', 'active') RETURNING id
+	`, TestEventName1).Scan(&eventID).Error)
+
+	ctx := context.Background()
+	seeded := 0
+	for _, size := range []int{10, 50, 100} {
+		seedTransactions(b, testDB.DB, eventID, payerID, debtorID, seeded+1, size)
+		seeded = size
+
+		b.Run(fmt.Sprintf("transactions=%d", size), func(b *testing.B) {
+			queries.count.Store(0)
+			for i := 0; i < b.N; i++ {
+				page, err := c.TransactionService.ListTransactions(ctx, eventID, &service.TransactionListRequest{Limit: size})
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(page.Transactions) != size {
+					b.Fatalf("получено %d транзакций вместо %d", len(page.Transactions), size)
+				}
+			}
+			b.ReportMetric(float64(queries.count.Load())/float64(b.N), "queries/op")
+		})
+	}
+}
+
+// seedTransactions создает транзакции с номерами из диапазона [from, to] вместе с плательщиками, долями и долгами.
+// ID назначает база, каждая пятая транзакция распределяется по позициям чека
+func seedTransactions(t testing.TB, db *gorm.DB, eventID, payerID, debtorID int64, from, to int) {
+	err := db.Exec(`
+		WITH created AS (
+			INSERT INTO transactions (event_id, name, datetime, total_paid, payer_id, split_type)
+			SELECT $1, 'Расход ' || g, timestamp '2025-06-01' + g * interval '1 minute', 100, $2,
+			       CASE WHEN g % 5 = 0 THEN 4 ELSE 0 END
+			FROM generate_series($4::int, $5::int) g
+			RETURNING id, split_type
+		), payers AS (
+			INSERT INTO transaction_payers (transaction_id, user_id, amount)
+			SELECT id, $2, 100 FROM created
+		), shares AS (
+			INSERT INTO transaction_shares (transaction_id, user_id, value)
+			SELECT id, u, 50 FROM created, unnest(ARRAY[$2::bigint, $3::bigint]) u
+		), debts AS (
+			INSERT INTO debts (transaction_id, from_user_id, to_user_id, amount)
+			SELECT id, $3, $2, 50 FROM created
+		), charges AS (
+			INSERT INTO transaction_charges (transaction_id, name, amount)
+			SELECT id, 'Чаевые', 10 FROM created WHERE split_type = 4
+		), items AS (
+			INSERT INTO transaction_items (transaction_id, name, price, quantity)
+			SELECT id, 'Позиция ' || id, 90, 1 FROM created WHERE split_type = 4
+			RETURNING id
+		)
+		INSERT INTO transaction_item_consumers (item_id, user_id)
+		SELECT id, u FROM items, unnest(ARRAY[$2::bigint, $3::bigint]) u
+	`, eventID, payerID, debtorID, from, to).Error
+	require.NoError(t, err, "не удалось создать транзакции")
+}
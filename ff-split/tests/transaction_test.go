@@ -2,7 +2,9 @@ package tests
 
 import (
 	"testing"
+	"time"
 
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
 	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
 	"github.com/stretchr/testify/suite"
 )
@@ -42,7 +44,7 @@ func (s *TransactionSuite) TestCreateTransaction_Success() {
 	transactionCategoryID := transactionCategory.ID
 	reqBody := api.CreateTransactionJSONRequestBody{
 		Name:                  transactionName,
-		Amount:                TestAmount1,
+		Amount:                money.FromFloat(TestAmount1),
 		FromUser:              user1.ID,
 		Type:                  transactionType,
 		Users:                 []int64{user1.ID, user2.ID, user3.ID},
@@ -57,7 +59,7 @@ func (s *TransactionSuite) TestCreateTransaction_Success() {
 	if err == nil && resp.StatusCode() == 201 {
 		s.Require().NotNil(resp.JSON201, "транзакция должна быть создана")
 		s.Require().Equal(transactionName, *resp.JSON201.Name)
-		s.Require().Equal(TestAmount1, *resp.JSON201.Amount)
+		s.Require().Equal(money.FromFloat(TestAmount1), *resp.JSON201.Amount)
 		s.Require().Equal(user1.ID, *resp.JSON201.FromUser)
 
 		// Проверяем, что транзакция создана в БД
@@ -104,7 +106,7 @@ func (s *TransactionSuite) TestCreateTransaction_WithCustomPortions() {
 	}
 	reqBody := api.CreateTransactionJSONRequestBody{
 		Name:                  transactionName,
-		Amount:                TestAmount1,
+		Amount:                money.FromFloat(TestAmount1),
 		FromUser:              user1.ID,
 		Type:                  transactionType,
 		Users:                 []int64{user1.ID, user2.ID},
@@ -145,6 +147,347 @@ func (s *TransactionSuite) TestCreateTransaction_WithCustomPortions() {
 	}
 }
 
+// TestCreateTransaction_EqualSplit тестирует создание транзакции с делением поровну
+func (s *TransactionSuite) TestCreateTransaction_EqualSplit() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	user3 := s.createTestUser(TestUserID3, TestUserID3, TestNickname3, TestName3)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+	s.addUserToEvent(user3.ID, event.ID)
+
+	// user1 заплатил 1000, делим поровну на троих
+	reqBody := api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин поровну",
+		Amount:   money.FromFloat(TestAmount1),
+		FromUser: user1.ID,
+		Type:     api.Equal,
+		Users:    []int64{user1.ID, user2.ID, user3.ID},
+	}
+
+	// Act - действие
+	resp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, resp.StatusCode(), "должен быть статус 201")
+	s.Require().NotNil(resp.JSON201, "транзакция должна быть создана")
+	s.Require().Equal("equal", *resp.JSON201.Type)
+
+	// Тип распределения сохраняется как 0 (поровну)
+	var splitType int
+	err = s.GetDB().Table("transactions").Where("id = ?", resp.JSON201.Id).Select("split_type").Scan(&splitType).Error
+	s.NoError(err)
+	s.Equal(0, splitType)
+
+	// Доли в сумме дают ровно 1000, остаток копейки достается плательщику
+	s.Require().NotNil(resp.JSON201.Shares)
+	s.Require().Len(*resp.JSON201.Shares, 3, "должно быть 3 доли")
+	var total money.Money
+	for _, share := range *resp.JSON201.Shares {
+		if *share.UserId == user1.ID {
+			s.Equal(money.FromFloat(333.34), *share.Value)
+		} else {
+			s.Equal(money.FromFloat(333.33), *share.Value)
+		}
+		total += *share.Value
+	}
+	s.Equal(money.FromFloat(TestAmount1), total)
+
+	s.Require().NotNil(resp.JSON201.Debts)
+	s.Len(*resp.JSON201.Debts, 2, "должны быть долги user2 и user3")
+
+	// Повторное чтение возвращает тот же тип распределения
+	getResp, err := s.APIClient.GetTransactionByIDWithResponse(s.Ctx, event.ID, *resp.JSON201.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(200, getResp.StatusCode())
+	s.Equal("equal", *getResp.JSON200.Type)
+}
+
+// TestCreateTransaction_EqualSplitExcludePayer тестирует деление поровну без плательщика
+func (s *TransactionSuite) TestCreateTransaction_EqualSplitExcludePayer() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Gift", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Праздник", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	user3 := s.createTestUser(TestUserID3, TestUserID3, TestNickname3, TestName3)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+	s.addUserToEvent(user3.ID, event.ID)
+
+	// user1 купил подарок за 1000 и не участвует в разделе суммы
+	excludePayer := true
+	reqBody := api.CreateTransactionJSONRequestBody{
+		Name:         "Подарок",
+		Amount:       money.FromFloat(TestAmount1),
+		FromUser:     user1.ID,
+		Type:         api.Equal,
+		Users:        []int64{user1.ID, user2.ID, user3.ID},
+		ExcludePayer: &excludePayer,
+	}
+
+	// Act - действие
+	resp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, resp.StatusCode(), "должен быть статус 201")
+	s.Require().NotNil(resp.JSON201.Debts)
+	s.Require().Len(*resp.JSON201.Debts, 2, "должно быть 2 долга")
+	for _, debt := range *resp.JSON201.Debts {
+		s.Equal(user1.ID, *debt.ToUserId)
+		s.Equal(money.FromFloat(500), *debt.Amount)
+	}
+}
+
+// TestCreateTransaction_MultiplePayers тестирует создание транзакции с несколькими плательщиками
+func (s *TransactionSuite) TestCreateTransaction_MultiplePayers() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Hotel", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	user3 := s.createTestUser(TestUserID3, TestUserID3, TestNickname3, TestName3)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+	s.addUserToEvent(user3.ID, event.ID)
+
+	// user1 оплатил отель картой 600, user2 доплатил наличными 300, делим поровну на троих
+	payers := []api.PayerDTO{
+		{UserId: user1.ID, Amount: money.FromFloat(600)},
+		{UserId: user2.ID, Amount: money.FromFloat(300)},
+	}
+	reqBody := api.CreateTransactionJSONRequestBody{
+		Name:     "Отель",
+		Amount:   money.FromFloat(900),
+		FromUser: user1.ID,
+		Type:     api.TransactionRequestType("units"),
+		Users:    []int64{user1.ID, user2.ID, user3.ID},
+		Payers:   &payers,
+	}
+
+	// Act - действие
+	resp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, resp.StatusCode(), "должен быть статус 201")
+	s.Require().NotNil(resp.JSON201, "транзакция должна быть создана")
+	s.Require().NotNil(resp.JSON201.Payers)
+	s.Require().Len(*resp.JSON201.Payers, 2, "должно быть 2 плательщика")
+
+	// Проверяем, что оплаты сохранены в БД
+	var payersCount int64
+	err = s.GetDB().Table("transaction_payers").Where("transaction_id = ?", resp.JSON201.Id).Count(&payersCount).Error
+	s.NoError(err)
+	s.Equal(int64(2), payersCount, "должны быть сохранены оплаты двух плательщиков")
+
+	// user2 оплатил ровно свою долю, поэтому должен только user3 и только user1
+	s.Require().NotNil(resp.JSON201.Debts)
+	s.Require().Len(*resp.JSON201.Debts, 1, "должен быть 1 долг")
+	debt := (*resp.JSON201.Debts)[0]
+	s.Equal(user3.ID, *debt.FromUserId)
+	s.Equal(user1.ID, *debt.ToUserId)
+	s.Equal(money.FromFloat(300), *debt.Amount)
+}
+
+// TestCreateTransaction_PayersAmountMismatch тестирует отказ, если оплаты не покрывают сумму
+func (s *TransactionSuite) TestCreateTransaction_PayersAmountMismatch() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Hotel", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	payers := []api.PayerDTO{
+		{UserId: user1.ID, Amount: money.FromFloat(600)},
+		{UserId: user2.ID, Amount: money.FromFloat(100)},
+	}
+	reqBody := api.CreateTransactionJSONRequestBody{
+		Name:     "Отель",
+		Amount:   money.FromFloat(900),
+		FromUser: user1.ID,
+		Type:     api.TransactionRequestType("units"),
+		Users:    []int64{user1.ID, user2.ID},
+		Payers:   &payers,
+	}
+
+	// Act - действие
+	resp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.NotEqual(201, resp.StatusCode(), "транзакция не должна быть создана")
+
+	var count int64
+	err = s.GetDB().Table("transactions").Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(0), count, "транзакция не должна сохраниться в БД")
+}
+
+// TestCreateTransaction_Items тестирует создание транзакции по позициям чека и работу с позициями
+func (s *TransactionSuite) TestCreateTransaction_Items() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Ресторан", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	// user1 оплатил чек: пицца на двоих и чаевые 10
+	quantity := 2.0
+	items := []api.ItemRequest{
+		{Name: "Пицца", Price: money.FromFloat(30), Quantity: &quantity, Consumers: []int64{user1.ID, user2.ID}},
+	}
+	charges := []api.ChargeDTO{
+		{Name: "Чаевые", Amount: money.FromFloat(10)},
+	}
+	reqBody := api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин",
+		Amount:   money.FromFloat(70),
+		FromUser: user1.ID,
+		Type:     api.Items,
+		Users:    []int64{user1.ID, user2.ID},
+		Items:    &items,
+		Charges:  &charges,
+	}
+
+	// Act - создание транзакции
+	resp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, reqBody)
+
+	// Assert
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, resp.StatusCode(), "должен быть статус 201")
+	s.Require().NotNil(resp.JSON201.Items)
+	s.Require().Len(*resp.JSON201.Items, 1)
+	s.Require().NotNil(resp.JSON201.Debts)
+	s.Require().Len(*resp.JSON201.Debts, 1)
+	s.Equal(money.FromFloat(35), *(*resp.JSON201.Debts)[0].Amount)
+	transactionID := *resp.JSON201.Id
+
+	// Act - добавление позиции, которую ел только user2
+	itemResp, err := s.APIClient.CreateTransactionItemWithResponse(s.Ctx, event.ID, transactionID, api.ItemRequest{
+		Name:      "Вино",
+		Price:     money.FromFloat(40),
+		Consumers: []int64{user2.ID},
+	})
+
+	// Assert - сумма и долги пересчитаны, чаевые делятся пропорционально (30 и 70 из 100)
+	s.Require().NoError(err)
+	s.Require().Equal(201, itemResp.StatusCode(), "должен быть статус 201")
+	s.Equal(money.FromFloat(110), *itemResp.JSON201.Amount)
+	s.Require().Len(*itemResp.JSON201.Debts, 1)
+	s.Equal(user2.ID, *(*itemResp.JSON201.Debts)[0].FromUserId)
+	s.Equal(money.FromFloat(77), *(*itemResp.JSON201.Debts)[0].Amount)
+
+	listResp, err := s.APIClient.GetTransactionItemsWithResponse(s.Ctx, event.ID, transactionID)
+	s.Require().NoError(err)
+	s.Require().Equal(200, listResp.StatusCode())
+	s.Require().Len(*listResp.JSON200.Items, 2)
+	wineID := *(*listResp.JSON200.Items)[1].Id
+
+	// Act - удаление позиции
+	deleteResp, err := s.APIClient.DeleteTransactionItemWithResponse(s.Ctx, event.ID, transactionID, wineID)
+
+	// Assert - транзакция вернулась к исходной сумме
+	s.Require().NoError(err)
+	s.Require().Equal(200, deleteResp.StatusCode())
+	s.Equal(money.FromFloat(70), *deleteResp.JSON200.Amount)
+
+	var itemsCount int64
+	err = s.GetDB().Table("transaction_items").Where("transaction_id = ?", transactionID).Count(&itemsCount).Error
+	s.NoError(err)
+	s.Equal(int64(1), itemsCount)
+}
+
+// TestCreateTransaction_ForeignCurrency тестирует пересчет долгов транзакции в валюте, отличной от валюты мероприятия
+func (s *TransactionSuite) TestCreateTransaction_ForeignCurrency() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Travel", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	// Задаем курс евро к рублю — базовой валюте мероприятия по умолчанию
+	rateResp, err := s.APIClient.SetExchangeRateWithResponse(s.Ctx, api.SetExchangeRateJSONRequestBody{
+		CurrencyFrom: "eur",
+		CurrencyTo:   "RUB",
+		Rate:         100,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(200, rateResp.StatusCode())
+	s.Equal("EUR", rateResp.JSON200.CurrencyFrom)
+
+	currency := "EUR"
+	reqBody := api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин в Париже",
+		Amount:   money.FromFloat(50),
+		FromUser: user1.ID,
+		Type:     api.Equal,
+		Users:    []int64{user1.ID, user2.ID},
+		Currency: &currency,
+	}
+
+	// Act - действие
+	resp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, reqBody)
+
+	// Assert - проверка
+	s.Require().NoError(err, "запрос должен выполниться успешно")
+	s.Require().Equal(201, resp.StatusCode(), "должен быть статус 201")
+	s.Require().NotNil(resp.JSON201)
+	s.Equal("EUR", *resp.JSON201.Currency)
+	s.Equal(100.0, *resp.JSON201.ExchangeRate)
+	s.Equal(money.FromFloat(50), *resp.JSON201.Amount, "сумма остается в валюте транзакции")
+
+	// Долг пересчитан в рубли: 25 EUR × 100
+	s.Require().NotNil(resp.JSON201.Debts)
+	s.Require().Len(*resp.JSON201.Debts, 1)
+	s.Equal(money.FromFloat(2500), *(*resp.JSON201.Debts)[0].Amount)
+
+	// Курс фиксируется на момент ввода и не меняется при обновлении курса
+	_, err = s.APIClient.SetExchangeRateWithResponse(s.Ctx, api.SetExchangeRateJSONRequestBody{
+		CurrencyFrom: "EUR",
+		CurrencyTo:   "RUB",
+		Rate:         120,
+	})
+	s.Require().NoError(err)
+
+	debtsResp, err := s.APIClient.GetDebtsByEventIDWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(200, debtsResp.StatusCode())
+	s.Require().NotNil(debtsResp.JSON200.Debts)
+	s.Require().Len(*debtsResp.JSON200.Debts, 1)
+	s.Equal(money.FromFloat(2500), *(*debtsResp.JSON200.Debts)[0].Amount)
+
+	// Без курса транзакцию в неизвестной валюте создать нельзя
+	unknown := "USD"
+	reqBody.Currency = &unknown
+	resp, err = s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, reqBody)
+	s.Require().NoError(err)
+	s.Equal(404, resp.StatusCode(), "курс USD/RUB не задан")
+}
+
 // TestGetTransactionsByEventID_Success тестирует получение транзакций мероприятия
 func (s *TransactionSuite) TestGetTransactionsByEventID_Success() {
 	// Arrange - подготовка
@@ -171,7 +514,7 @@ func (s *TransactionSuite) TestGetTransactionsByEventID_Success() {
 	s.NoError(err)
 
 	// Act - действие
-	resp, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID)
+	resp, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID, nil)
 
 	// Assert - проверка
 	s.Require().NoError(err, "запрос должен выполниться успешно")
@@ -181,6 +524,139 @@ func (s *TransactionSuite) TestGetTransactionsByEventID_Success() {
 	s.Require().GreaterOrEqual(len(*resp.JSON200.Transactions), 2, "должно быть минимум 2 транзакции")
 }
 
+// TestGetTransactionsByEventID_FiltersAndPagination тестирует фильтры, сортировку и постраничную выдачу транзакций
+func (s *TransactionSuite) TestGetTransactionsByEventID_FiltersAndPagination() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	transactionCategory := s.createTestTransactionCategory(TestCategoryID2, "Еда", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	user3 := s.createTestUser(TestUserID3, TestUserID3, TestNickname3, TestName3)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+	s.addUserToEvent(user3.ID, event.ID)
+
+	// Транзакции идут по дням: Ужин (1000), Такси (500.50), Ужин в кафе (250.25)
+	transactions := []struct {
+		name       string
+		amount     float64
+		payerID    int64
+		categoryID *int
+		sharedWith []int64
+	}{
+		{"Ужин", TestAmount1, user1.ID, &transactionCategory.ID, []int64{user1.ID, user2.ID}},
+		{"Такси", TestAmount2, user2.ID, nil, []int64{user2.ID, user3.ID}},
+		{"Ужин в кафе", TestAmount3, user1.ID, &transactionCategory.ID, []int64{user1.ID, user3.ID}},
+	}
+	for i, tx := range transactions {
+		transactionID := i + 1
+		err := s.GetDB().Exec(`
+			INSERT INTO transactions (id, event_id, name, transaction_category_id, datetime, total_paid, payer_id, split_type)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`, transactionID, event.ID, tx.name, tx.categoryID, time.Date(2025, 6, 1+i, 12, 0, 0, 0, time.UTC), tx.amount, tx.payerID, 0).Error
+		s.Require().NoError(err)
+		for _, userID := range tx.sharedWith {
+			err := s.GetDB().Exec(`
+				INSERT INTO transaction_shares (transaction_id, user_id, value) VALUES ($1, $2, $3)
+			`, transactionID, userID, tx.amount/2).Error
+			s.Require().NoError(err)
+		}
+	}
+
+	names := func(resp *api.GetTransactionsByEventIDResponse) []string {
+		s.Require().Equal(200, resp.StatusCode(), string(resp.Body))
+		result := make([]string, 0, len(*resp.JSON200.Transactions))
+		for _, tx := range *resp.JSON200.Transactions {
+			result = append(result, *tx.Name)
+		}
+		return result
+	}
+
+	s.Run("постраничная выдача от новых к старым", func() {
+		limit := 2
+		firstPage, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID, &api.GetTransactionsByEventIDParams{Limit: &limit})
+		s.Require().NoError(err)
+		s.Equal([]string{"Ужин в кафе", "Такси"}, names(firstPage))
+		s.Require().NotNil(firstPage.JSON200.NextCursor)
+
+		secondPage, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID, &api.GetTransactionsByEventIDParams{
+			Limit:  &limit,
+			Cursor: firstPage.JSON200.NextCursor,
+		})
+		s.Require().NoError(err)
+		s.Equal([]string{"Ужин"}, names(secondPage))
+		s.Nil(secondPage.JSON200.NextCursor)
+	})
+
+	s.Run("сортировка по сумме", func() {
+		sort, order := api.TransactionSortAmount, api.Asc
+		resp, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID, &api.GetTransactionsByEventIDParams{
+			Sort:  &sort,
+			Order: &order,
+		})
+		s.Require().NoError(err)
+		s.Equal([]string{"Ужин в кафе", "Такси", "Ужин"}, names(resp))
+	})
+
+	s.Run("фильтры", func() {
+		q := "УЖИН"
+		from := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+		amountMin, amountMax := 300.0, 600.0
+		cases := map[string]struct {
+			params   api.GetTransactionsByEventIDParams
+			expected []string
+		}{
+			"поиск по названию": {api.GetTransactionsByEventIDParams{Q: &q}, []string{"Ужин в кафе", "Ужин"}},
+			"категория":         {api.GetTransactionsByEventIDParams{CategoryId: &transactionCategory.ID}, []string{"Ужин в кафе", "Ужин"}},
+			"плательщик":        {api.GetTransactionsByEventIDParams{PayerId: &user2.ID}, []string{"Такси"}},
+			"участник":          {api.GetTransactionsByEventIDParams{ParticipantId: &user3.ID}, []string{"Ужин в кафе", "Такси"}},
+			"диапазон сумм":     {api.GetTransactionsByEventIDParams{AmountMin: &amountMin, AmountMax: &amountMax}, []string{"Такси"}},
+			"период":            {api.GetTransactionsByEventIDParams{From: &from}, []string{"Ужин в кафе", "Такси"}},
+		}
+		for name, tc := range cases {
+			params := tc.params
+			resp, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID, &params)
+			s.Require().NoError(err, name)
+			s.Equal(tc.expected, names(resp), name)
+		}
+	})
+
+	s.Run("курсор другой сортировки", func() {
+		limit := 1
+		firstPage, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID, &api.GetTransactionsByEventIDParams{Limit: &limit})
+		s.Require().NoError(err)
+		s.Require().NotNil(firstPage.JSON200.NextCursor)
+
+		sort := api.TransactionSortAmount
+		resp, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID, &api.GetTransactionsByEventIDParams{
+			Sort:   &sort,
+			Cursor: firstPage.JSON200.NextCursor,
+		})
+		s.Require().NoError(err)
+		s.Equal(400, resp.StatusCode())
+	})
+
+	s.Run("курсор на транзакцию из корзины", func() {
+		limit := 1
+		firstPage, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID, &api.GetTransactionsByEventIDParams{Limit: &limit})
+		s.Require().NoError(err)
+		s.Require().NotNil(firstPage.JSON200.NextCursor)
+		s.Require().NotEmpty(*firstPage.JSON200.Transactions)
+
+		cursorID := (*firstPage.JSON200.Transactions)[0].Id
+		s.Require().NoError(s.GetDB().Exec(`UPDATE transactions SET deleted_at = now() WHERE id = $1`, cursorID).Error)
+
+		resp, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID, &api.GetTransactionsByEventIDParams{
+			Cursor: firstPage.JSON200.NextCursor,
+		})
+		s.Require().NoError(err)
+		s.Equal(400, resp.StatusCode())
+	})
+}
+
 // TestGetTransactionByID_Success тестирует получение транзакции по ID
 func (s *TransactionSuite) TestGetTransactionByID_Success() {
 	// Arrange - подготовка
@@ -208,6 +684,7 @@ func (s *TransactionSuite) TestGetTransactionByID_Success() {
 	s.Require().NotNil(resp.JSON200, "транзакция должна быть возвращена")
 	s.Require().Equal(int(TestTransactionID1), *resp.JSON200.Id)
 	s.Require().Equal(transactionName, *resp.JSON200.Name)
+	s.Require().Equal("equal", *resp.JSON200.Type, "split_type 0 означает деление поровну")
 }
 
 // TestUpdateTransaction_Success тестирует обновление транзакции
@@ -237,7 +714,7 @@ func (s *TransactionSuite) TestUpdateTransaction_Success() {
 	transactionCategoryID := transactionCategory.ID
 	reqBody := api.UpdateTransactionJSONRequestBody{
 		Name:                  newName,
-		Amount:                newAmount,
+		Amount:                money.FromFloat(newAmount),
 		FromUser:              user1.ID,
 		Type:                  transactionType,
 		Users:                 []int64{user1.ID, user2.ID},
@@ -252,7 +729,7 @@ func (s *TransactionSuite) TestUpdateTransaction_Success() {
 	if err == nil && resp.StatusCode() == 200 {
 		s.Require().NotNil(resp.JSON200, "обновленная транзакция должна быть возвращена")
 		s.Require().Equal(newName, *resp.JSON200.Name)
-		s.Require().Equal(newAmount, *resp.JSON200.Amount)
+		s.Require().Equal(money.FromFloat(newAmount), *resp.JSON200.Amount)
 	}
 }
 
@@ -281,11 +758,15 @@ func (s *TransactionSuite) TestDeleteTransaction_Success() {
 	s.Require().Equal(200, resp.StatusCode(), "должен быть статус 200")
 	s.Require().NotNil(resp.JSON200, "должен быть возвращен объект успеха")
 
-	// Проверяем, что транзакция удалена из БД
+	// Проверяем, что транзакция перенесена в корзину
 	var count int64
-	err = s.GetDB().Table("transactions").Where("id = ?", TestTransactionID1).Count(&count).Error
+	err = s.GetDB().Table("transactions").Where("id = ? AND deleted_at IS NULL", TestTransactionID1).Count(&count).Error
 	s.NoError(err)
-	s.Equal(int64(0), count, "транзакция должна быть удалена из БД")
+	s.Equal(int64(0), count, "транзакция должна быть удалена")
+
+	err = s.GetDB().Table("transactions").Where("id = ? AND deleted_at IS NOT NULL", TestTransactionID1).Count(&count).Error
+	s.NoError(err)
+	s.Equal(int64(1), count, "транзакция должна остаться в корзине")
 }
 
 // TestGetDebtsByEventID_Success тестирует получение долгов мероприятия
@@ -324,3 +805,99 @@ func (s *TransactionSuite) TestGetDebtsByEventID_Success() {
 	s.Require().NotNil(resp.JSON200.Debts)
 	s.Require().GreaterOrEqual(len(*resp.JSON200.Debts), 1, "должен быть минимум 1 долг")
 }
+
+// TestOptimizedDebts_RecalculatedAfterTransactionChanges тестирует пересчет оптимизированных долгов после изменения транзакций
+func (s *TransactionSuite) TestOptimizedDebts_RecalculatedAfterTransactionChanges() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	// user1 заплатил 1000 за двоих — user2 должен 500
+	createResp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин",
+		Amount:   money.FromFloat(TestAmount1),
+		FromUser: user1.ID,
+		Type:     api.Equal,
+		Users:    []int64{user1.ID, user2.ID},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(201, createResp.StatusCode())
+
+	debtsResp, err := s.APIClient.GetOptimizedDebtsByEventIDWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Require().Len(*debtsResp.JSON200.OptimizedDebts, 1)
+	s.Equal(money.FromFloat(TestAmount1/2), *(*debtsResp.JSON200.OptimizedDebts)[0].Amount)
+
+	// Act - действие: user2 добавляет свою транзакцию, user1 должен 250
+	_, err = s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, api.CreateTransactionJSONRequestBody{
+		Name:     "Такси",
+		Amount:   money.FromFloat(TestAmount2 - 0.5),
+		FromUser: user2.ID,
+		Type:     api.Equal,
+		Users:    []int64{user1.ID, user2.ID},
+	})
+	s.Require().NoError(err)
+
+	// Assert - проверка: оптимизированные долги пересчитаны без ручного вызова оптимизации
+	debtsResp, err = s.APIClient.GetOptimizedDebtsByEventIDWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Require().Len(*debtsResp.JSON200.OptimizedDebts, 1)
+	s.Equal(money.FromFloat(250), *(*debtsResp.JSON200.OptimizedDebts)[0].Amount)
+
+	// Удаление транзакции также помечает долги устаревшими
+	deleteResp, err := s.APIClient.DeleteTransactionWithResponse(s.Ctx, event.ID, *createResp.JSON201.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(200, deleteResp.StatusCode())
+
+	debtsResp, err = s.APIClient.GetOptimizedDebtsByEventIDWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Require().Len(*debtsResp.JSON200.OptimizedDebts, 1)
+	s.Equal(user1.ID, *(*debtsResp.JSON200.OptimizedDebts)[0].FromUserId)
+	s.Equal(money.FromFloat(250), *(*debtsResp.JSON200.OptimizedDebts)[0].Amount)
+}
+
+// TestOptimizeDebts_AlgorithmOverride тестирует выбор алгоритма оптимизации параметром запроса
+func (s *TransactionSuite) TestOptimizeDebts_AlgorithmOverride() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	_, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин",
+		Amount:   money.FromFloat(TestAmount1),
+		FromUser: user1.ID,
+		Type:     api.Equal,
+		Users:    []int64{user1.ID, user2.ID},
+	})
+	s.Require().NoError(err)
+
+	// Act - действие
+	algorithm := api.EdmondsKarp
+	resp, err := s.APIClient.OptimizeDebtsWithResponse(s.Ctx, event.ID, &api.OptimizeDebtsParams{Algorithm: &algorithm})
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Require().Equal(200, resp.StatusCode())
+	s.Equal(api.EdmondsKarp, *resp.JSON200.Algorithm)
+	s.Equal(1, *resp.JSON200.OriginalTransfers)
+	s.Equal(0, *resp.JSON200.RemovedTransfers)
+	s.Require().Len(*resp.JSON200.OptimizedDebts, 1)
+
+	// Без параметра используется алгоритм мероприятия
+	resp, err = s.APIClient.OptimizeDebtsWithResponse(s.Ctx, event.ID, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(200, resp.StatusCode())
+	s.Equal(api.Dinic, *resp.JSON200.Algorithm)
+}
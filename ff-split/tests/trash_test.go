@@ -0,0 +1,254 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ivasnev/FinFlow/ff-split/internal/common/money"
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
+	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// TrashSuite представляет suite для тестов корзины
+type TrashSuite struct {
+	BaseSuite
+}
+
+// TestTrashSuite запускает все тесты в TrashSuite
+func TestTrashSuite(t *testing.T) {
+	suite.Run(t, new(TrashSuite))
+}
+
+// TestTransactionTrash_DeleteAndRestore тестирует перенос транзакции в корзину и ее восстановление
+func (s *TrashSuite) TestTransactionTrash_DeleteAndRestore() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
+	s.addUserToEvent(user2.ID, event.ID)
+
+	createResp, err := s.APIClient.CreateTransactionWithResponse(s.Ctx, event.ID, api.CreateTransactionJSONRequestBody{
+		Name:     "Ужин",
+		Amount:   money.FromFloat(TestAmount1),
+		FromUser: user1.ID,
+		Type:     api.Equal,
+		Users:    []int64{user1.ID, user2.ID},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(201, createResp.StatusCode())
+	transactionID := *createResp.JSON201.Id
+	balanceBefore := s.eventBalance(event.ID)
+	s.Require().NotZero(balanceBefore, "баланс должен учитывать транзакцию")
+
+	// Act - действие: удаление
+	deleteResp, err := s.APIClient.DeleteTransactionWithResponse(s.Ctx, event.ID, transactionID)
+	s.Require().NoError(err)
+	s.Require().Equal(200, deleteResp.StatusCode())
+
+	// Assert - проверка: транзакция пропала из списка, долгов и баланса, но есть в корзине
+	listResp, err := s.APIClient.GetTransactionsByEventIDWithResponse(s.Ctx, event.ID, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(200, listResp.StatusCode())
+	s.Empty(*listResp.JSON200.Transactions, "удаленная транзакция не должна попадать в список")
+
+	debtsResp, err := s.APIClient.GetDebtsByEventIDWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(200, debtsResp.StatusCode())
+	if debtsResp.JSON200.Debts != nil {
+		s.Empty(*debtsResp.JSON200.Debts, "долги удаленной транзакции не должны учитываться")
+	}
+	s.Zero(s.eventBalance(event.ID), "удаленная транзакция не должна влиять на баланс")
+
+	trashResp, err := s.APIClient.GetEventTrashWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(200, trashResp.StatusCode())
+	s.Require().Len(trashResp.JSON200.Transactions, 1)
+	s.Equal(transactionID, *trashResp.JSON200.Transactions[0].Id)
+	s.NotNil(trashResp.JSON200.Transactions[0].DeletedAt, "должно быть указано время удаления")
+
+	// Act - действие: восстановление
+	restoreResp, err := s.APIClient.RestoreTransactionWithResponse(s.Ctx, event.ID, transactionID)
+
+	// Assert - проверка: транзакция восстановлена вместе с долями и долгами
+	s.Require().NoError(err)
+	s.Require().Equal(200, restoreResp.StatusCode())
+	s.Nil(restoreResp.JSON200.DeletedAt)
+	s.Require().NotNil(restoreResp.JSON200.Shares)
+	s.Len(*restoreResp.JSON200.Shares, 2)
+	s.Equal(balanceBefore, s.eventBalance(event.ID), "баланс должен вернуться после восстановления")
+
+	trashResp, err = s.APIClient.GetEventTrashWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Empty(trashResp.JSON200.Transactions, "корзина должна быть пуста после восстановления")
+
+	activitiesResp, err := s.APIClient.GetActivitiesByEventIDWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(*activitiesResp.JSON200.Activities)
+	s.Equal(api.TransactionRestored, *(*activitiesResp.JSON200.Activities)[0].Type)
+}
+
+// TestRestoreTransaction_NotInTrash тестирует восстановление транзакции, которой нет в корзине
+func (s *TrashSuite) TestRestoreTransaction_NotInTrash() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+
+	err := s.GetDB().Exec(`
+		INSERT INTO transactions (id, event_id, name, total_paid, payer_id, split_type)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, TestTransactionID1, event.ID, "Активная транзакция", TestAmount1, user1.ID, 0).Error
+	s.Require().NoError(err)
+
+	// Act - действие
+	resp, err := s.APIClient.RestoreTransactionWithResponse(s.Ctx, event.ID, int(TestTransactionID1))
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Equal(404, resp.StatusCode(), "восстановить можно только транзакцию из корзины")
+}
+
+// TestEventTrash_DeleteAndRestore тестирует перенос мероприятия в корзину и его восстановление
+func (s *TrashSuite) TestEventTrash_DeleteAndRestore() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Travel", TestRequestID)
+	category := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
+
+	// Act - действие: удаление
+	deleteResp, err := s.APIClient.DeleteEventWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(200, deleteResp.StatusCode())
+
+	// Assert - проверка: мероприятие недоступно, но есть в корзине пользователя
+	getResp, err := s.APIClient.GetEventByIDWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Equal(404, getResp.StatusCode(), "удаленное мероприятие не должно быть доступно")
+
+	eventsResp, err := s.APIClient.GetEventsWithResponse(s.Ctx, nil)
+	s.Require().NoError(err)
+	s.Empty(*eventsResp.JSON200.Events, "удаленное мероприятие не должно попадать в список")
+
+	trashResp, err := s.APIClient.GetDeletedEventsWithResponse(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(200, trashResp.StatusCode())
+	s.Require().Len(*trashResp.JSON200.Events, 1)
+	s.Equal(event.ID, *(*trashResp.JSON200.Events)[0].Id)
+	s.NotNil((*trashResp.JSON200.Events)[0].DeletedAt)
+
+	// Act - действие: восстановление
+	restoreResp, err := s.APIClient.RestoreEventWithResponse(s.Ctx, event.ID)
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Require().Equal(200, restoreResp.StatusCode())
+	s.Equal(event.ID, *restoreResp.JSON200.Id)
+	s.Nil(restoreResp.JSON200.DeletedAt)
+
+	getResp, err = s.APIClient.GetEventByIDWithResponse(s.Ctx, event.ID)
+	s.Require().NoError(err)
+	s.Equal(200, getResp.StatusCode(), "восстановленное мероприятие должно быть доступно")
+}
+
+// TestRestoreEvent_NotOwner тестирует восстановление мероприятия участником без прав владельца
+func (s *TrashSuite) TestRestoreEvent_NotOwner() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Travel", TestRequestID)
+	category := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+
+	err := s.GetDB().Exec(`UPDATE events SET deleted_at = now() WHERE id = $1`, event.ID).Error
+	s.Require().NoError(err)
+
+	// Act - действие
+	resp, err := s.APIClient.RestoreEventWithResponse(s.Ctx, event.ID)
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Equal(403, resp.StatusCode(), "восстановить мероприятие может только владелец")
+}
+
+// TestPurgeExpired тестирует окончательное удаление записей после срока хранения
+func (s *TrashSuite) TestPurgeExpired() {
+	// Arrange - подготовка
+	icon := s.createTestIcon(TestIconID1, "Food", TestRequestID)
+	eventCategory := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
+	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &eventCategory.ID)
+	deletedEvent := s.createTestEvent(TestEventID2, TestEventName2, "Описание", &eventCategory.ID)
+
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEvent(user1.ID, event.ID)
+	s.addUserToEvent(user1.ID, deletedEvent.ID)
+
+	err := s.GetDB().Exec(`
+		INSERT INTO transactions (id, event_id, name, total_paid, payer_id, split_type, deleted_at)
+		VALUES ($1, $2, 'Старая', 100, $3, 0, now() - $4 * interval '1 hour'),
+		       ($5, $2, 'Свежая', 100, $3, 0, now())
+	`, TestTransactionID1, event.ID, user1.ID, int(TestTrashRetention.Hours())+1, TestTransactionID2).Error
+	s.Require().NoError(err)
+
+	err = s.GetDB().Exec(`
+		INSERT INTO transactions (id, event_id, name, total_paid, payer_id, split_type)
+		VALUES ($1, $2, 'Мероприятия в корзине', 100, $3, 0)
+	`, TestTransactionID3, deletedEvent.ID, user1.ID).Error
+	s.Require().NoError(err)
+
+	err = s.GetDB().Exec(`
+		INSERT INTO transaction_revisions (transaction_id, event_id, action, snapshot)
+		VALUES ($1, $2, 'created', '{}'), ($3, $4, 'created', '{}')
+	`, TestTransactionID1, event.ID, TestTransactionID3, deletedEvent.ID).Error
+	s.Require().NoError(err)
+
+	err = s.GetDB().Exec(`
+		UPDATE events SET deleted_at = now() - $1 * interval '1 hour' WHERE id = $2
+	`, int(TestTrashRetention.Hours())+1, deletedEvent.ID).Error
+	s.Require().NoError(err)
+
+	// Act - действие
+	result, err := s.Container.TrashService.PurgeExpired(s.Ctx, time.Now())
+
+	// Assert - проверка
+	s.Require().NoError(err)
+	s.Equal(int64(1), result.Transactions)
+	s.Equal(1, result.Events)
+
+	var count int64
+	s.Require().NoError(s.GetDB().Table("transactions").Where("event_id = ?", event.ID).Count(&count).Error)
+	s.Equal(int64(1), count, "в корзине должна остаться только свежая транзакция")
+
+	s.Require().NoError(s.GetDB().Table("events").Where("id = ?", deletedEvent.ID).Count(&count).Error)
+	s.Equal(int64(0), count, "мероприятие должно быть удалено окончательно")
+
+	s.Require().NoError(s.GetDB().Table("transaction_revisions").Where("transaction_id = ?", TestTransactionID1).Count(&count).Error)
+	s.Equal(int64(1), count, "история очищенной транзакции должна сохраниться")
+
+	s.Require().NoError(s.GetDB().Table("transaction_revisions").Where("event_id = ?", deletedEvent.ID).Count(&count).Error)
+	s.Equal(int64(0), count, "история удаляется вместе с мероприятием")
+}
+
+// eventBalance возвращает баланс текущего пользователя в мероприятии
+func (s *TrashSuite) eventBalance(eventID int64) money.Money {
+	resp, err := s.APIClient.GetEventsWithResponse(s.Ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(200, resp.StatusCode())
+	for _, event := range *resp.JSON200.Events {
+		if *event.Id == eventID && event.Balance != nil {
+			return *event.Balance
+		}
+	}
+	return 0
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/ivasnev/FinFlow/ff-split/internal/models"
 	"github.com/ivasnev/FinFlow/ff-split/pkg/api"
 	"github.com/stretchr/testify/suite"
 )
@@ -52,15 +53,16 @@ func (s *UserSuite) TestAddUsersToEvent_Success() {
 	category := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
 	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
 
-	// Создаем пользователей (но не добавляем к мероприятию)
+	// Текущий пользователь состоит в мероприятии, второго добавляем запросом
 	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
 	user2 := s.createTestUser(TestUserID2, TestUserID2, TestNickname2, TestName2)
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
 
 	// В этом тесте НЕ нужен мок для ff-id, так как пользователи уже существуют в локальной БД
 
 	// Подготавливаем запрос
 	reqBody := api.AddUsersToEventJSONRequestBody{
-		UserIds: []int64{*user1.UserID, *user2.UserID},
+		UserIds: []int64{*user2.UserID},
 	}
 
 	// Act - действие
@@ -75,7 +77,7 @@ func (s *UserSuite) TestAddUsersToEvent_Success() {
 	var count int64
 	err = s.GetDB().Table("user_event").Where("event_id = ?", event.ID).Count(&count).Error
 	s.NoError(err)
-	s.Equal(int64(2), count, "должно быть добавлено 2 пользователя")
+	s.Equal(int64(2), count, "в мероприятии должно быть 2 пользователя")
 }
 
 // TestRemoveUserFromEvent_Success тестирует удаление пользователя из мероприятия
@@ -115,6 +117,9 @@ func (s *UserSuite) TestAddUsersToEvent_UserNotFound() {
 	category := s.createTestEventCategory(TestCategoryID1, "Путешествие", icon.ID)
 	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
 
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
+
 	nonExistentUserID := int64(999)
 
 	// Подготавливаем запрос
@@ -134,7 +139,7 @@ func (s *UserSuite) TestAddUsersToEvent_UserNotFound() {
 	var count int64
 	err = s.GetDB().Table("user_event").Where("event_id = ?", event.ID).Count(&count).Error
 	s.NoError(err)
-	s.Equal(int64(0), count, "пользователь не должен быть добавлен")
+	s.Equal(int64(1), count, "пользователь не должен быть добавлен")
 }
 
 // TestGetUsersByExternalIDs_WithSync тестирует получение пользователей по внешним ID с синхронизацией
@@ -239,6 +244,9 @@ func (s *UserSuite) TestCreateDummyUser_Success() {
 	category := s.createTestEventCategory(TestCategoryID1, "Вечеринка", icon.ID)
 	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
 
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
+	s.addUserToEventWithRole(user1.ID, event.ID, models.EventRoleOwner)
+
 	// Подготавливаем запрос
 	dummyNickname := "Гость 1"
 	reqBody := api.CreateDummyUserJSONRequestBody{
@@ -270,13 +278,13 @@ func (s *UserSuite) TestGetDummiesByEventID_Success() {
 	event := s.createTestEvent(TestEventID1, TestEventName1, "Описание", &category.ID)
 
 	// Создаем dummy-пользователей и добавляем к мероприятию
-	dummy1 := s.createTestDummyUser(TestUserID1, "Гость 1", "Гость Один")
-	dummy2 := s.createTestDummyUser(TestUserID2, "Гость 2", "Гость Два")
+	dummy1 := s.createTestDummyUser(TestUserID2, "Гость 1", "Гость Один")
+	dummy2 := s.createTestDummyUser(TestUserID3, "Гость 2", "Гость Два")
 	s.addUserToEvent(dummy1.ID, event.ID)
 	s.addUserToEvent(dummy2.ID, event.ID)
 
 	// Создаем также обычного пользователя (не должен попасть в результат)
-	user1 := s.createTestUser(TestUserID3, TestUserID3, TestNickname1, TestName1)
+	user1 := s.createTestUser(TestUserID1, TestUserID1, TestNickname1, TestName1)
 	s.addUserToEvent(user1.ID, event.ID)
 
 	// Act - действие